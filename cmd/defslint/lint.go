@@ -0,0 +1,477 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"origin/internal/builddefs"
+	"origin/internal/craftdefs"
+	"origin/internal/game"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/skilldefs"
+	"origin/internal/tiledefs"
+)
+
+// requiredObjectKeys are looked up by key from server code.
+var requiredObjectKeys = []string{"player", "player_death", "build"}
+
+type severity int
+
+const (
+	severityError severity = iota
+	severityWarning
+)
+
+func (s severity) String() string {
+	if s == severityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+type finding struct {
+	Severity severity
+	Catalog  string // items, objects, crafts, builds, processes, skills, tiles
+	Key      string
+	Message  string
+}
+
+func (f finding) String() string {
+	if f.Key == "" {
+		return fmt.Sprintf("%s: %s: %s", f.Severity, f.Catalog, f.Message)
+	}
+	return fmt.Sprintf("%s: %s/%s: %s", f.Severity, f.Catalog, f.Key, f.Message)
+}
+
+// defsCatalog is the full set of definitions loaded the same way the server loads them.
+type defsCatalog struct {
+	items     *itemdefs.Registry
+	objects   *objectdefs.Registry
+	crafts    *craftdefs.Registry
+	builds    *builddefs.Registry
+	processes *processdefs.Registry
+	skills    *skilldefs.Registry
+	tiles     *tiledefs.Registry
+	behaviors contracts.BehaviorRegistry
+}
+
+// loadCatalog runs every loader with its startup validation. Loaders fail fast, so the
+// first invalid file stops the lint.
+func loadCatalog(dataDir string, behaviors contracts.BehaviorRegistry, logger *zap.Logger) (*defsCatalog, error) {
+	items, err := itemdefs.LoadFromDirectory(filepath.Join(dataDir, "items"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("items: %w", err)
+	}
+	objects, err := objectdefs.LoadFromDirectoryWithItems(filepath.Join(dataDir, "objects"), behaviors, items, logger)
+	if err != nil {
+		return nil, fmt.Errorf("objects: %w", err)
+	}
+	crafts, err := craftdefs.LoadFromDirectoryWithRefs(filepath.Join(dataDir, "crafts"), items, objects, logger)
+	if err != nil {
+		return nil, fmt.Errorf("crafts: %w", err)
+	}
+	builds, err := builddefs.LoadFromDirectoryWithRefs(filepath.Join(dataDir, "builds"), items, objects, logger)
+	if err != nil {
+		return nil, fmt.Errorf("builds: %w", err)
+	}
+	processes, err := processdefs.LoadFromDirectoryWithRefs(filepath.Join(dataDir, "processes"), items, logger)
+	if err != nil {
+		return nil, fmt.Errorf("processes: %w", err)
+	}
+	skills, err := skilldefs.LoadFromDirectory(filepath.Join(dataDir, "skills"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("skills: %w", err)
+	}
+	tiles, err := tiledefs.LoadFromDirectory(filepath.Join(dataDir, "tiles"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("tiles: %w", err)
+	}
+	return &defsCatalog{items: items, objects: objects, crafts: crafts, builds: builds, processes: processes, skills: skills, tiles: tiles, behaviors: behaviors}, nil
+}
+
+type linter struct {
+	catalog   *defsCatalog
+	itemsAll  []*itemdefs.ItemDef
+	usedItems map[string]struct{}
+	findings  []finding
+}
+
+// lintCatalog runs cross-reference checks the loaders do not cover.
+func lintCatalog(catalog *defsCatalog) []finding {
+	l := &linter{
+		catalog:   catalog,
+		itemsAll:  catalog.items.All(),
+		usedItems: make(map[string]struct{}),
+	}
+	sort.Slice(l.itemsAll, func(i, j int) bool { return l.itemsAll[i].DefID < l.itemsAll[j].DefID })
+
+	l.checkRequiredObjects()
+	l.checkCrafts()
+	l.checkBuilds()
+	l.checkProcesses()
+	l.checkObjects()
+	l.checkItems()
+	l.checkServerItems()
+	l.checkUnusedItems()
+	l.checkDuplicateResources()
+
+	sort.SliceStable(l.findings, func(i, j int) bool { return l.findings[i].Severity < l.findings[j].Severity })
+	return l.findings
+}
+
+func (l *linter) errorf(catalog, key, format string, args ...any) {
+	l.findings = append(l.findings, finding{Severity: severityError, Catalog: catalog, Key: key, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) warnf(catalog, key, format string, args ...any) {
+	l.findings = append(l.findings, finding{Severity: severityWarning, Catalog: catalog, Key: key, Message: fmt.Sprintf(format, args...)})
+}
+
+// useItemTag marks every item with the tag as used and reports whether any item has it.
+func (l *linter) useItemTag(tag string) bool {
+	found := false
+	for _, item := range l.itemsAll {
+		for _, itemTag := range item.Tags {
+			if itemTag == tag {
+				l.usedItems[item.Key] = struct{}{}
+				found = true
+				break
+			}
+		}
+	}
+	return found
+}
+
+func (l *linter) useItemKey(catalog, key, field, itemKey string) {
+	if _, ok := l.catalog.items.GetByKey(itemKey); !ok {
+		l.errorf(catalog, key, "%s unknown item key %q", field, itemKey)
+		return
+	}
+	l.usedItems[itemKey] = struct{}{}
+}
+
+func (l *linter) checkRequiredObjects() {
+	for _, key := range requiredObjectKeys {
+		if _, ok := l.catalog.objects.GetByKey(key); !ok {
+			l.errorf("objects", key, "object is required by server code but not defined")
+		}
+	}
+}
+
+func (l *linter) checkCrafts() {
+	crafts := l.catalog.crafts.All()
+	sort.Slice(crafts, func(i, j int) bool { return crafts[i].DefID < crafts[j].DefID })
+	for _, craft := range crafts {
+		for i, in := range craft.Inputs {
+			if in.ItemKey != "" {
+				l.useItemKey("crafts", craft.Key, fmt.Sprintf("inputs[%d].itemKey", i), in.ItemKey)
+			} else if !l.useItemTag(in.ItemTag) {
+				l.errorf("crafts", craft.Key, "inputs[%d].itemTag %q matches no item", i, in.ItemTag)
+			}
+		}
+		for i, out := range craft.Outputs {
+			l.useItemKey("crafts", craft.Key, fmt.Sprintf("outputs[%d].itemKey", i), out.ItemKey)
+		}
+		l.checkRequiredSkills("crafts", craft.Key, craft.RequiredSkills)
+	}
+}
+
+func (l *linter) checkBuilds() {
+	builds := l.catalog.builds.All()
+	sort.Slice(builds, func(i, j int) bool { return builds[i].DefID < builds[j].DefID })
+	for _, build := range builds {
+		for i, in := range build.Inputs {
+			if in.ItemKey != "" {
+				l.useItemKey("builds", build.Key, fmt.Sprintf("inputs[%d].itemKey", i), in.ItemKey)
+			} else if !l.useItemTag(in.ItemTag) {
+				l.errorf("builds", build.Key, "inputs[%d].itemTag %q matches no item", i, in.ItemTag)
+			}
+		}
+		if _, ok := l.catalog.objects.GetByKey(build.ObjectKey); !ok {
+			l.errorf("builds", build.Key, "objectKey unknown object key %q", build.ObjectKey)
+		}
+		l.checkRequiredSkills("builds", build.Key, build.RequiredSkills)
+	}
+}
+
+func (l *linter) checkRequiredSkills(catalog, key string, skills []string) {
+	for i, skill := range skills {
+		if _, ok := l.catalog.skills.GetByKey(skill); !ok {
+			l.errorf(catalog, key, "requiredSkills[%d] unknown skill key %q", i, skill)
+		}
+	}
+}
+
+func (l *linter) checkProcesses() {
+	machines := make(map[string]struct{})
+	for _, obj := range l.catalog.objects.All() {
+		if obj.ProcessorConfig != nil {
+			machines[obj.ProcessorConfig.Machine] = struct{}{}
+		}
+	}
+	recipes := l.catalog.processes.All()
+	sort.Slice(recipes, func(i, j int) bool { return recipes[i].DefID < recipes[j].DefID })
+	for _, recipe := range recipes {
+		l.useItemKey("processes", recipe.Key, "input.itemKey", recipe.Input.ItemKey)
+		l.useItemKey("processes", recipe.Key, "output.itemKey", recipe.Output.ItemKey)
+		if recipe.WasteItemKey != "" {
+			l.useItemKey("processes", recipe.Key, "wasteItemKey", recipe.WasteItemKey)
+		}
+		if _, ok := machines[recipe.Machine]; !ok {
+			l.warnf("processes", recipe.Key, "machine %q is not used by any processor object", recipe.Machine)
+		}
+	}
+}
+
+func (l *linter) checkObjects() {
+	objects := l.catalog.objects.All()
+	sort.Slice(objects, func(i, j int) bool { return objects[i].DefID < objects[j].DefID })
+	for _, obj := range objects {
+		if obj.TakeConfig != nil {
+			for i, take := range obj.TakeConfig.Items {
+				l.checkTake(obj.Key, fmt.Sprintf("take.items[%d]", i), &take)
+			}
+		}
+		if obj.TreeConfig != nil {
+			l.checkTreeStages(obj)
+		}
+		if obj.CropConfig != nil {
+			l.checkCropStages(obj)
+		}
+		if obj.ProcessorConfig != nil {
+			l.checkProcessor(obj)
+		}
+		l.checkAppearanceFlags(obj)
+	}
+}
+
+func (l *linter) checkTreeStages(obj *objectdefs.ObjectDef) {
+	for i, stage := range obj.TreeConfig.Stages {
+		for j, objectKey := range stage.SpawnChopObject {
+			// The key is an alias resolved by the fall direction, so both axis variants must exist.
+			var missing []string
+			for _, axisKey := range []string{
+				behaviors.ResolveAxisLogDefKey(objectKey, 1, 0),
+				behaviors.ResolveAxisLogDefKey(objectKey, 0, 1),
+			} {
+				if _, ok := l.catalog.objects.GetByKey(axisKey); !ok {
+					missing = append(missing, fmt.Sprintf("%q", axisKey))
+				}
+			}
+			if len(missing) > 0 {
+				l.errorf("objects", obj.Key, "tree.stages[%d].spawnChopObject[%d] %q: unknown object key %s", i, j, objectKey, strings.Join(missing, ", "))
+			}
+		}
+		for j, itemKey := range stage.SpawnChopItem {
+			l.useItemKey("objects", obj.Key, fmt.Sprintf("tree.stages[%d].spawnChopItem[%d]", i, j), itemKey)
+		}
+		for j, take := range stage.Take {
+			l.checkTake(obj.Key, fmt.Sprintf("tree.stages[%d].take[%d]", i, j), &take)
+		}
+		if stage.TransformToDefKey != "" {
+			if _, ok := l.catalog.objects.GetByKey(stage.TransformToDefKey); !ok {
+				l.errorf("objects", obj.Key, "tree.stages[%d].transformToDefKey unknown object key %q", i, stage.TransformToDefKey)
+			}
+		}
+	}
+}
+
+func (l *linter) checkTake(key, field string, take *objectdefs.TakeConfig) {
+	l.useItemKey("objects", key, field+".itemDefKey", take.ItemDefKey)
+	if take.ToolTag != "" && !l.useItemTag(take.ToolTag) {
+		l.errorf("objects", key, "%s.toolTag %q matches no item", field, take.ToolTag)
+	}
+}
+
+func (l *linter) checkCropStages(obj *objectdefs.ObjectDef) {
+	l.useItemKey("objects", obj.Key, "crop.seedItemKey", obj.CropConfig.SeedItemKey)
+	for i, stage := range obj.CropConfig.Stages {
+		for j, itemKey := range stage.Harvest {
+			l.useItemKey("objects", obj.Key, fmt.Sprintf("crop.stages[%d].harvest[%d]", i, j), itemKey)
+		}
+		for j, take := range stage.Take {
+			l.checkTake(obj.Key, fmt.Sprintf("crop.stages[%d].take[%d]", i, j), &take)
+		}
+	}
+}
+
+// checkProcessor reports processor objects missing the container inventories the machine uses:
+// input grid key 0, output grid key 1 and fuel grid key 2, opened through the container behavior.
+func (l *linter) checkProcessor(obj *objectdefs.ObjectDef) {
+	for i, fuel := range obj.ProcessorConfig.Fuel {
+		l.useItemKey("objects", obj.Key, fmt.Sprintf("processor.fuel[%d].itemKey", i), fuel.ItemKey)
+	}
+	if !obj.HasBehavior("container") {
+		l.errorf("objects", obj.Key, "processor requires the container behavior")
+	}
+	grids := make(map[uint32]struct{})
+	if obj.Components != nil {
+		for _, inv := range obj.Components.Inventory {
+			if inv.Kind == "grid" {
+				grids[inv.Key] = struct{}{}
+			}
+		}
+	}
+	for _, key := range []uint32{0, 1, 2} {
+		if _, ok := grids[key]; !ok {
+			l.errorf("objects", obj.Key, "processor requires grid inventory with key %d", key)
+		}
+	}
+	hasRecipes := false
+	for _, recipe := range l.catalog.processes.All() {
+		if recipe.Machine == obj.ProcessorConfig.Machine {
+			hasRecipes = true
+			break
+		}
+	}
+	if !hasRecipes {
+		l.warnf("objects", obj.Key, "processor.machine %q has no recipes", obj.ProcessorConfig.Machine)
+	}
+}
+
+// checkAppearanceFlags reports appearance conditions no behavior of the object can satisfy.
+func (l *linter) checkAppearanceFlags(obj *objectdefs.ObjectDef) {
+	producible := make(map[string]struct{})
+	for _, behaviorKey := range obj.BehaviorOrder {
+		behavior, ok := l.catalog.behaviors.GetBehavior(behaviorKey)
+		if !ok {
+			continue
+		}
+		provider, ok := behavior.(contracts.BehaviorAppearanceFlagProvider)
+		if !ok {
+			continue
+		}
+		flags := provider.AppearanceFlags(&contracts.BehaviorDefConfigContext{
+			BehaviorKey: behaviorKey,
+			RawConfig:   obj.Behaviors[behaviorKey],
+			Items:       l.catalog.items,
+		})
+		for _, flag := range flags {
+			producible[flag] = struct{}{}
+		}
+	}
+
+	for _, appearance := range obj.Appearance {
+		if appearance.When == nil {
+			continue
+		}
+		for _, flag := range appearance.When.Flags {
+			if _, ok := producible[flag]; !ok {
+				l.errorf("objects", obj.Key, "appearance[%s] flag %q is not produced by behaviors %v", appearance.ID, flag, obj.BehaviorOrder)
+			}
+		}
+	}
+}
+
+func (l *linter) checkItems() {
+	for _, item := range l.itemsAll {
+		if item.Food != nil {
+			// Edible items are used by the eat action.
+			l.usedItems[item.Key] = struct{}{}
+		}
+		if item.Spoil != nil && item.Spoil.SpoiledItemKey != "" {
+			l.useItemKey("items", item.Key, "spoil.spoiledItemKey", item.Spoil.SpoiledItemKey)
+		}
+		if item.Container == nil {
+			continue
+		}
+		rules := item.Container.Rules
+		for _, key := range append(append([]string{}, rules.AllowItemKeys...), rules.DenyItemKeys...) {
+			if _, ok := l.catalog.items.GetByKey(key); !ok {
+				l.errorf("items", item.Key, "container.rules unknown item key %q", key)
+			}
+		}
+		for _, tag := range append(append([]string{}, rules.AllowTags...), rules.DenyTags...) {
+			if !l.hasItemTag(tag) {
+				l.warnf("items", item.Key, "container.rules tag %q matches no item", tag)
+			}
+		}
+	}
+}
+
+// checkServerItems marks items the server uses by key (terraform materials and yields) as used
+// and reports the ones the catalog does not define.
+func (l *linter) checkServerItems() {
+	for _, key := range game.TerraformItemKeys() {
+		if _, ok := l.catalog.items.GetByKey(key); !ok {
+			l.errorf("items", key, "item is required by server code but not defined")
+			continue
+		}
+		l.usedItems[key] = struct{}{}
+	}
+}
+
+func (l *linter) hasItemTag(tag string) bool {
+	for _, item := range l.itemsAll {
+		for _, itemTag := range item.Tags {
+			if itemTag == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (l *linter) checkUnusedItems() {
+	for _, item := range l.itemsAll {
+		if _, ok := l.usedItems[item.Key]; !ok {
+			l.warnf("items", item.Key, "unused: not referenced by any craft, build, process or object")
+		}
+	}
+}
+
+// checkDuplicateResources reports definitions of the same catalog sharing a resource,
+// usually a copy-paste leftover.
+func (l *linter) checkDuplicateResources() {
+	itemOwners := make(map[string]string)
+	for _, item := range l.itemsAll {
+		if item.Resource == "" {
+			continue
+		}
+		if first, ok := itemOwners[item.Resource]; ok {
+			l.warnf("items", item.Key, "resource %q already used by %s", item.Resource, first)
+			continue
+		}
+		itemOwners[item.Resource] = item.Key
+	}
+
+	objects := l.catalog.objects.All()
+	sort.Slice(objects, func(i, j int) bool { return objects[i].DefID < objects[j].DefID })
+	objectOwners := make(map[string]string)
+	for _, obj := range objects {
+		if obj.Resource == "" {
+			continue
+		}
+		if first, ok := objectOwners[obj.Resource]; ok {
+			l.warnf("objects", obj.Key, "resource %q already used by %s", obj.Resource, first)
+			continue
+		}
+		objectOwners[obj.Resource] = obj.Key
+	}
+}
+
+func countBySeverity(findings []finding, s severity) int {
+	n := 0
+	for _, f := range findings {
+		if f.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+func joinFindings(findings []finding) string {
+	lines := make([]string, len(findings))
+	for i, f := range findings {
+		lines[i] = f.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"origin/internal/builddefs"
+	"origin/internal/craftdefs"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/skilldefs"
+)
+
+func TestLoadCatalog_RepoData(t *testing.T) {
+	catalog, err := loadCatalog(filepath.Join("..", "..", "data"), behaviors.MustDefaultRegistry(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("load repo data: %v", err)
+	}
+	if catalog.items.Count() == 0 || catalog.objects.Count() == 0 {
+		t.Fatalf("expected items and objects, got %d items, %d objects", catalog.items.Count(), catalog.objects.Count())
+	}
+
+	first := joinFindings(lintCatalog(catalog))
+	second := joinFindings(lintCatalog(catalog))
+	if first != second {
+		t.Fatalf("findings must be deterministic:\n%s\n---\n%s", first, second)
+	}
+}
+
+func TestLintCatalog_RepoDataHasNoErrors(t *testing.T) {
+	catalog, err := loadCatalog(filepath.Join("..", "..", "data"), behaviors.MustDefaultRegistry(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("load repo data: %v", err)
+	}
+	var errs []finding
+	for _, f := range lintCatalog(catalog) {
+		if f.Severity == severityError {
+			errs = append(errs, f)
+		}
+	}
+	if len(errs) != 0 {
+		t.Fatalf("expected shipped data to lint without errors, got %d:\n%s", len(errs), joinFindings(errs))
+	}
+}
+
+func TestDumpResolvedObject_RepoTemplate(t *testing.T) {
+	catalog, err := loadCatalog(filepath.Join("..", "..", "data"), behaviors.MustDefaultRegistry(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("load repo data: %v", err)
+	}
+
+	data, err := dumpResolvedObject(catalog, "tree_oak")
+	if err != nil {
+		t.Fatalf("dump: %v", err)
+	}
+	var def objectdefs.ObjectDef
+	if err := json.Unmarshal(data, &def); err != nil {
+		t.Fatalf("dump is not an object definition: %v", err)
+	}
+	if def.Extends != "" || def.Abstract {
+		t.Fatalf("dump must be fully resolved, got extends=%q abstract=%v", def.Extends, def.Abstract)
+	}
+	if def.HP != 100 || len(def.Appearance) != 6 || def.Appearance[5].Resource != "trees/oak/6" {
+		t.Fatalf("template fields not merged:\n%s", data)
+	}
+	if !strings.Contains(string(data), `"transformToDefKey": "stump_oak"`) {
+		t.Fatalf("tree stages not merged:\n%s", data)
+	}
+
+	if _, err := dumpResolvedObject(catalog, "tree_base"); err == nil {
+		t.Fatal("abstract templates are not registered and cannot be dumped")
+	}
+}
+
+func TestLoadCatalog_LoaderErrorStopsLint(t *testing.T) {
+	_, err := loadCatalog(t.TempDir(), behaviors.MustDefaultRegistry(), zap.NewNop())
+	if err == nil || !strings.HasPrefix(err.Error(), "items:") {
+		t.Fatalf("expected items loader error, got %v", err)
+	}
+}
+
+func lintTestCatalog(t *testing.T) *defsCatalog {
+	t.Helper()
+
+	items := itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1, Key: "stone", Tags: []string{"stone"}, Resource: "items/stone.png"},
+		{DefID: 2, Key: "branch", Tags: []string{"wood"}, Resource: "items/branch.png"},
+		{DefID: 3, Key: "pebble", Resource: "items/stone.png"},
+		{DefID: 4, Key: "bag", Container: &itemdefs.ContainerDef{
+			Rules: itemdefs.ContentRules{AllowTags: []string{"seed"}, AllowItemKeys: []string{"seed_rye"}},
+		}},
+		{DefID: 5, Key: "apple", Food: &itemdefs.FoodDef{Energy: 10}},
+	})
+
+	treeRaw := json.RawMessage(`{"stages":[{"chopPointsTotal":1,"spawnChopObject":["log"],"spawnChopItem":["branch"],"transformToDefKey":"stump"}]}`)
+	tree := objectdefs.ObjectDef{
+		DefID:         10,
+		Key:           "tree",
+		Resource:      "trees/birch",
+		Behaviors:     map[string]json.RawMessage{"tree": treeRaw},
+		BehaviorOrder: []string{"tree"},
+		Appearance: []objectdefs.Appearance{
+			{ID: "young", When: &objectdefs.AppearanceWhen{Flags: []string{"tree.stage1"}}, Resource: "trees/young"},
+			{ID: "old", When: &objectdefs.AppearanceWhen{Flags: []string{"tree.stage2"}}, Resource: "trees/old"},
+		},
+	}
+	tree.SetTreeBehaviorConfig(contracts.TreeBehaviorConfig{Stages: []contracts.TreeStageConfig{{
+		ChopPointsTotal:   1,
+		SpawnChopObject:   []string{"log"},
+		SpawnChopItem:     []string{"branch"},
+		TransformToDefKey: "stump",
+		Take:              []contracts.TakeConfig{{ID: "take_branch", ItemDefKey: "branch", Count: 1, ToolTag: "saw"}},
+	}}})
+	objects := objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{DefID: 1, Key: "player", Resource: "player"},
+		{DefID: 2, Key: "player_death", Resource: "player"},
+		tree,
+	})
+
+	crafts := craftdefs.NewRegistry([]craftdefs.CraftDef{{
+		DefID:          1,
+		Key:            "knife",
+		Inputs:         []craftdefs.CraftInput{{ItemTag: "stone", Count: 1}, {ItemTag: "metal", Count: 1}},
+		Outputs:        []craftdefs.CraftOutput{{ItemKey: "stone", Count: 1}},
+		RequiredSkills: []string{"smithing"},
+	}})
+	builds := builddefs.NewRegistry([]builddefs.BuildDef{{
+		DefID:          1,
+		Key:            "hut",
+		Inputs:         []builddefs.BuildInput{{ItemKey: "branch", Count: 1}},
+		ObjectKey:      "hut",
+		RequiredSkills: []string{"carpentry"},
+	}})
+
+	return &defsCatalog{
+		items:     items,
+		objects:   objects,
+		crafts:    crafts,
+		builds:    builds,
+		processes: processdefs.NewRegistry(nil),
+		skills:    skilldefs.NewRegistry([]skilldefs.SkillDef{{DefID: 1, Key: "carpentry"}}),
+		behaviors: behaviors.MustDefaultRegistry(),
+	}
+}
+
+func TestLintCatalog_ReportsCrossReferenceFindings(t *testing.T) {
+	findings := lintCatalog(lintTestCatalog(t))
+	got := joinFindings(findings)
+
+	wantErrors := []string{
+		`error: objects/build: object is required by server code but not defined`,
+		`error: crafts/knife: inputs[1].itemTag "metal" matches no item`,
+		`error: crafts/knife: requiredSkills[0] unknown skill key "smithing"`,
+		`error: builds/hut: objectKey unknown object key "hut"`,
+		`error: objects/tree: tree.stages[0].spawnChopObject[0] "log": unknown object key "log_x", "log_y"`,
+		`error: objects/tree: tree.stages[0].transformToDefKey unknown object key "stump"`,
+		`error: objects/tree: tree.stages[0].take[0].toolTag "saw" matches no item`,
+		`error: objects/tree: appearance[old] flag "tree.stage2" is not produced by behaviors [tree]`,
+		`error: items/bag: container.rules unknown item key "seed_rye"`,
+		`error: items/soil: item is required by server code but not defined`,
+	}
+	wantWarnings := []string{
+		`warning: items/bag: container.rules tag "seed" matches no item`,
+		`warning: items/pebble: unused: not referenced by any craft, build, process or object`,
+		`warning: items/bag: unused: not referenced by any craft, build, process or object`,
+		`warning: items/pebble: resource "items/stone.png" already used by stone`,
+		`warning: objects/player_death: resource "player" already used by player`,
+	}
+	for _, want := range append(wantErrors, wantWarnings...) {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing finding %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "tree.stage1") {
+		t.Errorf("stage flag produced by tree behavior must not be reported:\n%s", got)
+	}
+	if strings.Contains(got, "items/branch: unused") {
+		t.Errorf("item used by build and tree must not be reported unused:\n%s", got)
+	}
+	if strings.Contains(got, "items/apple: unused") {
+		t.Errorf("edible item must not be reported unused:\n%s", got)
+	}
+	if n := countBySeverity(findings, severityError); n != len(wantErrors) {
+		t.Errorf("expected %d errors, got %d:\n%s", len(wantErrors), n, got)
+	}
+	if n := countBySeverity(findings, severityWarning); n != len(wantWarnings) {
+		t.Errorf("expected %d warnings, got %d:\n%s", len(wantWarnings), n, got)
+	}
+	if findings[len(findings)-1].Severity != severityWarning || findings[0].Severity != severityError {
+		t.Errorf("errors must be listed before warnings:\n%s", got)
+	}
+}
+
+func TestLintCatalog_ReportsProcessorFindings(t *testing.T) {
+	catalog := lintTestCatalog(t)
+	oven := objectdefs.ObjectDef{
+		DefID:         20,
+		Key:           "oven",
+		Resource:      "oven",
+		Behaviors:     map[string]json.RawMessage{"processor": json.RawMessage(`{}`)},
+		BehaviorOrder: []string{"processor"},
+		Components: &objectdefs.Components{Inventory: []objectdefs.InventoryDef{
+			{Kind: "grid", W: 2, H: 2},
+			{Kind: "grid", Key: 1, W: 2, H: 2},
+		}},
+	}
+	oven.SetProcessorBehaviorConfig(contracts.ProcessorBehaviorConfig{
+		Machine: "oven",
+		Fuel:    []contracts.ProcessorFuelConfig{{ItemKey: "pebble", Ticks: 10}},
+	})
+	objects := []objectdefs.ObjectDef{oven}
+	for _, obj := range catalog.objects.All() {
+		objects = append(objects, *obj)
+	}
+	catalog.objects = objectdefs.NewRegistry(objects)
+	catalog.processes = processdefs.NewRegistry([]processdefs.ProcessDef{{
+		DefID:         1,
+		Key:           "kiln_brick",
+		Machine:       "kiln",
+		Input:         processdefs.ProcessInput{ItemKey: "bag"},
+		Output:        processdefs.ProcessOutput{ItemKey: "stone", Count: 1},
+		TicksRequired: 10,
+	}})
+
+	got := joinFindings(lintCatalog(catalog))
+	for _, want := range []string{
+		`error: objects/oven: processor requires the container behavior`,
+		`error: objects/oven: processor requires grid inventory with key 2`,
+		`warning: objects/oven: processor.machine "oven" has no recipes`,
+		`warning: processes/kiln_brick: machine "kiln" is not used by any processor object`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing finding %q in:\n%s", want, got)
+		}
+	}
+	for _, unexpected := range []string{"items/pebble: unused", "items/bag: unused", "grid inventory with key 1"} {
+		if strings.Contains(got, unexpected) {
+			t.Errorf("unexpected finding %q in:\n%s", unexpected, got)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Command defslint validates data definitions the way the server loads them and checks
+// references between catalogs. With -schema-out it writes JSON Schemas of the definition files,
+// with -dump-object it prints an object definition with templates (extends) resolved.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"origin/internal/game/behaviors"
+)
+
+func main() {
+	dataDir := flag.String("data", "./data", "data directory with items, objects, crafts and builds")
+	strict := flag.Bool("strict", false, "treat warnings as errors")
+	schemaOut := flag.String("schema-out", "", "write JSON Schemas to this directory and exit")
+	dumpObject := flag.String("dump-object", "", "print the resolved object definition with this key and exit")
+	flag.Parse()
+
+	logger := zap.NewNop()
+
+	behaviorRegistry, err := behaviors.DefaultRegistry()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "behavior registry: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *schemaOut != "" {
+		written, err := writeSchemas(*schemaOut, behaviorRegistry)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		sort.Strings(written)
+		for _, path := range written {
+			fmt.Println("wrote", path)
+		}
+		return
+	}
+
+	catalog, err := loadCatalog(*dataDir, behaviorRegistry, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *dumpObject != "" {
+		data, err := dumpResolvedObject(catalog, *dumpObject)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	findings := lintCatalog(catalog)
+	if len(findings) > 0 {
+		fmt.Println(joinFindings(findings))
+	}
+	errors := countBySeverity(findings, severityError)
+	warnings := countBySeverity(findings, severityWarning)
+	fmt.Printf("%d items, %d objects, %d crafts, %d builds, %d processes, %d skills, %d tiles: %d errors, %d warnings\n",
+		catalog.items.Count(), catalog.objects.Count(), catalog.crafts.Count(), catalog.builds.Count(),
+		catalog.processes.Count(), catalog.skills.Count(), catalog.tiles.Count(), errors, warnings)
+
+	if errors > 0 || (*strict && warnings > 0) {
+		os.Exit(1)
+	}
+}
+
+// dumpResolvedObject renders the effective definition as the server uses it: templates merged
+// and loader defaults applied.
+func dumpResolvedObject(catalog *defsCatalog, key string) ([]byte, error) {
+	def, ok := catalog.objects.GetByKey(key)
+	if !ok {
+		return nil, fmt.Errorf("unknown object key %q", key)
+	}
+	return json.MarshalIndent(def, "", "  ")
+}
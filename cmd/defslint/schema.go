@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"origin/internal/builddefs"
+	"origin/internal/craftdefs"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/skilldefs"
+	"origin/internal/tiledefs"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// schemaGenerator derives JSON Schemas from the Go types the loaders decode into.
+// Loaders decode with DisallowUnknownFields, so every object is closed.
+type schemaGenerator struct {
+	// required lists JSON fields that loaders reject when missing.
+	required map[reflect.Type][]string
+	// fields replaces generated schemas of single fields.
+	fields map[reflect.Type]map[string]map[string]any
+}
+
+func newSchemaGenerator(behaviors contracts.BehaviorRegistry) *schemaGenerator {
+	g := &schemaGenerator{
+		required: map[reflect.Type][]string{
+			reflect.TypeOf(itemdefs.ItemsFile{}):     {"v", "items"},
+			reflect.TypeOf(itemdefs.ItemDef{}):       {"defId", "key", "name", "size"},
+			reflect.TypeOf(itemdefs.Size{}):          {"w", "h"},
+			reflect.TypeOf(itemdefs.Stack{}):         {"mode"},
+			reflect.TypeOf(itemdefs.FoodDef{}):       {"energy"},
+			reflect.TypeOf(objectdefs.ObjectsFile{}): {"v", "objects"},
+			// Object entries may inherit everything but the key through extends; the loader
+			// checks required fields on the merged definition.
+			reflect.TypeOf(objectdefs.ObjectDef{}):      {"key"},
+			reflect.TypeOf(craftdefs.CraftsFile{}):      {"v", "crafts"},
+			reflect.TypeOf(craftdefs.CraftDef{}):        {"defId", "key", "inputs", "outputs", "ticksRequired"},
+			reflect.TypeOf(craftdefs.CraftInput{}):      {"count"},
+			reflect.TypeOf(craftdefs.CraftOutput{}):     {"itemKey", "count"},
+			reflect.TypeOf(builddefs.BuildsFile{}):      {"v", "builds"},
+			reflect.TypeOf(builddefs.BuildDef{}):        {"defId", "key", "inputs", "ticksRequired", "objectKey"},
+			reflect.TypeOf(builddefs.BuildInput{}):      {"count"},
+			reflect.TypeOf(processdefs.ProcessesFile{}): {"v", "recipes"},
+			reflect.TypeOf(processdefs.ProcessDef{}):    {"defId", "key", "machine", "input", "output", "ticksRequired"},
+			reflect.TypeOf(processdefs.ProcessInput{}):  {"itemKey"},
+			reflect.TypeOf(processdefs.ProcessOutput{}): {"itemKey"},
+			reflect.TypeOf(skilldefs.SkillsFile{}):      {"v", "skills"},
+			reflect.TypeOf(skilldefs.SkillDef{}):        {"defId", "key"},
+			reflect.TypeOf(tiledefs.TilesFile{}):        {"v", "tiles"},
+			reflect.TypeOf(tiledefs.TileDef{}):          {"tileId", "key"},
+		},
+		fields: map[reflect.Type]map[string]map[string]any{
+			reflect.TypeOf(itemdefs.Stack{}): {
+				"mode": {"type": "string", "enum": []string{itemdefs.StackModeNone, itemdefs.StackModeStack}},
+			},
+			reflect.TypeOf(itemdefs.FoodDef{}): {
+				"qualityScaling": {"type": "string", "enum": []string{
+					itemdefs.FoodQualityScalingNone, itemdefs.FoodQualityScalingLinear, itemdefs.FoodQualityScalingSqrt,
+				}},
+			},
+		},
+	}
+	versionField := map[string]any{"const": 1}
+	for _, fileType := range []reflect.Type{
+		reflect.TypeOf(itemdefs.ItemsFile{}),
+		reflect.TypeOf(objectdefs.ObjectsFile{}),
+		reflect.TypeOf(craftdefs.CraftsFile{}),
+		reflect.TypeOf(builddefs.BuildsFile{}),
+		reflect.TypeOf(processdefs.ProcessesFile{}),
+		reflect.TypeOf(skilldefs.SkillsFile{}),
+		reflect.TypeOf(tiledefs.TilesFile{}),
+	} {
+		g.fields[fileType] = map[string]map[string]any{"v": versionField}
+	}
+	g.fields[reflect.TypeOf(objectdefs.ObjectDef{})] = map[string]map[string]any{
+		"behaviors": g.behaviorsSchema(behaviors),
+	}
+	return g
+}
+
+// behaviorsSchema allows one property per registered behavior. Behaviors without a
+// config prototype accept any object.
+func (g *schemaGenerator) behaviorsSchema(behaviors contracts.BehaviorRegistry) map[string]any {
+	properties := make(map[string]any)
+	for _, key := range behaviors.Keys() {
+		behavior, ok := behaviors.GetBehavior(key)
+		if !ok {
+			continue
+		}
+		schema := map[string]any{}
+		if prototype, ok := behavior.(contracts.BehaviorDefConfigPrototype); ok {
+			schema = g.schemaOf(reflect.TypeOf(prototype.DefConfigPrototype()))
+		}
+		// null removes a behavior inherited through extends.
+		schema["type"] = []string{"object", "null"}
+		properties[key] = schema
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+func (g *schemaGenerator) schemaOf(t reflect.Type) map[string]any {
+	if t == rawMessageType {
+		return map[string]any{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaOf(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if override, ok := g.fields[t][name]; ok {
+			properties[name] = override
+			continue
+		}
+		properties[name] = g.schemaOf(field.Type)
+	}
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if required := g.required[t]; len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func (g *schemaGenerator) document(title string, fileType any) map[string]any {
+	schema := g.schemaOf(reflect.TypeOf(fileType))
+	schema["$schema"] = jsonSchemaDialect
+	schema["title"] = title
+	return schema
+}
+
+// schemaDocuments returns the schema of every definition file type keyed by file name.
+func schemaDocuments(behaviors contracts.BehaviorRegistry) map[string]map[string]any {
+	g := newSchemaGenerator(behaviors)
+	return map[string]map[string]any{
+		"items.schema.json":     g.document("Item definitions", itemdefs.ItemsFile{}),
+		"objects.schema.json":   g.document("Object definitions", objectdefs.ObjectsFile{}),
+		"crafts.schema.json":    g.document("Craft definitions", craftdefs.CraftsFile{}),
+		"builds.schema.json":    g.document("Build definitions", builddefs.BuildsFile{}),
+		"processes.schema.json": g.document("Process definitions", processdefs.ProcessesFile{}),
+		"skills.schema.json":    g.document("Skill definitions", skilldefs.SkillsFile{}),
+		"tiles.schema.json":     g.document("Tile definitions", tiledefs.TilesFile{}),
+	}
+}
+
+func marshalSchema(schema map[string]any) ([]byte, error) {
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func writeSchemas(dir string, behaviors contracts.BehaviorRegistry) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create schema dir: %w", err)
+	}
+	var written []string
+	for name, schema := range schemaDocuments(behaviors) {
+		data, err := marshalSchema(schema)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", name, err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return nil, fmt.Errorf("write %s: %w", name, err)
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
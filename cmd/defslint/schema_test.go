@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"testing"
+
+	"origin/internal/game/behaviors"
+)
+
+const repoSchemaDir = "../../data/schema"
+
+var (
+	testLineComment  = regexp.MustCompile(`(?m)//.*$`)
+	testBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+)
+
+func TestSchemas_CommittedSchemasUpToDate(t *testing.T) {
+	for name, schema := range schemaDocuments(behaviors.MustDefaultRegistry()) {
+		want, err := marshalSchema(schema)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", name, err)
+		}
+		got, err := os.ReadFile(filepath.Join(repoSchemaDir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale, regenerate with: go run ./cmd/defslint -schema-out data/schema", name)
+		}
+	}
+}
+
+func TestSchemas_RepoDataValidates(t *testing.T) {
+	docs := schemaDocuments(behaviors.MustDefaultRegistry())
+	for _, catalog := range []string{"items", "objects", "crafts", "builds", "processes", "skills"} {
+		schema := roundTripSchema(t, docs[catalog+".schema.json"])
+		files, err := filepath.Glob(filepath.Join("..", "..", "data", catalog, "*.jsonc"))
+		if err != nil || len(files) == 0 {
+			t.Fatalf("no %s files: %v", catalog, err)
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("read %s: %v", file, err)
+			}
+			data = testLineComment.ReplaceAll(testBlockComment.ReplaceAll(data, nil), nil)
+			var doc any
+			if err := json.Unmarshal(data, &doc); err != nil {
+				t.Fatalf("parse %s: %v", file, err)
+			}
+			if errs := validateSchema(schema, doc, "$"); len(errs) > 0 {
+				t.Errorf("%s does not match schema:\n%v", file, errs)
+			}
+		}
+	}
+}
+
+func TestSchemas_RejectUnknownFieldsAndBehaviors(t *testing.T) {
+	schema := roundTripSchema(t, schemaDocuments(behaviors.MustDefaultRegistry())["objects.schema.json"])
+	var doc any
+	if err := json.Unmarshal([]byte(`{
+  "v": 2,
+  "objects": [
+    {"defId": 1, "key": "box", "name": "Box", "color": "red", "behaviors": {"container": null, "magic": {}}},
+    {"defId": 2, "key": "tree", "name": "Tree", "behaviors": {"tree": {"stages": [{"chopPointsTotal": "x"}]}}}
+  ]
+}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := validateSchema(schema, doc, "$")
+	want := []string{
+		"$.v: expected const 1",
+		"$.objects[0]: unknown property \"color\"",
+		"$.objects[0].behaviors: unknown property \"magic\"",
+		"$.objects[1].behaviors.tree.stages[0].chopPointsTotal: expected integer",
+	}
+	sort.Strings(errs)
+	sort.Strings(want)
+	if fmt.Sprint(errs) != fmt.Sprint(want) {
+		t.Fatalf("unexpected errors:\n got %v\nwant %v", errs, want)
+	}
+}
+
+// roundTripSchema converts a generated schema to the generic form a JSON reader sees.
+func roundTripSchema(t *testing.T, schema map[string]any) map[string]any {
+	t.Helper()
+	data, err := marshalSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// validateSchema implements the keywords emitted by schemaGenerator.
+func validateSchema(schema map[string]any, value any, path string) []string {
+	var errs []string
+	if want, ok := schema["const"]; ok && fmt.Sprint(want) != fmt.Sprint(value) {
+		errs = append(errs, fmt.Sprintf("%s: expected const %v", path, want))
+	}
+	if enum, ok := schema["enum"].([]any); ok {
+		found := false
+		for _, v := range enum {
+			found = found || v == value
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("%s: %v not in enum %v", path, value, enum))
+		}
+	}
+
+	schemaType := schema["type"]
+	if types, ok := schema["type"].([]any); ok {
+		if value == nil && slices.Contains(types, any("null")) {
+			return errs
+		}
+		schemaType = types[0]
+	}
+	switch schemaType {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return append(errs, fmt.Sprintf("%s: expected object", path))
+		}
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			if _, ok := obj[name.(string)]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: missing required %q", path, name))
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, v := range obj {
+			if prop, ok := properties[name]; ok {
+				errs = append(errs, validateSchema(prop.(map[string]any), v, path+"."+name)...)
+				continue
+			}
+			switch extra := schema["additionalProperties"].(type) {
+			case bool:
+				if !extra {
+					errs = append(errs, fmt.Sprintf("%s: unknown property %q", path, name))
+				}
+			case map[string]any:
+				errs = append(errs, validateSchema(extra, v, path+"."+name)...)
+			}
+		}
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return append(errs, fmt.Sprintf("%s: expected array", path))
+		}
+		for i, v := range arr {
+			errs = append(errs, validateSchema(schema["items"].(map[string]any), v, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected string", path))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected boolean", path))
+		}
+	case "number", "integer":
+		n, ok := value.(float64)
+		if !ok || (schemaType == "integer" && n != float64(int64(n))) {
+			errs = append(errs, fmt.Sprintf("%s: expected %s", path, schemaType))
+		} else if minimum, ok := schema["minimum"].(float64); ok && n < minimum {
+			errs = append(errs, fmt.Sprintf("%s: below minimum %v", path, minimum))
+		}
+	}
+	return errs
+}
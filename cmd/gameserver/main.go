@@ -28,7 +28,10 @@ import (
 	"origin/internal/metrics"
 	"origin/internal/objectdefs"
 	"origin/internal/persistence"
+	"origin/internal/processdefs"
 	"origin/internal/restapi"
+	"origin/internal/skilldefs"
+	"origin/internal/tiledefs"
 )
 
 func main() {
@@ -111,6 +114,24 @@ func main() {
 	}
 	builddefs.SetGlobal(buildRegistry)
 
+	processRegistry, err := processdefs.LoadFromDirectory("./data/processes", logger)
+	if err != nil {
+		logger.Fatal("Failed to load process definitions", zap.Error(err))
+	}
+	processdefs.SetGlobal(processRegistry)
+
+	skillRegistry, err := skilldefs.LoadFromDirectory("./data/skills", logger)
+	if err != nil {
+		logger.Fatal("Failed to load skill definitions", zap.Error(err))
+	}
+	skilldefs.SetGlobal(skillRegistry)
+
+	tileRegistry, err := tiledefs.LoadFromDirectory("./data/tiles", logger)
+	if err != nil {
+		logger.Fatal("Failed to load tile definitions", zap.Error(err))
+	}
+	tiledefs.SetGlobal(tileRegistry)
+
 	inventoryLoader := inventory.NewInventoryLoader(logger)
 	inventorySnapshotSender := inventory.NewSnapshotSender(logger)
 
@@ -133,6 +154,7 @@ func main() {
 	}
 
 	httpHandler := restapi.NewHandler(db, g.EntityIDManager(), logger, &cfg.Game)
+	httpHandler.SetAdminService(g)
 	httpHandler.RegisterRoutes(mux)
 
 	// Register Prometheus metrics
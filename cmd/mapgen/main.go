@@ -27,6 +27,7 @@ import (
 const (
 	TreeDensity    = 0.05
 	BoulderDensity = 0.0012
+	AnimalDensity  = 0.0005
 )
 
 func main() {
@@ -395,6 +396,31 @@ func (g *MapGenerator) generateChunkWithRNG(ctx context.Context, chunkX, chunkY
 					CreateTick: 0,
 					LastTick:   0,
 				})
+			} else if animalKeys := animalKeysForTile(tile); len(animalKeys) > 0 && rng.Float64() < AnimalDensity {
+				animalDef, ok := g.objectDefs.GetByKey(animalKeys[rng.Intn(len(animalKeys))])
+				if !ok {
+					continue
+				}
+				entityID := g.lastEntityID.Add(1)
+				tileWorldX := int(worldOffsetX) + tx*g.coordPerTile + rng.Intn(g.coordPerTile)
+				tileWorldY := int(worldOffsetY) + ty*g.coordPerTile + rng.Intn(g.coordPerTile)
+
+				entities = append(entities, repository.UpsertObjectParams{
+					ID:         int64(entityID),
+					TypeID:     animalDef.DefID,
+					Region:     g.region,
+					X:          tileWorldX,
+					Y:          tileWorldY,
+					Layer:      0,
+					ChunkX:     chunkX,
+					ChunkY:     chunkY,
+					Heading:    sql.NullInt16{Int16: int16(rng.Intn(8)), Valid: true},
+					Quality:    10,
+					Hp:         sql.NullInt32{Int32: int32(animalDef.HP), Valid: true},
+					OwnerID:    sql.NullInt64{},
+					CreateTick: 0,
+					LastTick:   0,
+				})
 			}
 		}
 	}
@@ -441,6 +467,21 @@ func (g *MapGenerator) treeDefForTile(tile byte) *objectdefs.ObjectDef {
 	return nil
 }
 
+// animalKeysForTile lists the wild animal species (object keys) that may spawn on a tile.
+func animalKeysForTile(tile byte) []string {
+	switch tile {
+	case types.TileConiferousForest, types.TileBroadleafForest:
+		return []string{"deer", "fox", "bear"}
+	case types.TileThicket:
+		return []string{"fox", "bear"}
+	case types.TileGrass:
+		return []string{"aurochs", "sheep", "deer"}
+	case types.TileHeath, types.TileMoor:
+		return []string{"mufflon", "sheep"}
+	}
+	return nil
+}
+
 type PerlinNoise struct {
 	perm [512]int
 }
@@ -47,6 +47,12 @@ func stripJSONCComments(data []byte) []byte {
 }
 
 func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
+	return LoadFromDirectoryWithRefs(dir, itemdefs.Global(), objectdefs.Global(), logger)
+}
+
+// LoadFromDirectoryWithRefs validates item and object references against the given registries.
+// Used by hot reload, where the referenced registries are not global yet.
+func LoadFromDirectoryWithRefs(dir string, items *itemdefs.Registry, objects *objectdefs.Registry, logger *zap.Logger) (*Registry, error) {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -81,7 +87,7 @@ func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
 	seenIDs := make(map[int]string)
 	seenKeys := make(map[string]string)
 	for _, filePath := range files {
-		builds, err := loadFile(filePath)
+		builds, err := loadFile(filePath, items, objects)
 		if err != nil {
 			return nil, err
 		}
@@ -113,7 +119,7 @@ func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
 	return NewRegistry(all), nil
 }
 
-func loadFile(filePath string) ([]BuildDef, error) {
+func loadFile(filePath string, items *itemdefs.Registry, objects *objectdefs.Registry) ([]BuildDef, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to read file: %v", err)}
@@ -133,7 +139,7 @@ func loadFile(filePath string) ([]BuildDef, error) {
 
 	for i := range file.Builds {
 		applyDefaults(&file.Builds[i])
-		if err := validateBuild(&file.Builds[i], filePath); err != nil {
+		if err := validateBuild(&file.Builds[i], filePath, items, objects); err != nil {
 			return nil, err
 		}
 	}
@@ -161,7 +167,7 @@ func applyDefaults(b *BuildDef) {
 	}
 }
 
-func validateBuild(b *BuildDef, filePath string) error {
+func validateBuild(b *BuildDef, filePath string, items *itemdefs.Registry, objects *objectdefs.Registry) error {
 	if b.DefID <= 0 {
 		return &LoadError{FilePath: filePath, Key: b.Key, Message: "defId must be > 0"}
 	}
@@ -181,16 +187,14 @@ func validateBuild(b *BuildDef, filePath string) error {
 		return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: "objectKey is required"}
 	}
 
-	objectRegistry := objectdefs.Global()
-	if objectRegistry == nil {
+	if objects == nil {
 		return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: "object defs registry not loaded"}
 	}
-	if _, ok := objectRegistry.GetByKey(b.ObjectKey); !ok {
+	if _, ok := objects.GetByKey(b.ObjectKey); !ok {
 		return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: fmt.Sprintf("objectKey unknown: %s", b.ObjectKey)}
 	}
 
 	totalQualityWeight := uint64(0)
-	itemRegistry := itemdefs.Global()
 	for i, in := range b.Inputs {
 		hasItemKey := in.ItemKey != ""
 		hasItemTag := in.ItemTag != ""
@@ -206,10 +210,10 @@ func validateBuild(b *BuildDef, filePath string) error {
 			return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: fmt.Sprintf("inputs[%d].count must be > 0", i)}
 		}
 		if hasItemKey {
-			if itemRegistry == nil {
+			if items == nil {
 				return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: "item defs registry not loaded"}
 			}
-			if _, ok := itemRegistry.GetByKey(in.ItemKey); !ok {
+			if _, ok := items.GetByKey(in.ItemKey); !ok {
 				return &LoadError{FilePath: filePath, DefID: b.DefID, Key: b.Key, Message: fmt.Sprintf("inputs[%d].itemKey unknown: %s", i, in.ItemKey)}
 			}
 		}
@@ -1,6 +1,9 @@
 package builddefs
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 type Registry struct {
 	byID  map[int]*BuildDef
@@ -9,7 +12,7 @@ type Registry struct {
 }
 
 var (
-	globalRegistry *Registry
+	globalRegistry atomic.Pointer[Registry]
 	registryOnce   sync.Once
 )
 
@@ -62,15 +65,21 @@ func (r *Registry) Count() int {
 
 func SetGlobal(r *Registry) {
 	registryOnce.Do(func() {
-		globalRegistry = r
+		globalRegistry.Store(r)
 	})
 }
 
 func SetGlobalForTesting(r *Registry) {
 	registryOnce = sync.Once{}
-	globalRegistry = r
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
 }
 
 func Global() *Registry {
-	return globalRegistry
+	return globalRegistry.Load()
 }
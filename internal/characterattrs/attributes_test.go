@@ -2,6 +2,7 @@ package characterattrs
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -67,3 +68,18 @@ func TestGet_FailSafe(t *testing.T) {
 		t.Fatalf("expected CHA=1 for missing key, got %d", got)
 	}
 }
+
+func TestTrainCost_GrowsWithCurrentValue(t *testing.T) {
+	if got := TrainCost(DefaultValue); got != TrainCostBase {
+		t.Fatalf("expected base cost %d at default value, got %d", TrainCostBase, got)
+	}
+	if got := TrainCost(0); got != TrainCostBase {
+		t.Fatalf("expected values below default to cost %d, got %d", TrainCostBase, got)
+	}
+	if got := TrainCost(3); got != 900 {
+		t.Fatalf("expected TrainCost(3)=900, got %d", got)
+	}
+	if got := TrainCost(math.MaxInt32 * 4); got != math.MaxInt64 {
+		t.Fatalf("expected huge values to saturate, got %d", got)
+	}
+}
@@ -0,0 +1,19 @@
+package characterattrs
+
+import "math"
+
+// TrainCostBase is the LP price of raising an attribute from DefaultValue to DefaultValue+1.
+const TrainCostBase int64 = 100
+
+// TrainCost returns the LP price of raising an attribute from current to current+1.
+// The price grows with the square of the current value.
+func TrainCost(current int) int64 {
+	if current < DefaultValue {
+		current = DefaultValue
+	}
+	level := int64(current)
+	if level > int64(math.Sqrt(float64(math.MaxInt64/TrainCostBase))) {
+		return math.MaxInt64
+	}
+	return TrainCostBase * level * level
+}
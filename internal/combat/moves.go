@@ -0,0 +1,63 @@
+package combat
+
+import (
+	"math"
+
+	"origin/internal/characterattrs"
+)
+
+// Move is a melee attack performed against an entity the attacker is in combat with.
+// The hit lands WindupTicks after the request; the move is available again CooldownTicks after the request.
+type Move struct {
+	Key           string
+	WindupTicks   uint64
+	CooldownTicks uint64
+	StaminaCost   float64
+	// Power is the base damage before strength and weapon are applied.
+	Power float64
+	// HardRatio is the share of the damage dealt to HHP, the rest goes to SHP.
+	HardRatio float64
+	// Reach is the max distance between attacker and target when the hit lands, in world units.
+	Reach float64
+}
+
+const (
+	// DisengageDistance breaks a combat relation when the two sides drift further apart.
+	DisengageDistance = 300.0
+	// UnarmedDamage is the weapon damage used when no weapon is equipped.
+	UnarmedDamage = 0.0
+)
+
+var moves = [...]Move{
+	{Key: "jab", WindupTicks: 3, CooldownTicks: 10, StaminaCost: 30, Power: 4, HardRatio: 0, Reach: 20},
+	{Key: "strike", WindupTicks: 6, CooldownTicks: 20, StaminaCost: 60, Power: 8, HardRatio: 0.1, Reach: 20},
+	{Key: "heavy_blow", WindupTicks: 12, CooldownTicks: 50, StaminaCost: 150, Power: 16, HardRatio: 0.25, Reach: 20},
+}
+
+// Moves returns all combat moves in display order.
+func Moves() []Move {
+	out := make([]Move, len(moves))
+	copy(out, moves[:])
+	return out
+}
+
+func MoveByKey(key string) (Move, bool) {
+	for _, move := range moves {
+		if move.Key == key {
+			return move, true
+		}
+	}
+	return Move{}, false
+}
+
+// Damage returns soft and hard damage of a hit: (Power + weaponDamage) * sqrt(STR),
+// split by the move HardRatio.
+func Damage(move Move, attributes characterattrs.Values, weaponDamage float64) (soft float64, hard float64) {
+	if weaponDamage < 0 {
+		weaponDamage = 0
+	}
+	strength := characterattrs.Get(attributes, characterattrs.STR)
+	total := (move.Power + weaponDamage) * math.Sqrt(float64(strength))
+	hard = total * move.HardRatio
+	return total - hard, hard
+}
@@ -0,0 +1,42 @@
+package combat
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+)
+
+func TestMoveByKey(t *testing.T) {
+	move, ok := MoveByKey("strike")
+	if !ok || move.Key != "strike" {
+		t.Fatalf("MoveByKey(strike) = (%+v, %v), want strike", move, ok)
+	}
+	if _, ok := MoveByKey("kick"); ok {
+		t.Fatalf("MoveByKey(kick) must not find a move")
+	}
+	for _, move := range Moves() {
+		if move.WindupTicks == 0 || move.CooldownTicks < move.WindupTicks || move.Reach <= 0 {
+			t.Fatalf("move %s has invalid timing or reach: %+v", move.Key, move)
+		}
+	}
+}
+
+func TestDamageScalesWithStrengthAndWeapon(t *testing.T) {
+	move := Move{Power: 8, HardRatio: 0.25}
+	soft, hard := Damage(move, characterattrs.Default(), UnarmedDamage)
+	if soft != 6 || hard != 2 {
+		t.Fatalf("Damage(unarmed, STR 1) = (%v,%v), want (6,2)", soft, hard)
+	}
+
+	attributes := characterattrs.Default()
+	attributes[characterattrs.STR] = 4
+	soft, hard = Damage(move, attributes, 4)
+	if soft != 18 || hard != 6 {
+		t.Fatalf("Damage(weapon 4, STR 4) = (%v,%v), want (18,6)", soft, hard)
+	}
+
+	soft, hard = Damage(move, characterattrs.Default(), -10)
+	if soft != 6 || hard != 2 {
+		t.Fatalf("negative weapon damage must be ignored, got (%v,%v)", soft, hard)
+	}
+}
@@ -75,15 +75,53 @@ type GameConfig struct {
 	ChatMaxLen        int `mapstructure:"chat_max_len"`         // Max chat message length (default: 256)
 	ChatMinIntervalMs int `mapstructure:"chat_min_interval_ms"` // Min interval between messages in ms (default: 400)
 
+	// Chat history settings
+	ChatHistoryPrivateLimit    int           `mapstructure:"chat_history_private_limit"`    // Max private messages returned on history request (default: 50)
+	ChatHistoryLocalLimit      int           `mapstructure:"chat_history_local_limit"`      // Max local messages returned on history request (default: 50)
+	ChatHistoryLocalWindow     time.Duration `mapstructure:"chat_history_local_window"`     // How far back local history is searched (default: 30m)
+	ChatRetentionMonths        int           `mapstructure:"chat_retention_months"`         // Monthly chat partitions older than this are dropped (default: 6)
+	ChatRetentionCheckInterval time.Duration `mapstructure:"chat_retention_check_interval"` // Retention job period (default: 6h)
+
+	// Server announcements broadcast on the global chat channel
+	Announcements []AnnouncementConfig `mapstructure:"announcements"`
+
 	InteractionPendingTimeout     time.Duration `mapstructure:"interaction_pending_timeout"`     // Pending context action timeout (default: 15s)
 	ObjectBehaviorBudgetPerTick   int           `mapstructure:"object_behavior_budget_per_tick"` // Max dirty behavior objects processed per tick (default: 512)
 	BehaviorTickGlobalBudget      int           `mapstructure:"behavior_tick_global_budget_per_tick"`
+	AnimalAIBudgetPerTick         int           `mapstructure:"animal_ai_budget_per_tick"`        // Max animal AI decisions per tick (default: 256)
+	PathfindingNodeBudget         int           `mapstructure:"pathfinding_node_budget_per_tick"` // Max A* nodes expanded per tick over all path requests (default: 2048)
+	PathfindingMaxNodes           int           `mapstructure:"pathfinding_max_nodes_per_search"` // A* gives up (PATH_BLOCKED) after this many nodes (default: 8192)
 	BehaviorTickCatchupLimit      int           `mapstructure:"behavior_tick_catchup_limit_ticks"`
 	PlayerStatsTTLms              int           `mapstructure:"player_stats_ttl_ms"`
 	StaminaRegenIntervalTicks     int           `mapstructure:"stamina_regen_interval_ticks"`
 	LifeDeathFactor               float64       `mapstructure:"life_death_factor"`
 	ShpRegenIntervalTicks         int           `mapstructure:"shp_regen_interval_ticks"`
 	StarvationDamageIntervalTicks int           `mapstructure:"starvation_damage_interval_ticks"`
+
+	// In-game calendar derived from the persisted server runtime
+	DayLength             time.Duration `mapstructure:"day_length"`               // Real time of one in-game day (default: 2h)
+	SeasonDays            int           `mapstructure:"season_days"`              // In-game days per season (default: 7)
+	WorldTimeSyncInterval time.Duration `mapstructure:"world_time_sync_interval"` // Period of S2C_WorldTime broadcasts (default: 1m)
+
+	// Regional weather simulation
+	WeatherSeed       int64 `mapstructure:"weather_seed"`        // Seed of the deterministic weather model (default: 1)
+	WeatherCellChunks int   `mapstructure:"weather_cell_chunks"` // Size of a weather cell in chunks, 0 disables weather (default: 8)
+}
+
+// AnnouncementConfig describes a scheduled global chat message.
+// Exactly one of Interval (recurring) or At (one-shot, RFC3339) must be set.
+// For one-shot messages Countdown lists lead times (e.g. 10m, 5m, 1m) at which the message is sent
+// before At instead of at At itself; "{remaining}" in Text is replaced with the time left.
+type AnnouncementConfig struct {
+	Text      string          `mapstructure:"text"`
+	Interval  time.Duration   `mapstructure:"interval"`
+	At        string          `mapstructure:"at"`
+	Countdown []time.Duration `mapstructure:"countdown"`
+}
+
+// ParseAt returns the one-shot time of the announcement.
+func (a AnnouncementConfig) ParseAt() (time.Time, error) {
+	return time.Parse(time.RFC3339, strings.TrimSpace(a.At))
 }
 
 type EntityIDConfig struct {
@@ -170,12 +208,54 @@ func Load(logger *zap.Logger) (*Config, error) {
 			zap.Int("game.shp_regen_interval_ticks", cfg.Game.ShpRegenIntervalTicks),
 		)
 	}
+	if cfg.Game.ChatRetentionMonths <= 0 {
+		logger.Fatal("Invalid chat retention: game.chat_retention_months must be > 0",
+			zap.Int("game.chat_retention_months", cfg.Game.ChatRetentionMonths),
+		)
+	}
+	for i, announcement := range cfg.Game.Announcements {
+		if strings.TrimSpace(announcement.Text) == "" {
+			logger.Fatal("Invalid announcement: game.announcements[].text must not be empty", zap.Int("index", i))
+		}
+		if (announcement.Interval > 0) == (announcement.At != "") {
+			logger.Fatal("Invalid announcement: exactly one of interval or at must be set", zap.Int("index", i))
+		}
+		if announcement.At != "" {
+			if _, err := announcement.ParseAt(); err != nil {
+				logger.Fatal("Invalid announcement: game.announcements[].at must be RFC3339",
+					zap.Int("index", i),
+					zap.String("at", announcement.At),
+				)
+			}
+		}
+	}
 	if cfg.Game.StarvationDamageIntervalTicks <= 0 {
 		logger.Fatal("Invalid starvation interval: game.starvation_damage_interval_ticks must be > 0",
 			zap.Int("game.starvation_damage_interval_ticks", cfg.Game.StarvationDamageIntervalTicks),
 		)
 	}
 
+	if cfg.Game.DayLength < time.Minute {
+		logger.Fatal("Invalid day length: game.day_length must be >= 1m",
+			zap.Duration("game.day_length", cfg.Game.DayLength),
+		)
+	}
+	if cfg.Game.SeasonDays <= 0 {
+		logger.Fatal("Invalid season length: game.season_days must be > 0",
+			zap.Int("game.season_days", cfg.Game.SeasonDays),
+		)
+	}
+	if cfg.Game.WorldTimeSyncInterval <= 0 {
+		logger.Fatal("Invalid world time sync interval: game.world_time_sync_interval must be > 0",
+			zap.Duration("game.world_time_sync_interval", cfg.Game.WorldTimeSyncInterval),
+		)
+	}
+	if cfg.Game.WeatherCellChunks < 0 {
+		logger.Fatal("Invalid weather cell size: game.weather_cell_chunks must be >= 0",
+			zap.Int("game.weather_cell_chunks", cfg.Game.WeatherCellChunks),
+		)
+	}
+
 	return &cfg, nil
 }
 
@@ -230,15 +310,28 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("game.chat_local_radius", 1000)
 	v.SetDefault("game.chat_max_len", 256)
 	v.SetDefault("game.chat_min_interval_ms", 400)
+	v.SetDefault("game.chat_history_private_limit", 50)
+	v.SetDefault("game.chat_history_local_limit", 50)
+	v.SetDefault("game.chat_history_local_window", 30*time.Minute)
+	v.SetDefault("game.chat_retention_months", 6)
+	v.SetDefault("game.chat_retention_check_interval", 6*time.Hour)
 	v.SetDefault("game.interaction_pending_timeout", 15*time.Second)
 	v.SetDefault("game.object_behavior_budget_per_tick", 512)
 	v.SetDefault("game.behavior_tick_global_budget_per_tick", 200)
 	v.SetDefault("game.behavior_tick_catchup_limit_ticks", 2000)
+	v.SetDefault("game.animal_ai_budget_per_tick", 256)
+	v.SetDefault("game.pathfinding_node_budget_per_tick", 2048)
+	v.SetDefault("game.pathfinding_max_nodes_per_search", 8192)
 	v.SetDefault("game.player_stats_ttl_ms", 1000)
 	v.SetDefault("game.stamina_regen_interval_ticks", _const.DefaultStaminaRegenIntervalTicks)
 	v.SetDefault("game.life_death_factor", 1.0)
 	v.SetDefault("game.shp_regen_interval_ticks", 100)
 	v.SetDefault("game.starvation_damage_interval_ticks", 432000)
+	v.SetDefault("game.day_length", 2*time.Hour)
+	v.SetDefault("game.season_days", 7)
+	v.SetDefault("game.world_time_sync_interval", time.Minute)
+	v.SetDefault("game.weather_seed", 1)
+	v.SetDefault("game.weather_cell_chunks", 8)
 
 	// EntityID defaults
 	v.SetDefault("entity_id.range_size", 1000)
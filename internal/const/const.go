@@ -25,6 +25,7 @@ const (
 const (
 	PlayerVisionRadius     = 600
 	PlayerVisionPower      = 600
+	NightVisionRadiusScale = 0.5 // vision radius multiplier at full night
 	VisionUpdateInterval   = 3 * time.Second
 	VisionUpdateJitter     = 50 * time.Millisecond
 	VisionPosEpsilon       = 0.01
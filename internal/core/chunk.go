@@ -302,6 +302,39 @@ func (c *Chunk) SetTiles(tiles []byte, lastTick uint64) {
 	c.mu.Unlock()
 }
 
+// SetTile replaces a single tile. The tiles are copied on write, so slices handed out earlier
+// stay unchanged; only the passable/swimmable bits of that tile are recalculated.
+// The chunk version is bumped and the tiles are persisted on the next save.
+// Returns false when out of bounds or the tile is unchanged.
+func (c *Chunk) SetTile(localTileX, localTileY, chunkSize int, tileID byte, lastTick uint64) bool {
+	if localTileX < 0 || localTileX >= chunkSize || localTileY < 0 || localTileY >= chunkSize {
+		return false
+	}
+	index := localTileY*chunkSize + localTileX
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if index >= len(c.Tiles) || c.Tiles[index] == tileID {
+		return false
+	}
+	tiles := make([]byte, len(c.Tiles))
+	copy(tiles, c.Tiles)
+	tiles[index] = tileID
+	c.Tiles = tiles
+	c.LastTick = lastTick
+	c.Version++
+	c.tilesDirty = true
+	c.updateTileBits(index)
+	return true
+}
+
+// TilesVersion returns the chunk version without copying the tiles.
+func (c *Chunk) TilesVersion() uint32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Version
+}
+
 func (c *Chunk) TilesDirty() bool {
 	c.mu.RLock()
 	d := c.tilesDirty
@@ -343,6 +376,8 @@ func (c *Chunk) IsDirty(world *ecs.World) bool {
 }
 
 func (c *Chunk) populateTileBitsets() {
+	clear(c.isPassable)
+	clear(c.isSwimmable)
 	for i, tileID := range c.Tiles {
 		if types.IsTilePassable(tileID) {
 			c.setBit(c.isPassable, i)
@@ -353,6 +388,20 @@ func (c *Chunk) populateTileBitsets() {
 	}
 }
 
+func (c *Chunk) updateTileBits(index int) {
+	tileID := c.Tiles[index]
+	c.assignBit(c.isPassable, index, types.IsTilePassable(tileID))
+	c.assignBit(c.isSwimmable, index, types.IsTileSwimmable(tileID))
+}
+
+func (c *Chunk) assignBit(bitset []uint64, index int, value bool) {
+	if value {
+		c.setBit(bitset, index)
+		return
+	}
+	bitset[index/64] &^= 1 << uint(index%64)
+}
+
 func (c *Chunk) setBit(bitset []uint64, index int) {
 	wordIndex := index / 64
 	bitIndex := uint(index % 64)
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+
+	"origin/internal/types"
+)
+
+func TestChunkSetTile_MarksDirtyAndRecomputesBitsets(t *testing.T) {
+	const chunkSize = 4
+	chunk := NewChunk(types.ChunkCoord{}, 1, 0, chunkSize)
+	tiles := make([]byte, chunkSize*chunkSize)
+	for i := range tiles {
+		tiles[i] = types.TileGrass
+	}
+	chunk.SetTiles(tiles, 10)
+	chunk.ClearTilesDirty()
+	version := chunk.Version
+
+	if !chunk.SetTile(1, 2, chunkSize, types.TileDeepWater, 20) {
+		t.Fatalf("expected tile change")
+	}
+	if tileID, _ := chunk.TileID(1, 2, chunkSize); tileID != types.TileDeepWater {
+		t.Fatalf("expected deep water, got %d", tileID)
+	}
+	if tiles[2*chunkSize+1] != types.TileGrass {
+		t.Fatalf("SetTile must not mutate the previous tiles slice")
+	}
+	if !chunk.TilesDirty() || chunk.Version != version+1 || chunk.LastTick != 20 {
+		t.Fatalf("expected dirty tiles and bumped version, got dirty=%v version=%d tick=%d",
+			chunk.TilesDirty(), chunk.Version, chunk.LastTick)
+	}
+	if chunk.IsTilePassable(1, 2, chunkSize) {
+		t.Fatalf("deep water must not stay passable")
+	}
+	if !chunk.IsTileSwimmable(1, 2, chunkSize) {
+		t.Fatalf("deep water must be swimmable")
+	}
+
+	if chunk.SetTile(1, 2, chunkSize, types.TileDeepWater, 30) {
+		t.Fatalf("unchanged tile must not be rewritten")
+	}
+	if chunk.SetTile(chunkSize, 0, chunkSize, types.TileDirt, 30) {
+		t.Fatalf("out of bounds tile must be rejected")
+	}
+}
+
+func TestChunkSetTile_UpdatesOnlyChangedTileBits(t *testing.T) {
+	const chunkSize = 8
+	chunk := NewChunk(types.ChunkCoord{}, 1, 0, chunkSize)
+	tiles := make([]byte, chunkSize*chunkSize)
+	for i := range tiles {
+		tiles[i] = types.TileShallowWater
+	}
+	tiles[0] = types.TileDeepWater
+	chunk.SetTiles(tiles, 10)
+
+	chunk.SetTile(3, 3, chunkSize, types.TileDirt, 20)
+	chunk.SetTile(0, 0, chunkSize, types.TileStonePaving, 20)
+
+	for y := 0; y < chunkSize; y++ {
+		for x := 0; x < chunkSize; x++ {
+			tileID, _ := chunk.TileID(x, y, chunkSize)
+			if chunk.IsTilePassable(x, y, chunkSize) != types.IsTilePassable(tileID) ||
+				chunk.IsTileSwimmable(x, y, chunkSize) != types.IsTileSwimmable(tileID) {
+				t.Fatalf("bitsets out of sync at (%d,%d) for tile %d", x, y, tileID)
+			}
+		}
+	}
+	if chunk.IsTileSwimmable(3, 3, chunkSize) || !chunk.IsTilePassable(0, 0, chunkSize) {
+		t.Fatalf("expected filled water to be dry and paved deep water to be passable")
+	}
+}
@@ -199,6 +199,25 @@ func (g *SpatialHashGrid) QueryAABB(minX, minY, maxX, maxY int, result *[]types.
 	}
 }
 
+// QueryStaticAABB appends only static handles of the cells overlapping the box.
+func (g *SpatialHashGrid) QueryStaticAABB(minX, minY, maxX, maxY int, result *[]types.Handle) {
+	minCX := int32(float64(minX) * g.invCellSize)
+	maxCX := int32(float64(maxX) * g.invCellSize)
+	minCY := int32(float64(minY) * g.invCellSize)
+	maxCY := int32(float64(maxY) * g.invCellSize)
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for cy := minCY; cy <= maxCY; cy++ {
+		for cx := minCX; cx <= maxCX; cx++ {
+			if handles, ok := g.staticCells[mortonEncode(cx, cy)]; ok {
+				*result = append(*result, handles...)
+			}
+		}
+	}
+}
+
 func (g *SpatialHashGrid) StaticCount() int {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
@@ -142,3 +142,18 @@ func TestSpatialHashGridGetAllHandles(t *testing.T) {
 		t.Errorf("expected dynamic handle to be %v, got %v", h3, dynamicHandles[0])
 	}
 }
+
+func TestSpatialHashGridQueryStaticAABB(t *testing.T) {
+	grid := NewSpatialHashGrid(16.0)
+	tree := types.MakeHandle(1, 0)
+	player := types.MakeHandle(2, 0)
+	grid.AddStatic(tree, 10, 10)
+	grid.AddDynamic(player, 12, 12)
+
+	var result []types.Handle
+	grid.QueryStaticAABB(0, 0, 20, 20, &result)
+
+	if len(result) != 1 || result[0] != tree {
+		t.Errorf("expected only the static handle, got %v", result)
+	}
+}
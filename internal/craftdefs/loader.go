@@ -46,6 +46,12 @@ func stripJSONCComments(data []byte) []byte {
 }
 
 func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
+	return LoadFromDirectoryWithRefs(dir, itemdefs.Global(), objectdefs.Global(), logger)
+}
+
+// LoadFromDirectoryWithRefs validates item and object references against the given registries.
+// Used by hot reload, where the referenced registries are not global yet.
+func LoadFromDirectoryWithRefs(dir string, items *itemdefs.Registry, objects *objectdefs.Registry, logger *zap.Logger) (*Registry, error) {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -80,7 +86,7 @@ func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
 	seenIDs := make(map[int]string)
 	seenKeys := make(map[string]string)
 	for _, filePath := range files {
-		crafts, err := loadFile(filePath)
+		crafts, err := loadFile(filePath, items, objects)
 		if err != nil {
 			return nil, err
 		}
@@ -112,7 +118,7 @@ func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
 	return NewRegistry(all), nil
 }
 
-func loadFile(filePath string) ([]CraftDef, error) {
+func loadFile(filePath string, items *itemdefs.Registry, objects *objectdefs.Registry) ([]CraftDef, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to read file: %v", err)}
@@ -132,7 +138,7 @@ func loadFile(filePath string) ([]CraftDef, error) {
 
 	for i := range file.Crafts {
 		applyDefaults(&file.Crafts[i])
-		if err := validateCraft(&file.Crafts[i], filePath); err != nil {
+		if err := validateCraft(&file.Crafts[i], filePath, items, objects); err != nil {
 			return nil, err
 		}
 	}
@@ -159,7 +165,7 @@ func applyDefaults(c *CraftDef) {
 	}
 }
 
-func validateCraft(c *CraftDef, filePath string) error {
+func validateCraft(c *CraftDef, filePath string, items *itemdefs.Registry, objects *objectdefs.Registry) error {
 	if c.DefID <= 0 {
 		return &LoadError{FilePath: filePath, Key: c.Key, Message: "defId must be > 0"}
 	}
@@ -179,6 +185,10 @@ func validateCraft(c *CraftDef, filePath string) error {
 		return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: "staminaCost must be >= 0"}
 	}
 
+	if items == nil {
+		return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: "item defs registry not loaded"}
+	}
+
 	totalQualityWeight := uint64(0)
 	for i, in := range c.Inputs {
 		hasItemKey := in.ItemKey != ""
@@ -195,7 +205,7 @@ func validateCraft(c *CraftDef, filePath string) error {
 			return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: fmt.Sprintf("inputs[%d].count must be > 0", i)}
 		}
 		if hasItemKey {
-			if _, ok := itemdefs.Global().GetByKey(in.ItemKey); !ok {
+			if _, ok := items.GetByKey(in.ItemKey); !ok {
 				return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: fmt.Sprintf("inputs[%d].itemKey unknown: %s", i, in.ItemKey)}
 			}
 		}
@@ -211,12 +221,15 @@ func validateCraft(c *CraftDef, filePath string) error {
 		if out.Count == 0 {
 			return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: fmt.Sprintf("outputs[%d].count must be > 0", i)}
 		}
-		if _, ok := itemdefs.Global().GetByKey(out.ItemKey); !ok {
+		if _, ok := items.GetByKey(out.ItemKey); !ok {
 			return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: fmt.Sprintf("outputs[%d].itemKey unknown: %s", i, out.ItemKey)}
 		}
 	}
 	if c.RequiredLinkedObject != "" {
-		if _, ok := objectdefs.Global().GetByKey(c.RequiredLinkedObject); !ok {
+		if objects == nil {
+			return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: "object defs registry not loaded"}
+		}
+		if _, ok := objects.GetByKey(c.RequiredLinkedObject); !ok {
 			return &LoadError{FilePath: filePath, DefID: c.DefID, Key: c.Key, Message: fmt.Sprintf("requiredLinkedObjectKey unknown: %s", c.RequiredLinkedObject)}
 		}
 	}
@@ -223,3 +223,46 @@ func TestLoadFromDirectory_UnknownExactItemKeyRejected(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "itemKey unknown: missing_item")
 }
+
+func TestLoadFromDirectoryWithRefs_UsesGivenRegistries(t *testing.T) {
+	setCraftDefsTestRegistries(t)
+	dir := t.TempDir()
+
+	writeCraftDefsTestFile(t, dir, "crafts.json", `{
+		"v": 1,
+		"source": "test",
+		"crafts": [
+			{
+				"defId": 1,
+				"key": "copper_ingot",
+				"inputs": [
+					{ "itemKey": "copper_ore", "count": 2, "qualityWeight": 1 }
+				],
+				"outputs": [
+					{ "itemKey": "copper_ingot", "count": 1 }
+				],
+				"requiredLinkedObjectKey": "furnace",
+				"staminaCost": 1,
+				"ticksRequired": 1
+			}
+		]
+	}`)
+
+	_, err := LoadFromDirectory(dir, craftDefsTestLogger())
+	require.Error(t, err, "global registries do not know the new item")
+
+	items := itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1, Key: "copper_ore", Name: "Copper Ore"},
+		{DefID: 2, Key: "copper_ingot", Name: "Copper Ingot"},
+	})
+	objects := objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{DefID: 1, Key: "furnace", Name: "Furnace"},
+	})
+	registry, err := LoadFromDirectoryWithRefs(dir, items, objects, craftDefsTestLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.Count())
+
+	_, err = LoadFromDirectoryWithRefs(dir, items, objectdefs.NewRegistry(nil), craftDefsTestLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requiredLinkedObjectKey unknown: furnace")
+}
@@ -10,6 +10,8 @@ type CyclicActionTargetKind uint8
 const (
 	CyclicActionTargetObject CyclicActionTargetKind = 1
 	CyclicActionTargetSelf   CyclicActionTargetKind = 2
+	// CyclicActionTargetItem targets an item instance in the player's inventory (TargetID is the item id).
+	CyclicActionTargetItem CyclicActionTargetKind = 3
 )
 
 type ActiveCyclicAction struct {
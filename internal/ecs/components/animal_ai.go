@@ -0,0 +1,33 @@
+package components
+
+import (
+	"origin/internal/ecs"
+	"origin/internal/types"
+)
+
+type AnimalAIMode uint8
+
+const (
+	AnimalAIWander AnimalAIMode = iota
+	AnimalAIFlee
+	AnimalAIChase
+)
+
+// AnimalAI is the runtime state of a wild animal brain. It is not persisted:
+// a loaded animal starts wandering around the point it was loaded at.
+type AnimalAI struct {
+	Mode AnimalAIMode
+	// ThreatID is the player the animal flees from or chases; 0 while wandering.
+	ThreatID types.EntityID
+
+	HomeX float64
+	HomeY float64
+	// NextWanderTick is when an idle animal may start its next wander walk.
+	NextWanderTick uint64
+}
+
+const AnimalAIComponentID ecs.ComponentID = 35
+
+func init() {
+	ecs.RegisterComponent[AnimalAI](AnimalAIComponentID)
+}
@@ -0,0 +1,62 @@
+package components
+
+import (
+	"origin/internal/ecs"
+	"origin/internal/types"
+)
+
+// CombatRelation links an entity to one opponent. Relations are kept on both sides.
+type CombatRelation struct {
+	TargetID    types.EntityID
+	StartedTick uint64
+}
+
+// CombatState tracks the opponents of an entity, its move cooldowns and the move it is winding up.
+// The component is removed when the last relation ends.
+type CombatState struct {
+	Relations []CombatRelation
+
+	// Cooldowns maps a move key to the tick when the move can be used again.
+	Cooldowns map[string]uint64
+
+	// PendingMove is the key of the move that lands at PendingHitTick; empty when none.
+	PendingMove     string
+	PendingTargetID types.EntityID
+	PendingHitTick  uint64
+}
+
+func (c *CombatState) HasRelation(targetID types.EntityID) bool {
+	for _, relation := range c.Relations {
+		if relation.TargetID == targetID {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveRelation drops the relation with the target and cancels a move aimed at it.
+func (c *CombatState) RemoveRelation(targetID types.EntityID) bool {
+	for idx, relation := range c.Relations {
+		if relation.TargetID != targetID {
+			continue
+		}
+		c.Relations = append(c.Relations[:idx], c.Relations[idx+1:]...)
+		if c.PendingTargetID == targetID {
+			c.ClearPendingMove()
+		}
+		return true
+	}
+	return false
+}
+
+func (c *CombatState) ClearPendingMove() {
+	c.PendingMove = ""
+	c.PendingTargetID = 0
+	c.PendingHitTick = 0
+}
+
+const CombatStateComponentID ecs.ComponentID = 34
+
+func init() {
+	ecs.RegisterComponent[CombatState](CombatStateComponentID)
+}
@@ -0,0 +1,41 @@
+package components
+
+import (
+	"origin/internal/ecs"
+	"origin/internal/types"
+)
+
+type FollowStopReason uint8
+
+const (
+	FollowActive FollowStopReason = iota
+	// FollowStopExplicit is a stop command or a movement target set by anything else.
+	FollowStopExplicit
+	// FollowStopLeaderLost is a despawned leader or one the follower no longer sees.
+	FollowStopLeaderLost
+	FollowStopLinked
+	FollowStopPathBlocked
+)
+
+// Follow keeps a mover within Distance of a leader. FollowSystem re-targets the mover
+// at the leader whenever it falls behind and stops it once close enough.
+// It is runtime-only state and is not persisted.
+type Follow struct {
+	LeaderID     types.EntityID
+	LeaderHandle types.Handle
+	Distance     float64
+
+	// PathX/PathY is the leader position the current path was requested for.
+	PathX float64
+	PathY float64
+	// Announced is set once the follower has been told the follow started.
+	Announced bool
+	// StopReason is set by StopFollow; FollowSystem ends the follow on its next update.
+	StopReason FollowStopReason
+}
+
+const FollowComponentID ecs.ComponentID = 37
+
+func init() {
+	ecs.RegisterComponent[Follow](FollowComponentID)
+}
@@ -60,6 +60,16 @@ type InvItem struct {
 	Quality  uint32
 	Quantity uint32
 
+	// Wear is durability used up by work (0 for new items); the item breaks when it reaches
+	// itemdefs.ItemDef.MaxDurability(Quality).
+	Wear uint32
+
+	// Spoil is spoilage accumulated up to SpoilTick, in ticks at itemdefs.DefaultSpoilRate;
+	// the item is spoiled when it reaches itemdefs.SpoilDef.Ticks. SpoilTick is the server tick
+	// Spoil was last brought up to date (0 = not yet stamped). Only items with itemdefs.SpoilDef use them.
+	Spoil     uint64
+	SpoilTick uint64
+
 	// size in slots (no rotation); fits max 20x20 so uint8 is enough
 	W uint8
 	H uint8
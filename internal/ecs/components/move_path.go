@@ -0,0 +1,61 @@
+package components
+
+import (
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/types"
+)
+
+type MovePathState uint8
+
+const (
+	// MovePathPending waits for PathfindingSystem; the mover stands still meanwhile.
+	MovePathPending MovePathState = iota
+	// MovePathReady has waypoints to follow before the final leg to the movement target.
+	MovePathReady
+)
+
+// MovePath is the route computed for the current movement target.
+// Waypoints are intermediate points only: after the last one MovementSystem heads for
+// Movement.TargetX/Y (or the live target entity position) as usual.
+// A path no longer matching the movement target is dropped and the mover walks straight.
+type MovePath struct {
+	State MovePathState
+	// Seq identifies the request; a newer request for the same mover supersedes older ones.
+	Seq uint32
+
+	TargetType   constt.TargetType
+	TargetHandle types.Handle
+	GoalX        float64
+	GoalY        float64
+
+	Waypoints []types.Vector2
+	Next      int
+	// Repaths counts re-paths after getting blocked on the way.
+	Repaths int
+}
+
+const MovePathComponentID ecs.ComponentID = 36
+
+func init() {
+	ecs.RegisterComponent[MovePath](MovePathComponentID)
+}
+
+// Matches reports whether the path was computed for the current movement target.
+func (p *MovePath) Matches(m *Movement) bool {
+	if p.TargetType != m.TargetType {
+		return false
+	}
+	if m.TargetType == constt.TargetEntity {
+		return p.TargetHandle == m.TargetHandle
+	}
+	return p.GoalX == m.TargetX && p.GoalY == m.TargetY
+}
+
+// CurrentWaypoint returns the next intermediate waypoint, if any is left.
+func (p *MovePath) CurrentWaypoint() (types.Vector2, bool) {
+	if p.State != MovePathReady || p.Next >= len(p.Waypoints) {
+		return types.Vector2{}, false
+	}
+	return p.Waypoints[p.Next], true
+}
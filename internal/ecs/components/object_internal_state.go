@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"origin/internal/ecs"
+	"origin/internal/types"
 )
 
 // ObjectInternalState tracks runtime state and dirty flag for world objects.
@@ -37,6 +38,22 @@ type TakeBehaviorState struct {
 	Taken map[string]int `json:"-"`
 }
 
+type CropBehaviorState struct {
+	Stage          int            `json:"stage,omitempty"`
+	NextGrowthTick uint64         `json:"next_growth_tick,omitempty"`
+	Taken          map[string]int `json:"-"`
+}
+
+// ProcessorBehaviorState is the fuel timer and per-item progress of a processing machine.
+// Progress and Overcook are keyed by item id inside the machine inventories.
+type ProcessorBehaviorState struct {
+	Lit           bool                      `json:"lit,omitempty"`
+	FuelTicksLeft uint64                    `json:"fuel_ticks_left,omitempty"`
+	LastTick      uint64                    `json:"last_tick,omitempty"`
+	Progress      map[types.EntityID]uint64 `json:"progress,omitempty"`
+	Overcook      map[types.EntityID]uint64 `json:"overcook,omitempty"`
+}
+
 type BuildBehaviorState struct {
 	BuildKey     string                   `json:"build_key,omitempty"`
 	BuildDefID   int                      `json:"build_def_id,omitempty"`
@@ -198,6 +215,56 @@ func (s *TakeBehaviorState) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (s CropBehaviorState) MarshalJSON() ([]byte, error) {
+	payload := make(map[string]any, 2+len(s.Taken))
+	if s.Stage > 0 {
+		payload["stage"] = s.Stage
+	}
+	if s.NextGrowthTick > 0 {
+		payload["next_growth_tick"] = s.NextGrowthTick
+	}
+	appendTakenCounts(payload, s.Taken)
+	return json.Marshal(payload)
+}
+
+func (s *CropBehaviorState) UnmarshalJSON(data []byte) error {
+	if s == nil {
+		return fmt.Errorf("crop behavior state is nil")
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*s = CropBehaviorState{}
+	for key, value := range raw {
+		switch key {
+		case "stage":
+			if err := json.Unmarshal(value, &s.Stage); err != nil {
+				return fmt.Errorf("crop state field %q: %w", key, err)
+			}
+		case "next_growth_tick":
+			if err := json.Unmarshal(value, &s.NextGrowthTick); err != nil {
+				return fmt.Errorf("crop state field %q: %w", key, err)
+			}
+		default:
+			taken, err := unmarshalTakenCountField(key, value)
+			if err != nil {
+				return fmt.Errorf("crop state field %q: %w", key, err)
+			}
+			if taken.ActionID == "" || taken.Count <= 0 {
+				continue
+			}
+			if s.Taken == nil {
+				s.Taken = make(map[string]int)
+			}
+			s.Taken[taken.ActionID] = taken.Count
+		}
+	}
+	return nil
+}
+
 type takenCountField struct {
 	ActionID string
 	Count    int
@@ -120,3 +120,27 @@ func TestTakeBehaviorState_UnmarshalJSONDynamicTakenKeys(t *testing.T) {
 		t.Fatalf("expected chip_flint=2, got %d", state.Taken["chip_flint"])
 	}
 }
+
+func TestCropBehaviorState_JSONRoundTrip(t *testing.T) {
+	state := CropBehaviorState{
+		Stage:          2,
+		NextGrowthTick: 900,
+		Taken:          map[string]int{"take_straw": 1},
+	}
+
+	payload, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+
+	var decoded CropBehaviorState
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if decoded.Stage != 2 || decoded.NextGrowthTick != 900 {
+		t.Fatalf("unexpected base fields: %+v", decoded)
+	}
+	if decoded.Taken["take_straw"] != 1 {
+		t.Fatalf("expected take_straw=1, got %d", decoded.Taken["take_straw"])
+	}
+}
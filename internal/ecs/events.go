@@ -3,6 +3,7 @@ package ecs
 import (
 	constt "origin/internal/const"
 	"origin/internal/types"
+	"origin/internal/weather"
 
 	"time"
 )
@@ -28,6 +29,8 @@ const (
 	TopicGameplayChunk             = "gameplay.chunk.*"
 	TopicGameplayChunkLoad         = "gameplay.chunk.load"
 	TopicGameplayChunkUnload       = "gameplay.chunk.unload"
+	TopicGameplayChunkTileUpdate   = "gameplay.chunk.tile_update"
+	TopicGameplayChunkWeather      = "gameplay.chunk.weather"
 	TopicSystemAll                 = "system.*"
 	TopicSystemTick                = "system.tick"
 	TopicSystemShutdown            = "system.shutdown"
@@ -115,11 +118,12 @@ type ChunkLoadEvent struct {
 	Tiles     []byte
 	Epoch     uint32
 	Version   uint32 // версия чанка
+	Weather   weather.Type
 }
 
 func (e *ChunkLoadEvent) Topic() string { return e.topic }
 
-func NewChunkLoadEvent(entityID types.EntityID, x, y, layer int, tiles []byte, epoch uint32, version uint32) *ChunkLoadEvent {
+func NewChunkLoadEvent(entityID types.EntityID, x, y, layer int, tiles []byte, epoch uint32, version uint32, weatherType weather.Type) *ChunkLoadEvent {
 	return &ChunkLoadEvent{
 		topic:     TopicGameplayChunkLoad,
 		Timestamp: time.Now(),
@@ -130,6 +134,7 @@ func NewChunkLoadEvent(entityID types.EntityID, x, y, layer int, tiles []byte, e
 		Tiles:     tiles,
 		Epoch:     epoch,
 		Version:   version,
+		Weather:   weatherType,
 	}
 }
 
@@ -158,6 +163,66 @@ func NewChunkUnloadEvent(entityID types.EntityID, x, y, layer int, epoch uint32)
 	}
 }
 
+// ChunkTileUpdateEvent represents a single tile change of a chunk an entity has loaded
+type ChunkTileUpdateEvent struct {
+	topic     string
+	Timestamp time.Time
+	EntityID  types.EntityID // Entity streaming the chunk
+	X         int
+	Y         int
+	Layer     int
+	LocalX    int // tile position inside the chunk
+	LocalY    int
+	TileID    byte
+	Epoch     uint32
+	Version   uint32 // версия чанка после изменения
+}
+
+func (e *ChunkTileUpdateEvent) Topic() string { return e.topic }
+
+func NewChunkTileUpdateEvent(entityID types.EntityID, x, y, layer, localX, localY int, tileID byte, epoch uint32, version uint32) *ChunkTileUpdateEvent {
+	return &ChunkTileUpdateEvent{
+		topic:     TopicGameplayChunkTileUpdate,
+		Timestamp: time.Now(),
+		EntityID:  entityID,
+		X:         x,
+		Y:         y,
+		Layer:     layer,
+		LocalX:    localX,
+		LocalY:    localY,
+		TileID:    tileID,
+		Epoch:     epoch,
+		Version:   version,
+	}
+}
+
+// ChunkWeatherEvent represents a weather change over a chunk an entity has loaded
+type ChunkWeatherEvent struct {
+	topic     string
+	Timestamp time.Time
+	EntityID  types.EntityID // Entity streaming the chunk
+	X         int
+	Y         int
+	Layer     int
+	Weather   weather.Type
+	Epoch     uint32
+}
+
+func (e *ChunkWeatherEvent) Topic() string { return e.topic }
+
+func NewChunkWeatherEvent(entityID types.EntityID, x, y, layer int, weatherType weather.Type, epoch uint32) *ChunkWeatherEvent {
+	return &ChunkWeatherEvent{
+		topic:     TopicGameplayChunkWeather,
+		Timestamp: time.Now(),
+		EntityID:  entityID,
+		X:         x,
+		Y:         y,
+		Layer:     layer,
+		Weather:   weatherType,
+		Epoch:     epoch,
+	}
+}
+
 // MoveBatchEntry holds movement data for a single entity within a batch.
 type MoveBatchEntry struct {
 	EntityID types.EntityID
@@ -0,0 +1,42 @@
+package ecs
+
+import "origin/internal/types"
+
+const animalThinkKey = "animal"
+
+// AnimalThinkSchedule orders animal AI decisions by due tick.
+// It is kept apart from BehaviorTickSchedule so animal AI is drained with its own per-tick budget
+// and never starves object behavior ticks. Only animals of active chunks are ever scheduled;
+// entries of despawned animals are dropped when they come due.
+type AnimalThinkSchedule struct {
+	schedule BehaviorTickSchedule
+	popped   []BehaviorTickKey
+}
+
+func (s *AnimalThinkSchedule) Schedule(entityID types.EntityID, dueTick uint64) bool {
+	return s.schedule.Schedule(entityID, animalThinkKey, dueTick)
+}
+
+func (s *AnimalThinkSchedule) Cancel(entityID types.EntityID) bool {
+	return s.schedule.Cancel(entityID, animalThinkKey)
+}
+
+// PopDue appends up to max animals whose think is due at nowTick.
+func (s *AnimalThinkSchedule) PopDue(nowTick uint64, max int, dst []types.EntityID) []types.EntityID {
+	s.popped = s.schedule.PopDue(nowTick, max, s.popped[:0])
+	for _, key := range s.popped {
+		dst = append(dst, key.EntityID)
+	}
+	return dst
+}
+
+func (s *AnimalThinkSchedule) PendingCount() int {
+	return s.schedule.PendingCount()
+}
+
+func ScheduleAnimalThink(w *World, entityID types.EntityID, dueTick uint64) bool {
+	if w == nil {
+		return false
+	}
+	return GetResource[AnimalThinkSchedule](w).Schedule(entityID, dueTick)
+}
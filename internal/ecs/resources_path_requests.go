@@ -0,0 +1,43 @@
+package ecs
+
+import "origin/internal/types"
+
+// PathRequest asks PathfindingSystem to route a mover. Seq matches MovePath.Seq of the mover;
+// a request whose mover has since asked again (or dropped the path) is stale and skipped.
+type PathRequest struct {
+	Handle types.Handle
+	Seq    uint32
+}
+
+// PathRequestQueue holds path requests in arrival order. PathfindingSystem drains it
+// with a per-tick node budget, so a burst of requests is spread over several ticks.
+type PathRequestQueue struct {
+	requests []PathRequest
+	head     int
+	nextSeq  uint32
+}
+
+// Push enqueues a request for the mover and returns its sequence number.
+func (q *PathRequestQueue) Push(h types.Handle) uint32 {
+	q.nextSeq++
+	q.requests = append(q.requests, PathRequest{Handle: h, Seq: q.nextSeq})
+	return q.nextSeq
+}
+
+// Pop removes the oldest request.
+func (q *PathRequestQueue) Pop() (PathRequest, bool) {
+	if q.head >= len(q.requests) {
+		return PathRequest{}, false
+	}
+	request := q.requests[q.head]
+	q.head++
+	if q.head == len(q.requests) {
+		q.requests = q.requests[:0]
+		q.head = 0
+	}
+	return request, true
+}
+
+func (q *PathRequestQueue) Len() int {
+	return len(q.requests) - q.head
+}
@@ -4,7 +4,9 @@ import (
 	"sync"
 	"time"
 
+	"origin/internal/timeutil"
 	"origin/internal/types"
+	"origin/internal/weather"
 )
 
 // TimeState holds per-tick time data, updated once before systems run.
@@ -13,12 +15,13 @@ type TimeState struct {
 	Tick                uint64
 	TickRate            int
 	TickPeriod          time.Duration
-	Delta               float64       // Fixed-step dt in seconds
-	Now                 time.Time     // Runtime game time (advances only while server process runs)
-	UnixMs              int64         // Wall Unix milliseconds for network packets
-	WallNow             time.Time     // Wall-clock time at tick start
-	RuntimeSecondsTotal int64         // Runtime total in whole seconds
-	Uptime              time.Duration // Time since this server process started
+	Delta               float64            // Fixed-step dt in seconds
+	Now                 time.Time          // Runtime game time (advances only while server process runs)
+	UnixMs              int64              // Wall Unix milliseconds for network packets
+	WallNow             time.Time          // Wall-clock time at tick start
+	RuntimeSecondsTotal int64              // Runtime total in whole seconds
+	WorldTime           timeutil.WorldTime // In-game calendar time derived from RuntimeSecondsTotal
+	Uptime              time.Duration      // Time since this server process started
 }
 
 type BehaviorTickPolicy struct {
@@ -83,5 +86,7 @@ type ObserverVisibility struct {
 	LastY          float64
 	LastChunkX     int
 	LastChunkY     int
-	LastChunkGens  []ChunkGen // chunk generations at last vision update (dirty-flag skip)
+	LastChunkGens  []ChunkGen   // chunk generations at last vision update (dirty-flag skip)
+	LastRadius     float64      // vision radius at last vision update, changes with daylight
+	LastWeather    weather.Type // weather over the observer at last vision update
 }
@@ -0,0 +1,81 @@
+package ecs
+
+import (
+	"sync"
+
+	_const "origin/internal/const"
+	"origin/internal/timeutil"
+	"origin/internal/types"
+	"origin/internal/weather"
+)
+
+// ChunkWeather is the weather of one chunk.
+type ChunkWeather struct {
+	Coord   types.ChunkCoord
+	Weather weather.Type
+}
+
+// WeatherState caches the weather of chunks clients stream. WeatherSystem refreshes it
+// from the model; chunk loads and gameplay hooks read it from any goroutine.
+type WeatherState struct {
+	mu        sync.RWMutex
+	model     weather.Model
+	worldTime timeutil.WorldTime
+	byChunk   map[types.ChunkCoord]weather.Type
+}
+
+func (s *WeatherState) SetModel(model weather.Model) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model = model
+	s.byChunk = nil
+}
+
+// At returns the weather the chunk's clients were last told about.
+// A chunk not tracked yet is computed from the model and tracked from now on.
+func (s *WeatherState) At(coord types.ChunkCoord) weather.Type {
+	s.mu.RLock()
+	current, ok := s.byChunk[coord]
+	s.mu.RUnlock()
+	if ok {
+		return current
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.byChunk[coord]; ok {
+		return current
+	}
+	current = s.model.At(coord, s.worldTime)
+	if s.byChunk == nil {
+		s.byChunk = make(map[types.ChunkCoord]weather.Type, 64)
+	}
+	s.byChunk[coord] = current
+	return current
+}
+
+// AtWorldPos returns the weather of the chunk containing the world position.
+func (s *WeatherState) AtWorldPos(x, y float64) weather.Type {
+	return s.At(types.WorldToChunkCoord(int(x), int(y), _const.ChunkSize, _const.CoordPerTile))
+}
+
+// Update advances the weather to worldTime for the given chunks and returns the chunks
+// whose weather changed. Chunks not listed are forgotten.
+func (s *WeatherState) Update(worldTime timeutil.WorldTime, coords []types.ChunkCoord) []ChunkWeather {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.worldTime = worldTime
+	previous := s.byChunk
+	s.byChunk = make(map[types.ChunkCoord]weather.Type, len(coords))
+
+	var changed []ChunkWeather
+	for _, coord := range coords {
+		current := s.model.At(coord, worldTime)
+		s.byChunk[coord] = current
+		if last, tracked := previous[coord]; tracked && last != current {
+			changed = append(changed, ChunkWeather{Coord: coord, Weather: current})
+		}
+	}
+	return changed
+}
@@ -0,0 +1,67 @@
+package ecs
+
+import (
+	"testing"
+
+	"origin/internal/timeutil"
+	"origin/internal/types"
+	"origin/internal/weather"
+)
+
+func TestWeatherStateUpdateReportsOnlyTrackedChanges(t *testing.T) {
+	t.Parallel()
+
+	model := weather.NewModel(3, 1)
+	state := WeatherState{}
+	state.SetModel(model)
+
+	day := timeutil.WorldTime{DayLengthSeconds: 24 * 3600, SeasonDays: 7}
+	coords := make([]types.ChunkCoord, 0, 64)
+	for x := 0; x < 64; x++ {
+		coords = append(coords, types.ChunkCoord{X: x})
+	}
+
+	if changed := state.Update(day, coords); len(changed) != 0 {
+		t.Fatalf("expected no changes for newly tracked chunks, got %v", changed)
+	}
+	for _, coord := range coords {
+		if got, want := state.At(coord), model.At(coord, day); got != want {
+			t.Fatalf("chunk %v: weather %v, want %v", coord, got, want)
+		}
+	}
+
+	later := day
+	later.Day = 3
+	changed := state.Update(later, coords)
+	want := 0
+	for _, coord := range coords {
+		if model.At(coord, day) != model.At(coord, later) {
+			want++
+		}
+	}
+	if want == 0 || len(changed) != want {
+		t.Fatalf("expected %d changed chunks, got %d", want, len(changed))
+	}
+	for _, change := range changed {
+		if change.Weather != model.At(change.Coord, later) {
+			t.Fatalf("chunk %v reported %v, want %v", change.Coord, change.Weather, model.At(change.Coord, later))
+		}
+	}
+
+	// Chunks dropped from the update are forgotten: their next change is not reported.
+	if changed := state.Update(later, coords[:1]); len(changed) != 0 {
+		t.Fatalf("expected no changes without time passing, got %v", changed)
+	}
+	if changed := state.Update(day, coords[1:]); len(changed) != 0 {
+		t.Fatalf("expected forgotten chunks not to report changes, got %v", changed)
+	}
+}
+
+func TestWeatherStateZeroValueIsClear(t *testing.T) {
+	t.Parallel()
+
+	var state WeatherState
+	if got := state.AtWorldPos(-5, 123456); got != weather.Clear {
+		t.Fatalf("expected clear weather without a model, got %v", got)
+	}
+}
@@ -0,0 +1,303 @@
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+// AnimalAISystemPriority runs animal decisions before MovementSystem (100) moves them in the same tick.
+const AnimalAISystemPriority = 90
+
+// animalThinkIntervalTicks is how often an active animal re-evaluates players around it.
+const animalThinkIntervalTicks = 5
+
+// AnimalAISystem drives wild animals (objects with the "animal" behavior) through wander, flee and chase.
+// Animals only think while their chunk is active: the animal behavior schedules the first think
+// on spawn/activation and deactivated animals are despawned, so idle animals elsewhere cost nothing.
+// Due thinks are drained with a per-tick budget; the rest wait for the next tick.
+type AnimalAISystem struct {
+	ecs.BaseSystem
+	chunkManager  core.ChunkManager
+	logger        *zap.Logger
+	budgetPerTick int
+	rng           *rand.Rand
+	dueBatch      []types.EntityID
+	candidates    []types.Handle
+}
+
+type AnimalAISystemConfig struct {
+	BudgetPerTick int
+	// ChunkManager keeps animals inside active chunks and finds nearby players.
+	ChunkManager core.ChunkManager
+}
+
+func NewAnimalAISystem(logger *zap.Logger, cfg AnimalAISystemConfig) *AnimalAISystem {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.BudgetPerTick <= 0 {
+		cfg.BudgetPerTick = 256
+	}
+
+	return &AnimalAISystem{
+		BaseSystem:    ecs.NewBaseSystem("AnimalAISystem", AnimalAISystemPriority),
+		chunkManager:  cfg.ChunkManager,
+		logger:        logger,
+		budgetPerTick: cfg.BudgetPerTick,
+		rng:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		dueBatch:      make([]types.EntityID, 0, cfg.BudgetPerTick),
+		candidates:    make([]types.Handle, 0, 64),
+	}
+}
+
+func (s *AnimalAISystem) Update(w *ecs.World, dt float64) {
+	_ = dt
+	if s.chunkManager == nil {
+		return
+	}
+
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	schedule := ecs.GetResource[ecs.AnimalThinkSchedule](w)
+	s.dueBatch = schedule.PopDue(nowTick, s.budgetPerTick, s.dueBatch[:0])
+	for _, entityID := range s.dueBatch {
+		if s.think(w, nowTick, entityID) {
+			schedule.Schedule(entityID, nowTick+animalThinkIntervalTicks)
+		}
+	}
+}
+
+// think runs one decision of an animal and reports whether it should think again.
+func (s *AnimalAISystem) think(w *ecs.World, nowTick uint64, entityID types.EntityID) bool {
+	handle := w.GetHandleByEntityID(entityID)
+	if handle == types.InvalidHandle || !w.Alive(handle) {
+		return false
+	}
+	ai, hasAI := ecs.GetComponent[components.AnimalAI](w, handle)
+	if !hasAI {
+		return false
+	}
+	info, hasInfo := ecs.GetComponent[components.EntityInfo](w, handle)
+	if !hasInfo {
+		return false
+	}
+	def, found := objectdefs.Global().GetByID(int(info.TypeID))
+	if !found || def.AnimalConfig == nil {
+		return false
+	}
+	transform, hasTransform := ecs.GetComponent[components.Transform](w, handle)
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, handle)
+	chunkRef, hasChunkRef := ecs.GetComponent[components.ChunkRef](w, handle)
+	if !hasTransform || !hasMovement || !hasChunkRef {
+		return false
+	}
+	cfg := def.AnimalConfig
+
+	threatHandle, threatTransform := s.findThreat(w, ai, transform, chunkRef, cfg)
+	switch {
+	case threatHandle != types.InvalidHandle && cfg.Aggressive:
+		s.chase(w, handle, &ai, movement, threatHandle, threatTransform)
+	case threatHandle != types.InvalidHandle:
+		s.flee(w, handle, &ai, transform, threatHandle, threatTransform, cfg)
+	default:
+		s.wander(w, nowTick, handle, &ai, transform, movement, cfg)
+	}
+	ecs.WithComponent(w, handle, func(state *components.AnimalAI) {
+		*state = ai
+	})
+	return true
+}
+
+// findThreat keeps the current threat while it stays within the calm radius,
+// otherwise it picks the nearest living player within the alert radius.
+func (s *AnimalAISystem) findThreat(
+	w *ecs.World,
+	ai components.AnimalAI,
+	transform components.Transform,
+	chunkRef components.ChunkRef,
+	cfg *objectdefs.AnimalBehaviorConfig,
+) (types.Handle, components.Transform) {
+	if ai.Mode != components.AnimalAIWander && ai.ThreatID != 0 {
+		threatHandle := w.GetHandleByEntityID(ai.ThreatID)
+		if threatTransform, ok := animalThreatTransform(w, threatHandle); ok &&
+			distanceSq(transform.X, transform.Y, threatTransform.X, threatTransform.Y) <= cfg.CalmRadius*cfg.CalmRadius {
+			return threatHandle, threatTransform
+		}
+	}
+
+	s.candidates = s.candidates[:0]
+	s.queryNearby(transform.X, transform.Y, cfg.AlertRadius, chunkRef)
+	bestHandle := types.InvalidHandle
+	var bestTransform components.Transform
+	bestDistSq := cfg.AlertRadius * cfg.AlertRadius
+	for _, candidate := range s.candidates {
+		if !ecs.HasComponent[components.CharacterProfile](w, candidate) {
+			continue
+		}
+		candidateTransform, ok := animalThreatTransform(w, candidate)
+		if !ok {
+			continue
+		}
+		distSq := distanceSq(transform.X, transform.Y, candidateTransform.X, candidateTransform.Y)
+		if distSq <= bestDistSq {
+			bestHandle = candidate
+			bestTransform = candidateTransform
+			bestDistSq = distSq
+		}
+	}
+	return bestHandle, bestTransform
+}
+
+func (s *AnimalAISystem) queryNearby(x, y, radius float64, chunkRef components.ChunkRef) {
+	minChunkX := int(math.Floor((x - radius) / constt.ChunkWorldSize))
+	maxChunkX := int(math.Floor((x + radius) / constt.ChunkWorldSize))
+	minChunkY := int(math.Floor((y - radius) / constt.ChunkWorldSize))
+	maxChunkY := int(math.Floor((y + radius) / constt.ChunkWorldSize))
+	for chunkY := max(minChunkY, chunkRef.CurrentChunkY-1); chunkY <= min(maxChunkY, chunkRef.CurrentChunkY+1); chunkY++ {
+		for chunkX := max(minChunkX, chunkRef.CurrentChunkX-1); chunkX <= min(maxChunkX, chunkRef.CurrentChunkX+1); chunkX++ {
+			chunk := s.chunkManager.GetChunkFast(types.ChunkCoord{X: chunkX, Y: chunkY})
+			if chunk == nil {
+				continue
+			}
+			chunk.Spatial().QueryRadius(x, y, radius, &s.candidates)
+		}
+	}
+}
+
+func (s *AnimalAISystem) chase(
+	w *ecs.World,
+	handle types.Handle,
+	ai *components.AnimalAI,
+	movement components.Movement,
+	threatHandle types.Handle,
+	threatTransform components.Transform,
+) {
+	threatID, _ := w.GetExternalID(threatHandle)
+	ai.Mode = components.AnimalAIChase
+	ai.ThreatID = threatID
+	if movement.TargetType == constt.TargetEntity && movement.TargetHandle == threatHandle && movement.Mode == constt.Run {
+		return
+	}
+	ecs.WithComponent(w, handle, func(m *components.Movement) {
+		m.Mode = constt.Run
+		m.SetTargetHandle(threatHandle, int(threatTransform.X), int(threatTransform.Y))
+	})
+}
+
+// flee runs straight away from the threat, up to the calm radius. A shorter run is tried
+// when the full one would leave the active chunks; with none available the animal holds still.
+func (s *AnimalAISystem) flee(
+	w *ecs.World,
+	handle types.Handle,
+	ai *components.AnimalAI,
+	transform components.Transform,
+	threatHandle types.Handle,
+	threatTransform components.Transform,
+	cfg *objectdefs.AnimalBehaviorConfig,
+) {
+	threatID, _ := w.GetExternalID(threatHandle)
+	ai.Mode = components.AnimalAIFlee
+	ai.ThreatID = threatID
+
+	dx := transform.X - threatTransform.X
+	dy := transform.Y - threatTransform.Y
+	dist := math.Sqrt(dx*dx + dy*dy)
+	if dist < 0.001 {
+		angle := s.rng.Float64() * 2 * math.Pi
+		dx, dy, dist = math.Cos(angle), math.Sin(angle), 1
+	}
+	for _, runDistance := range []float64{cfg.CalmRadius, cfg.CalmRadius / 2} {
+		targetX := transform.X + dx/dist*runDistance
+		targetY := transform.Y + dy/dist*runDistance
+		if !s.isInActiveChunk(targetX, targetY) {
+			continue
+		}
+		ecs.WithComponent(w, handle, func(m *components.Movement) {
+			m.Mode = constt.Run
+			m.SetTargetPoint(int(targetX), int(targetY))
+		})
+		return
+	}
+}
+
+// wander calms a fleeing/chasing animal down and otherwise walks to a random point around home
+// after a random pause.
+func (s *AnimalAISystem) wander(
+	w *ecs.World,
+	nowTick uint64,
+	handle types.Handle,
+	ai *components.AnimalAI,
+	transform components.Transform,
+	movement components.Movement,
+	cfg *objectdefs.AnimalBehaviorConfig,
+) {
+	if ai.Mode != components.AnimalAIWander {
+		ai.Mode = components.AnimalAIWander
+		ai.ThreatID = 0
+		ai.NextWanderTick = nowTick + cfg.WanderPauseTicks
+		if movement.State == constt.StateMoving {
+			ecs.WithComponent(w, handle, func(m *components.Movement) {
+				m.ClearTarget()
+			})
+			ecs.GetResource[ecs.MovedEntities](w).Add(handle, transform.X, transform.Y)
+		}
+		return
+	}
+	if movement.State == constt.StateMoving || nowTick < ai.NextWanderTick {
+		return
+	}
+
+	ai.NextWanderTick = nowTick + cfg.WanderPauseTicks + uint64(s.rng.Int63n(int64(cfg.WanderPauseTicks)+1))
+	angle := s.rng.Float64() * 2 * math.Pi
+	distance := s.rng.Float64() * cfg.WanderRadius
+	targetX := ai.HomeX + math.Cos(angle)*distance
+	targetY := ai.HomeY + math.Sin(angle)*distance
+	if !s.isInActiveChunk(targetX, targetY) {
+		return
+	}
+	ecs.WithComponent(w, handle, func(m *components.Movement) {
+		m.Mode = constt.Walk
+		m.SetTargetPoint(int(targetX), int(targetY))
+	})
+}
+
+// isInActiveChunk keeps animals from walking into chunks that are not simulated:
+// ChunkSystem can only migrate entities into active chunks.
+func (s *AnimalAISystem) isInActiveChunk(x, y float64) bool {
+	if x < 0 || y < 0 {
+		return false
+	}
+	chunk := s.chunkManager.GetChunkFast(types.ChunkCoord{
+		X: int(x) / constt.ChunkWorldSize,
+		Y: int(y) / constt.ChunkWorldSize,
+	})
+	return chunk != nil && chunk.GetState() == types.ChunkStateActive
+}
+
+// animalThreatTransform returns the position of a player an animal can react to.
+// Knocked out and dead players are ignored.
+func animalThreatTransform(w *ecs.World, handle types.Handle) (components.Transform, bool) {
+	if handle == types.InvalidHandle || !w.Alive(handle) {
+		return components.Transform{}, false
+	}
+	if health, hasHealth := ecs.GetComponent[components.EntityHealth](w, handle); hasHealth &&
+		(health.KOUntilTick != 0 || health.HHP <= 0) {
+		return components.Transform{}, false
+	}
+	return ecs.GetComponent[components.Transform](w, handle)
+}
+
+func distanceSq(ax, ay, bx, by float64) float64 {
+	dx := ax - bx
+	dy := ay - by
+	return dx*dx + dy*dy
+}
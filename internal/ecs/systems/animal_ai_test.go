@@ -0,0 +1,201 @@
+package systems
+
+import (
+	"math"
+	"testing"
+
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+const (
+	testPassiveAnimalDefID    = 950
+	testAggressiveAnimalDefID = 951
+)
+
+type testAnimalChunkManager struct {
+	chunks map[types.ChunkCoord]*core.Chunk
+}
+
+func newTestAnimalChunkManager(activeCoords ...types.ChunkCoord) *testAnimalChunkManager {
+	cm := &testAnimalChunkManager{chunks: make(map[types.ChunkCoord]*core.Chunk, len(activeCoords))}
+	for _, coord := range activeCoords {
+		chunk := core.NewChunk(coord, 0, 0, constt.ChunkSize)
+		chunk.SetState(types.ChunkStateActive)
+		cm.chunks[coord] = chunk
+	}
+	return cm
+}
+
+func (cm *testAnimalChunkManager) ActiveChunks() []*core.Chunk { return nil }
+
+func (cm *testAnimalChunkManager) GetChunk(coord types.ChunkCoord) *core.Chunk {
+	return cm.chunks[coord]
+}
+
+func (cm *testAnimalChunkManager) GetChunkFast(coord types.ChunkCoord) *core.Chunk {
+	return cm.chunks[coord]
+}
+
+func (cm *testAnimalChunkManager) UpdateEntityPosition(types.EntityID, types.ChunkCoord) {}
+
+func setupAnimalTestDefs(t *testing.T) {
+	t.Helper()
+	previous := objectdefs.Global()
+	t.Cleanup(func() { objectdefs.SetGlobalForTesting(previous) })
+
+	passive := objectdefs.ObjectDef{DefID: testPassiveAnimalDefID, Key: "test_deer"}
+	passive.AnimalConfig = &objectdefs.AnimalBehaviorConfig{AlertRadius: 96, CalmRadius: 192, WanderRadius: 60, WanderPauseTicks: 10}
+	aggressive := objectdefs.ObjectDef{DefID: testAggressiveAnimalDefID, Key: "test_bear"}
+	aggressive.AnimalConfig = &objectdefs.AnimalBehaviorConfig{Aggressive: true, AlertRadius: 96, CalmRadius: 192, WanderRadius: 60, WanderPauseTicks: 10}
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{passive, aggressive}))
+}
+
+func spawnTestAnimal(w *ecs.World, entityID types.EntityID, defID int, x, y float64) types.Handle {
+	handle := w.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: x, Y: y})
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(defID)})
+		ecs.AddComponent(w, h, components.Movement{Speed: 28, TargetHandle: types.InvalidHandle})
+		ecs.AddComponent(w, h, components.ChunkRef{})
+		ecs.AddComponent(w, h, components.AnimalAI{HomeX: x, HomeY: y})
+	})
+	ecs.ScheduleAnimalThink(w, entityID, 0)
+	return handle
+}
+
+func spawnTestAnimalThreat(w *ecs.World, cm *testAnimalChunkManager, entityID types.EntityID, x, y float64) types.Handle {
+	handle := w.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: x, Y: y})
+		ecs.AddComponent(w, h, components.CharacterProfile{})
+		ecs.AddComponent(w, h, components.EntityHealth{SHP: 10, HHP: 10})
+	})
+	cm.chunks[types.ChunkCoord{}].Spatial().AddDynamic(handle, int(x), int(y))
+	return handle
+}
+
+func runAnimalThinkAt(w *ecs.World, system *AnimalAISystem, tick uint64) {
+	ecs.GetResource[ecs.TimeState](w).Tick = tick
+	system.Update(w, 0)
+}
+
+func TestAnimalAISystem_PassiveAnimalFleesAndCalmsDown(t *testing.T) {
+	setupAnimalTestDefs(t)
+	w := ecs.NewWorldForTesting()
+	cm := newTestAnimalChunkManager(types.ChunkCoord{})
+	system := NewAnimalAISystem(nil, AnimalAISystemConfig{ChunkManager: cm})
+	animalHandle := spawnTestAnimal(w, 7001, testPassiveAnimalDefID, 500, 500)
+	playerHandle := spawnTestAnimalThreat(w, cm, 7002, 550, 500)
+
+	runAnimalThinkAt(w, system, 1)
+
+	ai, _ := ecs.GetComponent[components.AnimalAI](w, animalHandle)
+	movement, _ := ecs.GetComponent[components.Movement](w, animalHandle)
+	if ai.Mode != components.AnimalAIFlee || ai.ThreatID != 7002 {
+		t.Fatalf("expected animal to flee from player, got %+v", ai)
+	}
+	if movement.State != constt.StateMoving || movement.Mode != constt.Run || movement.TargetX != 500-192 || movement.TargetY != 500 {
+		t.Fatalf("expected run away from player by calm radius, got %+v", movement)
+	}
+
+	// Beyond the alert radius but inside the calm radius the animal keeps fleeing.
+	ecs.WithComponent(w, playerHandle, func(transform *components.Transform) { transform.X = 650 })
+	runAnimalThinkAt(w, system, 1+animalThinkIntervalTicks)
+	if ai, _ = ecs.GetComponent[components.AnimalAI](w, animalHandle); ai.Mode != components.AnimalAIFlee {
+		t.Fatalf("expected animal to keep fleeing inside calm radius, got %+v", ai)
+	}
+
+	ecs.WithComponent(w, playerHandle, func(transform *components.Transform) { transform.X = 800 })
+	runAnimalThinkAt(w, system, 1+2*animalThinkIntervalTicks)
+	ai, _ = ecs.GetComponent[components.AnimalAI](w, animalHandle)
+	movement, _ = ecs.GetComponent[components.Movement](w, animalHandle)
+	if ai.Mode != components.AnimalAIWander || ai.ThreatID != 0 {
+		t.Fatalf("expected animal to calm down, got %+v", ai)
+	}
+	if movement.State != constt.StateIdle {
+		t.Fatalf("expected calmed animal to stop, got %+v", movement)
+	}
+}
+
+func TestAnimalAISystem_AggressiveAnimalChasesAndIgnoresKnockedOut(t *testing.T) {
+	setupAnimalTestDefs(t)
+	w := ecs.NewWorldForTesting()
+	cm := newTestAnimalChunkManager(types.ChunkCoord{})
+	system := NewAnimalAISystem(nil, AnimalAISystemConfig{ChunkManager: cm})
+	animalHandle := spawnTestAnimal(w, 7101, testAggressiveAnimalDefID, 500, 500)
+	playerHandle := spawnTestAnimalThreat(w, cm, 7102, 550, 500)
+
+	runAnimalThinkAt(w, system, 1)
+
+	ai, _ := ecs.GetComponent[components.AnimalAI](w, animalHandle)
+	movement, _ := ecs.GetComponent[components.Movement](w, animalHandle)
+	if ai.Mode != components.AnimalAIChase || ai.ThreatID != 7102 {
+		t.Fatalf("expected animal to chase player, got %+v", ai)
+	}
+	if movement.TargetType != constt.TargetEntity || movement.TargetHandle != playerHandle || movement.Mode != constt.Run {
+		t.Fatalf("expected run toward player handle, got %+v", movement)
+	}
+
+	ecs.WithComponent(w, playerHandle, func(health *components.EntityHealth) { health.KOUntilTick = 1 })
+	runAnimalThinkAt(w, system, 1+animalThinkIntervalTicks)
+	if ai, _ = ecs.GetComponent[components.AnimalAI](w, animalHandle); ai.Mode != components.AnimalAIWander {
+		t.Fatalf("expected knocked out player to be ignored, got %+v", ai)
+	}
+}
+
+func TestAnimalAISystem_WanderStaysNearHomeInActiveChunks(t *testing.T) {
+	setupAnimalTestDefs(t)
+	w := ecs.NewWorldForTesting()
+	cm := newTestAnimalChunkManager(types.ChunkCoord{})
+	system := NewAnimalAISystem(nil, AnimalAISystemConfig{ChunkManager: cm})
+	// Home in the chunk corner: half of the wander circle lies outside the active chunk.
+	animalHandle := spawnTestAnimal(w, 7201, testPassiveAnimalDefID, 10, 10)
+
+	walks := 0
+	for tick := uint64(1); tick < 2000; tick += animalThinkIntervalTicks {
+		runAnimalThinkAt(w, system, tick)
+		movement, _ := ecs.GetComponent[components.Movement](w, animalHandle)
+		if movement.State != constt.StateMoving {
+			continue
+		}
+		walks++
+		if movement.Mode != constt.Walk || movement.TargetX < 0 || movement.TargetY < 0 {
+			t.Fatalf("expected walk inside active chunk, got %+v", movement)
+		}
+		if math.Hypot(movement.TargetX-10, movement.TargetY-10) > 60+1 {
+			t.Fatalf("expected wander target within wander radius of home, got %+v", movement)
+		}
+		// Arrive at once so the next wander can start after its pause.
+		ecs.WithComponent(w, animalHandle, func(m *components.Movement) { m.ClearTarget() })
+	}
+	if walks == 0 {
+		t.Fatalf("expected animal to wander")
+	}
+}
+
+func TestAnimalAISystem_ThinkBudgetAndDespawnedAnimals(t *testing.T) {
+	setupAnimalTestDefs(t)
+	w := ecs.NewWorldForTesting()
+	cm := newTestAnimalChunkManager(types.ChunkCoord{})
+	system := NewAnimalAISystem(nil, AnimalAISystemConfig{BudgetPerTick: 2, ChunkManager: cm})
+	spawnTestAnimal(w, 7301, testPassiveAnimalDefID, 500, 500)
+	spawnTestAnimal(w, 7302, testPassiveAnimalDefID, 600, 500)
+	despawned := spawnTestAnimal(w, 7303, testPassiveAnimalDefID, 700, 500)
+	w.Despawn(despawned)
+	schedule := ecs.GetResource[ecs.AnimalThinkSchedule](w)
+
+	runAnimalThinkAt(w, system, 1)
+	// Two animals thought and were rescheduled; the third is still due.
+	if got := schedule.PendingCount(); got != 3 {
+		t.Fatalf("expected 3 pending thinks after budgeted tick, got %d", got)
+	}
+
+	runAnimalThinkAt(w, system, 2)
+	// The despawned animal is dropped instead of rescheduled.
+	if got := schedule.PendingCount(); got != 2 {
+		t.Fatalf("expected despawned animal to leave the schedule, got %d pending", got)
+	}
+}
@@ -15,6 +15,7 @@ type BehaviorTickSystem struct {
 	ecs.BaseSystem
 	logger           *zap.Logger
 	behaviorRegistry contracts.BehaviorRegistry
+	deps             *contracts.ExecutionDeps
 	budgetPerTick    int
 	processBatch     []ecs.BehaviorTickKey
 }
@@ -22,6 +23,8 @@ type BehaviorTickSystem struct {
 type BehaviorTickSystemConfig struct {
 	BudgetPerTick    int
 	BehaviorRegistry contracts.BehaviorRegistry
+	// Deps are passed to behaviors that touch items or clients on ticks (processors).
+	Deps *contracts.ExecutionDeps
 }
 
 func NewBehaviorTickSystem(logger *zap.Logger, cfg BehaviorTickSystemConfig) *BehaviorTickSystem {
@@ -36,6 +39,7 @@ func NewBehaviorTickSystem(logger *zap.Logger, cfg BehaviorTickSystemConfig) *Be
 		BaseSystem:       ecs.NewBaseSystem("BehaviorTickSystem", BehaviorTickSystemPriority),
 		logger:           logger,
 		behaviorRegistry: cfg.BehaviorRegistry,
+		deps:             cfg.Deps,
 		budgetPerTick:    cfg.BudgetPerTick,
 		processBatch:     make([]ecs.BehaviorTickKey, 0, cfg.BudgetPerTick),
 	}
@@ -96,6 +100,7 @@ func (s *BehaviorTickSystem) processTickKey(w *ecs.World, currentTick uint64, ti
 		BehaviorKey:  tickKey.BehaviorKey,
 		CurrentTick:  currentTick,
 		CurrentState: runtimeState,
+		Deps:         s.deps,
 	})
 	if err != nil {
 		s.logger.Error("scheduled behavior tick failed",
@@ -9,6 +9,7 @@ import (
 	"origin/internal/ecs/components"
 	"origin/internal/persistence"
 	"origin/internal/persistence/repository"
+	"origin/internal/skilldefs"
 	"origin/internal/types"
 	"sync"
 	"time"
@@ -101,6 +102,9 @@ type CharacterSnapshot struct {
 	Skills      string
 	Discovery   string
 	Inventories []InventorySnapshot
+
+	// SkillIDs are defIds of learned skills, inserted into the skill table.
+	SkillIDs []int
 }
 
 type CharacterSaver struct {
@@ -150,7 +154,7 @@ func (s *CharacterSaver) Save(w *ecs.World, entityID types.EntityID, handle type
 	}
 	shpValue, hhpValue := s.resolveHealthSnapshotValues(w, handle)
 	inventories := s.inventorySaver.SerializeInventories(w, entityID, handle)
-	s.enqueueSnapshot(s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories))
+	s.enqueueSnapshot(s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories, learnedSkillIDs(w, handle)))
 }
 
 // SaveSync persists character snapshot immediately in caller goroutine.
@@ -168,7 +172,7 @@ func (s *CharacterSaver) SaveSync(w *ecs.World, entityID types.EntityID, handle
 	}
 	shpValue, hhpValue := s.resolveHealthSnapshotValues(w, handle)
 	inventories := s.inventorySaver.SerializeInventories(w, entityID, handle)
-	snapshot := s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories)
+	snapshot := s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories, learnedSkillIDs(w, handle))
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -190,6 +194,9 @@ func (s *CharacterSaver) SaveSync(w *ecs.World, entityID types.EntityID, handle
 	if err := s.db.Queries().UpdateCharacters(ctx, params); err != nil {
 		return err
 	}
+	if err := s.insertSkills(ctx, []CharacterSnapshot{snapshot}); err != nil {
+		return err
+	}
 
 	if len(snapshot.Inventories) == 0 {
 		return nil
@@ -234,7 +241,7 @@ func (s *CharacterSaver) SaveDetached(w *ecs.World, entityID types.EntityID, han
 	}
 	shpValue, hhpValue := s.resolveHealthSnapshotValues(w, handle)
 	inventories := s.inventorySaver.SerializeInventories(w, entityID, handle)
-	s.enqueueSnapshot(s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories))
+	s.enqueueSnapshot(s.buildSnapshot(entityID, transform, attributesRaw, experienceRaw, skillsRaw, discoveryRaw, staminaValue, energyValue, shpValue, hhpValue, inventories, learnedSkillIDs(w, handle)))
 }
 
 func (s *CharacterSaver) buildSnapshot(
@@ -249,6 +256,7 @@ func (s *CharacterSaver) buildSnapshot(
 	shpValue int16,
 	hhpValue int16,
 	inventories []InventorySnapshot,
+	skillIDs []int,
 ) CharacterSnapshot {
 	return CharacterSnapshot{
 		CharacterID: int64(entityID),
@@ -264,6 +272,7 @@ func (s *CharacterSaver) buildSnapshot(
 		Skills:      skillsRaw,
 		Discovery:   discoveryRaw,
 		Inventories: inventories,
+		SkillIDs:    skillIDs,
 	}
 }
 
@@ -360,6 +369,42 @@ func (s *CharacterSaver) serializeCharacterProfile(w *ecs.World, entityID types.
 	return string(attributesRaw), string(experienceRaw), string(skillsRaw), string(discoveryRaw)
 }
 
+// learnedSkillIDs maps learned skill keys to skill defIds. Keys without a definition are kept
+// in the skills column only.
+func learnedSkillIDs(w *ecs.World, handle types.Handle) []int {
+	profile, hasProfile := ecs.GetComponent[components.CharacterProfile](w, handle)
+	registry := skilldefs.Global()
+	if !hasProfile || registry == nil || len(profile.Skills) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(profile.Skills))
+	for _, key := range profile.Skills {
+		if def, ok := registry.GetByKey(key); ok {
+			ids = append(ids, def.DefID)
+		}
+	}
+	return ids
+}
+
+// insertSkills adds rows for learned skills. Skills are never unlearned, so existing rows are kept.
+func (s *CharacterSaver) insertSkills(ctx context.Context, batch []CharacterSnapshot) error {
+	var characterIDs []int64
+	var skillIDs []int
+	for _, snapshot := range batch {
+		for _, skillID := range snapshot.SkillIDs {
+			characterIDs = append(characterIDs, snapshot.CharacterID)
+			skillIDs = append(skillIDs, skillID)
+		}
+	}
+	if len(skillIDs) == 0 {
+		return nil
+	}
+	return s.db.Queries().InsertSkills(ctx, repository.InsertSkillsParams{
+		CharacterIds: characterIDs,
+		SkillIds:     skillIDs,
+	})
+}
+
 func (s *CharacterSaver) enqueueSnapshot(snapshot CharacterSnapshot) {
 	select {
 	case s.snapshotChannel <- snapshot:
@@ -481,6 +526,12 @@ func (s *CharacterSaver) flushBatchWithContext(ctx context.Context, batch []Char
 			zap.Error(charUpdateErr))
 	}
 
+	if err := s.insertSkills(ctx, batch); err != nil {
+		s.logger.Error("Failed to batch insert skills",
+			zap.Int("batch_size", len(batch)),
+			zap.Error(err))
+	}
+
 	// Batch upsert all inventories in a single query
 	totalInv := 0
 	for _, snapshot := range batch {
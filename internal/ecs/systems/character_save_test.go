@@ -3,6 +3,11 @@ package systems
 import (
 	"math"
 	"testing"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/skilldefs"
+	"origin/internal/types"
 )
 
 func TestNormalizeCharacterHeading(t *testing.T) {
@@ -32,3 +37,22 @@ func TestNormalizeCharacterHeading(t *testing.T) {
 		})
 	}
 }
+
+func TestLearnedSkillIDs(t *testing.T) {
+	prev := skilldefs.Global()
+	skilldefs.SetGlobalForTesting(skilldefs.NewRegistry([]skilldefs.SkillDef{
+		{DefID: 1, Key: "lumberjacking"},
+		{DefID: 2, Key: "carpentry"},
+	}))
+	t.Cleanup(func() { skilldefs.SetGlobalForTesting(prev) })
+
+	w := ecs.NewWorldForTesting()
+	h := w.Spawn(types.EntityID(100), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.CharacterProfile{Skills: []string{"carpentry", "removed_skill"}})
+	})
+
+	got := learnedSkillIDs(w, h)
+	if len(got) != 1 || got[0] != 2 {
+		t.Fatalf("learnedSkillIDs() = %v, want [2]", got)
+	}
+}
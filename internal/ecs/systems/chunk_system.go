@@ -41,6 +41,12 @@ func (s *ChunkSystem) Update(w *ecs.World, dt float64) {
 			continue
 		}
 
+		// Persistent world objects (animals) must be re-saved at their new position,
+		// otherwise chunk deactivation keeps the stale DB row.
+		ecs.WithComponent(w, h, func(state *components.ObjectInternalState) {
+			state.IsDirty = true
+		})
+
 		// Calculate current chunk from new position
 		newChunkX := int(newX) / _const.ChunkWorldSize
 		newChunkY := int(newY) / _const.ChunkWorldSize
@@ -119,6 +125,14 @@ func (s *ChunkSystem) migrateEntity(w *ecs.World, h types.Handle, chunkRef compo
 	// Update entity position in chunk manager
 	s.chunkManager.UpdateEntityPosition(entityID, newChunkCoord)
 
+	// Persistent objects change owning chunk in DB: both chunks must be saved.
+	if ecs.HasComponent[components.ObjectInternalState](w, h) {
+		if oldChunk != nil {
+			oldChunk.MarkRawDataDirty()
+		}
+		newChunk.MarkRawDataDirty()
+	}
+
 	//s.logger.Debug("Entity migrated between chunks",
 	//	zap.Uint64("handle", uint64(h)),
 	//	zap.Int("from_chunk_x", chunkRef.CurrentChunkX),
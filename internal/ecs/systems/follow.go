@@ -0,0 +1,255 @@
+package systems
+
+import (
+	"math"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+// FollowSystemPriority re-targets followers after AnimalAISystem (90) and before
+// PathfindingSystem (95), so a path requested here is served in the same tick.
+const FollowSystemPriority = 92
+
+const (
+	FollowDefaultDistance = 2 * constt.CoordPerTile
+	FollowMinDistance     = constt.CoordPerTile
+	FollowMaxDistance     = 20 * constt.CoordPerTile
+
+	// followSlack is how far past Distance the leader may get before an idle follower moves again.
+	followSlack = constt.CoordPerTile / 2
+	// followRepathDistance is how far the leader may move away from the point the current path
+	// was computed for before the follower asks for a new one.
+	followRepathDistance = 3 * constt.CoordPerTile
+)
+
+type FollowStateSender interface {
+	SendFollowState(entityID types.EntityID, state *netproto.S2C_FollowState)
+}
+
+// FollowSystem drives movers with a Follow component (StartFollow). The follower walks toward
+// the leader's live position whenever it falls behind and stops within Distance of it.
+// Following ends on StopFollow, on a movement target set by anything else, on a link,
+// when the leader despawns or leaves the follower's vision, or when no path leads to it.
+type FollowSystem struct {
+	ecs.BaseSystem
+	sender    FollowStateSender
+	logger    *zap.Logger
+	query     *ecs.PreparedQuery
+	followers []types.Handle
+}
+
+func NewFollowSystem(world *ecs.World, sender FollowStateSender, logger *zap.Logger) *FollowSystem {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	query := ecs.NewPreparedQuery(
+		world,
+		0|(1<<components.FollowComponentID),
+		0,
+	)
+	return &FollowSystem{
+		BaseSystem: ecs.NewBaseSystem("FollowSystem", FollowSystemPriority),
+		sender:     sender,
+		logger:     logger,
+		query:      query,
+		followers:  make([]types.Handle, 0, 16),
+	}
+}
+
+// StartFollow makes the mover follow the leader at the given distance (0 for the default).
+// It replaces any previous follow of the mover.
+func StartFollow(w *ecs.World, h types.Handle, leaderHandle types.Handle, distance float64) bool {
+	if w == nil || h == leaderHandle || !w.Alive(h) || !w.Alive(leaderHandle) {
+		return false
+	}
+	leaderID, ok := w.GetExternalID(leaderHandle)
+	if !ok || !ecs.HasComponent[components.Movement](w, h) || !ecs.HasComponent[components.Transform](w, leaderHandle) {
+		return false
+	}
+	if distance <= 0 {
+		distance = FollowDefaultDistance
+	}
+	// The follow takes over the movement: a walk elsewhere would end it at once.
+	movement, _ := ecs.GetComponent[components.Movement](w, h)
+	if movement.State == constt.StateMoving &&
+		(movement.TargetType != constt.TargetEntity || movement.TargetHandle != leaderHandle) {
+		ecs.WithComponent(w, h, func(m *components.Movement) {
+			m.ClearTarget()
+		})
+		if transform, ok := ecs.GetComponent[components.Transform](w, h); ok {
+			ecs.GetResource[ecs.MovedEntities](w).Add(h, transform.X, transform.Y)
+		}
+	}
+	ecs.AddComponent(w, h, components.Follow{
+		LeaderID:     leaderID,
+		LeaderHandle: leaderHandle,
+		Distance:     math.Max(FollowMinDistance, math.Min(FollowMaxDistance, distance)),
+	})
+	return true
+}
+
+// StopFollow ends the follow of the mover on the next FollowSystem update. A mover still
+// heading for the leader is stopped then; a target set by the caller is kept.
+func StopFollow(w *ecs.World, h types.Handle, reason components.FollowStopReason) {
+	if w == nil || reason == components.FollowActive {
+		return
+	}
+	ecs.WithComponent(w, h, func(follow *components.Follow) {
+		if follow.StopReason == components.FollowActive {
+			follow.StopReason = reason
+		}
+	})
+}
+
+func (s *FollowSystem) Update(w *ecs.World, dt float64) {
+	_ = dt
+	// Collect first: ending a follow removes the component and moves the entity to another archetype.
+	s.followers = s.followers[:0]
+	s.query.ForEach(func(h types.Handle) {
+		s.followers = append(s.followers, h)
+	})
+	for _, h := range s.followers {
+		s.updateFollower(w, h)
+	}
+}
+
+func (s *FollowSystem) updateFollower(w *ecs.World, h types.Handle) {
+	follow, hasFollow := ecs.GetComponent[components.Follow](w, h)
+	if !hasFollow {
+		return
+	}
+	followerID, _ := w.GetExternalID(h)
+	if follow.StopReason != components.FollowActive {
+		s.end(w, h, followerID, follow, follow.StopReason)
+		return
+	}
+
+	transform, hasTransform := ecs.GetComponent[components.Transform](w, h)
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, h)
+	if !hasTransform || !hasMovement {
+		s.end(w, h, followerID, follow, components.FollowStopLeaderLost)
+		return
+	}
+	movingToLeader := movement.State == constt.StateMoving &&
+		movement.TargetType == constt.TargetEntity && movement.TargetHandle == follow.LeaderHandle
+	if movement.State == constt.StateMoving && !movingToLeader {
+		// Someone else gave the follower a target.
+		s.end(w, h, followerID, follow, components.FollowStopExplicit)
+		return
+	}
+
+	leaderTransform, leaderOK := s.leaderTransform(w, h, follow)
+	if !leaderOK {
+		s.end(w, h, followerID, follow, components.FollowStopLeaderLost)
+		return
+	}
+	if _, linked := ecs.GetResource[ecs.LinkState](w).GetLink(followerID); linked {
+		s.end(w, h, followerID, follow, components.FollowStopLinked)
+		return
+	}
+	if !follow.Announced {
+		follow.Announced = true
+		s.sendState(followerID, &netproto.S2C_FollowState{
+			LeaderId: uint64(follow.LeaderID),
+			Distance: uint32(follow.Distance),
+		})
+	}
+
+	dist := math.Sqrt(distanceSq(transform.X, transform.Y, leaderTransform.X, leaderTransform.Y))
+	switch {
+	case dist <= follow.Distance:
+		if movingToLeader {
+			s.stopMover(w, h, transform)
+		}
+	case movement.State == constt.StateStunned:
+	case !movingToLeader && dist <= follow.Distance+followSlack:
+	case movingToLeader &&
+		distanceSq(follow.PathX, follow.PathY, leaderTransform.X, leaderTransform.Y) < followRepathDistance*followRepathDistance:
+	default:
+		ecs.WithComponent(w, h, func(m *components.Movement) {
+			m.SetTargetHandle(follow.LeaderHandle, int(leaderTransform.X), int(leaderTransform.Y))
+		})
+		RequestMovePath(w, h)
+		follow.PathX = leaderTransform.X
+		follow.PathY = leaderTransform.Y
+	}
+
+	ecs.WithComponent(w, h, func(state *components.Follow) {
+		*state = follow
+	})
+}
+
+// leaderTransform returns the leader position while the leader is alive and, for followers
+// with vision (players), still visible to the follower.
+func (s *FollowSystem) leaderTransform(w *ecs.World, h types.Handle, follow components.Follow) (components.Transform, bool) {
+	if w.GetHandleByEntityID(follow.LeaderID) != follow.LeaderHandle || !w.Alive(follow.LeaderHandle) {
+		return components.Transform{}, false
+	}
+	visState := ecs.GetResource[ecs.VisibilityState](w)
+	visState.Mu.RLock()
+	observer, isObserver := visState.VisibleByObserver[h]
+	visible := true
+	if isObserver {
+		_, visible = observer.Known[follow.LeaderHandle]
+	}
+	visState.Mu.RUnlock()
+	if !visible {
+		return components.Transform{}, false
+	}
+	return ecs.GetComponent[components.Transform](w, follow.LeaderHandle)
+}
+
+// end removes the follow and tells the follower why. The mover is stopped only while it still
+// heads for the leader, so a target set by anything else is kept.
+func (s *FollowSystem) end(
+	w *ecs.World,
+	h types.Handle,
+	followerID types.EntityID,
+	follow components.Follow,
+	reason components.FollowStopReason,
+) {
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, h)
+	transform, hasTransform := ecs.GetComponent[components.Transform](w, h)
+	if hasMovement && hasTransform && movement.State == constt.StateMoving &&
+		movement.TargetType == constt.TargetEntity && movement.TargetHandle == follow.LeaderHandle {
+		s.stopMover(w, h, transform)
+	}
+	ecs.RemoveComponent[components.Follow](w, h)
+	s.sendState(followerID, &netproto.S2C_FollowState{Reason: followStopReasonToProto(reason)})
+}
+
+func (s *FollowSystem) stopMover(w *ecs.World, h types.Handle, transform components.Transform) {
+	ecs.WithComponent(w, h, func(m *components.Movement) {
+		m.ClearTarget()
+	})
+	ecs.RemoveComponent[components.MovePath](w, h)
+	ecs.GetResource[ecs.MovedEntities](w).Add(h, transform.X, transform.Y)
+}
+
+func (s *FollowSystem) sendState(followerID types.EntityID, state *netproto.S2C_FollowState) {
+	if s.sender == nil || followerID == 0 {
+		return
+	}
+	s.sender.SendFollowState(followerID, state)
+}
+
+func followStopReasonToProto(reason components.FollowStopReason) netproto.FollowStopReason {
+	switch reason {
+	case components.FollowStopExplicit:
+		return netproto.FollowStopReason_FOLLOW_STOP_REASON_STOPPED
+	case components.FollowStopLeaderLost:
+		return netproto.FollowStopReason_FOLLOW_STOP_REASON_TARGET_LOST
+	case components.FollowStopLinked:
+		return netproto.FollowStopReason_FOLLOW_STOP_REASON_LINKED
+	case components.FollowStopPathBlocked:
+		return netproto.FollowStopReason_FOLLOW_STOP_REASON_PATH_BLOCKED
+	default:
+		return netproto.FollowStopReason_FOLLOW_STOP_REASON_NONE
+	}
+}
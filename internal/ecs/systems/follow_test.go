@@ -0,0 +1,235 @@
+package systems
+
+import (
+	"math"
+	"testing"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type testFollowStateSender struct {
+	states []*netproto.S2C_FollowState
+}
+
+func (s *testFollowStateSender) SendFollowState(_ types.EntityID, state *netproto.S2C_FollowState) {
+	s.states = append(s.states, state)
+}
+
+func (s *testFollowStateSender) last() *netproto.S2C_FollowState {
+	if len(s.states) == 0 {
+		return nil
+	}
+	return s.states[len(s.states)-1]
+}
+
+// runTestFollowTicks runs follow, pathfinding and movement, applying the follower's movement
+// intents directly (no collision).
+func runTestFollowTicks(w *ecs.World, follow *FollowSystem, pathfinding *PathfindingSystem, handle types.Handle, ticks int) {
+	movement := NewMovementSystem(w, nil, zap.NewNop())
+	moved := ecs.GetResource[ecs.MovedEntities](w)
+	for tick := 0; tick < ticks; tick++ {
+		moved.Count = 0
+		follow.Update(w, 0.1)
+		pathfinding.Update(w, 0.1)
+		movement.Update(w, 0.1)
+		for i := 0; i < moved.Count; i++ {
+			if moved.Handles[i] != handle {
+				continue
+			}
+			x, y := moved.IntentX[i], moved.IntentY[i]
+			ecs.WithComponent(w, handle, func(transform *components.Transform) {
+				transform.X, transform.Y = x, y
+			})
+		}
+	}
+}
+
+func testFollowDistance(w *ecs.World, a, b types.Handle) float64 {
+	at, _ := ecs.GetComponent[components.Transform](w, a)
+	bt, _ := ecs.GetComponent[components.Transform](w, b)
+	return math.Hypot(at.X-bt.X, at.Y-bt.Y)
+}
+
+func TestFollowSystem_KeepsDistanceAsLeaderMoves(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	sender := &testFollowStateSender{}
+	follow := NewFollowSystem(w, sender, nil)
+	pathfinding := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	follower := spawnTestPathMover(w, 8201, 60, 60)
+	leader := spawnTestPathMover(w, 8202, 180, 60)
+
+	if !StartFollow(w, follower, leader, 24) {
+		t.Fatalf("expected follow to start")
+	}
+	runTestFollowTicks(w, follow, pathfinding, follower, 100)
+
+	if dist := testFollowDistance(w, follower, leader); dist > 24 || dist < 18 {
+		t.Fatalf("expected follower to stop about 24 units from the leader, got %v", dist)
+	}
+	movement, _ := ecs.GetComponent[components.Movement](w, follower)
+	if movement.State != constt.StateIdle {
+		t.Fatalf("expected follower to stand still near the leader, got %+v", movement)
+	}
+	if len(sender.states) != 1 || sender.states[0].LeaderId != 8202 || sender.states[0].Distance != 24 {
+		t.Fatalf("expected a single follow start state, got %+v", sender.states)
+	}
+
+	ecs.WithComponent(w, leader, func(transform *components.Transform) {
+		transform.X, transform.Y = 180, 300
+	})
+	runTestFollowTicks(w, follow, pathfinding, follower, 200)
+
+	if dist := testFollowDistance(w, follower, leader); dist > 24 {
+		t.Fatalf("expected follower to catch up with the moved leader, got %v", dist)
+	}
+	if !ecs.HasComponent[components.Follow](w, follower) || len(sender.states) != 1 {
+		t.Fatalf("expected follow to stay active, states %+v", sender.states)
+	}
+}
+
+func TestFollowSystem_IdleFollowerIgnoresSmallLeaderSteps(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	follow := NewFollowSystem(w, nil, nil)
+	follower := spawnTestPathMover(w, 8211, 60, 60)
+	leader := spawnTestPathMover(w, 8212, 80, 60)
+
+	StartFollow(w, follower, leader, 24)
+	ecs.WithComponent(w, leader, func(transform *components.Transform) {
+		transform.X = 60 + 24 + followSlack - 1
+	})
+	follow.Update(w, 0.1)
+
+	movement, _ := ecs.GetComponent[components.Movement](w, follower)
+	if movement.State != constt.StateIdle {
+		t.Fatalf("expected follower to wait within the slack, got %+v", movement)
+	}
+
+	ecs.WithComponent(w, leader, func(transform *components.Transform) {
+		transform.X = 60 + 24 + followSlack + 1
+	})
+	follow.Update(w, 0.1)
+
+	movement, _ = ecs.GetComponent[components.Movement](w, follower)
+	if movement.State != constt.StateMoving || movement.TargetType != constt.TargetEntity || movement.TargetHandle != leader {
+		t.Fatalf("expected follower to head for the leader, got %+v", movement)
+	}
+}
+
+func TestFollowSystem_StartFollowClampsDistance(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	follower := spawnTestPathMover(w, 8221, 60, 60)
+	leader := spawnTestPathMover(w, 8222, 80, 60)
+
+	if StartFollow(w, follower, follower, 24) {
+		t.Fatalf("expected follow of self to be rejected")
+	}
+	StartFollow(w, follower, leader, 0)
+	state, _ := ecs.GetComponent[components.Follow](w, follower)
+	if state.Distance != FollowDefaultDistance {
+		t.Fatalf("expected default distance, got %v", state.Distance)
+	}
+	StartFollow(w, follower, leader, 10000)
+	state, _ = ecs.GetComponent[components.Follow](w, follower)
+	if state.Distance != FollowMaxDistance {
+		t.Fatalf("expected max distance, got %v", state.Distance)
+	}
+}
+
+func TestFollowSystem_EndsFollow(t *testing.T) {
+	cases := []struct {
+		name   string
+		apply  func(w *ecs.World, follower, leader types.Handle)
+		reason netproto.FollowStopReason
+		moving bool
+	}{
+		{
+			name: "explicit stop",
+			apply: func(w *ecs.World, follower, _ types.Handle) {
+				StopFollow(w, follower, components.FollowStopExplicit)
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_STOPPED,
+		},
+		{
+			name: "new movement target",
+			apply: func(w *ecs.World, follower, _ types.Handle) {
+				moveTestPathMoverTo(w, follower, 10, 10)
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_STOPPED,
+			moving: true,
+		},
+		{
+			name: "leader despawned",
+			apply: func(w *ecs.World, _, leader types.Handle) {
+				w.Despawn(leader)
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_TARGET_LOST,
+		},
+		{
+			name: "leader out of vision",
+			apply: func(w *ecs.World, follower, _ types.Handle) {
+				visState := ecs.GetResource[ecs.VisibilityState](w)
+				visState.VisibleByObserver[follower] = ecs.ObserverVisibility{Known: map[types.Handle]types.EntityID{}}
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_TARGET_LOST,
+		},
+		{
+			name: "linked",
+			apply: func(w *ecs.World, follower, _ types.Handle) {
+				ecs.GetResource[ecs.LinkState](w).SetLink(ecs.PlayerLink{
+					PlayerID:     8231,
+					PlayerHandle: follower,
+					TargetID:     8299,
+				})
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_LINKED,
+		},
+		{
+			name: "path blocked",
+			apply: func(w *ecs.World, follower, _ types.Handle) {
+				StopFollow(w, follower, components.FollowStopPathBlocked)
+			},
+			reason: netproto.FollowStopReason_FOLLOW_STOP_REASON_PATH_BLOCKED,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := ecs.NewWorldForTesting()
+			sender := &testFollowStateSender{}
+			follow := NewFollowSystem(w, sender, nil)
+			follower := spawnTestPathMover(w, 8231, 60, 60)
+			leader := spawnTestPathMover(w, 8232, 180, 60)
+
+			StartFollow(w, follower, leader, 24)
+			follow.Update(w, 0.1)
+			movement, _ := ecs.GetComponent[components.Movement](w, follower)
+			if movement.State != constt.StateMoving || movement.TargetHandle != leader {
+				t.Fatalf("expected follower to head for the leader, got %+v", movement)
+			}
+
+			tc.apply(w, follower, leader)
+			follow.Update(w, 0.1)
+
+			if ecs.HasComponent[components.Follow](w, follower) {
+				t.Fatalf("expected follow to end")
+			}
+			if last := sender.last(); last == nil || last.Reason != tc.reason || last.LeaderId != 0 {
+				t.Fatalf("expected stop state with reason %v, got %+v", tc.reason, sender.states)
+			}
+			movement, _ = ecs.GetComponent[components.Movement](w, follower)
+			if tc.moving != (movement.State == constt.StateMoving) {
+				t.Fatalf("unexpected follower movement after stop: %+v", movement)
+			}
+			if movement.State == constt.StateMoving && movement.TargetHandle == leader {
+				t.Fatalf("expected follower to stop heading for the leader, got %+v", movement)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package systems
 
 import (
 	"fmt"
+	"math"
 	constt "origin/internal/const"
 	"origin/internal/itemdefs"
 	netproto "origin/internal/network/proto"
@@ -76,6 +77,26 @@ func BuildInventoryStateProto(container InventoryContainerState) *netproto.Inven
 	return invState
 }
 
+// ItemHintExt returns the extra hint line of an item: its freshness for items that spoil, nil otherwise.
+// spoil must be up to date (see inventory.SettleContainerSpoilage).
+func ItemHintExt(def *itemdefs.ItemDef, spoil uint64) *string {
+	if def == nil || def.Spoil == nil {
+		return nil
+	}
+	freshness := def.Spoil.Freshness(spoil)
+	state := "Spoiled"
+	switch {
+	case freshness >= 2.0/3:
+		state = "Fresh"
+	case freshness >= 1.0/3:
+		state = "Stale"
+	case freshness > 0:
+		state = "Rotting"
+	}
+	hint := fmt.Sprintf("%s (%d%%)", state, int(math.Ceil(freshness*100)))
+	return &hint
+}
+
 // BuildItemInstanceProto creates a proto ItemInstance from InventoryItemState.
 func BuildItemInstanceProto(item InventoryItemState) *netproto.ItemInstance {
 	instance := &netproto.ItemInstance{
@@ -84,6 +105,7 @@ func BuildItemInstanceProto(item InventoryItemState) *netproto.ItemInstance {
 		Resource: item.Resource,
 		Quality:  item.Quality,
 		Quantity: item.Quantity,
+		Wear:     item.Wear,
 		W:        uint32(item.W),
 		H:        uint32(item.H),
 	}
@@ -91,6 +113,8 @@ func BuildItemInstanceProto(item InventoryItemState) *netproto.ItemInstance {
 	// Set name from item definition
 	if def, ok := itemdefs.Global().GetByID(int(item.TypeID)); ok {
 		instance.Name = def.Name
+		instance.MaxDurability = def.MaxDurability(item.Quality)
+		instance.HintExt = ItemHintExt(def, item.Spoil)
 	}
 
 	if item.NestedRef != nil {
@@ -20,6 +20,8 @@ type MovementSystem struct {
 	chunkManager core.ChunkManager
 	logger       *zap.Logger
 	movingQuery  *ecs.PreparedQuery
+	// finishedPaths are removed after the query loop: removing a component moves the entity to another archetype
+	finishedPaths []types.Handle
 }
 
 func NewMovementSystem(world *ecs.World, chunkManager core.ChunkManager, logger *zap.Logger) *MovementSystem {
@@ -42,6 +44,7 @@ func NewMovementSystem(world *ecs.World, chunkManager core.ChunkManager, logger
 
 func (s *MovementSystem) Update(w *ecs.World, dt float64) {
 	movedEntities := ecs.GetResource[ecs.MovedEntities](w)
+	s.finishedPaths = s.finishedPaths[:0]
 	// Use prepared query to iterate over entities with Transform and Movement
 	s.movingQuery.ForEach(func(h types.Handle) {
 		movement, ok := ecs.GetComponent[components.Movement](w, h)
@@ -113,18 +116,60 @@ func (s *MovementSystem) Update(w *ecs.World, dt float64) {
 			ecs.WithComponent(w, h, func(m *components.Movement) {
 				m.ClearTarget()
 			})
+			s.finishedPaths = append(s.finishedPaths, h)
 			movedEntities.Add(h, transform.X, transform.Y)
 			return
 		}
 
-		dx := movement.TargetX - transform.X
-		dy := movement.TargetY - transform.Y
+		// Follow path waypoints first; the final leg heads for the target itself.
+		steerX, steerY := movement.TargetX, movement.TargetY
+		followingWaypoint := false
+		if path, hasPath := ecs.GetComponent[components.MovePath](w, h); hasPath {
+			switch {
+			case !path.Matches(&movement):
+				s.finishedPaths = append(s.finishedPaths, h)
+			case path.State == components.MovePathPending:
+				// Wait in place until PathfindingSystem routes the mover.
+				return
+			default:
+				if waypoint, ok := path.CurrentWaypoint(); ok {
+					steerX, steerY = waypoint.X, waypoint.Y
+					followingWaypoint = true
+				}
+			}
+		}
+
+		dx := steerX - transform.X
+		dy := steerY - transform.Y
 		dist := math.Sqrt(dx*dx + dy*dy)
 
+		if followingWaypoint && dist <= 0.001 {
+			ecs.WithComponent(w, h, func(p *components.MovePath) {
+				p.Next++
+			})
+			return
+		}
+
 		if dist > 0.001 {
-			speed := movement.GetCurrentSpeed()
+			speed := movement.GetCurrentSpeed() * s.tileSpeedModifier(transform.X, transform.Y)
 			step := speed * dt
 
+			if followingWaypoint && step >= dist {
+				// Reached the waypoint: step onto it and head for the next one on the next tick.
+				ecs.WithComponent(w, h, func(t *components.Transform) {
+					t.Direction = math.Atan2(dy, dx)
+				})
+				ecs.WithComponent(w, h, func(m *components.Movement) {
+					m.VelocityX = (dx / dist) * speed
+					m.VelocityY = (dy / dist) * speed
+				})
+				ecs.WithComponent(w, h, func(p *components.MovePath) {
+					p.Next++
+				})
+				movedEntities.Add(h, steerX, steerY)
+				return
+			}
+
 			// Clamp step to prevent overshoot oscillation
 			if step >= dist {
 				// Reached target, snap to exact position
@@ -134,6 +179,7 @@ func (s *MovementSystem) Update(w *ecs.World, dt float64) {
 				ecs.WithComponent(w, h, func(m *components.Movement) {
 					m.ClearTarget()
 				})
+				s.finishedPaths = append(s.finishedPaths, h)
 				// Add to moved entities buffer
 				movedEntities.Add(h, movement.TargetX, movement.TargetY)
 				return
@@ -173,4 +219,17 @@ func (s *MovementSystem) Update(w *ecs.World, dt float64) {
 			}
 		}
 	})
+
+	for _, h := range s.finishedPaths {
+		ecs.RemoveComponent[components.MovePath](w, h)
+	}
+}
+
+// tileSpeedModifier scales the mode speed by the tile under the mover. The scaled speed ends up
+// in the velocity sent with S2C_ObjectMove, so client prediction uses it too.
+func (s *MovementSystem) tileSpeedModifier(x, y float64) float64 {
+	if !entitystats.MovementNeedsTileContext() {
+		return 1.0
+	}
+	return entitystats.ResolveTileSpeedModifier(resolveMovementTileContext(s.chunkManager, x, y))
 }
@@ -7,6 +7,7 @@ import (
 	constt "origin/internal/const"
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
+	"origin/internal/tiledefs"
 	"origin/internal/types"
 
 	"go.uber.org/zap"
@@ -151,3 +152,63 @@ func TestMovementSystem_CarryingOverstuffedStillForcesCrawl(t *testing.T) {
 		t.Fatalf("expected crawl velocity 5.0, got %v", movement.VelocityX)
 	}
 }
+
+func TestMovementSystem_TileSpeedStacksWithCarryCap(t *testing.T) {
+	prev := tiledefs.Global()
+	tiledefs.SetGlobalForTesting(tiledefs.NewRegistry([]tiledefs.TileDef{
+		{TileID: types.TileStonePaving, Key: "stone_paving", Speed: 1.3, Stamina: 0.8},
+		{TileID: types.TileThicket, Key: "thicket", Speed: 0.65, Stamina: 1.4},
+	}))
+	t.Cleanup(func() { tiledefs.SetGlobalForTesting(prev) })
+
+	cases := []struct {
+		name     string
+		x        float64
+		mode     constt.MoveMode
+		carrying bool
+		velocity float64
+	}{
+		{name: "paving run", x: 6, mode: constt.Run, velocity: 19.5},
+		{name: "paving run while carrying", x: 6, mode: constt.Run, carrying: true, velocity: 13},
+		{name: "thicket walk", x: 18, mode: constt.Walk, velocity: 6.5},
+		{name: "grass walk", x: 30, mode: constt.Walk, velocity: 10},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			world := ecs.NewWorldForTesting()
+			cm := newTestPathChunkManager(map[[2]int]byte{
+				{0, 0}: types.TileStonePaving,
+				{1, 0}: types.TileThicket,
+			})
+			handle := world.Spawn(types.EntityID(7010), func(w *ecs.World, h types.Handle) {
+				ecs.AddComponent(w, h, components.Transform{X: tc.x, Y: 6})
+				ecs.AddComponent(w, h, components.Movement{
+					Mode:       tc.mode,
+					State:      constt.StateMoving,
+					Speed:      10,
+					TargetType: constt.TargetPoint,
+					TargetX:    tc.x + 100,
+					TargetY:    6,
+				})
+				ecs.AddComponent(w, h, components.EntityStats{
+					Stamina: 500,
+					Energy:  1000,
+				})
+				if tc.carrying {
+					ecs.AddComponent(w, h, components.LiftCarryState{
+						ObjectEntityID: types.EntityID(9001),
+						ObjectHandle:   types.InvalidHandle,
+					})
+				}
+			})
+
+			system := NewMovementSystem(world, cm, zap.NewNop())
+			system.Update(world, 1.0)
+
+			movement, _ := ecs.GetComponent[components.Movement](world, handle)
+			if math.Abs(movement.VelocityX-tc.velocity) > 1e-9 {
+				t.Fatalf("expected velocity %v, got %v (mode %v)", tc.velocity, movement.VelocityX, movement.Mode)
+			}
+		})
+	}
+}
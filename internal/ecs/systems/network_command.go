@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"fmt"
 	constt "origin/internal/const"
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
@@ -25,10 +26,39 @@ const (
 // Commands are drained from the inbox at the start of each tick
 // ChatDeliveryService provides methods to send chat messages to clients
 type ChatDeliveryService interface {
-	SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string)
+	SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string, toEntityID types.EntityID)
 	BroadcastChatMessage(entityIDs []types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string)
 }
 
+// PrivateChatRouter delivers private (whisper) messages to a character that may be on any shard.
+// Returns false when the target is not online; the router reports the failure to the sender.
+type PrivateChatRouter interface {
+	RoutePrivateChatMessage(fromEntityID types.EntityID, fromName string, toEntityID types.EntityID, text string) bool
+}
+
+// PartyChatRouter delivers party messages to every member of the sender's party across shards.
+// Returns false when the sender is not in a party.
+type PartyChatRouter interface {
+	RoutePartyChatMessage(fromEntityID types.EntityID, fromName string, text string) bool
+}
+
+// ChatHistoryEntry is a delivered chat message queued for persistence.
+type ChatHistoryEntry struct {
+	Channel    netproto.ChatChannel
+	SenderID   types.EntityID // 0 for server messages
+	SenderName string         // server messages only: the announcer name
+	ReceiverID types.EntityID // private messages only
+	X, Y       int
+	Layer      int
+	Text       string
+	SentAt     time.Time
+}
+
+// ChatHistoryRecorder persists chat messages asynchronously. Must never block the tick.
+type ChatHistoryRecorder interface {
+	RecordChatMessage(entry ChatHistoryEntry)
+}
+
 // InventoryResultSender sends inventory operation results to clients
 type InventoryResultSender interface {
 	SendInventoryOpResult(entityID types.EntityID, result *netproto.S2C_InventoryOpResult)
@@ -61,6 +91,12 @@ type AdminCommandHandler interface {
 	ExecutePendingTeleport(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, targetX, targetY float64)
 }
 
+// AdminItemGiver gives items to a player on behalf of server jobs (admin REST API).
+// Returns the inventory executor message on success.
+type AdminItemGiver interface {
+	GiveItemToPlayer(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, itemKey string, count, quality uint32) (string, error)
+}
+
 // InventoryOpResult represents the result of an inventory operation
 type InventoryOpResult struct {
 	Success           bool
@@ -96,6 +132,8 @@ type InventoryItemState struct {
 	Resource  string
 	Quality   uint32
 	Quantity  uint32
+	Wear      uint32
+	Spoil     uint64
 	W, H      uint8
 	X, Y      uint8
 	EquipSlot netproto.EquipSlot
@@ -141,6 +179,29 @@ type LiftCommandService interface {
 	SendCarryLockedWarning(playerID types.EntityID)
 }
 
+// ItemActionCommandService handles actions on items in the player's own inventory.
+type ItemActionCommandService interface {
+	HandleItemContextMenu(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_ItemContextMenu)
+	HandleItemAction(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_ItemAction)
+}
+
+// SkillCommandService lists and learns character skills.
+type SkillCommandService interface {
+	HandleSkillList(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_SkillList)
+	HandleLearnSkill(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_LearnSkill)
+}
+
+// CombatCommandService starts combat and performs combat moves.
+type CombatCommandService interface {
+	HandleAttack(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.Attack)
+	HandleCombatMove(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_CombatMove)
+}
+
+// AttributeCommandService raises character attributes for LP.
+type AttributeCommandService interface {
+	HandleTrainAttribute(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_TrainAttribute)
+}
+
 type NetworkCommandSystem struct {
 	ecs.BaseSystem
 
@@ -148,6 +209,9 @@ type NetworkCommandSystem struct {
 	serverInbox       *network.ServerJobInbox
 	logger            *zap.Logger
 	chatDelivery      ChatDeliveryService
+	privateChatRouter PrivateChatRouter
+	partyChatRouter   PartyChatRouter
+	chatHistory       ChatHistoryRecorder
 	chatLocalRadiusSq float64
 
 	// Inventory operation handling
@@ -156,7 +220,9 @@ type NetworkCommandSystem struct {
 	inventorySnapshotSender InventorySnapshotSender
 
 	// Admin command handling
-	adminHandler AdminCommandHandler
+	adminHandler   AdminCommandHandler
+	adminItemGiver AdminItemGiver
+	characterSaver *CharacterSaver
 
 	// Vision system for forcing vision updates after inventory operations
 	visionSystem *VisionSystem
@@ -167,6 +233,10 @@ type NetworkCommandSystem struct {
 	craftCommandService  CraftCommandService
 	buildCommandService  BuildCommandService
 	liftCommandService   LiftCommandService
+	itemActionService    ItemActionCommandService
+	skillService         SkillCommandService
+	attributeService     AttributeCommandService
+	combatService        CombatCommandService
 	contextPendingTTL    time.Duration
 
 	// Reusable buffers to avoid allocations
@@ -210,11 +280,36 @@ func (s *NetworkCommandSystem) SetAdminHandler(handler AdminCommandHandler) {
 	s.adminHandler = handler
 }
 
+// SetAdminItemGiver sets the handler for JobAdminGiveItem.
+func (s *NetworkCommandSystem) SetAdminItemGiver(giver AdminItemGiver) {
+	s.adminItemGiver = giver
+}
+
+// SetCharacterSaver sets the saver used by JobSaveAllCharacters.
+func (s *NetworkCommandSystem) SetCharacterSaver(saver *CharacterSaver) {
+	s.characterSaver = saver
+}
+
 // SetInventorySnapshotSender sets the handler for sending full inventory snapshots on login/reattach.
 func (s *NetworkCommandSystem) SetInventorySnapshotSender(sender InventorySnapshotSender) {
 	s.inventorySnapshotSender = sender
 }
 
+// SetPrivateChatRouter sets the cross-shard router used for CHAT_CHANNEL_PRIVATE messages.
+func (s *NetworkCommandSystem) SetPrivateChatRouter(router PrivateChatRouter) {
+	s.privateChatRouter = router
+}
+
+// SetPartyChatRouter sets the cross-shard router used for CHAT_CHANNEL_PARTY messages.
+func (s *NetworkCommandSystem) SetPartyChatRouter(router PartyChatRouter) {
+	s.partyChatRouter = router
+}
+
+// SetChatHistoryRecorder sets the sink that persists delivered chat messages.
+func (s *NetworkCommandSystem) SetChatHistoryRecorder(recorder ChatHistoryRecorder) {
+	s.chatHistory = recorder
+}
+
 func (s *NetworkCommandSystem) SetOpenContainerService(service OpenContainerCoordinator) {
 	s.openContainerService = service
 }
@@ -239,6 +334,22 @@ func (s *NetworkCommandSystem) SetLiftCommandService(service LiftCommandService)
 	s.liftCommandService = service
 }
 
+func (s *NetworkCommandSystem) SetItemActionCommandService(service ItemActionCommandService) {
+	s.itemActionService = service
+}
+
+func (s *NetworkCommandSystem) SetSkillCommandService(service SkillCommandService) {
+	s.skillService = service
+}
+
+func (s *NetworkCommandSystem) SetAttributeCommandService(service AttributeCommandService) {
+	s.attributeService = service
+}
+
+func (s *NetworkCommandSystem) SetCombatCommandService(service CombatCommandService) {
+	s.combatService = service
+}
+
 func (s *NetworkCommandSystem) SetContextPendingTTL(ttl time.Duration) {
 	if ttl <= 0 {
 		return
@@ -322,6 +433,22 @@ func (s *NetworkCommandSystem) processPlayerCommand(w *ecs.World, cmd *network.P
 		s.handleOpenWindow(w, handle, cmd)
 	case network.CmdCloseWindow:
 		s.handleCloseWindow(w, handle, cmd)
+	case network.CmdItemContextMenu:
+		s.handleItemContextMenu(w, handle, cmd)
+	case network.CmdItemAction:
+		s.handleItemAction(w, handle, cmd)
+	case network.CmdSkillList:
+		s.handleSkillList(w, handle, cmd)
+	case network.CmdLearnSkill:
+		s.handleLearnSkill(w, handle, cmd)
+	case network.CmdTrainAttribute:
+		s.handleTrainAttribute(w, handle, cmd)
+	case network.CmdAttack:
+		s.handleAttack(w, handle, cmd)
+	case network.CmdCombatMove:
+		s.handleCombatMove(w, handle, cmd)
+	case network.CmdFollow:
+		s.handleFollow(w, handle, cmd)
 	default:
 		s.logger.Warn("Unknown command type",
 			zap.Uint64("client_id", cmd.ClientID),
@@ -419,6 +546,93 @@ func (s *NetworkCommandSystem) handleLiftPutDown(w *ecs.World, playerHandle type
 	s.liftCommandService.HandleLiftPutDown(w, cmd.CharacterID, playerHandle, msg)
 }
 
+func (s *NetworkCommandSystem) handleItemContextMenu(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.C2S_ItemContextMenu)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for ItemContextMenu", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.itemActionService == nil {
+		return
+	}
+	s.itemActionService.HandleItemContextMenu(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleItemAction(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	if s.rejectIfCarrying(w, playerHandle, cmd.CharacterID) {
+		return
+	}
+	msg, ok := cmd.Payload.(*netproto.C2S_ItemAction)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for ItemAction", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.itemActionService == nil {
+		return
+	}
+	s.itemActionService.HandleItemAction(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleSkillList(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.C2S_SkillList)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for SkillList", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.skillService == nil {
+		return
+	}
+	s.skillService.HandleSkillList(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleLearnSkill(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.C2S_LearnSkill)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for LearnSkill", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.skillService == nil {
+		return
+	}
+	s.skillService.HandleLearnSkill(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleTrainAttribute(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.C2S_TrainAttribute)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for TrainAttribute", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.attributeService == nil {
+		return
+	}
+	s.attributeService.HandleTrainAttribute(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleAttack(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.Attack)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for Attack", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.combatService == nil {
+		return
+	}
+	s.combatService.HandleAttack(w, cmd.CharacterID, playerHandle, msg)
+}
+
+func (s *NetworkCommandSystem) handleCombatMove(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	msg, ok := cmd.Payload.(*netproto.C2S_CombatMove)
+	if !ok || msg == nil {
+		s.logger.Error("Invalid payload type for CombatMove", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if s.combatService == nil {
+		return
+	}
+	s.combatService.HandleCombatMove(w, cmd.CharacterID, playerHandle, msg)
+}
+
 func (s *NetworkCommandSystem) handleOpenWindow(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
 	msg, ok := cmd.Payload.(*netproto.C2S_OpenWindow)
 	if !ok || msg == nil {
@@ -502,6 +716,7 @@ func (s *NetworkCommandSystem) handleMoveTo(w *ecs.World, playerHandle types.Han
 	ecs.WithComponent(w, playerHandle, func(mov *components.Movement) {
 		mov.SetTargetPoint(int(moveTo.X), int(moveTo.Y))
 	})
+	RequestMovePath(w, playerHandle)
 }
 
 func (s *NetworkCommandSystem) handleMoveToEntity(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
@@ -594,6 +809,7 @@ func (s *NetworkCommandSystem) handleMoveToEntity(w *ecs.World, playerHandle typ
 	ecs.WithComponent(w, playerHandle, func(m *components.Movement) {
 		m.SetTargetHandle(targetHandle, int(targetTransform.X), int(targetTransform.Y))
 	})
+	RequestMovePath(w, playerHandle)
 
 	// If autoInteract, determine interaction type and set PendingInteraction
 	if moveToEntity.AutoInteract {
@@ -616,6 +832,36 @@ func (s *NetworkCommandSystem) handleMoveToEntity(w *ecs.World, playerHandle typ
 		zap.Bool("auto_interact", moveToEntity.AutoInteract))
 }
 
+func (s *NetworkCommandSystem) handleFollow(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
+	follow, ok := cmd.Payload.(*netproto.Follow)
+	if !ok || follow == nil {
+		s.logger.Error("Invalid payload type for Follow", zap.Uint64("client_id", cmd.ClientID))
+		return
+	}
+	if follow.EntityId == 0 {
+		StopFollow(w, playerHandle, components.FollowStopExplicit)
+		return
+	}
+
+	leaderHandle := w.GetHandleByEntityID(types.EntityID(follow.EntityId))
+	if leaderHandle == types.InvalidHandle || !w.Alive(leaderHandle) || leaderHandle == playerHandle {
+		s.logger.Debug("Follow: leader entity not found",
+			zap.Uint64("client_id", cmd.ClientID),
+			zap.Uint64("leader_entity_id", follow.EntityId))
+		return
+	}
+	mov, ok := ecs.GetComponent[components.Movement](w, playerHandle)
+	if !ok || mov.State == constt.StateStunned {
+		return
+	}
+	if !s.enforceMovementModeByStamina(w, playerHandle) {
+		return
+	}
+
+	s.clearPendingInteractionIntents(w, playerHandle, cmd.CharacterID)
+	StartFollow(w, playerHandle, leaderHandle, float64(follow.Distance))
+}
+
 func (s *NetworkCommandSystem) handleSetMovementMode(w *ecs.World, playerHandle types.Handle, cmd *network.PlayerCommand) {
 	payload, ok := cmd.Payload.(*netproto.C2S_MovementMode)
 	if !ok || payload == nil {
@@ -867,6 +1113,7 @@ func (s *NetworkCommandSystem) startPendingContextAction(
 		ecs.WithComponent(w, playerHandle, func(m *components.Movement) {
 			m.SetTargetHandle(targetHandle, int(targetTransform.X), int(targetTransform.Y))
 		})
+		RequestMovePath(w, playerHandle)
 	}
 
 	ttlMs := s.contextPendingTTL.Milliseconds()
@@ -942,6 +1189,7 @@ func (s *NetworkCommandSystem) handlePickupInteract(
 	ecs.WithComponent(w, playerHandle, func(m *components.Movement) {
 		m.SetTargetHandle(targetHandle, int(targetTransform.X), int(targetTransform.Y))
 	})
+	RequestMovePath(w, playerHandle)
 
 	ecs.AddComponent(w, playerHandle, components.PendingInteraction{
 		TargetEntityID: targetEntityID,
@@ -966,6 +1214,13 @@ func (s *NetworkCommandSystem) handleChat(w *ecs.World, playerHandle types.Handl
 		}
 	}
 
+	senderName, ok := chatSenderName(w, playerHandle)
+	if !ok {
+		s.logger.Debug("Chat sender has no Appearance component",
+			zap.Int64("character_id", int64(cmd.CharacterID)))
+		return
+	}
+
 	senderTransform, hasTransform := ecs.GetComponent[components.Transform](w, playerHandle)
 	if !hasTransform {
 		s.logger.Debug("Chat sender has no Transform component",
@@ -973,18 +1228,25 @@ func (s *NetworkCommandSystem) handleChat(w *ecs.World, playerHandle types.Handl
 		return
 	}
 
-	senderAppearance, hasAppearance := ecs.GetComponent[components.Appearance](w, playerHandle)
-	if !hasAppearance {
-		s.logger.Debug("Chat sender has no Appearance component",
-			zap.Int64("character_id", int64(cmd.CharacterID)))
+	if payload.Channel == netproto.ChatChannel_CHAT_CHANNEL_PARTY {
+		if s.partyChatRouter == nil || !s.partyChatRouter.RoutePartyChatMessage(cmd.CharacterID, senderName, payload.Text) {
+			s.logger.Debug("Party chat from player without party",
+				zap.Int64("sender_id", int64(cmd.CharacterID)))
+			return
+		}
+		s.recordChat(cmd, senderTransform, payload.Channel, 0, payload.Text)
 		return
 	}
 
-	senderName := "Unknown"
-	if senderAppearance.Name != nil && *senderAppearance.Name != "" {
-		senderName = *senderAppearance.Name
+	if payload.Channel == netproto.ChatChannel_CHAT_CHANNEL_PRIVATE {
+		if s.handlePrivateChat(cmd, senderName, payload) {
+			s.recordChat(cmd, senderTransform, payload.Channel, payload.TargetID, payload.Text)
+		}
+		return
 	}
 
+	s.recordChat(cmd, senderTransform, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, payload.Text)
+
 	recipients := s.findChatRecipients(w, senderTransform.X, senderTransform.Y, cmd.CharacterID)
 
 	if len(recipients) == 0 {
@@ -1008,6 +1270,63 @@ func (s *NetworkCommandSystem) handleChat(w *ecs.World, playerHandle types.Handl
 		zap.Int("text_len", len(payload.Text)))
 }
 
+// handlePrivateChat routes a whisper and reports whether it was delivered.
+func (s *NetworkCommandSystem) handlePrivateChat(cmd *network.PlayerCommand, senderName string, payload *network.ChatCommandPayload) bool {
+	if s.privateChatRouter == nil {
+		s.logger.Warn("Private chat router not configured",
+			zap.Int64("sender_id", int64(cmd.CharacterID)))
+		return false
+	}
+	if payload.TargetID == 0 || payload.TargetID == cmd.CharacterID {
+		return false
+	}
+
+	if !s.privateChatRouter.RoutePrivateChatMessage(cmd.CharacterID, senderName, payload.TargetID, payload.Text) {
+		s.logger.Debug("Private chat target offline",
+			zap.Int64("sender_id", int64(cmd.CharacterID)),
+			zap.Int64("target_id", int64(payload.TargetID)))
+		return false
+	}
+
+	s.logger.Debug("Private chat message delivered",
+		zap.Int64("sender_id", int64(cmd.CharacterID)),
+		zap.Int64("target_id", int64(payload.TargetID)),
+		zap.Int("text_len", len(payload.Text)))
+	return true
+}
+
+func (s *NetworkCommandSystem) recordChat(cmd *network.PlayerCommand, senderTransform components.Transform, channel netproto.ChatChannel, receiverID types.EntityID, text string) {
+	if s.chatHistory == nil {
+		return
+	}
+	sentAt := cmd.ReceivedAt
+	if sentAt.IsZero() {
+		sentAt = time.Now()
+	}
+	s.chatHistory.RecordChatMessage(ChatHistoryEntry{
+		Channel:    channel,
+		SenderID:   cmd.CharacterID,
+		ReceiverID: receiverID,
+		X:          int(senderTransform.X),
+		Y:          int(senderTransform.Y),
+		Layer:      cmd.Layer,
+		Text:       text,
+		SentAt:     sentAt,
+	})
+}
+
+// chatSenderName resolves the display name of a chat sender from its Appearance.
+func chatSenderName(w *ecs.World, playerHandle types.Handle) (string, bool) {
+	senderAppearance, hasAppearance := ecs.GetComponent[components.Appearance](w, playerHandle)
+	if !hasAppearance {
+		return "", false
+	}
+	if senderAppearance.Name != nil && *senderAppearance.Name != "" {
+		return *senderAppearance.Name, true
+	}
+	return "Unknown", true
+}
+
 func (s *NetworkCommandSystem) findChatRecipients(w *ecs.World, senderX, senderY float64, senderID types.EntityID) []types.EntityID {
 	recipients := make([]types.EntityID, 0, 32)
 
@@ -1372,6 +1691,10 @@ func (s *NetworkCommandSystem) processServerJob(w *ecs.World, job *network.Serve
 		s.handleCraftListSnapshotJob(w, job)
 	case network.JobSendBuildListSnapshot:
 		s.handleBuildListSnapshotJob(w, job)
+	case network.JobAdminGiveItem:
+		s.handleAdminGiveItemJob(w, job)
+	case network.JobSaveAllCharacters:
+		s.handleSaveAllCharactersJob(w, job)
 	default:
 		s.logger.Warn("Unknown server job type", zap.Uint16("job_type", job.JobType))
 	}
@@ -1480,6 +1803,46 @@ func (s *NetworkCommandSystem) handleBuildListSnapshotJob(w *ecs.World, job *net
 	}
 }
 
+func (s *NetworkCommandSystem) handleAdminGiveItemJob(w *ecs.World, job *network.ServerJob) {
+	payload, ok := job.Payload.(*network.AdminGiveItemJobPayload)
+	if !ok {
+		s.logger.Error("Invalid payload for admin give item job")
+		return
+	}
+	result := network.ServerJobResult{}
+	charEntity, online := ecs.GetResource[ecs.CharacterEntities](w).Map[job.TargetID]
+	switch {
+	case s.adminItemGiver == nil:
+		result.Err = fmt.Errorf("give service unavailable")
+	case !online || !w.Alive(charEntity.Handle):
+		result.Err = fmt.Errorf("player %d is not in this shard", job.TargetID)
+	default:
+		result.Message, result.Err = s.adminItemGiver.GiveItemToPlayer(w, job.TargetID, charEntity.Handle, payload.ItemKey, payload.Count, payload.Quality)
+	}
+	if payload.Result != nil {
+		payload.Result <- result
+	}
+}
+
+func (s *NetworkCommandSystem) handleSaveAllCharactersJob(w *ecs.World, job *network.ServerJob) {
+	payload, ok := job.Payload.(*network.SaveAllCharactersJobPayload)
+	if !ok {
+		s.logger.Error("Invalid payload for save all characters job")
+		return
+	}
+	result := network.ServerJobResult{}
+	if s.characterSaver == nil {
+		result.Err = fmt.Errorf("character saver unavailable")
+	} else {
+		count := len(ecs.GetResource[ecs.CharacterEntities](w).Map)
+		s.characterSaver.SaveAll(w)
+		result.Message = fmt.Sprintf("%d characters queued for save", count)
+	}
+	if payload.Result != nil {
+		payload.Result <- result
+	}
+}
+
 // Stats returns processing statistics
 func (s *NetworkCommandSystem) Stats() (playerReceived, playerDropped, playerProcessed, serverReceived, serverDropped, serverProcessed uint64) {
 	pr, pd, pp := s.playerInbox.Stats()
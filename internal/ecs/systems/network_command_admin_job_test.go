@@ -0,0 +1,83 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/ecs"
+	"origin/internal/network"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type recordingItemGiver struct {
+	playerID types.EntityID
+	handle   types.Handle
+	itemKey  string
+}
+
+func (g *recordingItemGiver) GiveItemToPlayer(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, itemKey string, count, quality uint32) (string, error) {
+	g.playerID = playerID
+	g.handle = playerHandle
+	g.itemKey = itemKey
+	return "given", nil
+}
+
+func TestNetworkCommandSystem_AdminGiveItemJob_ResolvesCharacterHandle(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(8201)
+	playerHandle := world.Spawn(playerID, nil)
+	ecs.GetResource[ecs.CharacterEntities](world).Add(playerID, playerHandle, time.Now().Add(time.Minute))
+
+	giver := &recordingItemGiver{}
+	system := NewNetworkCommandSystem(nil, nil, nil, nil, nil, nil, 0, zap.NewNop())
+	system.SetAdminItemGiver(giver)
+
+	resultCh := make(chan network.ServerJobResult, 1)
+	system.processServerJob(world, &network.ServerJob{
+		JobType:  network.JobAdminGiveItem,
+		TargetID: playerID,
+		Payload:  &network.AdminGiveItemJobPayload{ItemKey: "apple", Count: 1, Quality: 10, Result: resultCh},
+	})
+
+	result := <-resultCh
+	if result.Err != nil || result.Message != "given" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if giver.playerID != playerID || giver.handle != playerHandle || giver.itemKey != "apple" {
+		t.Fatalf("unexpected give call: %+v", giver)
+	}
+}
+
+func TestNetworkCommandSystem_AdminGiveItemJob_UnknownPlayer(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	system := NewNetworkCommandSystem(nil, nil, nil, nil, nil, nil, 0, zap.NewNop())
+	system.SetAdminItemGiver(&recordingItemGiver{})
+
+	resultCh := make(chan network.ServerJobResult, 1)
+	system.processServerJob(world, &network.ServerJob{
+		JobType:  network.JobAdminGiveItem,
+		TargetID: 8202,
+		Payload:  &network.AdminGiveItemJobPayload{ItemKey: "apple", Result: resultCh},
+	})
+
+	if result := <-resultCh; result.Err == nil {
+		t.Fatal("expected error for player not in shard")
+	}
+}
+
+func TestNetworkCommandSystem_SaveAllJob_WithoutSaverFails(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	system := NewNetworkCommandSystem(nil, nil, nil, nil, nil, nil, 0, zap.NewNop())
+
+	resultCh := make(chan network.ServerJobResult, 1)
+	system.processServerJob(world, &network.ServerJob{
+		JobType: network.JobSaveAllCharacters,
+		Payload: &network.SaveAllCharactersJobPayload{Result: resultCh},
+	})
+
+	if result := <-resultCh; result.Err == nil {
+		t.Fatal("expected error without character saver")
+	}
+}
@@ -0,0 +1,209 @@
+package systems
+
+import (
+	"testing"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type privateChatCall struct {
+	from types.EntityID
+	name string
+	to   types.EntityID
+	text string
+}
+
+type testPrivateChatRouter struct {
+	online map[types.EntityID]bool
+	calls  []privateChatCall
+}
+
+func (r *testPrivateChatRouter) RoutePrivateChatMessage(fromEntityID types.EntityID, fromName string, toEntityID types.EntityID, text string) bool {
+	r.calls = append(r.calls, privateChatCall{from: fromEntityID, name: fromName, to: toEntityID, text: text})
+	return r.online[toEntityID]
+}
+
+type testChatHistory struct {
+	entries []ChatHistoryEntry
+}
+
+func (h *testChatHistory) RecordChatMessage(entry ChatHistoryEntry) {
+	h.entries = append(h.entries, entry)
+}
+
+type testChatDelivery struct {
+	broadcasts int
+}
+
+func (d *testChatDelivery) SendChatMessage(types.EntityID, netproto.ChatChannel, types.EntityID, string, string, types.EntityID) {
+}
+
+func (d *testChatDelivery) BroadcastChatMessage([]types.EntityID, netproto.ChatChannel, types.EntityID, string, string) {
+	d.broadcasts++
+}
+
+func TestNetworkCommandSystem_HandleChat_PrivateRoutesToTarget(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	senderID := types.EntityID(7001)
+	targetID := types.EntityID(7002)
+	senderName := "Alice"
+	senderHandle := world.Spawn(senderID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Appearance{Name: &senderName})
+		ecs.AddComponent(w, h, components.Transform{X: 10, Y: 10})
+	})
+
+	delivery := &testChatDelivery{}
+	router := &testPrivateChatRouter{online: map[types.EntityID]bool{targetID: true}}
+	system := NewNetworkCommandSystem(nil, nil, delivery, nil, nil, nil, 100, zap.NewNop())
+	system.SetPrivateChatRouter(router)
+	history := &testChatHistory{}
+	system.SetChatHistoryRecorder(history)
+
+	system.handleChat(world, senderHandle, &network.PlayerCommand{
+		CharacterID: senderID,
+		CommandType: network.CmdChat,
+		Layer:       1,
+		Payload: &network.ChatCommandPayload{
+			Channel:  netproto.ChatChannel_CHAT_CHANNEL_PRIVATE,
+			Text:     "psst",
+			TargetID: targetID,
+		},
+	})
+
+	if len(router.calls) != 1 {
+		t.Fatalf("expected 1 routed whisper, got %d", len(router.calls))
+	}
+	call := router.calls[0]
+	if call.from != senderID || call.to != targetID || call.name != senderName || call.text != "psst" {
+		t.Fatalf("unexpected routed whisper: %+v", call)
+	}
+	if delivery.broadcasts != 0 {
+		t.Fatalf("private message must not be broadcast locally, got %d broadcasts", delivery.broadcasts)
+	}
+	if len(history.entries) != 1 {
+		t.Fatalf("expected delivered whisper to be recorded, got %d entries", len(history.entries))
+	}
+	entry := history.entries[0]
+	if entry.ReceiverID != targetID || entry.Channel != netproto.ChatChannel_CHAT_CHANNEL_PRIVATE || entry.Layer != 1 || entry.X != 10 {
+		t.Fatalf("unexpected history entry: %+v", entry)
+	}
+}
+
+func TestNetworkCommandSystem_HandleChat_OfflineWhisperNotRecorded(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	senderID := types.EntityID(7004)
+	senderName := "Carol"
+	senderHandle := world.Spawn(senderID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Appearance{Name: &senderName})
+		ecs.AddComponent(w, h, components.Transform{X: 1, Y: 1})
+	})
+
+	router := &testPrivateChatRouter{online: map[types.EntityID]bool{}}
+	history := &testChatHistory{}
+	system := NewNetworkCommandSystem(nil, nil, &testChatDelivery{}, nil, nil, nil, 100, zap.NewNop())
+	system.SetPrivateChatRouter(router)
+	system.SetChatHistoryRecorder(history)
+
+	system.handleChat(world, senderHandle, &network.PlayerCommand{
+		CharacterID: senderID,
+		CommandType: network.CmdChat,
+		Payload: &network.ChatCommandPayload{
+			Channel:  netproto.ChatChannel_CHAT_CHANNEL_PRIVATE,
+			Text:     "anyone?",
+			TargetID: types.EntityID(9999),
+		},
+	})
+
+	if len(router.calls) != 1 {
+		t.Fatalf("expected whisper to be routed once, got %d", len(router.calls))
+	}
+	if len(history.entries) != 0 {
+		t.Fatalf("expected undelivered whisper not to be recorded, got %d", len(history.entries))
+	}
+}
+
+func TestNetworkCommandSystem_HandleChat_PrivateIgnoresSelfTarget(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	senderID := types.EntityID(7003)
+	senderName := "Bob"
+	senderHandle := world.Spawn(senderID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Appearance{Name: &senderName})
+		ecs.AddComponent(w, h, components.Transform{X: 1, Y: 1})
+	})
+
+	router := &testPrivateChatRouter{online: map[types.EntityID]bool{senderID: true}}
+	system := NewNetworkCommandSystem(nil, nil, &testChatDelivery{}, nil, nil, nil, 100, zap.NewNop())
+	system.SetPrivateChatRouter(router)
+
+	system.handleChat(world, senderHandle, &network.PlayerCommand{
+		CharacterID: senderID,
+		CommandType: network.CmdChat,
+		Payload: &network.ChatCommandPayload{
+			Channel:  netproto.ChatChannel_CHAT_CHANNEL_PRIVATE,
+			Text:     "hi me",
+			TargetID: senderID,
+		},
+	})
+
+	if len(router.calls) != 0 {
+		t.Fatalf("expected self whisper to be dropped, got %d routed", len(router.calls))
+	}
+}
+
+type testPartyChatRouter struct {
+	members map[types.EntityID]bool
+	texts   []string
+}
+
+func (r *testPartyChatRouter) RoutePartyChatMessage(fromEntityID types.EntityID, fromName string, text string) bool {
+	if !r.members[fromEntityID] {
+		return false
+	}
+	r.texts = append(r.texts, fromName+": "+text)
+	return true
+}
+
+func TestNetworkCommandSystem_HandleChat_PartyRoutesToRouter(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	senderID := types.EntityID(7005)
+	senderName := "Dave"
+	senderHandle := world.Spawn(senderID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Appearance{Name: &senderName})
+		ecs.AddComponent(w, h, components.Transform{X: 1, Y: 1})
+	})
+
+	delivery := &testChatDelivery{}
+	router := &testPartyChatRouter{members: map[types.EntityID]bool{senderID: true}}
+	history := &testChatHistory{}
+	system := NewNetworkCommandSystem(nil, nil, delivery, nil, nil, nil, 100, zap.NewNop())
+	system.SetPartyChatRouter(router)
+	system.SetChatHistoryRecorder(history)
+
+	system.handleChat(world, senderHandle, &network.PlayerCommand{
+		CharacterID: senderID,
+		CommandType: network.CmdChat,
+		Payload: &network.ChatCommandPayload{
+			Channel: netproto.ChatChannel_CHAT_CHANNEL_PARTY,
+			Text:    "regroup",
+		},
+	})
+
+	if len(router.texts) != 1 || router.texts[0] != "Dave: regroup" {
+		t.Fatalf("expected party message routed with sender name, got %v", router.texts)
+	}
+	if delivery.broadcasts != 0 {
+		t.Fatalf("party message must not be broadcast locally, got %d broadcasts", delivery.broadcasts)
+	}
+	if len(history.entries) != 1 {
+		t.Fatalf("expected party message recorded to history, got %d entries", len(history.entries))
+	}
+	if entry := history.entries[0]; entry.Channel != netproto.ChatChannel_CHAT_CHANNEL_PARTY || entry.SenderID != senderID || entry.Text != "regroup" {
+		t.Fatalf("unexpected party history entry %+v", entry)
+	}
+}
@@ -0,0 +1,417 @@
+package systems
+
+import (
+	"math"
+
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/pathfind"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+// PathfindingSystemPriority resolves path requests after AnimalAISystem (90) and before MovementSystem (100).
+const PathfindingSystemPriority = 95
+
+const (
+	// pathMaxRepaths limits re-paths of a mover blocked on its way (e.g. by an object built after the search).
+	pathMaxRepaths = 3
+	// pathStaticReach is how far from a cell center static objects are looked up, on top of the mover half size.
+	// It matches the spatial request size of CollisionSystem.
+	pathStaticReach = 20
+)
+
+type PathBlockedSender interface {
+	SendError(entityID types.EntityID, errorCode netproto.ErrorCode, message string)
+}
+
+// PathfindingSystem routes movers that asked for a path (RequestMovePath) around impassable tiles
+// and static colliders. The grid has one cell per tile; a cell is blocked when the mover's collider,
+// centered in the cell, would overlap a static collider. Requests are served in arrival order and
+// A* expands at most NodeBudgetPerTick nodes per tick, resuming the same search on the next tick.
+// Unreachable targets stop the mover and reply ERROR_CODE_PATH_BLOCKED.
+type PathfindingSystem struct {
+	ecs.BaseSystem
+	chunkManager      core.ChunkManager
+	sender            PathBlockedSender
+	logger            *zap.Logger
+	nodeBudgetPerTick int
+	maxNodesPerSearch int
+	grid              pathGrid
+	active            *activePathSearch
+}
+
+type PathfindingSystemConfig struct {
+	NodeBudgetPerTick int
+	MaxNodesPerSearch int
+	ChunkManager      core.ChunkManager
+	Sender            PathBlockedSender
+}
+
+type activePathSearch struct {
+	request ecs.PathRequest
+	start   pathfind.Cell
+	goal    pathfind.Cell
+	isPoint bool
+	search  *pathfind.Search
+}
+
+func NewPathfindingSystem(logger *zap.Logger, cfg PathfindingSystemConfig) *PathfindingSystem {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.NodeBudgetPerTick <= 0 {
+		cfg.NodeBudgetPerTick = 2048
+	}
+	if cfg.MaxNodesPerSearch <= 0 {
+		cfg.MaxNodesPerSearch = 8192
+	}
+
+	return &PathfindingSystem{
+		BaseSystem:        ecs.NewBaseSystem("PathfindingSystem", PathfindingSystemPriority),
+		chunkManager:      cfg.ChunkManager,
+		sender:            cfg.Sender,
+		logger:            logger,
+		nodeBudgetPerTick: cfg.NodeBudgetPerTick,
+		maxNodesPerSearch: cfg.MaxNodesPerSearch,
+		grid: pathGrid{
+			chunkManager: cfg.ChunkManager,
+			cache:        make(map[pathfind.Cell]bool, 1024),
+			candidates:   make([]types.Handle, 0, 64),
+		},
+	}
+}
+
+// RequestMovePath asks PathfindingSystem to route the mover to its current movement target.
+// The mover waits in place until the path is ready. Call it after setting the target.
+func RequestMovePath(w *ecs.World, h types.Handle) bool {
+	return requestMovePath(w, h, 0)
+}
+
+func requestMovePath(w *ecs.World, h types.Handle, repaths int) bool {
+	if w == nil || h == types.InvalidHandle || !w.Alive(h) {
+		return false
+	}
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, h)
+	if !hasMovement || movement.State != constt.StateMoving || movement.TargetType == constt.TargetNone {
+		return false
+	}
+
+	seq := ecs.GetResource[ecs.PathRequestQueue](w).Push(h)
+	ecs.AddComponent(w, h, components.MovePath{
+		State:        components.MovePathPending,
+		Seq:          seq,
+		TargetType:   movement.TargetType,
+		TargetHandle: movement.TargetHandle,
+		GoalX:        movement.TargetX,
+		GoalY:        movement.TargetY,
+		Repaths:      repaths,
+	})
+	return true
+}
+
+// repathBlockedMover asks for a new path when a mover following one got stuck on the way.
+// It reports false when the mover has no path, touches its target entity (that is arrival)
+// or used up its re-paths; the caller then handles the collision as it would without a path.
+func repathBlockedMover(w *ecs.World, h types.Handle, collidedWith types.EntityID) bool {
+	path, hasPath := ecs.GetComponent[components.MovePath](w, h)
+	if !hasPath || path.State != components.MovePathReady || path.Repaths >= pathMaxRepaths {
+		return false
+	}
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, h)
+	if !hasMovement || movement.State != constt.StateMoving || !path.Matches(&movement) {
+		return false
+	}
+	if movement.TargetType == constt.TargetEntity && collidedWith != 0 {
+		if targetID, ok := w.GetExternalID(movement.TargetHandle); ok && targetID == collidedWith {
+			return false
+		}
+	}
+	return requestMovePath(w, h, path.Repaths+1)
+}
+
+func (s *PathfindingSystem) Update(w *ecs.World, dt float64) {
+	_ = dt
+	queue := ecs.GetResource[ecs.PathRequestQueue](w)
+	if s.chunkManager == nil {
+		// Nothing to route over: let movers walk straight.
+		for request, ok := queue.Pop(); ok; request, ok = queue.Pop() {
+			if s.isCurrent(w, request) {
+				s.complete(w, request.Handle, nil)
+			}
+		}
+		return
+	}
+
+	s.grid.world = w
+	budget := s.nodeBudgetPerTick
+	for budget > 0 {
+		if s.active == nil {
+			request, ok := queue.Pop()
+			if !ok {
+				break
+			}
+			budget -= s.begin(w, request)
+			continue
+		}
+		if !s.isCurrent(w, s.active.request) {
+			s.active = nil
+			continue
+		}
+
+		budget -= s.active.search.Step(&s.grid, budget)
+		switch s.active.search.Status() {
+		case pathfind.StatusFound:
+			s.complete(w, s.active.request.Handle, s.waypoints(s.active))
+			s.active = nil
+		case pathfind.StatusNoPath:
+			s.fail(w, s.active.request.Handle)
+			s.active = nil
+		}
+	}
+	s.grid.world = nil
+}
+
+// begin resolves trivial requests at once and starts A* for the rest. It returns the budget spent.
+func (s *PathfindingSystem) begin(w *ecs.World, request ecs.PathRequest) int {
+	if !s.isCurrent(w, request) {
+		return 0
+	}
+	h := request.Handle
+	movement, _ := ecs.GetComponent[components.Movement](w, h)
+	transform, hasTransform := ecs.GetComponent[components.Transform](w, h)
+	collider, hasCollider := ecs.GetComponent[components.Collider](w, h)
+	if !hasTransform || !hasCollider || movement.State != constt.StateMoving {
+		// Nothing blocks a mover without a collider; a stopped mover needs no path.
+		s.complete(w, h, nil)
+		return 1
+	}
+
+	goalX, goalY := movement.TargetX, movement.TargetY
+	goalRadius := 0
+	s.grid.reset(collider, movement.Mode == constt.Swim, types.InvalidHandle)
+	if movement.TargetType == constt.TargetEntity {
+		targetTransform, hasTargetTransform := ecs.GetComponent[components.Transform](w, movement.TargetHandle)
+		if !hasTargetTransform {
+			s.complete(w, h, nil)
+			return 1
+		}
+		goalX, goalY = targetTransform.X, targetTransform.Y
+		// The target's own collider is not an obstacle: bumping into it is how an interaction starts.
+		// Any cell from which the mover would touch the target ends the search.
+		s.grid.ignore = movement.TargetHandle
+		if targetCollider, ok := ecs.GetComponent[components.Collider](w, movement.TargetHandle); ok {
+			reach := math.Max(targetCollider.HalfWidth, targetCollider.HalfHeight) + math.Max(collider.HalfWidth, collider.HalfHeight)
+			goalRadius = int(math.Ceil(reach / constt.CoordPerTile))
+		}
+	}
+
+	start := worldToPathCell(transform.X, transform.Y)
+	goal := worldToPathCell(goalX, goalY)
+	if goalRadius == 0 && !s.grid.Walkable(goal.X, goal.Y) {
+		// The mover may not fit at the goal cell center while the exact target point is free
+		// (a point right next to a wall): then any neighbour cell ends the search and the final leg
+		// heads for the point itself.
+		if !s.grid.pointWalkable(goalX, goalY) {
+			s.fail(w, h)
+			return 1
+		}
+		goalRadius = 1
+	}
+	lineCost := max(goal.X-start.X, start.X-goal.X, goal.Y-start.Y, start.Y-goal.Y) + 1
+	if pathfind.LineWalkable(&s.grid, start, goal) {
+		s.complete(w, h, nil)
+		return lineCost
+	}
+
+	s.active = &activePathSearch{
+		request: request,
+		start:   start,
+		goal:    goal,
+		isPoint: movement.TargetType == constt.TargetPoint,
+		search:  pathfind.NewSearch(start, goal, goalRadius, s.maxNodesPerSearch),
+	}
+	return lineCost
+}
+
+// isCurrent reports whether the request is still the latest unresolved one of a live mover.
+func (s *PathfindingSystem) isCurrent(w *ecs.World, request ecs.PathRequest) bool {
+	if !w.Alive(request.Handle) {
+		return false
+	}
+	path, hasPath := ecs.GetComponent[components.MovePath](w, request.Handle)
+	if !hasPath || path.Seq != request.Seq || path.State != components.MovePathPending {
+		return false
+	}
+	movement, hasMovement := ecs.GetComponent[components.Movement](w, request.Handle)
+	if !hasMovement || !path.Matches(&movement) {
+		ecs.RemoveComponent[components.MovePath](w, request.Handle)
+		return false
+	}
+	return true
+}
+
+// waypoints turns the found cells into world points. The goal cell of a point target is dropped:
+// the final leg heads for the exact target point instead of the cell center.
+func (s *PathfindingSystem) waypoints(active *activePathSearch) []types.Vector2 {
+	cells := pathfind.Smooth(&s.grid, active.start, active.search.Path())
+	if active.isPoint && len(cells) > 0 && cells[len(cells)-1] == active.goal {
+		cells = cells[:len(cells)-1]
+	}
+	if len(cells) == 0 {
+		return nil
+	}
+	points := make([]types.Vector2, len(cells))
+	for i, cell := range cells {
+		points[i] = types.Vector2{
+			X: float64(cell.X*constt.CoordPerTile) + constt.CoordPerTile/2,
+			Y: float64(cell.Y*constt.CoordPerTile) + constt.CoordPerTile/2,
+		}
+	}
+	return points
+}
+
+func (s *PathfindingSystem) complete(w *ecs.World, h types.Handle, waypoints []types.Vector2) {
+	ecs.WithComponent(w, h, func(path *components.MovePath) {
+		path.State = components.MovePathReady
+		path.Waypoints = waypoints
+		path.Next = 0
+	})
+}
+
+// fail stops a mover whose target cannot be reached and tells the player why.
+func (s *PathfindingSystem) fail(w *ecs.World, h types.Handle) {
+	ecs.RemoveComponent[components.MovePath](w, h)
+	ecs.WithComponent(w, h, func(m *components.Movement) {
+		m.ClearTarget()
+	})
+	StopFollow(w, h, components.FollowStopPathBlocked)
+	if transform, ok := ecs.GetComponent[components.Transform](w, h); ok {
+		ecs.GetResource[ecs.MovedEntities](w).Add(h, transform.X, transform.Y)
+	}
+
+	entityID, ok := w.GetExternalID(h)
+	if !ok {
+		return
+	}
+	ClearPlayerInteractionIntents(w, h, entityID)
+	if s.sender != nil {
+		s.sender.SendError(entityID, netproto.ErrorCode_ERROR_CODE_PATH_BLOCKED, "Path blocked")
+	}
+}
+
+func worldToPathCell(x, y float64) pathfind.Cell {
+	return pathfind.Cell{
+		X: int(math.Floor(x / constt.CoordPerTile)),
+		Y: int(math.Floor(y / constt.CoordPerTile)),
+	}
+}
+
+// pathGrid is the walkability grid of one search. Results are cached for the search lifetime.
+type pathGrid struct {
+	chunkManager core.ChunkManager
+	world        *ecs.World
+
+	collider components.Collider
+	swimming bool
+	// ignore is the target entity of the search; its collider does not block.
+	ignore types.Handle
+
+	cache      map[pathfind.Cell]bool
+	candidates []types.Handle
+}
+
+func (g *pathGrid) reset(collider components.Collider, swimming bool, ignore types.Handle) {
+	g.collider = collider
+	g.swimming = swimming
+	g.ignore = ignore
+	clear(g.cache)
+}
+
+func (g *pathGrid) Walkable(x, y int) bool {
+	cell := pathfind.Cell{X: x, Y: y}
+	if walkable, cached := g.cache[cell]; cached {
+		return walkable
+	}
+	walkable := g.tileWalkable(x, y) && !g.staticBlocked(x, y)
+	g.cache[cell] = walkable
+	return walkable
+}
+
+// tileWalkable checks the tile passability bitsets. Tiles of missing or inactive chunks are blocked:
+// ChunkSystem only migrates entities into active chunks.
+func (g *pathGrid) tileWalkable(tileX, tileY int) bool {
+	if tileX < 0 || tileY < 0 {
+		return false
+	}
+	chunk := g.chunkManager.GetChunkFast(types.ChunkCoord{X: tileX / constt.ChunkSize, Y: tileY / constt.ChunkSize})
+	if chunk == nil || chunk.GetState() != types.ChunkStateActive {
+		return false
+	}
+	localX := tileX % constt.ChunkSize
+	localY := tileY % constt.ChunkSize
+	if g.swimming {
+		return chunk.IsTileSwimmable(localX, localY, constt.ChunkSize)
+	}
+	return chunk.IsTilePassable(localX, localY, constt.ChunkSize)
+}
+
+// pointWalkable reports whether the mover collider fits centered at the exact world point.
+func (g *pathGrid) pointWalkable(x, y float64) bool {
+	cell := worldToPathCell(x, y)
+	return g.tileWalkable(cell.X, cell.Y) && !g.staticBlockedAt(x, y)
+}
+
+// staticBlocked reports whether the mover collider centered in the cell overlaps a static collider.
+func (g *pathGrid) staticBlocked(tileX, tileY int) bool {
+	centerX := float64(tileX*constt.CoordPerTile) + constt.CoordPerTile/2
+	centerY := float64(tileY*constt.CoordPerTile) + constt.CoordPerTile/2
+	return g.staticBlockedAt(centerX, centerY)
+}
+
+// staticBlockedAt reports whether the mover collider centered at the point overlaps a static collider.
+func (g *pathGrid) staticBlockedAt(centerX, centerY float64) bool {
+	reachX := g.collider.HalfWidth + pathStaticReach
+	reachY := g.collider.HalfHeight + pathStaticReach
+	minX, minY := centerX-reachX, centerY-reachY
+	maxX, maxY := centerX+reachX, centerY+reachY
+
+	g.candidates = g.candidates[:0]
+	minChunkX := max(int(minX)/constt.ChunkWorldSize, 0)
+	minChunkY := max(int(minY)/constt.ChunkWorldSize, 0)
+	for chunkY := minChunkY; chunkY <= int(maxY)/constt.ChunkWorldSize; chunkY++ {
+		for chunkX := minChunkX; chunkX <= int(maxX)/constt.ChunkWorldSize; chunkX++ {
+			chunk := g.chunkManager.GetChunkFast(types.ChunkCoord{X: chunkX, Y: chunkY})
+			if chunk == nil {
+				continue
+			}
+			chunk.Spatial().QueryStaticAABB(int(minX), int(minY), int(maxX), int(maxY), &g.candidates)
+		}
+	}
+
+	for _, candidate := range g.candidates {
+		if candidate == g.ignore || !g.world.Alive(candidate) {
+			continue
+		}
+		candidateCollider, ok := ecs.GetComponent[components.Collider](g.world, candidate)
+		if !ok || candidateCollider.Phantom != nil {
+			continue
+		}
+		if g.collider.Layer&candidateCollider.Mask == 0 && candidateCollider.Layer&g.collider.Mask == 0 {
+			continue
+		}
+		candidateTransform, ok := ecs.GetComponent[components.Transform](g.world, candidate)
+		if !ok {
+			continue
+		}
+		if math.Abs(centerX-candidateTransform.X) < g.collider.HalfWidth+candidateCollider.HalfWidth &&
+			math.Abs(centerY-candidateTransform.Y) < g.collider.HalfHeight+candidateCollider.HalfHeight {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,302 @@
+package systems
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type testPathBlockedSender struct {
+	codes map[types.EntityID]netproto.ErrorCode
+}
+
+func (s *testPathBlockedSender) SendError(entityID types.EntityID, errorCode netproto.ErrorCode, _ string) {
+	if s.codes == nil {
+		s.codes = make(map[types.EntityID]netproto.ErrorCode)
+	}
+	s.codes[entityID] = errorCode
+}
+
+// newTestPathChunkManager returns one active grass chunk with the given tiles replaced.
+func newTestPathChunkManager(tiles map[[2]int]byte) *testAnimalChunkManager {
+	cm := newTestAnimalChunkManager(types.ChunkCoord{})
+	grass := make([]byte, constt.ChunkSize*constt.ChunkSize)
+	for i := range grass {
+		grass[i] = types.TileGrass
+	}
+	for tile, tileID := range tiles {
+		grass[tile[1]*constt.ChunkSize+tile[0]] = tileID
+	}
+	cm.chunks[types.ChunkCoord{}].SetTiles(grass, 0)
+	return cm
+}
+
+var testPathCollider = components.Collider{HalfWidth: 4, HalfHeight: 4, Layer: 1, Mask: 1}
+
+func spawnTestPathObstacle(w *ecs.World, cm *testAnimalChunkManager, entityID types.EntityID, x, y float64) types.Handle {
+	handle := w.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: x, Y: y})
+		ecs.AddComponent(w, h, components.Collider{HalfWidth: 6, HalfHeight: 6, Layer: 1, Mask: 1})
+	})
+	cm.chunks[types.ChunkCoord{}].Spatial().AddStatic(handle, int(x), int(y))
+	return handle
+}
+
+// spawnTestPathWall blocks x=120 from y=60 to y=180.
+func spawnTestPathWall(w *ecs.World, cm *testAnimalChunkManager) {
+	for i := 0; i <= 10; i++ {
+		spawnTestPathObstacle(w, cm, types.EntityID(8100+i), 120, 60+float64(i)*12)
+	}
+}
+
+func spawnTestPathMover(w *ecs.World, entityID types.EntityID, x, y float64) types.Handle {
+	return w.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: x, Y: y})
+		ecs.AddComponent(w, h, testPathCollider)
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, Speed: 30, TargetHandle: types.InvalidHandle})
+	})
+}
+
+func moveTestPathMoverTo(w *ecs.World, handle types.Handle, x, y int) {
+	ecs.WithComponent(w, handle, func(m *components.Movement) {
+		m.SetTargetPoint(x, y)
+	})
+	RequestMovePath(w, handle)
+}
+
+// runTestPathTicks runs pathfinding and movement, applying movement intents directly (no collision).
+// It returns the positions the mover passed through.
+func runTestPathTicks(t *testing.T, w *ecs.World, pathfinding *PathfindingSystem, handle types.Handle, ticks int) []types.Vector2 {
+	t.Helper()
+	movement := NewMovementSystem(w, nil, zap.NewNop())
+	moved := ecs.GetResource[ecs.MovedEntities](w)
+	var trail []types.Vector2
+	for tick := 0; tick < ticks; tick++ {
+		moved.Count = 0
+		pathfinding.Update(w, 0.1)
+		movement.Update(w, 0.1)
+		for i := 0; i < moved.Count; i++ {
+			if moved.Handles[i] != handle {
+				continue
+			}
+			x, y := moved.IntentX[i], moved.IntentY[i]
+			ecs.WithComponent(w, handle, func(transform *components.Transform) {
+				transform.X, transform.Y = x, y
+			})
+			trail = append(trail, types.Vector2{X: x, Y: y})
+		}
+	}
+	return trail
+}
+
+func TestPathfindingSystem_RoutesAroundStaticColliders(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	spawnTestPathWall(w, cm)
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	mover := spawnTestPathMover(w, 8001, 66, 126)
+
+	moveTestPathMoverTo(w, mover, 174, 126)
+	trail := runTestPathTicks(t, w, system, mover, 400)
+
+	transform, _ := ecs.GetComponent[components.Transform](w, mover)
+	movement, _ := ecs.GetComponent[components.Movement](w, mover)
+	if movement.State != constt.StateIdle || transform.X != 174 || transform.Y != 126 {
+		t.Fatalf("expected mover to arrive at the target, got %+v at (%v,%v)", movement, transform.X, transform.Y)
+	}
+	for _, point := range trail {
+		if math.Abs(point.X-120) < 6+4 && point.Y > 60-6-4 && point.Y < 180+6+4 {
+			t.Fatalf("mover walked through the wall at %+v", point)
+		}
+	}
+	if ecs.HasComponent[components.MovePath](w, mover) {
+		t.Fatalf("expected path to be dropped on arrival")
+	}
+}
+
+func TestPathfindingSystem_PointNextToObstacleIsReachable(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	spawnTestPathWall(w, cm)
+	sender := &testPathBlockedSender{}
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm, Sender: sender})
+	mover := spawnTestPathMover(w, 8006, 174, 126)
+
+	// The mover does not fit at the center of the target cell, but it fits at the point itself.
+	moveTestPathMoverTo(w, mover, 131, 126)
+	runTestPathTicks(t, w, system, mover, 200)
+
+	transform, _ := ecs.GetComponent[components.Transform](w, mover)
+	movement, _ := ecs.GetComponent[components.Movement](w, mover)
+	if movement.State != constt.StateIdle || transform.X != 131 || transform.Y != 126 {
+		t.Fatalf("expected mover to arrive at the point next to the wall, got %+v at (%v,%v)", movement, transform.X, transform.Y)
+	}
+	if _, blocked := sender.codes[8006]; blocked {
+		t.Fatalf("expected no PATH_BLOCKED, got %v", sender.codes)
+	}
+
+	// A point inside the obstacle itself is still refused.
+	moveTestPathMoverTo(w, mover, 120, 126)
+	system.Update(w, 0.1)
+	if sender.codes[8006] != netproto.ErrorCode_ERROR_CODE_PATH_BLOCKED {
+		t.Fatalf("expected PATH_BLOCKED for a point inside the wall, got %v", sender.codes)
+	}
+}
+
+func TestPathfindingSystem_StraightLineNeedsNoWaypoints(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	mover := spawnTestPathMover(w, 8002, 66, 126)
+
+	moveTestPathMoverTo(w, mover, 300, 126)
+	system.Update(w, 0.1)
+
+	path, hasPath := ecs.GetComponent[components.MovePath](w, mover)
+	if !hasPath || path.State != components.MovePathReady || len(path.Waypoints) != 0 {
+		t.Fatalf("expected ready path without waypoints, got %+v", path)
+	}
+}
+
+func TestPathfindingSystem_UnreachableTargetSendsPathBlocked(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	// The target tile is deep water.
+	cm := newTestPathChunkManager(map[[2]int]byte{{15, 10}: types.TileDeepWater})
+	sender := &testPathBlockedSender{}
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm, Sender: sender})
+	mover := spawnTestPathMover(w, 8003, 66, 126)
+	ecs.GetResource[ecs.LinkState](w).SetIntent(8003, 8999, types.InvalidHandle, time.Time{})
+
+	moveTestPathMoverTo(w, mover, 186, 126)
+	system.Update(w, 0.1)
+
+	movement, _ := ecs.GetComponent[components.Movement](w, mover)
+	if movement.State != constt.StateIdle || ecs.HasComponent[components.MovePath](w, mover) {
+		t.Fatalf("expected mover to stop without a path, got %+v", movement)
+	}
+	if sender.codes[8003] != netproto.ErrorCode_ERROR_CODE_PATH_BLOCKED {
+		t.Fatalf("expected PATH_BLOCKED, got %v", sender.codes)
+	}
+	if _, hasIntent := ecs.GetResource[ecs.LinkState](w).IntentByPlayer[8003]; hasIntent {
+		t.Fatalf("expected interaction intent to be cleared")
+	}
+}
+
+func TestPathfindingSystem_EnclosedTargetIsBlockedWithinNodeLimit(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	// Ring of water around tile (20,20).
+	tiles := make(map[[2]int]byte)
+	for dx := -1; dx <= 1; dx++ {
+		for dy := -1; dy <= 1; dy++ {
+			if dx != 0 || dy != 0 {
+				tiles[[2]int{20 + dx, 20 + dy}] = types.TileDeepWater
+			}
+		}
+	}
+	cm := newTestPathChunkManager(tiles)
+	sender := &testPathBlockedSender{}
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{NodeBudgetPerTick: 500, MaxNodesPerSearch: 2000, ChunkManager: cm, Sender: sender})
+	mover := spawnTestPathMover(w, 8004, 66, 126)
+
+	moveTestPathMoverTo(w, mover, 20*12+6, 20*12+6)
+	system.Update(w, 0.1)
+	if path, _ := ecs.GetComponent[components.MovePath](w, mover); path.State != components.MovePathPending {
+		t.Fatalf("expected search to continue on the next tick, got %+v", path)
+	}
+	for tick := 0; tick < 4; tick++ {
+		system.Update(w, 0.1)
+	}
+	if sender.codes[8004] != netproto.ErrorCode_ERROR_CODE_PATH_BLOCKED {
+		t.Fatalf("expected PATH_BLOCKED after the node limit, got %v", sender.codes)
+	}
+}
+
+func TestPathfindingSystem_EntityTargetStopsAtTouch(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	spawnTestPathWall(w, cm)
+	// The target stands right behind the wall.
+	target := spawnTestPathObstacle(w, cm, 8200, 138, 126)
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	mover := spawnTestPathMover(w, 8005, 66, 126)
+
+	ecs.WithComponent(w, mover, func(m *components.Movement) {
+		m.SetTargetHandle(target, 138, 126)
+	})
+	RequestMovePath(w, mover)
+	system.Update(w, 0.1)
+
+	path, _ := ecs.GetComponent[components.MovePath](w, mover)
+	if path.State != components.MovePathReady || len(path.Waypoints) == 0 {
+		t.Fatalf("expected waypoints around the wall, got %+v", path)
+	}
+	last := path.Waypoints[len(path.Waypoints)-1]
+	if last.X <= 120 || math.Hypot(last.X-138, last.Y-126) > 3*constt.CoordPerTile {
+		t.Fatalf("expected path to end next to the target behind the wall, got %+v", path.Waypoints)
+	}
+}
+
+func TestPathfindingSystem_NewTargetDropsStalePath(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	spawnTestPathWall(w, cm)
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	mover := spawnTestPathMover(w, 8006, 66, 126)
+
+	moveTestPathMoverTo(w, mover, 174, 126)
+	system.Update(w, 0.1)
+	// A target set without a path request (e.g. by AI) walks straight.
+	ecs.WithComponent(w, mover, func(m *components.Movement) {
+		m.SetTargetPoint(66, 300)
+	})
+	runTestPathTicks(t, w, system, mover, 1)
+
+	if ecs.HasComponent[components.MovePath](w, mover) {
+		t.Fatalf("expected stale path to be dropped")
+	}
+	movement, _ := ecs.GetComponent[components.Movement](w, mover)
+	if movement.VelocityX != 0 || movement.VelocityY <= 0 {
+		t.Fatalf("expected straight walk to the new target, got %+v", movement)
+	}
+}
+
+func TestRepathBlockedMover_LimitsRepathsAndKeepsTargetContact(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	cm := newTestPathChunkManager(nil)
+	spawnTestPathWall(w, cm)
+	system := NewPathfindingSystem(nil, PathfindingSystemConfig{ChunkManager: cm})
+	mover := spawnTestPathMover(w, 8007, 66, 126)
+
+	moveTestPathMoverTo(w, mover, 174, 126)
+	for repath := 1; repath <= pathMaxRepaths; repath++ {
+		system.Update(w, 0.1)
+		if !repathBlockedMover(w, mover, 8100) {
+			t.Fatalf("expected re-path %d to be requested", repath)
+		}
+		if path, _ := ecs.GetComponent[components.MovePath](w, mover); path.State != components.MovePathPending || path.Repaths != repath {
+			t.Fatalf("expected pending re-path %d, got %+v", repath, path)
+		}
+	}
+	system.Update(w, 0.1)
+	if repathBlockedMover(w, mover, 8100) {
+		t.Fatalf("expected re-paths to run out")
+	}
+
+	target := spawnTestPathObstacle(w, cm, 8201, 138, 126)
+	ecs.WithComponent(w, mover, func(m *components.Movement) {
+		m.SetTargetHandle(target, 138, 126)
+	})
+	RequestMovePath(w, mover)
+	system.Update(w, 0.1)
+	if repathBlockedMover(w, mover, 8201) {
+		t.Fatalf("expected touching the target entity to count as arrival")
+	}
+}
@@ -54,10 +54,15 @@ func (s *TransformUpdateSystem) Update(w *ecs.World, dt float64) {
 		var finalX, finalY float64
 		if hasCollision {
 			if collisionResult.PerpendicularOscillation {
-				// stop movement
-				ecs.WithComponent(w, h, func(m *components.Movement) {
-					m.ClearTarget()
-				})
+				// Path followers look for another way first; otherwise stop movement
+				if !repathBlockedMover(w, h, collisionResult.CollidedWith) {
+					ecs.WithComponent(w, h, func(m *components.Movement) {
+						m.ClearTarget()
+					})
+				}
+			} else if collisionResult.HasCollision && collisionResult.FinalX == transform.X && collisionResult.FinalY == transform.Y {
+				// Stuck at an impassable tile without sliding
+				repathBlockedMover(w, h, collisionResult.CollidedWith)
 			}
 
 			// Apply collision-adjusted position
@@ -211,9 +216,10 @@ func (s *TransformUpdateSystem) applyMovementStaminaTick(
 	moved := dx*dx+dy*dy > 0.000001
 	if moved {
 		tile := entitystats.MovementTileContext{}
-		if entitystats.MovementCostNeedsTileContext() {
-			tile = s.resolveMovementTileContext(fromX, fromY)
+		if entitystats.MovementNeedsTileContext() {
+			tile = resolveMovementTileContext(s.chunkManager, fromX, fromY)
 		}
+		tile.Weather = ecs.GetResource[ecs.WeatherState](w).AtWorldPos(fromX, fromY)
 		cost := entitystats.ResolveMovementStaminaCostPerTick(movement.Mode, con, tile)
 		if cost > 0 {
 			nextStamina := entitystats.ClampStamina(currentStamina-cost, maxStamina)
@@ -264,7 +270,12 @@ func (s *TransformUpdateSystem) applyMovementStaminaTick(
 	}
 }
 
-func (s *TransformUpdateSystem) resolveMovementTileContext(worldX float64, worldY float64) entitystats.MovementTileContext {
+// resolveMovementTileContext returns the tile under a world position, without a tile when the
+// chunk is not loaded.
+func resolveMovementTileContext(chunkManager core.ChunkManager, worldX float64, worldY float64) entitystats.MovementTileContext {
+	if chunkManager == nil {
+		return entitystats.MovementTileContext{HasTile: false}
+	}
 	tileSize := float64(constt.CoordPerTile)
 	tileX := int(math.Floor(worldX / tileSize))
 	tileY := int(math.Floor(worldY / tileSize))
@@ -275,7 +286,7 @@ func (s *TransformUpdateSystem) resolveMovementTileContext(worldX float64, world
 	localTileX := tileX - chunkX*chunkSize
 	localTileY := tileY - chunkY*chunkSize
 
-	chunk := s.chunkManager.GetChunk(types.ChunkCoord{X: chunkX, Y: chunkY})
+	chunk := chunkManager.GetChunk(types.ChunkCoord{X: chunkX, Y: chunkY})
 	if chunk == nil {
 		return entitystats.MovementTileContext{HasTile: false}
 	}
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"math"
 	"math/rand"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"origin/internal/ecs/components"
 	"origin/internal/eventbus"
 	"origin/internal/types"
+	"origin/internal/weather"
 
 	"go.uber.org/zap"
 )
@@ -131,6 +133,10 @@ type VisionSystem struct {
 	entityInfoStorage *ecs.ComponentStorage[components.EntityInfo]
 	externalIDStorage *ecs.ComponentStorage[ecs.ExternalID]
 
+	// daylight of the world time at the start of the update, shared by all workers
+	daylight float64
+	weather  *ecs.WeatherState
+
 	metrics visionMetricsWindow
 }
 
@@ -177,7 +183,10 @@ func (s *VisionSystem) ForceUpdateForObserver(w *ecs.World, observerHandle types
 		return
 	}
 
-	now := ecs.GetResource[ecs.TimeState](w).Now
+	timeState := ecs.GetResource[ecs.TimeState](w)
+	now := timeState.Now
+	s.daylight = timeState.WorldTime.Daylight()
+	s.weather = ecs.GetResource[ecs.WeatherState](w)
 
 	// Force immediate update by setting NextUpdateTime to now
 	observerVis.NextUpdateTime = now
@@ -205,7 +214,10 @@ func (s *VisionSystem) ForceUpdateForObserver(w *ecs.World, observerHandle types
 
 func (s *VisionSystem) Update(w *ecs.World, dt float64) {
 	visState := ecs.GetResource[ecs.VisibilityState](w)
-	now := ecs.GetResource[ecs.TimeState](w).Now
+	timeState := ecs.GetResource[ecs.TimeState](w)
+	now := timeState.Now
+	s.daylight = timeState.WorldTime.Daylight()
+	s.weather = ecs.GetResource[ecs.WeatherState](w)
 	observersTotal := len(visState.VisibleByObserver)
 
 	var collectDur time.Duration
@@ -451,8 +463,11 @@ func (s *VisionSystem) computeObserver(
 		return observerResult{handle: observerHandle, newVis: observerVis, skipOnly: true}
 	}
 
+	visionRadius := CalcMaxVisionRadius(vision, s.daylight)
+	observerWeather := s.weather.At(types.ChunkCoord{X: chunkRef.CurrentChunkX, Y: chunkRef.CurrentChunkY})
+
 	// --- Dirty-flag skip ---
-	if s.canSkipUpdate(&observerVis, observerTransform) {
+	if s.canSkipUpdate(&observerVis, observerTransform, visionRadius, observerWeather) {
 		observerVis.NextUpdateTime = now.Add(_const.VisionUpdateInterval + jitterDuration())
 		return observerResult{handle: observerHandle, newVis: observerVis, skipOnly: true, skipDirty: true}
 	}
@@ -464,7 +479,6 @@ func (s *VisionSystem) computeObserver(
 	observerID := observerExt.ID
 	var stats observerComputeStats
 
-	visionRadius := CalcMaxVisionRadius(vision)
 	visionRadiusSq := visionRadius * visionRadius
 
 	// --- Spatial query (worker-local buffers) ---
@@ -509,7 +523,7 @@ func (s *VisionSystem) computeObserver(
 			targetStealth = stealth.Value
 		}
 
-		if CalcVision(distSq, visionRadius, vision.Power, targetStealth) {
+		if CalcVision(distSq, visionRadius, vision.Power, targetStealth, observerWeather) {
 			if ext, hasExt := s.externalIDStorage.Get(candidateHandle); hasExt {
 				scratch.newVisibleBuf = append(scratch.newVisibleBuf, visibleEntry{candidateHandle, ext.ID})
 			}
@@ -570,6 +584,8 @@ func (s *VisionSystem) computeObserver(
 		LastChunkX:     chunkRef.CurrentChunkX,
 		LastChunkY:     chunkRef.CurrentChunkY,
 		LastChunkGens:  append([]ecs.ChunkGen(nil), scratch.queriedGens...),
+		LastRadius:     visionRadius,
+		LastWeather:    observerWeather,
 		NextUpdateTime: now.Add(_const.VisionUpdateInterval + jitterDuration()),
 	}
 
@@ -581,8 +597,10 @@ func (s *VisionSystem) computeObserver(
 func (s *VisionSystem) canSkipUpdate(
 	observerVis *ecs.ObserverVisibility,
 	transform components.Transform,
+	radius float64,
+	weatherType weather.Type,
 ) bool {
-	if len(observerVis.LastChunkGens) == 0 {
+	if len(observerVis.LastChunkGens) == 0 || observerVis.LastRadius != radius || observerVis.LastWeather != weatherType {
 		return false
 	}
 
@@ -786,13 +804,20 @@ func (s *VisionSystem) maybeLogMetrics() {
 	s.metrics = visionMetricsWindow{windowStart: now}
 }
 
-func CalcMaxVisionRadius(vision components.Vision) float64 {
-	// TODO formulae
-	return vision.Radius
+// visionDaylightSteps quantizes daylight so the radius changes a few times per dusk and dawn,
+// not every second: a radius change forces a full vision update of every observer.
+const visionDaylightSteps = 4
+
+// CalcMaxVisionRadius shrinks the vision radius towards NightVisionRadiusScale as daylight fades.
+func CalcMaxVisionRadius(vision components.Vision, daylight float64) float64 {
+	daylight = math.Round(math.Max(0, math.Min(1, daylight))*visionDaylightSteps) / visionDaylightSteps
+	return vision.Radius * (_const.NightVisionRadiusScale + (1-_const.NightVisionRadiusScale)*daylight)
 }
 
-func CalcVision(distSq float64, maxRadius float64, power float64, targetStealth float64) bool {
-	effectiveRange := power - targetStealth
+// CalcVision reports whether a target at distSq is seen. Weather over the observer scales its power,
+// so fog lets stealthy targets hide closer than on a clear day.
+func CalcVision(distSq float64, maxRadius float64, power float64, targetStealth float64, weatherType weather.Type) bool {
+	effectiveRange := power*weatherType.VisionPowerScale() - targetStealth
 	if effectiveRange <= 0 {
 		return false
 	}
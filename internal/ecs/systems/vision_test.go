@@ -0,0 +1,43 @@
+package systems
+
+import (
+	"testing"
+
+	"origin/internal/ecs/components"
+	"origin/internal/weather"
+)
+
+func TestCalcMaxVisionRadius_ShrinksAtNight(t *testing.T) {
+	vision := components.Vision{Radius: 600, Power: 600}
+	cases := []struct {
+		daylight float64
+		radius   float64
+	}{
+		{daylight: 1, radius: 600},
+		{daylight: 0, radius: 300},
+		{daylight: 0.5, radius: 450},
+		// quantized to quarter steps
+		{daylight: 0.3, radius: 375},
+		{daylight: 0.1, radius: 300},
+	}
+	for _, tc := range cases {
+		if got := CalcMaxVisionRadius(vision, tc.daylight); got != tc.radius {
+			t.Fatalf("expected radius %v at daylight %v, got %v", tc.radius, tc.daylight, got)
+		}
+	}
+}
+
+func TestCalcVision_FogReducesPower(t *testing.T) {
+	const maxRadius = 600.0
+	distSq := 250.0 * 250.0
+
+	if !CalcVision(distSq, maxRadius, 400, 100, weather.Clear) {
+		t.Fatal("expected target at 250 to be visible in clear weather")
+	}
+	if CalcVision(distSq, maxRadius, 400, 100, weather.Fog) {
+		t.Fatal("expected fog to hide target at 250")
+	}
+	if !CalcVision(50*50, maxRadius, 400, 100, weather.Fog) {
+		t.Fatal("expected target at 50 to stay visible in fog")
+	}
+}
@@ -0,0 +1,50 @@
+package systems
+
+import (
+	"origin/internal/ecs"
+	"origin/internal/types"
+	"origin/internal/weather"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// WeatherSystemPriority runs before movement and vision so they see the tick's weather.
+	WeatherSystemPriority = 20
+	// weatherUpdateIntervalTicks throttles weather recomputation; weather changes on the
+	// scale of in-game hours, so checking a few times per second is plenty.
+	weatherUpdateIntervalTicks = 10
+)
+
+// WeatherChunkPublisher lists the chunks clients stream and sends weather changes to them.
+type WeatherChunkPublisher interface {
+	ActiveChunkCoords() []types.ChunkCoord
+	PublishWeatherUpdate(coord types.ChunkCoord, weatherType weather.Type)
+}
+
+// WeatherSystem advances WeatherState to the current world time and notifies clients
+// streaming chunks whose weather changed.
+type WeatherSystem struct {
+	ecs.BaseSystem
+	publisher WeatherChunkPublisher
+	logger    *zap.Logger
+}
+
+func NewWeatherSystem(publisher WeatherChunkPublisher, logger *zap.Logger) *WeatherSystem {
+	return &WeatherSystem{
+		BaseSystem: ecs.NewBaseSystemWithInterval("WeatherSystem", WeatherSystemPriority, weatherUpdateIntervalTicks),
+		publisher:  publisher,
+		logger:     logger,
+	}
+}
+
+func (s *WeatherSystem) Update(w *ecs.World, dt float64) {
+	worldTime := ecs.GetResource[ecs.TimeState](w).WorldTime
+	changed := ecs.GetResource[ecs.WeatherState](w).Update(worldTime, s.publisher.ActiveChunkCoords())
+	for _, change := range changed {
+		s.publisher.PublishWeatherUpdate(change.Coord, change.Weather)
+	}
+	if len(changed) > 0 {
+		s.logger.Debug("Weather changed", zap.Int("chunks", len(changed)))
+	}
+}
@@ -0,0 +1,57 @@
+package systems
+
+import (
+	"testing"
+
+	"origin/internal/ecs"
+	"origin/internal/timeutil"
+	"origin/internal/types"
+	"origin/internal/weather"
+
+	"go.uber.org/zap"
+)
+
+type weatherPublisherStub struct {
+	coords    []types.ChunkCoord
+	published map[types.ChunkCoord]weather.Type
+}
+
+func (p *weatherPublisherStub) ActiveChunkCoords() []types.ChunkCoord { return p.coords }
+
+func (p *weatherPublisherStub) PublishWeatherUpdate(coord types.ChunkCoord, weatherType weather.Type) {
+	p.published[coord] = weatherType
+}
+
+func TestWeatherSystem_PublishesChangedChunks(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	model := weather.NewModel(5, 1)
+	ecs.GetResource[ecs.WeatherState](world).SetModel(model)
+
+	publisher := &weatherPublisherStub{published: make(map[types.ChunkCoord]weather.Type)}
+	for x := 0; x < 32; x++ {
+		publisher.coords = append(publisher.coords, types.ChunkCoord{X: x, Y: 1})
+	}
+	system := NewWeatherSystem(publisher, zap.NewNop())
+
+	before := timeutil.WorldTime{DayLengthSeconds: 24 * 3600, SeasonDays: 7}
+	ecs.GetResource[ecs.TimeState](world).WorldTime = before
+	system.Update(world, 0)
+	if len(publisher.published) != 0 {
+		t.Fatalf("expected no updates on first sight of chunks, got %v", publisher.published)
+	}
+
+	after := before
+	after.Day = 2
+	ecs.GetResource[ecs.TimeState](world).WorldTime = after
+	system.Update(world, 0)
+	if len(publisher.published) == 0 {
+		t.Fatal("expected weather updates after two days")
+	}
+	for _, coord := range publisher.coords {
+		published, sent := publisher.published[coord]
+		changed := model.At(coord, before) != model.At(coord, after)
+		if sent != changed || (sent && published != model.At(coord, after)) {
+			t.Fatalf("chunk %v: sent=%v (%v), changed=%v", coord, sent, published, changed)
+		}
+	}
+}
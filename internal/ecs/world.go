@@ -131,6 +131,8 @@ func NewWorldWithCapacity(maxHandles uint32, eventBus *eventbus.EventBus, layer
 		latest:   make(map[BehaviorTickKey]behaviorTickState, 256),
 		byEntity: make(map[types.EntityID]map[string]struct{}, 128),
 	})
+	InitResource(w, AnimalThinkSchedule{})
+	InitResource(w, PathRequestQueue{})
 	InitResource(w, EntityStatsUpdateState{
 		regenQueue:     make(entityStatsRegenMinHeap, 0, 128),
 		regenLatest:    make(map[types.Handle]entityStatsRegenState, 128),
@@ -162,6 +164,7 @@ func NewWorldWithCapacity(maxHandles uint32, eventBus *eventbus.EventBus, layer
 		ByPlayer: make(map[types.EntityID]map[string]struct{}, 64),
 	})
 	InitResource(w, TimeState{})
+	InitResource(w, WeatherState{})
 
 	return w
 }
@@ -4,22 +4,38 @@ import (
 	"math"
 	"origin/internal/characterattrs"
 	constt "origin/internal/const"
+	"origin/internal/tiledefs"
+	"origin/internal/weather"
 )
 
 type MovementTileContext struct {
 	TileID  byte
 	HasTile bool
+	Weather weather.Type // weather over the tile's chunk, known even without the tile
 }
 
-// ResolveTileStaminaModifier is intentionally a dedicated hook so tile-specific
-// stamina formulas (e.g. paved roads) can be introduced without touching callers.
+// ResolveTileStaminaModifier returns the stamina cost multiplier of the tile under the mover
+// from the tile definitions; unknown tiles cost base stamina.
 func ResolveTileStaminaModifier(tile MovementTileContext) float64 {
-	_ = tile
-	return 1.0
+	if !tile.HasTile {
+		return 1.0
+	}
+	return tiledefs.Global().StaminaMultiplier(tile.TileID)
+}
+
+// ResolveTileSpeedModifier returns the speed multiplier of the tile under the mover.
+// It applies on top of the movement mode, after stamina and carrying have capped the mode.
+func ResolveTileSpeedModifier(tile MovementTileContext) float64 {
+	if !tile.HasTile {
+		return 1.0
+	}
+	return tiledefs.Global().SpeedMultiplier(tile.TileID)
 }
 
-func MovementCostNeedsTileContext() bool {
-	return false
+// MovementNeedsTileContext reports whether any tile modifies movement, so callers can
+// skip the chunk lookup when there are no tile definitions.
+func MovementNeedsTileContext() bool {
+	return tiledefs.Global().Count() > 0
 }
 
 func ResolveMovementStaminaCostPerTick(
@@ -50,7 +66,7 @@ func ResolveMovementStaminaCostPerTick(
 	if base <= 0 {
 		return 0
 	}
-	return base * tileModifier
+	return base * tileModifier * tile.Weather.StaminaCostScale()
 }
 
 func SwimStaminaCostPerTick(con int) float64 {
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	constt "origin/internal/const"
+	"origin/internal/tiledefs"
+	"origin/internal/types"
+	"origin/internal/weather"
 )
 
 func TestResolveAllowedMoveMode(t *testing.T) {
@@ -99,3 +102,44 @@ func TestResolveAllowedMoveModeWithCarry_PrioritizesOverstuffedAndNoMove(t *test
 		t.Fatalf("expected carry below no-move threshold to stop movement, got mode=%v canMove=%v", mode, canMove)
 	}
 }
+
+func TestResolveTileModifiers(t *testing.T) {
+	prev := tiledefs.Global()
+	tiledefs.SetGlobalForTesting(nil)
+	t.Cleanup(func() { tiledefs.SetGlobalForTesting(prev) })
+
+	paving := MovementTileContext{TileID: types.TileStonePaving, HasTile: true}
+	if MovementNeedsTileContext() || ResolveTileSpeedModifier(paving) != 1 || ResolveTileStaminaModifier(paving) != 1 {
+		t.Fatalf("expected base modifiers without tile definitions")
+	}
+
+	tiledefs.SetGlobalForTesting(tiledefs.NewRegistry([]tiledefs.TileDef{
+		{TileID: types.TileStonePaving, Key: "stone_paving", Speed: 1.3, Stamina: 0.8},
+	}))
+	if !MovementNeedsTileContext() {
+		t.Fatalf("expected tile context to be needed with tile definitions")
+	}
+	if got := ResolveTileSpeedModifier(paving); got != 1.3 {
+		t.Fatalf("expected paving speed 1.3, got %v", got)
+	}
+	if got := ResolveMovementStaminaCostPerTick(constt.Walk, 10, paving); math.Abs(got-constt.MovementStaminaCostWalkPerTick*0.8) > 1e-9 {
+		t.Fatalf("expected paving walk cost scaled by 0.8, got %v", got)
+	}
+	if got := ResolveTileSpeedModifier(MovementTileContext{TileID: types.TileStonePaving}); got != 1 {
+		t.Fatalf("expected base speed without a resolved tile, got %v", got)
+	}
+	if got := ResolveTileSpeedModifier(MovementTileContext{TileID: types.TileGrass, HasTile: true}); got != 1 {
+		t.Fatalf("expected base speed on undefined tile, got %v", got)
+	}
+}
+
+func TestResolveMovementStaminaCostPerTick_Weather(t *testing.T) {
+	clearCost := ResolveMovementStaminaCostPerTick(constt.Run, 10, MovementTileContext{})
+	rain := ResolveMovementStaminaCostPerTick(constt.Run, 10, MovementTileContext{Weather: weather.Rain})
+	if math.Abs(rain-clearCost*weather.Rain.StaminaCostScale()) > 1e-9 || rain <= clearCost {
+		t.Fatalf("expected rain to raise run cost from %v, got %v", clearCost, rain)
+	}
+	if got := ResolveMovementStaminaCostPerTick(constt.Run, 10, MovementTileContext{Weather: weather.Fog}); got != clearCost {
+		t.Fatalf("expected fog to keep run cost %v, got %v", clearCost, got)
+	}
+}
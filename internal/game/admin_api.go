@@ -0,0 +1,326 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/world"
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+// AdminOnlinePlayer is one character entity listed by the admin API.
+type AdminOnlinePlayer struct {
+	EntityID  types.EntityID
+	Name      string
+	Layer     int
+	X         int
+	Y         int
+	Connected bool // false while detached
+}
+
+// AdminShardStats groups per-shard counters for the admin API.
+type AdminShardStats struct {
+	Layer       int
+	Players     int
+	Chunks      world.ChunkStats
+	PlayerInbox AdminInboxStats
+	ServerInbox AdminInboxStats
+}
+
+type AdminInboxStats struct {
+	Received  uint64
+	Dropped   uint64
+	Processed uint64
+}
+
+// AdminChatMessage is one stored chat message returned by the admin chat search.
+type AdminChatMessage struct {
+	ID         int64
+	Channel    netproto.ChatChannel
+	SenderID   types.EntityID // 0 for server messages
+	SenderName string         // server messages only
+	ReceiverID types.EntityID // private messages only
+	Layer      int
+	X          int
+	Y          int
+	Text       string
+	SentAt     time.Time
+}
+
+// AdminChatSearchMaxLimit caps the rows of one admin chat search.
+const AdminChatSearchMaxLimit = 1000
+
+// AdminStats is the admin API stats snapshot. The event bus is shared by all shards.
+type AdminStats struct {
+	Game             GameStats
+	EventQueueHigh   int
+	EventQueueMedium int
+	EventQueueLow    int
+	Shards           []AdminShardStats
+}
+
+// AdminOnlinePlayers lists character entities of all shards ordered by entity id.
+func (g *Game) AdminOnlinePlayers() []AdminOnlinePlayer {
+	var players []AdminOnlinePlayer
+	for layer, shard := range g.shardManager.GetShards() {
+		start := len(players)
+		shard.WithWorldRead(func(w *ecs.World) {
+			for entityID, charEntity := range ecs.GetResource[ecs.CharacterEntities](w).Map {
+				player := AdminOnlinePlayer{EntityID: entityID, Layer: layer}
+				if transform, ok := ecs.GetComponent[components.Transform](w, charEntity.Handle); ok {
+					player.X = int(transform.X)
+					player.Y = int(transform.Y)
+				}
+				if appearance, ok := ecs.GetComponent[components.Appearance](w, charEntity.Handle); ok && appearance.Name != nil {
+					player.Name = *appearance.Name
+				}
+				players = append(players, player)
+			}
+		})
+		// Client lookup takes ClientsMu, keep it outside of the world lock.
+		for i := start; i < len(players); i++ {
+			players[i].Connected = shard.HasClient(players[i].EntityID)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].EntityID < players[j].EntityID })
+	return players
+}
+
+type adminActorContextKey struct{}
+
+// WithAdminActor marks ctx as an admin API request of the given account.
+// Admin API actions are audited with that account id as the actor.
+func WithAdminActor(ctx context.Context, accountID int64) context.Context {
+	return context.WithValue(ctx, adminActorContextKey{}, accountID)
+}
+
+func adminActorFromContext(ctx context.Context) int64 {
+	accountID, _ := ctx.Value(adminActorContextKey{}).(int64)
+	return accountID
+}
+
+// AdminModerate runs a moderation request on behalf of the admin API and waits for the result.
+// API requests act with the admin role and no character. The audit entry is written when the
+// moderation finishes, even if ctx expired before.
+func (g *Game) AdminModerate(ctx context.Context, req ModerationRequest) (ModerationResult, error) {
+	req.ActorID = 0
+	req.ActorRole = RoleAdmin
+
+	type outcome struct {
+		result ModerationResult
+		err    error
+	}
+	done := make(chan outcome, 1)
+	g.ExecuteModeration(req, func(result ModerationResult, err error) {
+		g.recordAdminAPIAudit(ctx, req.Action.String(), req.Target, result.TargetID, result.Message, err)
+		done <- outcome{result: result, err: err}
+	})
+
+	select {
+	case out := <-done:
+		return out.result, out.err
+	case <-ctx.Done():
+		return ModerationResult{}, ctx.Err()
+	}
+}
+
+// AdminTeleport moves an online character through the regular transfer flow.
+// targetLayer nil keeps the current layer.
+func (g *Game) AdminTeleport(ctx context.Context, playerID types.EntityID, x, y int, targetLayer *int) error {
+	shard := g.shardManager.FindPlayerShard(playerID)
+	if shard == nil {
+		return fmt.Errorf("player %d is offline", playerID)
+	}
+	err := g.RequestAdminTeleport(playerID, shard.Layer(), x, y, targetLayer)
+	args := fmt.Sprintf("%d %d", x, y)
+	if targetLayer != nil {
+		args += fmt.Sprintf(" %d", *targetLayer)
+	}
+	g.recordAdminAPIAudit(ctx, "teleport", args, playerID, "teleport requested", err)
+	return err
+}
+
+// AdminGiveItem gives an item to an online character. The give runs as a server job on the
+// character's shard so inventory mutation stays on the shard thread.
+func (g *Game) AdminGiveItem(ctx context.Context, playerID types.EntityID, itemKey string, count, quality uint32) (string, error) {
+	shard := g.shardManager.FindPlayerShard(playerID)
+	if shard == nil {
+		return "", fmt.Errorf("player %d is offline", playerID)
+	}
+
+	resultCh := make(chan network.ServerJobResult, 1)
+	if err := shard.ServerInbox().Enqueue(&network.ServerJob{
+		JobType:  network.JobAdminGiveItem,
+		TargetID: playerID,
+		Payload: &network.AdminGiveItemJobPayload{
+			ItemKey: itemKey,
+			Count:   count,
+			Quality: quality,
+			Result:  resultCh,
+		},
+		CreatedAt: time.Now(),
+		Layer:     shard.Layer(),
+	}); err != nil {
+		return "", err
+	}
+
+	args := itemKey + " " + strconv.FormatUint(uint64(count), 10) + " " + strconv.FormatUint(uint64(quality), 10)
+	select {
+	case result := <-resultCh:
+		g.recordAdminAPIAudit(ctx, "give", args, playerID, result.Message, result.Err)
+		return result.Message, result.Err
+	case <-ctx.Done():
+		// The queued job still runs; audit it once the shard reports the result.
+		go func() {
+			result := <-resultCh
+			g.recordAdminAPIAudit(ctx, "give", args, playerID, result.Message, result.Err)
+		}()
+		return "", ctx.Err()
+	}
+}
+
+// AdminSaveAll queues a save of every character entity on every shard and waits until
+// each shard has taken its snapshots. Returns the result message per layer.
+func (g *Game) AdminSaveAll(ctx context.Context) (map[int]string, error) {
+	shards := g.shardManager.GetShards()
+	results := make(map[int]chan network.ServerJobResult, len(shards))
+	for layer, shard := range shards {
+		resultCh := make(chan network.ServerJobResult, 1)
+		if err := shard.ServerInbox().Enqueue(&network.ServerJob{
+			JobType:   network.JobSaveAllCharacters,
+			Payload:   &network.SaveAllCharactersJobPayload{Result: resultCh},
+			CreatedAt: time.Now(),
+			Layer:     layer,
+		}); err != nil {
+			return nil, fmt.Errorf("layer %d: %w", layer, err)
+		}
+		results[layer] = resultCh
+	}
+
+	saved := make(map[int]string, len(results))
+	for layer, resultCh := range results {
+		select {
+		case result := <-resultCh:
+			if result.Err != nil {
+				g.recordAdminAPIAudit(ctx, "save-all", "", 0, "", result.Err)
+				return nil, fmt.Errorf("layer %d: %w", layer, result.Err)
+			}
+			saved[layer] = result.Message
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	g.recordAdminAPIAudit(ctx, "save-all", "", 0, fmt.Sprintf("%d shards saved", len(saved)), nil)
+	return saved, nil
+}
+
+// AdminSetAccountRole stores a new account role and applies it to the account's online
+// characters right away, so a demoted admin loses commands without reconnecting.
+func (g *Game) AdminSetAccountRole(ctx context.Context, accountID int64, role AccountRole) error {
+	err := g.setAccountRole(ctx, accountID, role)
+	g.recordAdminAPIAudit(ctx, "role", strconv.FormatInt(accountID, 10)+" "+role.String(), 0, "role set to "+role.String(), err)
+	return err
+}
+
+func (g *Game) setAccountRole(ctx context.Context, accountID int64, role AccountRole) error {
+	if g.db == nil {
+		return fmt.Errorf("database unavailable")
+	}
+	updated, err := g.db.Queries().SetAccountRole(ctx, repository.SetAccountRoleParams{Role: int16(role), ID: accountID})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("account %d not found", accountID)
+	}
+	g.roles.SetAccountRole(accountID, role)
+	return nil
+}
+
+// AdminSearchChat returns chat messages sent or received by a character in [from, to), oldest first.
+func (g *Game) AdminSearchChat(ctx context.Context, characterID types.EntityID, from, to time.Time, limit int) ([]AdminChatMessage, error) {
+	if g.db == nil {
+		return nil, fmt.Errorf("database unavailable")
+	}
+	if limit <= 0 || limit > AdminChatSearchMaxLimit {
+		limit = AdminChatSearchMaxLimit
+	}
+	rows, err := g.db.Queries().SearchChatByCharacter(ctx, repository.SearchChatByCharacterParams{
+		CharacterID: int64(characterID),
+		FromTime:    from,
+		ToTime:      to,
+		RowLimit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]AdminChatMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, AdminChatMessage{
+			ID:         row.ID,
+			Channel:    netproto.ChatChannel(row.Channel),
+			SenderID:   types.EntityID(row.SenderID.Int64),
+			SenderName: row.SenderName.String,
+			ReceiverID: types.EntityID(row.ReceiverID.Int64),
+			Layer:      int(row.Layer),
+			X:          row.X,
+			Y:          row.Y,
+			Text:       row.Message,
+			SentAt:     row.CreatedAt,
+		})
+	}
+	return messages, nil
+}
+
+// AdminStats collects game, chunk, event bus and inbox counters of all shards.
+func (g *Game) AdminStats() AdminStats {
+	stats := AdminStats{Game: g.Stats()}
+	stats.EventQueueHigh, stats.EventQueueMedium, stats.EventQueueLow = g.shardManager.EventBus().QueueDepth()
+
+	for layer, shard := range g.shardManager.GetShards() {
+		shardStats := AdminShardStats{
+			Layer:  layer,
+			Chunks: shard.ChunkManager().Stats(),
+		}
+		shardStats.PlayerInbox.Received, shardStats.PlayerInbox.Dropped, shardStats.PlayerInbox.Processed = shard.PlayerInbox().Stats()
+		shardStats.ServerInbox.Received, shardStats.ServerInbox.Dropped, shardStats.ServerInbox.Processed = shard.ServerInbox().Stats()
+		shard.WithWorldRead(func(w *ecs.World) {
+			shardStats.Players = len(ecs.GetResource[ecs.CharacterEntities](w).Map)
+		})
+		stats.Shards = append(stats.Shards, shardStats)
+	}
+	sort.Slice(stats.Shards, func(i, j int) bool { return stats.Shards[i].Layer < stats.Shards[j].Layer })
+	return stats
+}
+
+// recordAdminAPIAudit writes an "api:" audit entry. The actor is the account of the request,
+// taken from ctx, instead of a character id.
+func (g *Game) recordAdminAPIAudit(ctx context.Context, command, args string, targetID types.EntityID, result string, err error) {
+	audit := g.shardManager.AdminAudit()
+	if audit == nil {
+		return
+	}
+	entry := AdminAuditEntry{
+		ActorID:   types.EntityID(adminActorFromContext(ctx)),
+		ActorRole: RoleAdmin,
+		TargetID:  targetID,
+		Command:   "api:" + command,
+		Args:      args,
+		Outcome:   AdminAuditSuccess,
+		Result:    result,
+		At:        time.Now(),
+	}
+	if err != nil {
+		entry.Outcome = AdminAuditFailed
+		entry.Result = err.Error()
+	}
+	audit.RecordAdminAudit(entry)
+}
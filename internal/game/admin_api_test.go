@@ -0,0 +1,32 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestAdminModerate_AuditsAccountEvenAfterTimeout(t *testing.T) {
+	audit := &AdminAuditWriter{logger: zap.NewNop(), queue: &backgroundWriter[AdminAuditEntry]{entries: make(chan AdminAuditEntry, 1)}}
+	g := &Game{
+		logger:       zap.NewNop(),
+		shardManager: &ShardManager{adminAudit: audit},
+		ctx:          context.Background(),
+	}
+
+	ctx, cancel := context.WithCancel(WithAdminActor(context.Background(), 42))
+	cancel()
+	_, _ = g.AdminModerate(ctx, ModerationRequest{Action: ModerationKick, Target: "bob"})
+	g.wg.Wait()
+
+	select {
+	case entry := <-audit.queue.entries:
+		if entry.ActorID != 42 || entry.ActorRole != RoleAdmin || entry.Command != "api:kick" || entry.Outcome != AdminAuditFailed {
+			t.Fatalf("unexpected audit entry: %+v", entry)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected moderation to be audited after the request timed out")
+	}
+}
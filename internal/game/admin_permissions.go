@@ -0,0 +1,230 @@
+package game
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/persistence"
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+// AccountRole is the privilege level stored in account.role. Roles are ordered:
+// every role can run the commands of the roles below it.
+type AccountRole int16
+
+const (
+	RolePlayer AccountRole = iota
+	RoleModerator
+	RoleGamemaster
+	RoleAdmin
+)
+
+func (r AccountRole) String() string {
+	switch r {
+	case RolePlayer:
+		return "player"
+	case RoleModerator:
+		return "moderator"
+	case RoleGamemaster:
+		return "gamemaster"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAccountRole parses a role name as returned by AccountRole.String.
+func ParseAccountRole(name string) (AccountRole, bool) {
+	for role := RolePlayer; role <= RoleAdmin; role++ {
+		if role.String() == name {
+			return role, true
+		}
+	}
+	return RolePlayer, false
+}
+
+// adminCommandRoles is the minimum role required for each chat slash-command.
+// Commands missing from this map are not admin commands and fall through to regular chat.
+var adminCommandRoles = map[string]AccountRole{
+	"/online":     RoleModerator,
+	"/broadcast":  RoleModerator,
+	"/kick":       RoleModerator,
+	"/mute":       RoleModerator,
+	"/ban":        RoleGamemaster,
+	"/unban":      RoleGamemaster,
+	"/tp":         RoleGamemaster,
+	"/spawn":      RoleGamemaster,
+	"/health":     RoleGamemaster,
+	"/stamina":    RoleGamemaster,
+	"/energy":     RoleGamemaster,
+	"/shp":        RoleGamemaster,
+	"/hhp":        RoleGamemaster,
+	"/damage":     RoleGamemaster,
+	"/error":      RoleGamemaster,
+	"/warn":       RoleGamemaster,
+	"/give":       RoleAdmin,
+	"/revive":     RoleAdmin,
+	"/countdown":  RoleAdmin,
+	"/reloaddefs": RoleAdmin,
+}
+
+// AdminRoleResolver resolves the account role of an online player.
+type AdminRoleResolver interface {
+	RoleOf(playerID types.EntityID) AccountRole
+}
+
+// PlayerRoles caches account roles of authenticated characters.
+// Written from auth, disconnect and role changes (network and API goroutines), read from shard ticks.
+type PlayerRoles struct {
+	mu       sync.RWMutex
+	roles    map[types.EntityID]AccountRole
+	accounts map[types.EntityID]int64
+}
+
+func NewPlayerRoles() *PlayerRoles {
+	return &PlayerRoles{
+		roles:    make(map[types.EntityID]AccountRole),
+		accounts: make(map[types.EntityID]int64),
+	}
+}
+
+// Set caches the role of a character's account.
+func (r *PlayerRoles) Set(playerID types.EntityID, accountID int64, role AccountRole) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accounts[playerID] = accountID
+	if role == RolePlayer {
+		delete(r.roles, playerID)
+		return
+	}
+	r.roles[playerID] = role
+}
+
+// SetAccountRole applies a role change to every cached character of the account.
+func (r *PlayerRoles) SetAccountRole(accountID int64, role AccountRole) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for playerID, playerAccountID := range r.accounts {
+		if playerAccountID != accountID {
+			continue
+		}
+		if role == RolePlayer {
+			delete(r.roles, playerID)
+		} else {
+			r.roles[playerID] = role
+		}
+	}
+}
+
+// Remove forgets a character on logout; its role is loaded again on the next auth.
+func (r *PlayerRoles) Remove(playerID types.EntityID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.roles, playerID)
+	delete(r.accounts, playerID)
+}
+
+func (r *PlayerRoles) RoleOf(playerID types.EntityID) AccountRole {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.roles[playerID]
+}
+
+// AdminAuditOutcome is stored in admin_audit.outcome.
+type AdminAuditOutcome int16
+
+const (
+	AdminAuditSuccess AdminAuditOutcome = iota
+	AdminAuditDenied
+	AdminAuditFailed
+)
+
+// AdminAuditEntry describes one privileged command invocation.
+type AdminAuditEntry struct {
+	ActorID   types.EntityID
+	ActorRole AccountRole
+	TargetID  types.EntityID // 0 = none
+	Command   string
+	Args      string
+	Outcome   AdminAuditOutcome
+	Result    string
+	At        time.Time
+}
+
+// AdminAuditRecorder persists admin command invocations.
+type AdminAuditRecorder interface {
+	RecordAdminAudit(entry AdminAuditEntry)
+}
+
+const (
+	adminAuditChannelSize  = 1024
+	adminAuditWriteTimeout = 3 * time.Second
+)
+
+// AdminAuditWriter writes audit entries on a background goroutine.
+// RecordAdminAudit never blocks the shard tick; a full queue drops the entry with an error log.
+type AdminAuditWriter struct {
+	db     *persistence.Postgres
+	logger *zap.Logger
+	queue  *backgroundWriter[AdminAuditEntry]
+}
+
+func NewAdminAuditWriter(db *persistence.Postgres, logger *zap.Logger) *AdminAuditWriter {
+	w := &AdminAuditWriter{
+		db:     db,
+		logger: logger,
+	}
+	w.queue = newBackgroundWriter(adminAuditChannelSize, 1, 0, func(batch []AdminAuditEntry) {
+		for _, entry := range batch {
+			w.write(entry)
+		}
+	})
+	return w
+}
+
+func (w *AdminAuditWriter) RecordAdminAudit(entry AdminAuditEntry) {
+	if !w.queue.enqueue(entry) {
+		w.logger.Error("Admin audit queue full, dropping entry",
+			zap.Int64("actor_id", int64(entry.ActorID)),
+			zap.String("command", entry.Command),
+			zap.String("args", entry.Args))
+	}
+}
+
+// Stop writes all queued entries and stops the writer.
+func (w *AdminAuditWriter) Stop() {
+	w.queue.stop()
+}
+
+func (w *AdminAuditWriter) write(entry AdminAuditEntry) {
+	if w.db == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), adminAuditWriteTimeout)
+	defer cancel()
+	if err := w.db.Queries().InsertAdminAudit(ctx, adminAuditParams(entry)); err != nil {
+		w.logger.Error("Failed to write admin audit entry",
+			zap.Int64("actor_id", int64(entry.ActorID)),
+			zap.String("command", entry.Command),
+			zap.Error(err))
+	}
+}
+
+func adminAuditParams(entry AdminAuditEntry) repository.InsertAdminAuditParams {
+	return repository.InsertAdminAuditParams{
+		ActorID:   int64(entry.ActorID),
+		ActorRole: int16(entry.ActorRole),
+		TargetID:  sql.NullInt64{Int64: int64(entry.TargetID), Valid: entry.TargetID != 0},
+		Command:   entry.Command,
+		Args:      entry.Args,
+		Outcome:   int16(entry.Outcome),
+		Result:    entry.Result,
+		CreatedAt: entry.At,
+	}
+}
@@ -0,0 +1,175 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/ecs"
+	"origin/internal/eventbus"
+	"origin/internal/types"
+
+	"go.uber.org/zap/zaptest"
+)
+
+type recordingAdminAudit struct {
+	entries []AdminAuditEntry
+}
+
+func (r *recordingAdminAudit) RecordAdminAudit(entry AdminAuditEntry) {
+	r.entries = append(r.entries, entry)
+}
+
+func newPermissionTestHandler(t *testing.T, roles *PlayerRoles, audit AdminAuditRecorder) (*ChatAdminCommandHandler, *ecs.World, *mockChatDeliveryService) {
+	t.Helper()
+	eventBus := eventbus.New(&eventbus.Config{MinWorkers: 1, MaxWorkers: 2})
+	world := ecs.NewWorldWithCapacity(100, eventBus, 0)
+	ecs.InitResource(world, ecs.CharacterEntities{Map: make(map[types.EntityID]ecs.CharacterEntity)})
+	mockChat := &mockChatDeliveryService{messages: make(map[types.EntityID]string)}
+	handler := NewChatAdminCommandHandler(nil, nil, mockChat, nil, nil, nil, nil, nil, eventBus, zaptest.NewLogger(t))
+	handler.SetRoleResolver(roles)
+	handler.SetAuditRecorder(audit)
+	return handler, world, mockChat
+}
+
+func TestPlayerRoles_DefaultsToPlayer(t *testing.T) {
+	roles := NewPlayerRoles()
+	if got := roles.RoleOf(1); got != RolePlayer {
+		t.Fatalf("expected player role by default, got %s", got)
+	}
+	roles.Set(1, 1, RoleGamemaster)
+	if got := roles.RoleOf(1); got != RoleGamemaster {
+		t.Fatalf("expected gamemaster, got %s", got)
+	}
+	roles.Set(1, 1, RolePlayer)
+	if got := roles.RoleOf(1); got != RolePlayer {
+		t.Fatalf("expected player after downgrade, got %s", got)
+	}
+}
+
+func TestPlayerRoles_AccountRoleChangeAndLogout(t *testing.T) {
+	roles := NewPlayerRoles()
+	roles.Set(1, 10, RoleAdmin)
+	roles.Set(2, 10, RoleAdmin)
+	roles.Set(3, 20, RoleAdmin)
+
+	roles.SetAccountRole(10, RoleModerator)
+	if roles.RoleOf(1) != RoleModerator || roles.RoleOf(2) != RoleModerator || roles.RoleOf(3) != RoleAdmin {
+		t.Fatalf("expected only account 10 demoted, got %s %s %s", roles.RoleOf(1), roles.RoleOf(2), roles.RoleOf(3))
+	}
+	roles.SetAccountRole(10, RolePlayer)
+	if roles.RoleOf(1) != RolePlayer {
+		t.Fatalf("expected player after demotion, got %s", roles.RoleOf(1))
+	}
+
+	roles.Remove(3)
+	if got := roles.RoleOf(3); got != RolePlayer {
+		t.Fatalf("expected no cached role after logout, got %s", got)
+	}
+	roles.SetAccountRole(20, RoleAdmin)
+	if got := roles.RoleOf(3); got != RolePlayer {
+		t.Fatalf("expected role change not to resurrect a logged out character, got %s", got)
+	}
+}
+
+func TestRoleOf_WithoutResolverIsPlayer(t *testing.T) {
+	handler := NewChatAdminCommandHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, zaptest.NewLogger(t))
+	if got := handler.roleOf(1); got != RolePlayer {
+		t.Fatalf("expected player role without a resolver, got %s", got)
+	}
+}
+
+func TestParseAccountRole(t *testing.T) {
+	for role := RolePlayer; role <= RoleAdmin; role++ {
+		if got, ok := ParseAccountRole(role.String()); !ok || got != role {
+			t.Fatalf("round trip of %s: got %s, %v", role, got, ok)
+		}
+	}
+	if _, ok := ParseAccountRole("root"); ok {
+		t.Fatalf("expected unknown role to fail")
+	}
+}
+
+func TestAdminCommandRoles_ModeratorCannotGive(t *testing.T) {
+	if adminCommandRoles["/give"] <= RoleModerator {
+		t.Fatal("/give must not be available to moderators")
+	}
+	if adminCommandRoles["/online"] > RoleModerator {
+		t.Fatal("/online must be available to moderators")
+	}
+}
+
+func TestHandleCommand_DeniedCommandIsAudited(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, mockChat := newPermissionTestHandler(t, roles, audit)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleModerator)
+
+	if handled := handler.HandleCommand(world, playerID, types.InvalidHandle, "/give apple 5"); !handled {
+		t.Fatal("expected denied command to be consumed")
+	}
+	if mockChat.messages[playerID] != "permission denied: /give requires admin role" {
+		t.Fatalf("unexpected denial message: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(audit.entries))
+	}
+	entry := audit.entries[0]
+	if entry.Outcome != AdminAuditDenied || entry.Command != "/give" || entry.Args != "apple 5" ||
+		entry.ActorRole != RoleModerator || entry.ActorID != playerID || entry.At.IsZero() {
+		t.Fatalf("unexpected audit entry: %+v", entry)
+	}
+}
+
+func TestHandleCommand_AllowedAndFailedOutcomes(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, _ := newPermissionTestHandler(t, roles, audit)
+
+	playerID := types.EntityID(11)
+	roles.Set(playerID, 1, RoleGamemaster)
+
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/online")
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/stamina abc")
+
+	if len(audit.entries) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", len(audit.entries))
+	}
+	if audit.entries[0].Outcome != AdminAuditSuccess || audit.entries[0].Result != "Online players: 0" {
+		t.Fatalf("unexpected /online audit: %+v", audit.entries[0])
+	}
+	if audit.entries[1].Outcome != AdminAuditFailed || audit.entries[1].Result != "invalid stamina value: abc" {
+		t.Fatalf("unexpected /stamina audit: %+v", audit.entries[1])
+	}
+}
+
+func TestHandleCommand_UnknownSlashTextIsNotAudited(t *testing.T) {
+	audit := &recordingAdminAudit{}
+	handler, world, _ := newPermissionTestHandler(t, NewPlayerRoles(), audit)
+
+	if handled := handler.HandleCommand(world, 12, types.InvalidHandle, "/shrug"); handled {
+		t.Fatal("unknown slash text must fall through to chat")
+	}
+	if len(audit.entries) != 0 {
+		t.Fatalf("expected no audit entries, got %d", len(audit.entries))
+	}
+}
+
+func TestAdminAuditParams(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	params := adminAuditParams(AdminAuditEntry{
+		ActorID:   1,
+		ActorRole: RoleAdmin,
+		Command:   "/broadcast",
+		Args:      "hi",
+		Outcome:   AdminAuditSuccess,
+		At:        at,
+	})
+	if params.TargetID.Valid {
+		t.Fatal("zero target must be stored as NULL")
+	}
+	if params.ActorRole != int16(RoleAdmin) || params.Command != "/broadcast" || !params.CreatedAt.Equal(at) {
+		t.Fatalf("unexpected params: %+v", params)
+	}
+}
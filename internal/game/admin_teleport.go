@@ -63,6 +63,7 @@ func (g *Game) spawnTeleportedPlayer(
 
 	normalizedAttributes, _ := characterattrs.FromRaw(character.Attributes)
 	profileExperience, profileSkills, profileDiscovery := loadCharacterProfileData(character, g.logger)
+	profileSkills = g.loadLearnedSkills(ctx, character.ID, profileSkills)
 	pos := spawnPos{X: x, Y: y}
 	setupFn := g.buildPlayerSetupFunc(ctx, character, pos, normalizedAttributes, profileExperience, profileSkills, profileDiscovery)
 	ok, handle := shard.TrySpawnPlayerWithPolicy(x, y, character, setupFn, SpawnCollisionPolicy{
@@ -105,7 +106,7 @@ func (g *Game) sendTeleportSystemMessage(playerID types.EntityID, layer int, tex
 	if shard == nil {
 		return
 	}
-	shard.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, "[Server]", text)
+	shard.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, "[Server]", text, 0)
 }
 
 func (g *Game) sendTeleportSystemMessageToClient(c *network.Client, text string) {
@@ -0,0 +1,207 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"origin/internal/config"
+	"origin/internal/ecs/systems"
+	netproto "origin/internal/network/proto"
+)
+
+const (
+	announcementSenderName    = "[Server]"
+	announcementCheckInterval = time.Second
+	announcementRemainingTag  = "{remaining}"
+)
+
+// defaultCountdownSteps are the lead times used by admin-initiated countdowns (/countdown).
+var defaultCountdownSteps = []time.Duration{
+	30 * time.Minute, 15 * time.Minute, 10 * time.Minute, 5 * time.Minute,
+	time.Minute, 30 * time.Second, 10 * time.Second,
+}
+
+type scheduledAnnouncement struct {
+	at       time.Time
+	text     string
+	interval time.Duration // 0 = one-shot
+}
+
+// AnnouncementScheduler keeps pending global announcements ordered by due time.
+type AnnouncementScheduler struct {
+	mu      sync.Mutex
+	pending []scheduledAnnouncement
+}
+
+// NewAnnouncementScheduler expands configured announcements relative to now.
+// Recurring messages first fire one interval after now; one-shot messages (and countdown
+// steps) that are already in the past are skipped.
+func NewAnnouncementScheduler(cfgs []config.AnnouncementConfig, now time.Time) *AnnouncementScheduler {
+	s := &AnnouncementScheduler{}
+	for _, cfg := range cfgs {
+		if cfg.Interval > 0 {
+			s.add(scheduledAnnouncement{at: now.Add(cfg.Interval), text: cfg.Text, interval: cfg.Interval})
+			continue
+		}
+		at, err := cfg.ParseAt()
+		if err != nil {
+			continue
+		}
+		s.ScheduleOnce(at, cfg.Text, cfg.Countdown, now)
+	}
+	return s
+}
+
+// ScheduleOnce adds a one-shot announcement at `at`, or one message per countdown lead time.
+func (s *AnnouncementScheduler) ScheduleOnce(at time.Time, text string, countdown []time.Duration, now time.Time) {
+	if len(countdown) == 0 {
+		if at.After(now) {
+			s.add(scheduledAnnouncement{at: at, text: strings.ReplaceAll(text, announcementRemainingTag, "now")})
+		}
+		return
+	}
+	for _, lead := range countdown {
+		fireAt := at.Add(-lead)
+		if lead <= 0 || !fireAt.After(now) {
+			continue
+		}
+		s.add(scheduledAnnouncement{at: fireAt, text: strings.ReplaceAll(text, announcementRemainingTag, formatAnnouncementRemaining(lead))})
+	}
+}
+
+func (s *AnnouncementScheduler) add(entry scheduledAnnouncement) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, entry)
+	sort.SliceStable(s.pending, func(i, j int) bool {
+		return s.pending[i].at.Before(s.pending[j].at)
+	})
+}
+
+// Due returns texts of all announcements due at now, re-arming recurring ones.
+// A recurring message that missed several periods is sent once.
+func (s *AnnouncementScheduler) Due(now time.Time) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		texts   []string
+		rearmed []scheduledAnnouncement
+	)
+	i := 0
+	for ; i < len(s.pending) && !s.pending[i].at.After(now); i++ {
+		entry := s.pending[i]
+		texts = append(texts, entry.text)
+		if entry.interval > 0 {
+			for !entry.at.After(now) {
+				entry.at = entry.at.Add(entry.interval)
+			}
+			rearmed = append(rearmed, entry)
+		}
+	}
+	if i == 0 {
+		return nil
+	}
+	s.pending = append(s.pending[:0], s.pending[i:]...)
+	s.pending = append(s.pending, rearmed...)
+	sort.SliceStable(s.pending, func(a, b int) bool {
+		return s.pending[a].at.Before(s.pending[b].at)
+	})
+	return texts
+}
+
+// Len returns the number of pending announcements.
+func (s *AnnouncementScheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+func formatAnnouncementRemaining(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%d h", int(d/time.Hour))
+	case d >= time.Minute && d%time.Minute == 0:
+		return fmt.Sprintf("%d min", int(d/time.Minute))
+	case d >= time.Minute:
+		return fmt.Sprintf("%d min %d sec", int(d/time.Minute), int((d%time.Minute)/time.Second))
+	default:
+		return fmt.Sprintf("%d sec", int(d/time.Second))
+	}
+}
+
+// BroadcastGlobalMessage sends a CHAT_CHANNEL_GLOBAL message to every connected client.
+// Implements GlobalChatBroadcaster for admin /broadcast and /countdown.
+func (g *Game) BroadcastGlobalMessage(fromName, text string) {
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_Chat{
+			Chat: &netproto.S2C_ChatMessage{
+				Channel:  netproto.ChatChannel_CHAT_CHANNEL_GLOBAL,
+				FromName: fromName,
+				Text:     text,
+			},
+		},
+	}
+	data, err := proto.Marshal(response)
+	if err != nil {
+		g.logger.Error("Failed to marshal global chat message", zap.Error(err))
+		return
+	}
+	g.networkServer.BroadcastToAllClients(data)
+	if history := g.shardManager.ChatHistory(); history != nil {
+		history.RecordChatMessage(systems.ChatHistoryEntry{
+			Channel:    netproto.ChatChannel_CHAT_CHANNEL_GLOBAL,
+			SenderName: fromName,
+			Text:       text,
+			SentAt:     time.Now(),
+		})
+	}
+	g.logger.Info("Global message broadcast",
+		zap.String("from", fromName),
+		zap.Int("text_len", len(text)))
+}
+
+// ScheduleGlobalCountdown announces text immediately and again at the default countdown steps
+// shorter than in. "{remaining}" in text is replaced with the time left.
+func (g *Game) ScheduleGlobalCountdown(in time.Duration, text string) {
+	now := time.Now()
+	g.BroadcastGlobalMessage(announcementSenderName, strings.ReplaceAll(text, announcementRemainingTag, formatAnnouncementRemaining(in)))
+
+	steps := make([]time.Duration, 0, len(defaultCountdownSteps))
+	for _, step := range defaultCountdownSteps {
+		if step < in {
+			steps = append(steps, step)
+		}
+	}
+	if len(steps) > 0 {
+		g.announcements.ScheduleOnce(now.Add(in), text, steps, now)
+	}
+}
+
+// startAnnouncementScheduler delivers scheduled server announcements on the global channel.
+func (g *Game) startAnnouncementScheduler() {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		ticker := time.NewTicker(announcementCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case now := <-ticker.C:
+				for _, text := range g.announcements.Due(now) {
+					g.BroadcastGlobalMessage(announcementSenderName, text)
+				}
+			case <-g.ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/config"
+	"origin/internal/ecs/systems"
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+)
+
+func TestAnnouncementScheduler_Recurring(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAnnouncementScheduler([]config.AnnouncementConfig{
+		{Text: "Vote for the server!", Interval: time.Hour},
+	}, now)
+
+	if texts := s.Due(now.Add(59 * time.Minute)); len(texts) != 0 {
+		t.Fatalf("expected nothing due before interval, got %v", texts)
+	}
+	if texts := s.Due(now.Add(time.Hour)); len(texts) != 1 || texts[0] != "Vote for the server!" {
+		t.Fatalf("expected recurring message, got %v", texts)
+	}
+	// Missed several periods: sent once and re-armed in the future.
+	if texts := s.Due(now.Add(5*time.Hour + time.Minute)); len(texts) != 1 {
+		t.Fatalf("expected single catch-up message, got %v", texts)
+	}
+	if texts := s.Due(now.Add(5*time.Hour + 2*time.Minute)); len(texts) != 0 {
+		t.Fatalf("expected re-armed message not due yet, got %v", texts)
+	}
+	if s.Len() != 1 {
+		t.Fatalf("recurring announcement must stay scheduled, got %d", s.Len())
+	}
+}
+
+func TestAnnouncementScheduler_OneShotCountdown(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	restartAt := now.Add(7 * time.Minute)
+	s := NewAnnouncementScheduler([]config.AnnouncementConfig{
+		{
+			Text:      "Server restart in {remaining}",
+			At:        restartAt.Format(time.RFC3339),
+			Countdown: []time.Duration{10 * time.Minute, 5 * time.Minute, time.Minute, 30 * time.Second},
+		},
+	}, now)
+
+	// The 10 min step is already in the past at startup.
+	if s.Len() != 3 {
+		t.Fatalf("expected 3 pending countdown steps, got %d", s.Len())
+	}
+	got := make([]string, 0, 3)
+	for tick := now; !tick.After(restartAt); tick = tick.Add(time.Second) {
+		got = append(got, s.Due(tick)...)
+	}
+	want := []string{"Server restart in 5 min", "Server restart in 1 min", "Server restart in 30 sec"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("step %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+	if s.Len() != 0 {
+		t.Fatalf("one-shot announcements must be removed after delivery, got %d", s.Len())
+	}
+}
+
+func TestAnnouncementScheduler_OneShotWithoutCountdown(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAnnouncementScheduler([]config.AnnouncementConfig{
+		{Text: "Past event", At: now.Add(-time.Minute).Format(time.RFC3339)},
+		{Text: "Maintenance starts", At: now.Add(time.Minute).Format(time.RFC3339)},
+	}, now)
+
+	if s.Len() != 1 {
+		t.Fatalf("expected past one-shot to be skipped, got %d pending", s.Len())
+	}
+	if texts := s.Due(now.Add(time.Minute)); len(texts) != 1 || texts[0] != "Maintenance starts" {
+		t.Fatalf("unexpected texts: %v", texts)
+	}
+}
+
+func TestFormatAnnouncementRemaining(t *testing.T) {
+	cases := map[time.Duration]string{
+		2 * time.Hour:              "2 h",
+		15 * time.Minute:           "15 min",
+		90 * time.Second:           "1 min 30 sec",
+		10 * time.Second:           "10 sec",
+		time.Hour + 30*time.Minute: "90 min",
+	}
+	for d, want := range cases {
+		if got := formatAnnouncementRemaining(d); got != want {
+			t.Fatalf("formatAnnouncementRemaining(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestBroadcastGlobalMessage_RecordsHistory(t *testing.T) {
+	history := &ChatHistoryWriter{logger: zap.NewNop(), queue: &backgroundWriter[systems.ChatHistoryEntry]{entries: make(chan systems.ChatHistoryEntry, 1)}}
+	g := &Game{
+		logger:        zap.NewNop(),
+		networkServer: network.NewServer(&config.NetworkConfig{}, &config.GameConfig{}, zap.NewNop()),
+		shardManager:  &ShardManager{chatHistory: history},
+	}
+
+	g.BroadcastGlobalMessage(announcementSenderName, "Restart in 5 min")
+
+	select {
+	case entry := <-history.queue.entries:
+		if entry.Channel != netproto.ChatChannel_CHAT_CHANNEL_GLOBAL || entry.SenderID != 0 ||
+			entry.SenderName != announcementSenderName || entry.Text != "Restart in 5 min" {
+			t.Fatalf("unexpected history entry: %+v", entry)
+		}
+	default:
+		t.Fatalf("expected the announcement to be recorded in chat history")
+	}
+}
@@ -0,0 +1,113 @@
+package game
+
+import (
+	"origin/internal/characterattrs"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/entitystats"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type attributeTrainingSender interface {
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendCharacterProfileSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle)
+	SendPlayerStatsSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle)
+}
+
+// AttributeTrainingService raises character attributes for LP.
+// The price of each point comes from characterattrs.TrainCost.
+type AttributeTrainingService struct {
+	sender   attributeTrainingSender
+	recorder CharacterAttributesRecorder
+	logger   *zap.Logger
+}
+
+func NewAttributeTrainingService(sender attributeTrainingSender, logger *zap.Logger) *AttributeTrainingService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &AttributeTrainingService{
+		sender: sender,
+		logger: logger,
+	}
+}
+
+var _ systems.AttributeCommandService = (*AttributeTrainingService)(nil)
+
+func (s *AttributeTrainingService) SetRecorder(recorder CharacterAttributesRecorder) {
+	s.recorder = recorder
+}
+
+func (s *AttributeTrainingService) HandleTrainAttribute(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_TrainAttribute) {
+	if s == nil || msg == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	name, ok := characterAttributeNameFromProtoKey(msg.Key)
+	if !ok {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, "ATTRIBUTE_NOT_FOUND")
+		return
+	}
+
+	reasonCode := ""
+	var cost int64
+	var trained characterattrs.Values
+	var exp components.CharacterExperience
+	ecs.MutateComponent[components.CharacterProfile](w, playerHandle, func(profile *components.CharacterProfile) bool {
+		values := characterattrs.Normalize(profile.Attributes)
+		cost = characterattrs.TrainCost(values[name])
+		if profile.Experience.LP < cost {
+			reasonCode = "ATTRIBUTE_NOT_ENOUGH_LP"
+			return false
+		}
+		values[name]++
+		profile.Experience.LP -= cost
+		profile.Attributes = values
+		trained = values
+		exp = profile.Experience
+		return true
+	})
+	if reasonCode != "" {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, reasonCode)
+		return
+	}
+	if trained == nil {
+		return
+	}
+
+	if s.recorder != nil {
+		s.recorder.RecordCharacterAttributes(playerID, trained, exp)
+	}
+	// A higher CON raises max stamina: regen must resume for a player who sat at the old maximum.
+	if stats, hasStats := ecs.GetComponent[components.EntityStats](w, playerHandle); hasStats {
+		maxStamina := entitystats.MaxStaminaFromCon(characterattrs.Get(trained, characterattrs.CON))
+		ecs.UpdateEntityStatsRegenSchedule(w, playerHandle, stats.Stamina, stats.Energy, maxStamina)
+	}
+
+	s.logger.Debug("Attribute trained",
+		zap.Int64("character_id", int64(playerID)),
+		zap.String("attribute", string(name)),
+		zap.Int("value", trained[name]),
+		zap.Int64("lp_cost", cost))
+	if s.sender == nil {
+		return
+	}
+	s.sender.SendCharacterProfileSnapshot(w, playerID, playerHandle)
+	// Max stamina and max HHP are derived from the attributes when the stats snapshot is built,
+	// so a forced snapshot publishes the new maxima right away.
+	s.sender.SendPlayerStatsSnapshot(w, playerID, playerHandle)
+}
+
+func (s *AttributeTrainingService) sendMiniAlert(entityID types.EntityID, severity netproto.AlertSeverity, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(entityID, &netproto.S2C_MiniAlert{
+		Severity:   severity,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(severity),
+	})
+}
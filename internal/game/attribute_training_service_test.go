@@ -0,0 +1,124 @@
+package game
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/entitystats"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+)
+
+type testAttributeTrainingSender struct {
+	alerts   []*netproto.S2C_MiniAlert
+	profiles int
+	stats    int
+}
+
+func (s *testAttributeTrainingSender) SendMiniAlert(_ types.EntityID, alert *netproto.S2C_MiniAlert) {
+	s.alerts = append(s.alerts, alert)
+}
+
+func (s *testAttributeTrainingSender) SendCharacterProfileSnapshot(_ *ecs.World, _ types.EntityID, _ types.Handle) {
+	s.profiles++
+}
+
+func (s *testAttributeTrainingSender) SendPlayerStatsSnapshot(_ *ecs.World, _ types.EntityID, _ types.Handle) {
+	s.stats++
+}
+
+type testAttributesRecorder struct {
+	characterIDs []types.EntityID
+	values       []characterattrs.Values
+	exps         []components.CharacterExperience
+}
+
+func (r *testAttributesRecorder) RecordCharacterAttributes(characterID types.EntityID, values characterattrs.Values, exp components.CharacterExperience) {
+	r.characterIDs = append(r.characterIDs, characterID)
+	r.values = append(r.values, values)
+	r.exps = append(r.exps, exp)
+}
+
+func TestAttributeTrainingService_TrainSpendsLPAndPersists(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(5101)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.CharacterProfile{
+			Attributes: characterattrs.Values{characterattrs.CON: 2},
+			Experience: components.CharacterExperience{LP: 500},
+		})
+		// Full stamina at CON 2: regen is idle until the maximum grows.
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: entitystats.MaxStaminaFromCon(2), Energy: 100})
+	})
+	sender := &testAttributeTrainingSender{}
+	recorder := &testAttributesRecorder{}
+	service := NewAttributeTrainingService(sender, nil)
+	service.SetRecorder(recorder)
+
+	service.HandleTrainAttribute(world, playerID, playerHandle, &netproto.C2S_TrainAttribute{
+		Key: netproto.CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_CON,
+	})
+
+	profile, _ := ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if got := characterattrs.Get(profile.Attributes, characterattrs.CON); got != 3 {
+		t.Fatalf("expected CON=3, got %d", got)
+	}
+	if profile.Experience.LP != 100 {
+		t.Fatalf("expected 400 LP spent, got lp=%d", profile.Experience.LP)
+	}
+	if len(profile.Attributes) != len(characterattrs.RequiredNames()) {
+		t.Fatalf("expected normalized attributes, got %v", profile.Attributes)
+	}
+	if sender.profiles != 1 || sender.stats != 1 || len(sender.alerts) != 0 {
+		t.Fatalf("expected profile and stats snapshots, got %+v", sender)
+	}
+	if len(recorder.characterIDs) != 1 || recorder.characterIDs[0] != playerID || recorder.values[0][characterattrs.CON] != 3 {
+		t.Fatalf("expected trained attributes to be persisted, got %+v", recorder)
+	}
+	if recorder.exps[0].LP != 100 {
+		t.Fatalf("expected spent LP to be persisted with the attributes, got lp=%d", recorder.exps[0].LP)
+	}
+	if pending := ecs.GetResource[ecs.EntityStatsUpdateState](world).PendingRegenCount(); pending != 1 {
+		t.Fatalf("expected stamina regen to resume after CON grew, pending=%d", pending)
+	}
+
+	service.HandleTrainAttribute(world, playerID, playerHandle, &netproto.C2S_TrainAttribute{
+		Key: netproto.CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_CON,
+	})
+	if len(sender.alerts) != 1 || sender.alerts[0].ReasonCode != "ATTRIBUTE_NOT_ENOUGH_LP" {
+		t.Fatalf("expected ATTRIBUTE_NOT_ENOUGH_LP, got %+v", sender.alerts)
+	}
+	profile, _ = ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if profile.Experience.LP != 100 || characterattrs.Get(profile.Attributes, characterattrs.CON) != 3 {
+		t.Fatalf("rejected training must not change the profile, got %+v", profile)
+	}
+	if len(recorder.characterIDs) != 1 || sender.stats != 1 {
+		t.Fatalf("rejected training must not persist or re-send stats")
+	}
+}
+
+func TestAttributeTrainingService_RejectsUnknownAttribute(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(5102)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.CharacterProfile{
+			Attributes: characterattrs.Default(),
+			Experience: components.CharacterExperience{LP: 1000},
+		})
+	})
+	sender := &testAttributeTrainingSender{}
+
+	NewAttributeTrainingService(sender, nil).HandleTrainAttribute(world, playerID, playerHandle, &netproto.C2S_TrainAttribute{
+		Key: netproto.CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_UNSPECIFIED,
+	})
+
+	if len(sender.alerts) != 1 || sender.alerts[0].ReasonCode != "ATTRIBUTE_NOT_FOUND" {
+		t.Fatalf("expected ATTRIBUTE_NOT_FOUND, got %+v", sender.alerts)
+	}
+	profile, _ := ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if profile.Experience.LP != 1000 {
+		t.Fatalf("rejected training must not spend LP, got %d", profile.Experience.LP)
+	}
+}
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// backgroundWriter hands queued entries to flush on its own goroutine.
+// Entries are flushed in order, either one by one (batchSize 1) or in batches of up to
+// batchSize, with a partial batch flushed every flushInterval.
+// enqueue never blocks: a full queue rejects the entry and the owner decides how to report it.
+// stop drains the queue with a final flush before returning.
+type backgroundWriter[T any] struct {
+	entries       chan T
+	batchSize     int
+	flushInterval time.Duration
+	flush         func(batch []T)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newBackgroundWriter[T any](queueSize, batchSize int, flushInterval time.Duration, flush func(batch []T)) *backgroundWriter[T] {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &backgroundWriter[T]{
+		entries:       make(chan T, queueSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		flush:         flush,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+	w.wg.Add(1)
+	go w.run()
+	return w
+}
+
+func (w *backgroundWriter[T]) enqueue(entry T) bool {
+	select {
+	case w.entries <- entry:
+		return true
+	default:
+		return false
+	}
+}
+
+func (w *backgroundWriter[T]) stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *backgroundWriter[T]) run() {
+	defer w.wg.Done()
+
+	batch := make([]T, 0, w.batchSize)
+	var tick <-chan time.Time
+	if w.flushInterval > 0 {
+		ticker := time.NewTicker(w.flushInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-w.ctx.Done():
+		drain:
+			for {
+				select {
+				case entry := <-w.entries:
+					batch = append(batch, entry)
+					if len(batch) >= w.batchSize {
+						w.flush(batch)
+						batch = batch[:0]
+					}
+				default:
+					break drain
+				}
+			}
+			if len(batch) > 0 {
+				w.flush(batch)
+			}
+			return
+
+		case entry := <-w.entries:
+			batch = append(batch, entry)
+			if len(batch) >= w.batchSize {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+
+		case <-tick:
+			if len(batch) > 0 {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package behaviors
+
+import (
+	"fmt"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/types"
+)
+
+const (
+	animalBehaviorKey = "animal"
+
+	// animalThinkSpreadTicks spreads the first think of animals loaded in one chunk over several ticks.
+	animalThinkSpreadTicks = 10
+)
+
+// animalBehavior marks a mobile object as a wild animal driven by AnimalAISystem.
+// The def must also have components.movement. Per-species config tunes wander, flee and chase.
+type animalBehavior struct{}
+
+func (animalBehavior) Key() string { return animalBehaviorKey }
+
+func (animalBehavior) DefConfigPrototype() any { return contracts.AnimalBehaviorConfig{} }
+
+func (animalBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
+	if ctx == nil {
+		return 0, fmt.Errorf("animal def config context is nil")
+	}
+
+	var cfg contracts.AnimalBehaviorConfig
+	if err := decodeStrictJSON(ctx.RawConfig, &cfg); err != nil {
+		return 0, fmt.Errorf("invalid animal config: %w", err)
+	}
+	if cfg.Priority <= 0 {
+		cfg.Priority = defaultBehaviorPriority
+	}
+	if cfg.AlertRadius <= 0 {
+		return 0, fmt.Errorf("animal.alertRadius must be > 0")
+	}
+	if cfg.CalmRadius == 0 {
+		cfg.CalmRadius = cfg.AlertRadius * 2
+	}
+	if cfg.CalmRadius < cfg.AlertRadius {
+		return 0, fmt.Errorf("animal.calmRadius must be >= alertRadius")
+	}
+	if cfg.WanderRadius <= 0 {
+		return 0, fmt.Errorf("animal.wanderRadius must be > 0")
+	}
+	if cfg.WanderPauseTicks == 0 {
+		return 0, fmt.Errorf("animal.wanderPauseTicks must be > 0")
+	}
+
+	if ctx.Def == nil {
+		return 0, fmt.Errorf("animal config target def is nil")
+	}
+	ctx.Def.SetAnimalBehaviorConfig(cfg)
+	return cfg.Priority, nil
+}
+
+// InitObject attaches the AI state and schedules the first think.
+// It runs on spawn and on chunk activation, so only animals of active chunks ever think.
+func (animalBehavior) InitObject(ctx *contracts.BehaviorObjectInitContext) error {
+	if ctx == nil || ctx.World == nil {
+		return nil
+	}
+	if ctx.Handle == types.InvalidHandle || !ctx.World.Alive(ctx.Handle) {
+		return nil
+	}
+	transform, hasTransform := ecs.GetComponent[components.Transform](ctx.World, ctx.Handle)
+	if !hasTransform {
+		return nil
+	}
+	ecs.AddComponent(ctx.World, ctx.Handle, components.AnimalAI{
+		Mode:  components.AnimalAIWander,
+		HomeX: transform.X,
+		HomeY: transform.Y,
+	})
+
+	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
+	ecs.ScheduleAnimalThink(ctx.World, ctx.EntityID, nowTick+1+uint64(ctx.EntityID)%animalThinkSpreadTicks)
+	return nil
+}
@@ -0,0 +1,81 @@
+package behaviors
+
+import (
+	"strings"
+	"testing"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+func TestAnimalValidateAndApplyDefConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "valid", raw: `{"aggressive":true,"alertRadius":90,"wanderRadius":120,"wanderPauseTicks":80}`},
+		{name: "missing alert radius", raw: `{"wanderRadius":120,"wanderPauseTicks":80}`, wantErr: "alertRadius must be > 0"},
+		{name: "calm inside alert", raw: `{"alertRadius":90,"calmRadius":60,"wanderRadius":120,"wanderPauseTicks":80}`, wantErr: "calmRadius must be >= alertRadius"},
+		{name: "missing wander radius", raw: `{"alertRadius":90,"wanderPauseTicks":80}`, wantErr: "wanderRadius must be > 0"},
+		{name: "missing wander pause", raw: `{"alertRadius":90,"wanderRadius":120}`, wantErr: "wanderPauseTicks must be > 0"},
+		{name: "unknown field", raw: `{"alertRadius":90,"wanderRadius":120,"wanderPauseTicks":80,"speed":3}`, wantErr: "invalid animal config"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			def := &objectdefs.ObjectDef{}
+			_, err := animalBehavior{}.ValidateAndApplyDefConfig(&contracts.BehaviorDefConfigContext{
+				BehaviorKey: animalBehaviorKey,
+				RawConfig:   []byte(testCase.raw),
+				Def:         def,
+			})
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if def.AnimalConfig == nil || !def.AnimalConfig.Aggressive || def.AnimalConfig.CalmRadius != 180 {
+					t.Fatalf("animal config not applied with default calm radius: %+v", def.AnimalConfig)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAnimalInitObject_AddsBrainAndSchedulesThink(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	ecs.GetResource[ecs.TimeState](world).Tick = 100
+	entityID := types.EntityID(7503)
+	handle := world.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: 240, Y: 360})
+	})
+
+	err := animalBehavior{}.InitObject(&contracts.BehaviorObjectInitContext{
+		World:    world,
+		Handle:   handle,
+		EntityID: entityID,
+		Reason:   contracts.ObjectBehaviorInitReasonRestore,
+	})
+	if err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+
+	ai, hasAI := ecs.GetComponent[components.AnimalAI](world, handle)
+	if !hasAI || ai.Mode != components.AnimalAIWander || ai.HomeX != 240 || ai.HomeY != 360 {
+		t.Fatalf("expected wandering brain at the load point, got %+v", ai)
+	}
+	schedule := ecs.GetResource[ecs.AnimalThinkSchedule](world)
+	if due := schedule.PopDue(103, 0, nil); len(due) != 0 {
+		t.Fatalf("expected first think spread by entity id, got due %v", due)
+	}
+	if due := schedule.PopDue(104, 0, nil); len(due) != 1 || due[0] != entityID {
+		t.Fatalf("expected first think at tick 104, got %v", due)
+	}
+}
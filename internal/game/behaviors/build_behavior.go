@@ -20,6 +20,8 @@ type buildBehavior struct{}
 
 func (buildBehavior) Key() string { return "build" }
 
+func (buildBehavior) DefConfigPrototype() any { return priorityOnlyDefConfig{} }
+
 func (buildBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("build def config context is nil")
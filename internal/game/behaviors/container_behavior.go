@@ -18,6 +18,8 @@ type containerBehavior struct{}
 
 func (containerBehavior) Key() string { return "container" }
 
+func (containerBehavior) DefConfigPrototype() any { return priorityOnlyDefConfig{} }
+
 func (containerBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("container def config context is nil")
@@ -60,6 +62,10 @@ func (containerBehavior) ApplyRuntime(ctx *contracts.BehaviorRuntimeContext) con
 	}
 }
 
+func (containerBehavior) AppearanceFlags(*contracts.BehaviorDefConfigContext) []string {
+	return []string{"container.has_items", "container.open"}
+}
+
 func (containerBehavior) ProvideActions(ctx *contracts.BehaviorActionListContext) []contracts.ContextAction {
 	if ctx == nil || ctx.World == nil {
 		return nil
@@ -5,6 +5,7 @@ import (
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
 	"origin/internal/eventbus"
+	"origin/internal/itemdefs"
 	netproto "origin/internal/network/proto"
 	"origin/internal/types"
 
@@ -43,6 +44,8 @@ type TakeConfig struct {
 	Name       string `json:"name"`
 	ItemDefKey string `json:"itemDefKey"`
 	Count      int    `json:"count"`
+	// ToolTag, if set, requires an equipped item with this tag; each take cycle wears it.
+	ToolTag string `json:"toolTag,omitempty"`
 }
 
 type TakeBehaviorConfig struct {
@@ -50,10 +53,57 @@ type TakeBehaviorConfig struct {
 	Items    []TakeConfig `json:"items"`
 }
 
+// CropBehaviorConfig contains crop-specific validated behavior config data.
+type CropBehaviorConfig struct {
+	Priority int `json:"priority,omitempty"`
+	// SeedItemKey is the item planted to grow this crop.
+	SeedItemKey string            `json:"seedItemKey"`
+	Stages      []CropStageConfig `json:"stages"`
+}
+
+type CropStageConfig struct {
+	StageDuration int          `json:"stageDurationTicks"`
+	Take          []TakeConfig `json:"take,omitempty"`
+	// Harvest lists items given by the harvest action, which removes the crop.
+	Harvest []string `json:"harvest,omitempty"`
+}
+
+// ProcessorBehaviorConfig contains processing-machine validated behavior config data.
+type ProcessorBehaviorConfig struct {
+	Priority int `json:"priority,omitempty"`
+	// Machine selects recipes from process defs (recipe.machine).
+	Machine string                `json:"machine"`
+	Fuel    []ProcessorFuelConfig `json:"fuel"`
+}
+
+type ProcessorFuelConfig struct {
+	ItemKey string `json:"itemKey"`
+	// Ticks is how long one fuel item keeps the machine lit.
+	Ticks uint64 `json:"ticks"`
+}
+
+// AnimalBehaviorConfig contains per-species wild animal AI config. Distances are world units.
+type AnimalBehaviorConfig struct {
+	Priority int `json:"priority,omitempty"`
+	// Aggressive animals chase a noticed player; the others flee from it.
+	Aggressive bool `json:"aggressive,omitempty"`
+	// AlertRadius is how close a player must come to be noticed.
+	AlertRadius float64 `json:"alertRadius"`
+	// CalmRadius is how far the player must get before the animal calms down. Default 2*alertRadius.
+	CalmRadius float64 `json:"calmRadius,omitempty"`
+	// WanderRadius bounds idle wandering around the point where the animal was spawned or loaded.
+	WanderRadius float64 `json:"wanderRadius"`
+	// WanderPauseTicks is the minimal idle pause between two wander walks.
+	WanderPauseTicks uint64 `json:"wanderPauseTicks"`
+}
+
 // BehaviorDefConfigTarget receives validated behavior config mutations.
 type BehaviorDefConfigTarget interface {
 	SetTreeBehaviorConfig(cfg TreeBehaviorConfig)
 	SetTakeBehaviorConfig(cfg TakeBehaviorConfig)
+	SetCropBehaviorConfig(cfg CropBehaviorConfig)
+	SetProcessorBehaviorConfig(cfg ProcessorBehaviorConfig)
+	SetAnimalBehaviorConfig(cfg AnimalBehaviorConfig)
 }
 
 // BehaviorDefConfigContext is object-definition behavior config input.
@@ -61,6 +111,17 @@ type BehaviorDefConfigContext struct {
 	BehaviorKey string
 	RawConfig   []byte
 	Def         BehaviorDefConfigTarget
+	// Items resolves item keys referenced by config. Nil means itemdefs.Global();
+	// hot reload sets it to the candidate registry that is not global yet.
+	Items *itemdefs.Registry
+}
+
+// ItemRegistry returns the item registry config references are validated against.
+func (c *BehaviorDefConfigContext) ItemRegistry() *itemdefs.Registry {
+	if c.Items != nil {
+		return c.Items
+	}
+	return itemdefs.Global()
 }
 
 // BehaviorDefConfigValidator validates behavior config in object definitions,
@@ -69,6 +130,18 @@ type BehaviorDefConfigValidator interface {
 	ValidateAndApplyDefConfig(ctx *BehaviorDefConfigContext) (priority int, err error)
 }
 
+// BehaviorDefConfigPrototype exposes the config type of behavior in object definitions.
+// Tooling derives the JSON Schema of `behaviors.<key>` from the returned zero value.
+type BehaviorDefConfigPrototype interface {
+	DefConfigPrototype() any
+}
+
+// BehaviorAppearanceFlagProvider lists runtime flags behavior can set on objects of one definition.
+// Tooling uses it to check that appearance conditions can ever match.
+type BehaviorAppearanceFlagProvider interface {
+	AppearanceFlags(ctx *BehaviorDefConfigContext) []string
+}
+
 // BehaviorActionSpec declares one action supported by behavior.
 // It is used for startup validation (fail fast).
 type BehaviorActionSpec struct {
@@ -152,6 +225,23 @@ type GiveItemFn func(
 	quality uint32,
 ) GiveItemOutcome
 
+// ToolWearOutcome reports the equipped tool after one cycle of work.
+type ToolWearOutcome struct {
+	// Found is false when no item with the tag is equipped.
+	Found bool
+	// Remaining and Max are 0 for tools that never wear out.
+	Remaining uint32
+	Max       uint32
+	Broken    bool
+}
+
+type WearToolFn func(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	tag string,
+) ToolWearOutcome
+
 type LiftObjectFn func(
 	w *ecs.World,
 	playerID types.EntityID,
@@ -160,10 +250,15 @@ type LiftObjectFn func(
 	targetHandle types.Handle,
 ) BehaviorResult
 
+// InventorySyncFn sends changed object inventory containers to players who have them open.
+type InventorySyncFn func(w *ecs.World, containerHandles []types.Handle)
+
 // ExecutionDeps contains shared dependencies for context action execution.
 type ExecutionDeps struct {
 	OpenContainer    OpenContainerFn
 	GiveItem         GiveItemFn
+	GiveItemOrDrop   GiveItemFn // like GiveItem, but drops what does not fit next to the player
+	WearTool         WearToolFn
 	LiftObject       LiftObjectFn
 	EventBus         *eventbus.EventBus
 	Chunks           TreeChunkProvider
@@ -172,6 +267,7 @@ type ExecutionDeps struct {
 	Alerts           MiniAlertSender
 	BuildState       BuildStateSender
 	BehaviorRegistry BehaviorRegistry
+	SyncInventories  InventorySyncFn
 	Logger           *zap.Logger
 }
 
@@ -225,6 +321,8 @@ type BehaviorTickContext struct {
 	BehaviorKey  string
 	CurrentTick  uint64
 	CurrentState *components.RuntimeObjectState
+	// Deps is nil when the tick system runs without services (tests, tools).
+	Deps *ExecutionDeps
 }
 
 // BehaviorTickResult is a scheduled behavior tick result.
@@ -0,0 +1,625 @@
+package behaviors
+
+import (
+	"fmt"
+	"strings"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/objectdefs"
+	"origin/internal/timeutil"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	actionHarvest       = "harvest"
+	cropBehaviorKey     = "crop"
+	cropStageFlagPrefix = "crop.stage"
+
+	cropHarvestCycleDurationTicks = 20
+	cropHarvestStaminaCost        = 15.0
+)
+
+// cropBehavior grows a planted crop through data-driven stages on the behavior tick scheduler.
+// Crop quality is the planted seed quality; takes and harvest inherit it.
+type cropBehavior struct{}
+
+func (cropBehavior) Key() string { return cropBehaviorKey }
+
+func (cropBehavior) DefConfigPrototype() any { return contracts.CropBehaviorConfig{} }
+
+func (cropBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
+	if ctx == nil {
+		return 0, fmt.Errorf("crop def config context is nil")
+	}
+
+	var cfg contracts.CropBehaviorConfig
+	if err := decodeStrictJSON(ctx.RawConfig, &cfg); err != nil {
+		return 0, fmt.Errorf("invalid crop config: %w", err)
+	}
+	if cfg.Priority <= 0 {
+		cfg.Priority = defaultBehaviorPriority
+	}
+	itemRegistry := ctx.ItemRegistry()
+	seedKey := strings.TrimSpace(cfg.SeedItemKey)
+	if seedKey == "" {
+		return 0, fmt.Errorf("crop.seedItemKey must not be empty")
+	}
+	if itemRegistry == nil {
+		return 0, fmt.Errorf("crop.seedItemKey validation requires loaded item defs")
+	}
+	if _, ok := itemRegistry.GetByKey(seedKey); !ok {
+		return 0, fmt.Errorf("crop.seedItemKey unknown item key %q", seedKey)
+	}
+	cfg.SeedItemKey = seedKey
+	if len(cfg.Stages) == 0 {
+		return 0, fmt.Errorf("crop.stages is required")
+	}
+	for idx, stage := range cfg.Stages {
+		if idx < len(cfg.Stages)-1 && stage.StageDuration <= 0 {
+			return 0, fmt.Errorf("crop.stages[%d].stageDurationTicks must be > 0 for non-final stage", idx)
+		}
+		for itemIdx, itemKey := range stage.Harvest {
+			itemKey = strings.TrimSpace(itemKey)
+			if itemKey == "" {
+				return 0, fmt.Errorf("crop.stages[%d].harvest[%d] must not be empty", idx, itemIdx)
+			}
+			if _, ok := itemRegistry.GetByKey(itemKey); !ok {
+				return 0, fmt.Errorf("crop.stages[%d].harvest[%d] unknown item key %q", idx, itemIdx, itemKey)
+			}
+		}
+		seenTakeIDs := make(map[string]int, len(stage.Take))
+		for takeIdx, takeCfg := range stage.Take {
+			if err := validateTakeConfig(cropBehaviorKey, idx, takeIdx, takeCfg, itemRegistry); err != nil {
+				return 0, err
+			}
+			takeID := strings.TrimSpace(takeCfg.ID)
+			if takeID == actionHarvest {
+				return 0, fmt.Errorf("crop.stages[%d].take[%d].id %q is reserved", idx, takeIdx, takeID)
+			}
+			if firstIndex, exists := seenTakeIDs[takeID]; exists {
+				return 0, fmt.Errorf("crop.stages[%d].take[%d].id duplicate %q (first at index %d)", idx, takeIdx, takeID, firstIndex)
+			}
+			seenTakeIDs[takeID] = takeIdx
+		}
+	}
+
+	if ctx.Def == nil {
+		return 0, fmt.Errorf("crop config target def is nil")
+	}
+	ctx.Def.SetCropBehaviorConfig(cfg)
+	return cfg.Priority, nil
+}
+
+func (cropBehavior) InitObject(ctx *contracts.BehaviorObjectInitContext) error {
+	if ctx == nil || ctx.World == nil {
+		return nil
+	}
+	if ctx.Handle == types.InvalidHandle || !ctx.World.Alive(ctx.Handle) {
+		return nil
+	}
+
+	def, found := objectdefs.Global().GetByID(int(ctx.EntityType))
+	if !found || def.CropConfig == nil {
+		return nil
+	}
+	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
+
+	switch ctx.Reason {
+	case contracts.ObjectBehaviorInitReasonSpawn:
+		initializeSpawnCropState(ctx.World, ctx.Handle, ctx.EntityID, def.CropConfig, nowTick)
+	case contracts.ObjectBehaviorInitReasonRestore:
+		initializeRestoredCropState(ctx.World, ctx.Handle, ctx.EntityID, def.CropConfig, nowTick)
+	}
+	return nil
+}
+
+func (cropBehavior) ApplyRuntime(ctx *contracts.BehaviorRuntimeContext) contracts.BehaviorRuntimeResult {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorRuntimeResult{}
+	}
+
+	def, found := objectdefs.Global().GetByID(int(ctx.EntityType))
+	if !found || def.CropConfig == nil {
+		return contracts.BehaviorRuntimeResult{}
+	}
+	stage := cropStageFromRuntimeState(ctx.PrevState, def.CropConfig)
+	return contracts.BehaviorRuntimeResult{
+		Flags: []string{cropStageFlag(stage)},
+	}
+}
+
+// AppearanceFlags returns one stage flag per configured stage.
+func (cropBehavior) AppearanceFlags(ctx *contracts.BehaviorDefConfigContext) []string {
+	if ctx == nil {
+		return nil
+	}
+	var cfg contracts.CropBehaviorConfig
+	if err := decodeStrictJSON(ctx.RawConfig, &cfg); err != nil {
+		return nil
+	}
+	flags := make([]string, 0, len(cfg.Stages))
+	for stage := 1; stage <= len(cfg.Stages); stage++ {
+		flags = append(flags, cropStageFlag(stage))
+	}
+	return flags
+}
+
+func (cropBehavior) OnScheduledTick(ctx *contracts.BehaviorTickContext) (contracts.BehaviorTickResult, error) {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorTickResult{}, nil
+	}
+	if ctx.Handle == types.InvalidHandle || !ctx.World.Alive(ctx.Handle) {
+		return contracts.BehaviorTickResult{}, nil
+	}
+
+	def, found := objectdefs.Global().GetByID(int(ctx.EntityType))
+	if !found || def.CropConfig == nil {
+		return contracts.BehaviorTickResult{}, nil
+	}
+	cropConfig := def.CropConfig
+	stageChanged := false
+
+	ecs.WithComponent(ctx.World, ctx.Handle, func(state *components.ObjectInternalState) {
+		cropState, hasCropState := components.GetBehaviorState[components.CropBehaviorState](*state, cropBehaviorKey)
+		if !hasCropState || cropState == nil {
+			ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey)
+			return
+		}
+
+		currentStage := normalizeCropStage(cropState.Stage, cropConfig)
+		currentNextTick := cropState.NextGrowthTick
+		if currentNextTick == 0 || currentStage >= cropStageMax(cropConfig) {
+			ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey)
+			return
+		}
+		if ctx.CurrentTick < currentNextTick {
+			ecs.ScheduleBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey, currentNextTick)
+			return
+		}
+		if ecs.GetResource[ecs.TimeState](ctx.World).WorldTime.Season == timeutil.SeasonWinter {
+			// Crops rest in winter: a due stage waits one more stage duration.
+			deferredTick := ctx.CurrentTick + cropStageTransitionDuration(cropConfig, currentStage)
+			setCropBehaviorState(state, cloneTakenCounts(cropState.Taken), currentStage, deferredTick)
+			ecs.ScheduleBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey, deferredTick)
+			return
+		}
+
+		nextStage, nextTick, _ := applyCropGrowthCatchup(cropConfig, currentStage, currentNextTick, ctx.CurrentTick, 0)
+		stageChanged = nextStage != currentStage
+		taken := cloneTakenCounts(cropState.Taken)
+		if stageChanged {
+			taken = nil
+		}
+		setCropBehaviorState(state, taken, nextStage, nextTick)
+		if nextTick == 0 {
+			ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey)
+			return
+		}
+		ecs.ScheduleBehaviorTick(ctx.World, ctx.EntityID, cropBehaviorKey, nextTick)
+	})
+
+	return contracts.BehaviorTickResult{
+		StateChanged: stageChanged,
+	}, nil
+}
+
+func (cropBehavior) ProvideActions(ctx *contracts.BehaviorActionListContext) []contracts.ContextAction {
+	if ctx == nil || ctx.World == nil {
+		return nil
+	}
+	cropConfig, stageCfg, taken := resolveCropTargetStage(ctx.World, ctx.TargetHandle)
+	if cropConfig == nil || stageCfg == nil {
+		return nil
+	}
+	actions := make([]contracts.ContextAction, 0, 1+len(stageCfg.Take))
+	if len(stageCfg.Harvest) > 0 {
+		actions = append(actions, contracts.ContextAction{
+			ActionID: actionHarvest,
+			Title:    "Harvest",
+		})
+	}
+	for _, takeCfg := range stageCfg.Take {
+		takeID := strings.TrimSpace(takeCfg.ID)
+		if takeID == "" || takenCountForAction(taken, takeID) >= takeCfg.Count {
+			continue
+		}
+		if !takeToolEquipped(ctx.World, ctx.PlayerID, &takeCfg) {
+			continue
+		}
+		actions = append(actions, contracts.ContextAction{
+			ActionID: takeID,
+			Title:    strings.TrimSpace(takeCfg.Name),
+		})
+	}
+	return actions
+}
+
+func (cropBehavior) ValidateAction(ctx *contracts.BehaviorActionValidateContext) contracts.BehaviorResult {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorResult{OK: false}
+	}
+	if ctx.PlayerHandle == types.InvalidHandle || !ctx.World.Alive(ctx.PlayerHandle) {
+		return contracts.BehaviorResult{OK: false}
+	}
+	if !isCropActionAvailable(ctx.World, ctx.TargetHandle, strings.TrimSpace(ctx.ActionID)) {
+		return contracts.BehaviorResult{OK: false}
+	}
+	if ctx.Phase == contracts.BehaviorValidationPhaseExecute {
+		if _, exists := ecs.GetComponent[components.ActiveCyclicAction](ctx.World, ctx.PlayerHandle); exists {
+			return contracts.BehaviorResult{
+				OK:          false,
+				UserVisible: true,
+				ReasonCode:  "action_already_active",
+				Severity:    contracts.BehaviorAlertSeverityWarning,
+			}
+		}
+	}
+	return contracts.BehaviorResult{OK: true}
+}
+
+func (cropBehavior) ExecuteAction(ctx *contracts.BehaviorActionExecuteContext) contracts.BehaviorResult {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorResult{OK: false}
+	}
+	if ctx.PlayerHandle == types.InvalidHandle || !ctx.World.Alive(ctx.PlayerHandle) {
+		return contracts.BehaviorResult{OK: false}
+	}
+	actionID := strings.TrimSpace(ctx.ActionID)
+	if !isCropActionAvailable(ctx.World, ctx.TargetHandle, actionID) {
+		return contracts.BehaviorResult{OK: false}
+	}
+
+	cycleDuration := uint32(takeCycleDurationTicks)
+	if actionID == actionHarvest {
+		cycleDuration = cropHarvestCycleDurationTicks
+	}
+	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
+	ecs.AddComponent(ctx.World, ctx.PlayerHandle, components.ActiveCyclicAction{
+		BehaviorKey:        cropBehaviorKey,
+		ActionID:           actionID,
+		TargetKind:         components.CyclicActionTargetObject,
+		TargetID:           ctx.TargetID,
+		TargetHandle:       ctx.TargetHandle,
+		CycleDurationTicks: cycleDuration,
+		CycleElapsedTicks:  0,
+		CycleIndex:         1,
+		StartedTick:        nowTick,
+	})
+
+	ecs.MutateComponent[components.Movement](ctx.World, ctx.PlayerHandle, func(m *components.Movement) bool {
+		m.State = constt.StateInteracting
+		return true
+	})
+	return contracts.BehaviorResult{OK: true}
+}
+
+func (cropBehavior) OnCycleComplete(ctx *contracts.BehaviorCycleContext) contracts.BehaviorCycleDecision {
+	if ctx == nil || ctx.World == nil || ctx.TargetHandle == types.InvalidHandle || !ctx.World.Alive(ctx.TargetHandle) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	deps := resolveExecutionDeps(ctx.Deps)
+	if deps.GiveItem == nil {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "CROP_TAKE_UNAVAILABLE")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	targetInfo, hasTargetInfo := ecs.GetComponent[components.EntityInfo](ctx.World, ctx.TargetHandle)
+	if !hasTargetInfo {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	cropConfig, stageCfg, taken := resolveCropTargetStage(ctx.World, ctx.TargetHandle)
+	if cropConfig == nil || stageCfg == nil {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	actionID := strings.TrimSpace(ctx.ActionID)
+	if actionID == actionHarvest {
+		return onCropHarvestCycleComplete(ctx, deps, targetInfo, stageCfg)
+	}
+
+	takeCfg := findCropTakeConfigByActionID(stageCfg, actionID)
+	if takeCfg == nil {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if takenCountForAction(taken, actionID) >= takeCfg.Count {
+		return contracts.BehaviorCycleDecisionComplete
+	}
+	if !ConsumePlayerLongActionStamina(ctx.World, ctx.PlayerHandle, takeCycleStaminaCost) {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "LOW_STAMINA")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if !wearCycleTool(ctx, deps, takeCfg.ToolTag) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	outcome := deps.GiveItem(ctx.World, ctx.PlayerID, ctx.PlayerHandle, strings.TrimSpace(takeCfg.ItemDefKey), 1, targetInfo.Quality)
+	if !outcome.Success {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "CROP_TAKE_GIVE_FAILED")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	newTaken := 0
+	ecs.WithComponent(ctx.World, ctx.TargetHandle, func(state *components.ObjectInternalState) {
+		cropState, hasCropState := components.GetBehaviorState[components.CropBehaviorState](*state, cropBehaviorKey)
+		if !hasCropState || cropState == nil {
+			return
+		}
+		takenMap := cloneTakenCounts(cropState.Taken)
+		takenMap, newTaken = incrementTakenCount(takenMap, actionID)
+		setCropBehaviorState(state, takenMap, cropState.Stage, cropState.NextGrowthTick)
+	})
+	if outcome.PlacedInHand || newTaken >= takeCfg.Count {
+		return contracts.BehaviorCycleDecisionComplete
+	}
+	return contracts.BehaviorCycleDecisionContinue
+}
+
+// onCropHarvestCycleComplete gives every harvest item at crop quality and removes the crop.
+// Items that do not fit in the inventory are dropped next to the player, so a full inventory
+// never destroys the harvest. The plowed tile stays, so the player can plant again.
+func onCropHarvestCycleComplete(
+	ctx *contracts.BehaviorCycleContext,
+	deps contracts.ExecutionDeps,
+	targetInfo components.EntityInfo,
+	stageCfg *objectdefs.CropStageConfig,
+) contracts.BehaviorCycleDecision {
+	if len(stageCfg.Harvest) == 0 {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if deps.GiveItemOrDrop == nil {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "CROP_TAKE_UNAVAILABLE")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	targetTransform, hasTargetTransform := ecs.GetComponent[components.Transform](ctx.World, ctx.TargetHandle)
+	targetChunkRef, hasTargetChunkRef := ecs.GetComponent[components.ChunkRef](ctx.World, ctx.TargetHandle)
+	if !hasTargetTransform || !hasTargetChunkRef {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if !ConsumePlayerLongActionStamina(ctx.World, ctx.PlayerHandle, cropHarvestStaminaCost) {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "LOW_STAMINA")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	logger := resolveLogger(deps.Logger)
+	for _, rawItemKey := range stageCfg.Harvest {
+		itemKey := strings.TrimSpace(rawItemKey)
+		outcome := deps.GiveItemOrDrop(ctx.World, ctx.PlayerID, ctx.PlayerHandle, itemKey, 1, targetInfo.Quality)
+		if !outcome.Success {
+			logger.Warn("crop harvest: failed to give item",
+				zap.String("item_key", itemKey),
+				zap.String("reason", outcome.Message),
+			)
+			sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "CROP_HARVEST_GIVE_FAILED")
+			return contracts.BehaviorCycleDecisionCanceled
+		}
+	}
+
+	deleteTreeTarget(
+		ctx.World,
+		ctx.TargetID,
+		ctx.TargetHandle,
+		targetInfo,
+		targetChunkRef,
+		targetTransform,
+		deps,
+	)
+	ForceVisionUpdates(ctx.World, deps.VisionForcer)
+	return contracts.BehaviorCycleDecisionComplete
+}
+
+func isCropActionAvailable(world *ecs.World, targetHandle types.Handle, actionID string) bool {
+	if actionID == "" {
+		return false
+	}
+	cropConfig, stageCfg, taken := resolveCropTargetStage(world, targetHandle)
+	if cropConfig == nil || stageCfg == nil {
+		return false
+	}
+	if actionID == actionHarvest {
+		return len(stageCfg.Harvest) > 0
+	}
+	takeCfg := findCropTakeConfigByActionID(stageCfg, actionID)
+	return takeCfg != nil && takenCountForAction(taken, actionID) < takeCfg.Count
+}
+
+// resolveCropTargetStage returns the crop config, current stage config and taken counts of a target.
+func resolveCropTargetStage(
+	world *ecs.World,
+	targetHandle types.Handle,
+) (*objectdefs.CropBehaviorConfig, *objectdefs.CropStageConfig, map[string]int) {
+	if world == nil || targetHandle == types.InvalidHandle || !world.Alive(targetHandle) {
+		return nil, nil, nil
+	}
+	info, hasInfo := ecs.GetComponent[components.EntityInfo](world, targetHandle)
+	if !hasInfo {
+		return nil, nil, nil
+	}
+	def, found := objectdefs.Global().GetByID(int(info.TypeID))
+	if !found || def.CropConfig == nil {
+		return nil, nil, nil
+	}
+	stage := 0
+	var taken map[string]int
+	if internalState, hasState := ecs.GetComponent[components.ObjectInternalState](world, targetHandle); hasState {
+		if cropState, hasCrop := components.GetBehaviorState[components.CropBehaviorState](internalState, cropBehaviorKey); hasCrop && cropState != nil {
+			stage = cropState.Stage
+			taken = cropState.Taken
+		}
+	}
+	stageCfg := cropStageConfigFor(def.CropConfig, normalizeCropStage(stage, def.CropConfig))
+	return def.CropConfig, stageCfg, taken
+}
+
+func findCropTakeConfigByActionID(stageCfg *objectdefs.CropStageConfig, actionID string) *objectdefs.TakeConfig {
+	if stageCfg == nil {
+		return nil
+	}
+	for index := range stageCfg.Take {
+		if strings.TrimSpace(stageCfg.Take[index].ID) == actionID {
+			return &stageCfg.Take[index]
+		}
+	}
+	return nil
+}
+
+func initializeSpawnCropState(
+	world *ecs.World,
+	handle types.Handle,
+	entityID types.EntityID,
+	cropConfig *objectdefs.CropBehaviorConfig,
+	nowTick uint64,
+) {
+	nextGrowthTick := uint64(0)
+	if duration := cropStageTransitionDuration(cropConfig, 1); duration > 0 {
+		nextGrowthTick = nowTick + duration
+		ecs.ScheduleBehaviorTick(world, entityID, cropBehaviorKey, nextGrowthTick)
+	} else {
+		ecs.CancelBehaviorTick(world, entityID, cropBehaviorKey)
+	}
+	ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
+		setCropBehaviorState(state, nil, 1, nextGrowthTick)
+	})
+}
+
+func initializeRestoredCropState(
+	world *ecs.World,
+	handle types.Handle,
+	entityID types.EntityID,
+	cropConfig *objectdefs.CropBehaviorConfig,
+	nowTick uint64,
+) {
+	catchupLimit := resolveGrowthCatchupLimit(world)
+	ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
+		cropState, hasCropState := components.GetBehaviorState[components.CropBehaviorState](*state, cropBehaviorKey)
+		if !hasCropState || cropState == nil {
+			ecs.CancelBehaviorTick(world, entityID, cropBehaviorKey)
+			return
+		}
+
+		currentStage := normalizeCropStage(cropState.Stage, cropConfig)
+		nextGrowthTick := cropState.NextGrowthTick
+		stateChanged := currentStage != cropState.Stage
+		if currentStage < cropStageMax(cropConfig) && nextGrowthTick == 0 {
+			nextGrowthTick = nowTick + cropStageTransitionDuration(cropConfig, currentStage)
+			stateChanged = true
+		}
+		nextStage, caughtUpNextTick, caughtUp := applyCropGrowthCatchup(cropConfig, currentStage, nextGrowthTick, nowTick, catchupLimit)
+		if caughtUp {
+			currentStage = nextStage
+			nextGrowthTick = caughtUpNextTick
+			stateChanged = true
+		}
+		if currentStage >= cropStageMax(cropConfig) {
+			nextGrowthTick = 0
+		}
+		if nextGrowthTick == 0 {
+			ecs.CancelBehaviorTick(world, entityID, cropBehaviorKey)
+		} else {
+			ecs.ScheduleBehaviorTick(world, entityID, cropBehaviorKey, nextGrowthTick)
+		}
+
+		if !stateChanged {
+			return
+		}
+		taken := cloneTakenCounts(cropState.Taken)
+		if currentStage != cropState.Stage {
+			taken = nil
+		}
+		setCropBehaviorState(state, taken, currentStage, nextGrowthTick)
+	})
+}
+
+func applyCropGrowthCatchup(
+	cropConfig *objectdefs.CropBehaviorConfig,
+	currentStage int,
+	nextGrowthTick uint64,
+	nowTick uint64,
+	catchupLimit uint64,
+) (int, uint64, bool) {
+	if cropConfig == nil {
+		return currentStage, nextGrowthTick, false
+	}
+	return advanceGrowthStages(
+		cropStageMax(cropConfig),
+		currentStage,
+		nextGrowthTick,
+		nowTick,
+		catchupLimit,
+		func(stage int) uint64 { return cropStageTransitionDuration(cropConfig, stage) },
+	)
+}
+
+func setCropBehaviorState(
+	state *components.ObjectInternalState,
+	taken map[string]int,
+	stage int,
+	nextGrowthTick uint64,
+) {
+	if state == nil {
+		return
+	}
+	components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{
+		Stage:          stage,
+		NextGrowthTick: nextGrowthTick,
+		Taken:          taken,
+	})
+}
+
+func cropStageFromRuntimeState(
+	runtimeState *components.RuntimeObjectState,
+	cropConfig *objectdefs.CropBehaviorConfig,
+) int {
+	if runtimeState == nil || runtimeState.Behaviors == nil {
+		return normalizeCropStage(0, cropConfig)
+	}
+	if typedState, ok := runtimeState.Behaviors[cropBehaviorKey].(*components.CropBehaviorState); ok && typedState != nil {
+		return normalizeCropStage(typedState.Stage, cropConfig)
+	}
+	return normalizeCropStage(0, cropConfig)
+}
+
+// normalizeCropStage clamps a stage into [1, max]. A missing stage is a fresh crop.
+func normalizeCropStage(stage int, cropConfig *objectdefs.CropBehaviorConfig) int {
+	maxStage := cropStageMax(cropConfig)
+	if stage < 1 {
+		return 1
+	}
+	if stage > maxStage {
+		return maxStage
+	}
+	return stage
+}
+
+func cropStageTransitionDuration(cropConfig *objectdefs.CropBehaviorConfig, stage int) uint64 {
+	if stage >= cropStageMax(cropConfig) {
+		return 0
+	}
+	stageCfg := cropStageConfigFor(cropConfig, stage)
+	if stageCfg == nil || stageCfg.StageDuration <= 0 {
+		return 0
+	}
+	return uint64(stageCfg.StageDuration)
+}
+
+func cropStageMax(cropConfig *objectdefs.CropBehaviorConfig) int {
+	if cropConfig == nil || len(cropConfig.Stages) == 0 {
+		return 1
+	}
+	return len(cropConfig.Stages)
+}
+
+func cropStageConfigFor(cropConfig *objectdefs.CropBehaviorConfig, stage int) *objectdefs.CropStageConfig {
+	if cropConfig == nil || stage < 1 || stage > len(cropConfig.Stages) {
+		return nil
+	}
+	return &cropConfig.Stages[stage-1]
+}
+
+func cropStageFlag(stage int) string {
+	return fmt.Sprintf("%s%d", cropStageFlagPrefix, stage)
+}
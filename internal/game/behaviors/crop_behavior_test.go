@@ -0,0 +1,345 @@
+package behaviors
+
+import (
+	"strings"
+	"testing"
+
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/timeutil"
+	"origin/internal/types"
+)
+
+func cropTestItemRegistry() *itemdefs.Registry {
+	return itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 9301, Key: "seed_wheat", Name: "Wheat Seed", Tags: []string{"seed"}},
+		{DefID: 9302, Key: "wheat", Name: "Wheat"},
+		{DefID: 9303, Key: "straw", Name: "Straw"},
+	})
+}
+
+func setupCropTestRegistries(t *testing.T, cropDefID int) {
+	t.Helper()
+
+	previousObjectRegistry := objectdefs.Global()
+	previousItemRegistry := itemdefs.Global()
+	t.Cleanup(func() {
+		objectdefs.SetGlobalForTesting(previousObjectRegistry)
+		itemdefs.SetGlobalForTesting(previousItemRegistry)
+	})
+
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{
+			DefID:    cropDefID,
+			Key:      "crop_test_wheat",
+			IsStatic: true,
+			CropConfig: &objectdefs.CropBehaviorConfig{
+				SeedItemKey: "seed_wheat",
+				Stages: []objectdefs.CropStageConfig{
+					{StageDuration: 10},
+					{StageDuration: 10},
+					{
+						Take:    []objectdefs.TakeConfig{{ID: "take_straw", Name: "Take Straw", ItemDefKey: "straw", Count: 1}},
+						Harvest: []string{"wheat", "wheat", "seed_wheat"},
+					},
+				},
+			},
+		},
+	}))
+	itemdefs.SetGlobalForTesting(cropTestItemRegistry())
+}
+
+func TestCropValidateAndApplyDefConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			raw:  `{"seedItemKey":"seed_wheat","stages":[{"stageDurationTicks":10},{"take":[{"id":"take_straw","name":"Take Straw","itemDefKey":"straw","count":1}],"harvest":["wheat"]}]}`,
+		},
+		{name: "missing seed", raw: `{"stages":[{}]}`, wantErr: "seedItemKey must not be empty"},
+		{name: "unknown seed", raw: `{"seedItemKey":"seed_rye","stages":[{}]}`, wantErr: `unknown item key "seed_rye"`},
+		{name: "no stages", raw: `{"seedItemKey":"seed_wheat"}`, wantErr: "crop.stages is required"},
+		{name: "zero duration", raw: `{"seedItemKey":"seed_wheat","stages":[{},{}]}`, wantErr: "stages[0].stageDurationTicks"},
+		{name: "unknown harvest", raw: `{"seedItemKey":"seed_wheat","stages":[{"harvest":["rye"]}]}`, wantErr: `harvest[0] unknown item key "rye"`},
+		{
+			name:    "reserved take id",
+			raw:     `{"seedItemKey":"seed_wheat","stages":[{"take":[{"id":"harvest","name":"Harvest","itemDefKey":"straw","count":1}]}]}`,
+			wantErr: `id "harvest" is reserved`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			def := &objectdefs.ObjectDef{}
+			_, err := cropBehavior{}.ValidateAndApplyDefConfig(&contracts.BehaviorDefConfigContext{
+				BehaviorKey: cropBehaviorKey,
+				RawConfig:   []byte(testCase.raw),
+				Def:         def,
+				Items:       cropTestItemRegistry(),
+			})
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if def.CropConfig == nil || def.CropConfig.SeedItemKey != "seed_wheat" || len(def.CropConfig.Stages) != 2 {
+					t.Fatalf("crop config not applied: %+v", def.CropConfig)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCropOnScheduledTick_AdvancesStageAndResetsTaken(t *testing.T) {
+	cropDefID := 7401
+	setupCropTestRegistries(t, cropDefID)
+
+	world := ecs.NewWorldForTesting()
+	entityID := types.EntityID(74010)
+	handle := world.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(cropDefID)})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{
+			Stage:          1,
+			NextGrowthTick: 10,
+			Taken:          map[string]int{"take_straw": 1},
+		})
+	})
+	ecs.ScheduleBehaviorTick(world, entityID, cropBehaviorKey, 10)
+
+	tick := func(currentTick uint64) contracts.BehaviorTickResult {
+		result, err := cropBehavior{}.OnScheduledTick(&contracts.BehaviorTickContext{
+			World:       world,
+			Handle:      handle,
+			EntityID:    entityID,
+			EntityType:  uint32(cropDefID),
+			BehaviorKey: cropBehaviorKey,
+			CurrentTick: currentTick,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+	cropState := func() *components.CropBehaviorState {
+		internalState, _ := ecs.GetComponent[components.ObjectInternalState](world, handle)
+		state, ok := components.GetBehaviorState[components.CropBehaviorState](internalState, cropBehaviorKey)
+		if !ok || state == nil {
+			t.Fatalf("missing crop state")
+		}
+		return state
+	}
+
+	if result := tick(10); !result.StateChanged {
+		t.Fatalf("expected stage change at tick 10")
+	}
+	if state := cropState(); state.Stage != 2 || state.NextGrowthTick != 20 || len(state.Taken) != 0 {
+		t.Fatalf("unexpected state after first growth: %+v", state)
+	}
+
+	tick(25)
+	if state := cropState(); state.Stage != 3 || state.NextGrowthTick != 0 {
+		t.Fatalf("expected final stage without next tick, got %+v", state)
+	}
+	if pending := ecs.GetResource[ecs.BehaviorTickSchedule](world).PendingCount(); pending != 0 {
+		t.Fatalf("expected no pending ticks at final stage, got %d", pending)
+	}
+}
+
+func TestCropOnScheduledTick_RestsInWinter(t *testing.T) {
+	cropDefID := 7402
+	setupCropTestRegistries(t, cropDefID)
+
+	world := ecs.NewWorldForTesting()
+	ecs.GetResource[ecs.TimeState](world).WorldTime.Season = timeutil.SeasonWinter
+	entityID := types.EntityID(74020)
+	handle := world.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(cropDefID)})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{Stage: 1, NextGrowthTick: 10})
+	})
+
+	tick := func(currentTick uint64) contracts.BehaviorTickResult {
+		result, err := cropBehavior{}.OnScheduledTick(&contracts.BehaviorTickContext{
+			World:       world,
+			Handle:      handle,
+			EntityID:    entityID,
+			EntityType:  uint32(cropDefID),
+			BehaviorKey: cropBehaviorKey,
+			CurrentTick: currentTick,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+	cropState := func() *components.CropBehaviorState {
+		internalState, _ := ecs.GetComponent[components.ObjectInternalState](world, handle)
+		state, _ := components.GetBehaviorState[components.CropBehaviorState](internalState, cropBehaviorKey)
+		return state
+	}
+
+	if result := tick(12); result.StateChanged {
+		t.Fatalf("expected no growth in winter")
+	}
+	if state := cropState(); state.Stage != 1 || state.NextGrowthTick != 22 {
+		t.Fatalf("expected growth deferred by a stage duration, got %+v", state)
+	}
+
+	ecs.GetResource[ecs.TimeState](world).WorldTime.Season = timeutil.SeasonSpring
+	if result := tick(22); !result.StateChanged {
+		t.Fatalf("expected growth to resume in spring")
+	}
+	if state := cropState(); state.Stage != 2 || state.NextGrowthTick != 32 {
+		t.Fatalf("unexpected state after spring growth: %+v", state)
+	}
+}
+
+func TestCropProvideActions_HarvestOnlyAtRipeStage(t *testing.T) {
+	cropDefID := 7402
+	setupCropTestRegistries(t, cropDefID)
+
+	world := ecs.NewWorldForTesting()
+	handle := world.Spawn(types.EntityID(74020), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(cropDefID)})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	setStage := func(stage int) {
+		ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
+			components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{Stage: stage})
+		})
+	}
+
+	setStage(1)
+	if actions := (cropBehavior{}).ProvideActions(&contracts.BehaviorActionListContext{World: world, TargetHandle: handle}); len(actions) != 0 {
+		t.Fatalf("expected no actions at stage 1, got %+v", actions)
+	}
+	setStage(3)
+	actions := cropBehavior{}.ProvideActions(&contracts.BehaviorActionListContext{World: world, TargetHandle: handle})
+	if !containsAction(actions, actionHarvest) || !containsAction(actions, "take_straw") {
+		t.Fatalf("expected harvest and take_straw at ripe stage, got %+v", actions)
+	}
+}
+
+func TestCropHarvestCycle_GivesItemsAtCropQualityAndRemovesCrop(t *testing.T) {
+	cropDefID := 7403
+	setupCropTestRegistries(t, cropDefID)
+
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(74030)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, State: constt.StateInteracting, Speed: constt.PlayerSpeed})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 150, Energy: 1000})
+	})
+	cropID := types.EntityID(74031)
+	cropHandle := world.Spawn(cropID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(cropDefID), IsStatic: true, Quality: 42})
+		ecs.AddComponent(w, h, components.Transform{X: 6, Y: 6})
+		ecs.AddComponent(w, h, components.ChunkRef{})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, cropHandle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{Stage: 3})
+	})
+
+	given := make(map[string]uint32)
+	decision := cropBehavior{}.OnCycleComplete(&contracts.BehaviorCycleContext{
+		World:        world,
+		PlayerID:     playerID,
+		PlayerHandle: playerHandle,
+		TargetID:     cropID,
+		TargetHandle: cropHandle,
+		ActionID:     actionHarvest,
+		Deps: &contracts.ExecutionDeps{
+			GiveItem: func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string, _ uint32, _ uint32) contracts.GiveItemOutcome {
+				t.Fatalf("harvest must give through GiveItemOrDrop")
+				return contracts.GiveItemOutcome{}
+			},
+			GiveItemOrDrop: func(_ *ecs.World, _ types.EntityID, _ types.Handle, itemKey string, count uint32, quality uint32) contracts.GiveItemOutcome {
+				if quality != 42 {
+					t.Fatalf("expected crop quality 42 for %s, got %d", itemKey, quality)
+				}
+				given[itemKey] += count
+				return contracts.GiveItemOutcome{Success: true}
+			},
+		},
+	})
+
+	if decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete decision, got %v", decision)
+	}
+	if given["wheat"] != 2 || given["seed_wheat"] != 1 {
+		t.Fatalf("unexpected harvest items: %+v", given)
+	}
+	if world.Alive(cropHandle) {
+		t.Fatalf("expected crop to be removed after harvest")
+	}
+}
+
+func TestCropHarvestCycle_KeepsCropWhenItemCannotBeGivenOrDropped(t *testing.T) {
+	cropDefID := 7404
+	setupCropTestRegistries(t, cropDefID)
+
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(74040)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, State: constt.StateInteracting, Speed: constt.PlayerSpeed})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 150, Energy: 1000})
+	})
+	cropID := types.EntityID(74041)
+	cropHandle := world.Spawn(cropID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(cropDefID), IsStatic: true, Quality: 42})
+		ecs.AddComponent(w, h, components.Transform{X: 6, Y: 6})
+		ecs.AddComponent(w, h, components.ChunkRef{})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, cropHandle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, cropBehaviorKey, &components.CropBehaviorState{Stage: 3})
+	})
+
+	alerts := &testMiniAlertSender{}
+	decision := cropBehavior{}.OnCycleComplete(&contracts.BehaviorCycleContext{
+		World:        world,
+		PlayerID:     playerID,
+		PlayerHandle: playerHandle,
+		TargetID:     cropID,
+		TargetHandle: cropHandle,
+		ActionID:     actionHarvest,
+		Deps: &contracts.ExecutionDeps{
+			GiveItem: func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string, _ uint32, _ uint32) contracts.GiveItemOutcome {
+				return contracts.GiveItemOutcome{Success: true}
+			},
+			GiveItemOrDrop: func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string, _ uint32, _ uint32) contracts.GiveItemOutcome {
+				return contracts.GiveItemOutcome{Success: false, Message: "no room to drop"}
+			},
+			Alerts: alerts,
+		},
+	})
+
+	if decision != contracts.BehaviorCycleDecisionCanceled {
+		t.Fatalf("expected canceled decision, got %v", decision)
+	}
+	if !world.Alive(cropHandle) {
+		t.Fatalf("crop must stay when its harvest could not be handed out")
+	}
+	if len(alerts.alerts) != 1 || alerts.alerts[0].ReasonCode != "CROP_HARVEST_GIVE_FAILED" {
+		t.Fatalf("expected CROP_HARVEST_GIVE_FAILED alert, got %+v", alerts.alerts)
+	}
+}
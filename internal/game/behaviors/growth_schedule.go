@@ -0,0 +1,61 @@
+package behaviors
+
+import "origin/internal/ecs"
+
+const defaultGrowthCatchupLimitTicks = uint64(2000)
+
+// resolveGrowthCatchupLimit returns how many overdue ticks a restored staged object may catch up.
+func resolveGrowthCatchupLimit(world *ecs.World) uint64 {
+	if policy, ok := ecs.TryGetResource[ecs.BehaviorTickPolicy](world); ok && policy != nil {
+		if policy.CatchUpLimitTicks > 0 {
+			return policy.CatchUpLimitTicks
+		}
+	}
+	return defaultGrowthCatchupLimitTicks
+}
+
+// advanceGrowthStages moves a staged object through every transition due by nowTick.
+// stageDuration returns the ticks a stage lasts before the next one; 0 stops growth.
+// Shared by tree and crop behaviors.
+func advanceGrowthStages(
+	maxStage int,
+	currentStage int,
+	nextGrowthTick uint64,
+	nowTick uint64,
+	catchupLimit uint64,
+	stageDuration func(stage int) uint64,
+) (int, uint64, bool) {
+	if currentStage >= maxStage || nextGrowthTick == 0 {
+		return currentStage, nextGrowthTick, false
+	}
+
+	effectiveNowTick := nowTick
+	if nowTick > nextGrowthTick && catchupLimit > 0 {
+		limitedNowTick := nextGrowthTick + catchupLimit
+		if limitedNowTick < effectiveNowTick {
+			effectiveNowTick = limitedNowTick
+		}
+	}
+
+	stage := currentStage
+	nextTick := nextGrowthTick
+	changed := false
+
+	for stage < maxStage && nextTick > 0 && nextTick <= effectiveNowTick {
+		stage++
+		changed = true
+		if stage >= maxStage {
+			nextTick = 0
+			break
+		}
+
+		transitionDuration := stageDuration(stage)
+		if transitionDuration == 0 {
+			nextTick = 0
+			break
+		}
+		nextTick += transitionDuration
+	}
+
+	return stage, nextTick, changed
+}
@@ -14,6 +14,8 @@ type liftBehavior struct{}
 
 func (liftBehavior) Key() string { return "lift" }
 
+func (liftBehavior) DefConfigPrototype() any { return priorityOnlyDefConfig{} }
+
 func (liftBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("lift def config context is nil")
@@ -10,6 +10,8 @@ type playerBehavior struct{}
 
 func (playerBehavior) Key() string { return "player" }
 
+func (playerBehavior) DefConfigPrototype() any { return priorityOnlyDefConfig{} }
+
 func (playerBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("player def config context is nil")
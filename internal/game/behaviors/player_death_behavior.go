@@ -32,6 +32,8 @@ type unequipCandidate struct {
 
 func (playerDeathBehavior) Key() string { return playerDeathBehaviorKey }
 
+func (playerDeathBehavior) DefConfigPrototype() any { return priorityOnlyDefConfig{} }
+
 func (playerDeathBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("player_death def config context is nil")
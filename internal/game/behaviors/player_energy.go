@@ -0,0 +1,79 @@
+package behaviors
+
+import (
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/entitystats"
+	"origin/internal/types"
+)
+
+// RestorePlayerEnergy adds energy capped at EnergyMax and propagates movement mode /
+// stats dirty flags and the regen schedule. Returns the energy actually added.
+func RestorePlayerEnergy(
+	world *ecs.World,
+	playerHandle types.Handle,
+	amount float64,
+) float64 {
+	if world == nil || playerHandle == types.InvalidHandle || !world.Alive(playerHandle) || amount <= 0 {
+		return 0
+	}
+
+	stats, hasStats := ecs.GetComponent[components.EntityStats](world, playerHandle)
+	if !hasStats {
+		return 0
+	}
+
+	currentEnergy := stats.Energy
+	if currentEnergy < 0 {
+		currentEnergy = 0
+	}
+	nextEnergy := currentEnergy + amount
+	if nextEnergy > constt.EnergyMax {
+		nextEnergy = constt.EnergyMax
+	}
+	if nextEnergy == stats.Energy {
+		return 0
+	}
+
+	con := characterattrs.DefaultValue
+	if profile, hasProfile := ecs.GetComponent[components.CharacterProfile](world, playerHandle); hasProfile {
+		con = characterattrs.Get(profile.Attributes, characterattrs.CON)
+	}
+	maxStamina := entitystats.MaxStaminaFromCon(con)
+	currentStamina := entitystats.ClampStamina(stats.Stamina, maxStamina)
+
+	ecs.WithComponent(world, playerHandle, func(entityStats *components.EntityStats) {
+		entityStats.Stamina = currentStamina
+		entityStats.Energy = nextEnergy
+	})
+	_, isCarrying := ecs.GetComponent[components.LiftCarryState](world, playerHandle)
+
+	// Energy above DefaultEnergy limits movement to crawl.
+	modeMutated := ecs.MutateComponent[components.Movement](world, playerHandle, func(m *components.Movement) bool {
+		mode, canMove := entitystats.ResolveAllowedMoveModeWithCarry(
+			m.Mode,
+			currentStamina,
+			maxStamina,
+			nextEnergy,
+			isCarrying,
+		)
+		changed := mode != m.Mode
+		if changed {
+			m.Mode = mode
+		}
+		if !canMove && m.State == constt.StateMoving {
+			m.ClearTarget()
+			changed = true
+		}
+		return changed
+	})
+	if modeMutated {
+		ecs.MarkMovementModeDirtyByHandle(world, playerHandle)
+	}
+
+	ecs.MarkPlayerStatsDirtyByHandle(world, playerHandle, ecs.ResolvePlayerStatsTTLms(world))
+	ecs.UpdateEntityStatsRegenSchedule(world, playerHandle, currentStamina, nextEnergy, maxStamina)
+	return nextEnergy - currentEnergy
+}
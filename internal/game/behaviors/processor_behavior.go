@@ -0,0 +1,662 @@
+package behaviors
+
+import (
+	"fmt"
+	"strings"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	processorBehaviorKey = "processor"
+	actionLight          = "light"
+	actionExtinguish     = "extinguish"
+	processorLitFlag     = "processor.lit"
+
+	// Machine inventories are fixed grid keys of the object (components.inventory).
+	processorInputInventoryKey  uint32 = 0
+	processorOutputInventoryKey uint32 = 1
+	processorFuelInventoryKey   uint32 = 2
+
+	// processorTickIntervalTicks is how often a lit machine simulates and syncs open inventories.
+	// The simulation itself is exact: every tick advances by the real elapsed ticks.
+	processorTickIntervalTicks = 10
+)
+
+// processorBehavior is a fuel-burning machine (kiln, oven, ...).
+// While lit it burns fuel from the fuel grid, converts input grid items by timed recipes
+// from process defs into the output grid, and overcooks finished output into waste.
+// State is kept in ObjectInternalState; a restored lit machine catches up on its first tick.
+type processorBehavior struct{}
+
+func (processorBehavior) Key() string { return processorBehaviorKey }
+
+func (processorBehavior) DefConfigPrototype() any { return contracts.ProcessorBehaviorConfig{} }
+
+func (processorBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
+	if ctx == nil {
+		return 0, fmt.Errorf("processor def config context is nil")
+	}
+
+	var cfg contracts.ProcessorBehaviorConfig
+	if err := decodeStrictJSON(ctx.RawConfig, &cfg); err != nil {
+		return 0, fmt.Errorf("invalid processor config: %w", err)
+	}
+	if cfg.Priority <= 0 {
+		cfg.Priority = defaultBehaviorPriority
+	}
+	cfg.Machine = strings.TrimSpace(cfg.Machine)
+	if cfg.Machine == "" {
+		return 0, fmt.Errorf("processor.machine must not be empty")
+	}
+	if len(cfg.Fuel) == 0 {
+		return 0, fmt.Errorf("processor.fuel is required")
+	}
+	itemRegistry := ctx.ItemRegistry()
+	if itemRegistry == nil {
+		return 0, fmt.Errorf("processor.fuel validation requires loaded item defs")
+	}
+	seenFuel := make(map[string]int, len(cfg.Fuel))
+	for idx := range cfg.Fuel {
+		fuel := &cfg.Fuel[idx]
+		fuel.ItemKey = strings.TrimSpace(fuel.ItemKey)
+		if fuel.ItemKey == "" {
+			return 0, fmt.Errorf("processor.fuel[%d].itemKey must not be empty", idx)
+		}
+		if _, ok := itemRegistry.GetByKey(fuel.ItemKey); !ok {
+			return 0, fmt.Errorf("processor.fuel[%d].itemKey unknown item key %q", idx, fuel.ItemKey)
+		}
+		if fuel.Ticks == 0 {
+			return 0, fmt.Errorf("processor.fuel[%d].ticks must be > 0", idx)
+		}
+		if firstIndex, exists := seenFuel[fuel.ItemKey]; exists {
+			return 0, fmt.Errorf("processor.fuel[%d].itemKey duplicate %q (first at index %d)", idx, fuel.ItemKey, firstIndex)
+		}
+		seenFuel[fuel.ItemKey] = idx
+	}
+
+	if ctx.Def == nil {
+		return 0, fmt.Errorf("processor config target def is nil")
+	}
+	ctx.Def.SetProcessorBehaviorConfig(cfg)
+	return cfg.Priority, nil
+}
+
+func (processorBehavior) InitObject(ctx *contracts.BehaviorObjectInitContext) error {
+	if ctx == nil || ctx.World == nil {
+		return nil
+	}
+	if ctx.Handle == types.InvalidHandle || !ctx.World.Alive(ctx.Handle) {
+		return nil
+	}
+	if ctx.Reason != contracts.ObjectBehaviorInitReasonRestore {
+		return nil
+	}
+
+	internalState, hasState := ecs.GetComponent[components.ObjectInternalState](ctx.World, ctx.Handle)
+	if !hasState {
+		return nil
+	}
+	processorState, hasProcessorState := components.GetBehaviorState[components.ProcessorBehaviorState](internalState, processorBehaviorKey)
+	if !hasProcessorState || processorState == nil || !processorState.Lit {
+		return nil
+	}
+	// Catch-up needs item and client deps, which only the tick system has: run it on the next tick.
+	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
+	ecs.ScheduleBehaviorTick(ctx.World, ctx.EntityID, processorBehaviorKey, nowTick)
+	return nil
+}
+
+func (processorBehavior) ApplyRuntime(ctx *contracts.BehaviorRuntimeContext) contracts.BehaviorRuntimeResult {
+	if ctx == nil || ctx.PrevState == nil || ctx.PrevState.Behaviors == nil {
+		return contracts.BehaviorRuntimeResult{}
+	}
+	processorState, ok := ctx.PrevState.Behaviors[processorBehaviorKey].(*components.ProcessorBehaviorState)
+	if !ok || processorState == nil || !processorState.Lit {
+		return contracts.BehaviorRuntimeResult{}
+	}
+	return contracts.BehaviorRuntimeResult{
+		Flags: []string{processorLitFlag},
+	}
+}
+
+func (processorBehavior) AppearanceFlags(*contracts.BehaviorDefConfigContext) []string {
+	return []string{processorLitFlag}
+}
+
+func (processorBehavior) OnScheduledTick(ctx *contracts.BehaviorTickContext) (contracts.BehaviorTickResult, error) {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorTickResult{}, nil
+	}
+	if ctx.Handle == types.InvalidHandle || !ctx.World.Alive(ctx.Handle) {
+		return contracts.BehaviorTickResult{}, nil
+	}
+	processorConfig := resolveProcessorConfig(ctx.EntityType)
+	if processorConfig == nil {
+		ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, processorBehaviorKey)
+		return contracts.BehaviorTickResult{}, nil
+	}
+
+	state := loadProcessorState(ctx.World, ctx.Handle)
+	if !state.Lit {
+		ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, processorBehaviorKey)
+		return contracts.BehaviorTickResult{}, nil
+	}
+
+	advanceProcessor(ctx.World, ctx.EntityID, processorConfig, &state, ctx.CurrentTick, resolveExecutionDeps(ctx.Deps))
+	if state.Lit && isStormOver(ctx.World, ctx.Handle) {
+		state.Lit = false
+	}
+	storeProcessorState(ctx.World, ctx.Handle, state)
+	if !state.Lit {
+		ecs.CancelBehaviorTick(ctx.World, ctx.EntityID, processorBehaviorKey)
+		return contracts.BehaviorTickResult{StateChanged: true}, nil
+	}
+	ecs.ScheduleBehaviorTick(ctx.World, ctx.EntityID, processorBehaviorKey, ctx.CurrentTick+processorTickIntervalTicks)
+	return contracts.BehaviorTickResult{}, nil
+}
+
+func (processorBehavior) ProvideActions(ctx *contracts.BehaviorActionListContext) []contracts.ContextAction {
+	if ctx == nil || ctx.World == nil {
+		return nil
+	}
+	if resolveProcessorTargetConfig(ctx.World, ctx.TargetHandle) == nil {
+		return nil
+	}
+	if loadProcessorState(ctx.World, ctx.TargetHandle).Lit {
+		return []contracts.ContextAction{{ActionID: actionExtinguish, Title: "Extinguish"}}
+	}
+	return []contracts.ContextAction{{ActionID: actionLight, Title: "Light"}}
+}
+
+func (processorBehavior) ValidateAction(ctx *contracts.BehaviorActionValidateContext) contracts.BehaviorResult {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorResult{OK: false}
+	}
+	if !isProcessorActionAvailable(ctx.World, ctx.TargetHandle, strings.TrimSpace(ctx.ActionID)) {
+		return contracts.BehaviorResult{OK: false}
+	}
+	return contracts.BehaviorResult{OK: true}
+}
+
+func (processorBehavior) ExecuteAction(ctx *contracts.BehaviorActionExecuteContext) contracts.BehaviorResult {
+	if ctx == nil || ctx.World == nil {
+		return contracts.BehaviorResult{OK: false}
+	}
+	actionID := strings.TrimSpace(ctx.ActionID)
+	if !isProcessorActionAvailable(ctx.World, ctx.TargetHandle, actionID) {
+		return contracts.BehaviorResult{OK: false}
+	}
+	processorConfig := resolveProcessorTargetConfig(ctx.World, ctx.TargetHandle)
+	deps := resolveExecutionDeps(ctx.Deps)
+	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
+	state := loadProcessorState(ctx.World, ctx.TargetHandle)
+
+	switch actionID {
+	case actionLight:
+		if isStormOver(ctx.World, ctx.TargetHandle) {
+			return contracts.BehaviorResult{
+				OK:          false,
+				UserVisible: true,
+				ReasonCode:  "processor_storm",
+				Severity:    contracts.BehaviorAlertSeverityWarning,
+			}
+		}
+		sim := newProcessorSimulation(ctx.World, ctx.TargetID, processorConfig, deps)
+		if state.FuelTicksLeft == 0 {
+			state.FuelTicksLeft = sim.consumeFuel()
+			sim.sync()
+		}
+		if state.FuelTicksLeft == 0 {
+			return contracts.BehaviorResult{
+				OK:          false,
+				UserVisible: true,
+				ReasonCode:  "processor_no_fuel",
+				Severity:    contracts.BehaviorAlertSeverityWarning,
+			}
+		}
+		state.Lit = true
+		state.LastTick = nowTick
+		ecs.ScheduleBehaviorTick(ctx.World, ctx.TargetID, processorBehaviorKey, nowTick+processorTickIntervalTicks)
+	case actionExtinguish:
+		advanceProcessor(ctx.World, ctx.TargetID, processorConfig, &state, nowTick, deps)
+		state.Lit = false
+		ecs.CancelBehaviorTick(ctx.World, ctx.TargetID, processorBehaviorKey)
+	}
+
+	storeProcessorState(ctx.World, ctx.TargetHandle, state)
+	ecs.MarkObjectBehaviorDirty(ctx.World, ctx.TargetHandle)
+	return contracts.BehaviorResult{OK: true}
+}
+
+func isProcessorActionAvailable(world *ecs.World, targetHandle types.Handle, actionID string) bool {
+	if resolveProcessorTargetConfig(world, targetHandle) == nil {
+		return false
+	}
+	lit := loadProcessorState(world, targetHandle).Lit
+	switch actionID {
+	case actionLight:
+		return !lit
+	case actionExtinguish:
+		return lit
+	default:
+		return false
+	}
+}
+
+// isStormOver reports whether the weather over the object puts out fires.
+// A machine caught in a storm goes out and keeps its unburnt fuel.
+func isStormOver(world *ecs.World, handle types.Handle) bool {
+	transform, hasTransform := ecs.GetComponent[components.Transform](world, handle)
+	if !hasTransform {
+		return false
+	}
+	return ecs.GetResource[ecs.WeatherState](world).AtWorldPos(transform.X, transform.Y).PutsOutFires()
+}
+
+func resolveProcessorConfig(entityType uint32) *objectdefs.ProcessorBehaviorConfig {
+	def, found := objectdefs.Global().GetByID(int(entityType))
+	if !found || def.ProcessorConfig == nil {
+		return nil
+	}
+	return def.ProcessorConfig
+}
+
+func resolveProcessorTargetConfig(world *ecs.World, targetHandle types.Handle) *objectdefs.ProcessorBehaviorConfig {
+	if world == nil || targetHandle == types.InvalidHandle || !world.Alive(targetHandle) {
+		return nil
+	}
+	info, hasInfo := ecs.GetComponent[components.EntityInfo](world, targetHandle)
+	if !hasInfo {
+		return nil
+	}
+	return resolveProcessorConfig(info.TypeID)
+}
+
+// loadProcessorState returns a copy of the processor state; a machine without state is unlit.
+func loadProcessorState(world *ecs.World, handle types.Handle) components.ProcessorBehaviorState {
+	internalState, hasState := ecs.GetComponent[components.ObjectInternalState](world, handle)
+	if !hasState {
+		return components.ProcessorBehaviorState{}
+	}
+	processorState, ok := components.GetBehaviorState[components.ProcessorBehaviorState](internalState, processorBehaviorKey)
+	if !ok || processorState == nil {
+		return components.ProcessorBehaviorState{}
+	}
+	state := *processorState
+	state.Progress = cloneItemTicks(processorState.Progress)
+	state.Overcook = cloneItemTicks(processorState.Overcook)
+	return state
+}
+
+func storeProcessorState(world *ecs.World, handle types.Handle, state components.ProcessorBehaviorState) {
+	ecs.WithComponent(world, handle, func(internalState *components.ObjectInternalState) {
+		components.SetBehaviorState(internalState, processorBehaviorKey, &state)
+	})
+}
+
+// advanceProcessor simulates a lit machine from state.LastTick to nowTick.
+// Time is split at fuel boundaries: each burnt-out fuel item is replaced from the fuel grid,
+// and the machine goes out when no fuel is left.
+func advanceProcessor(
+	world *ecs.World,
+	entityID types.EntityID,
+	processorConfig *objectdefs.ProcessorBehaviorConfig,
+	state *components.ProcessorBehaviorState,
+	nowTick uint64,
+	deps contracts.ExecutionDeps,
+) {
+	if state == nil || processorConfig == nil {
+		return
+	}
+	sim := newProcessorSimulation(world, entityID, processorConfig, deps)
+	if state.Progress == nil {
+		state.Progress = make(map[types.EntityID]uint64)
+	}
+	if state.Overcook == nil {
+		state.Overcook = make(map[types.EntityID]uint64)
+	}
+
+	for state.Lit && state.LastTick < nowTick {
+		if state.FuelTicksLeft == 0 {
+			state.FuelTicksLeft = sim.consumeFuel()
+			if state.FuelTicksLeft == 0 {
+				state.Lit = false
+				break
+			}
+		}
+		step := min(nowTick-state.LastTick, state.FuelTicksLeft)
+		sim.heat(state, step)
+		state.FuelTicksLeft -= step
+		state.LastTick += step
+	}
+	if !state.Lit {
+		state.LastTick = nowTick
+	}
+
+	sim.prune(state)
+	if len(state.Progress) == 0 {
+		state.Progress = nil
+	}
+	if len(state.Overcook) == 0 {
+		state.Overcook = nil
+	}
+	sim.sync()
+}
+
+type processorSimulation struct {
+	world    *ecs.World
+	entityID types.EntityID
+	config   *objectdefs.ProcessorBehaviorConfig
+	recipes  *processdefs.Registry
+	items    *itemdefs.Registry
+	deps     contracts.ExecutionDeps
+
+	placement *inventory.PlacementService
+	changed   []types.Handle
+}
+
+func newProcessorSimulation(
+	world *ecs.World,
+	entityID types.EntityID,
+	processorConfig *objectdefs.ProcessorBehaviorConfig,
+	deps contracts.ExecutionDeps,
+) *processorSimulation {
+	return &processorSimulation{
+		world:     world,
+		entityID:  entityID,
+		config:    processorConfig,
+		recipes:   processdefs.Global(),
+		items:     itemdefs.Global(),
+		deps:      deps,
+		placement: inventory.NewPlacementService(),
+	}
+}
+
+func (s *processorSimulation) container(key uint32) types.Handle {
+	refIndex := ecs.GetResource[ecs.InventoryRefIndex](s.world)
+	handle, found := refIndex.Lookup(constt.InventoryGrid, s.entityID, key)
+	if !found || !s.world.Alive(handle) {
+		return types.InvalidHandle
+	}
+	return handle
+}
+
+func (s *processorSimulation) markChanged(handle types.Handle) {
+	for _, existing := range s.changed {
+		if existing == handle {
+			return
+		}
+	}
+	s.changed = append(s.changed, handle)
+}
+
+func (s *processorSimulation) sync() {
+	if len(s.changed) == 0 || s.deps.SyncInventories == nil {
+		return
+	}
+	s.deps.SyncInventories(s.world, s.changed)
+	s.changed = nil
+}
+
+func (s *processorSimulation) itemKey(typeID uint32) string {
+	itemDef, ok := s.items.GetByID(int(typeID))
+	if !ok || itemDef == nil {
+		return ""
+	}
+	return itemDef.Key
+}
+
+// consumeFuel takes one unit of the first fuel item in the fuel grid and returns its burn ticks.
+func (s *processorSimulation) consumeFuel() uint64 {
+	fuelHandle := s.container(processorFuelInventoryKey)
+	if fuelHandle == types.InvalidHandle {
+		return 0
+	}
+	var ticks uint64
+	ecs.WithComponent(s.world, fuelHandle, func(container *components.InventoryContainer) {
+		for index := range container.Items {
+			ticks = s.config.FuelTicks(s.itemKey(container.Items[index].TypeID))
+			if ticks == 0 {
+				continue
+			}
+			if container.Items[index].Quantity > 1 {
+				container.Items[index].Quantity--
+			} else {
+				container.Items = append(container.Items[:index], container.Items[index+1:]...)
+			}
+			container.Version++
+			return
+		}
+	})
+	if ticks > 0 {
+		s.markChanged(fuelHandle)
+	}
+	return ticks
+}
+
+// heat advances overcook of finished output, then recipe progress of input items.
+// Output made during the step starts with the overcook it would have gained after finishing.
+func (s *processorSimulation) heat(state *components.ProcessorBehaviorState, step uint64) {
+	outputHandle := s.container(processorOutputInventoryKey)
+	if outputHandle != types.InvalidHandle {
+		s.overcook(state, outputHandle, step)
+	}
+
+	inputHandle := s.container(processorInputInventoryKey)
+	if inputHandle == types.InvalidHandle {
+		return
+	}
+	input, hasInput := ecs.GetComponent[components.InventoryContainer](s.world, inputHandle)
+	if !hasInput {
+		return
+	}
+	// convert removes items from the input grid: iterate over a copy.
+	items := append([]components.InvItem(nil), input.Items...)
+	for _, item := range items {
+		recipe, ok := s.recipes.GetForInput(s.config.Machine, s.itemKey(item.TypeID))
+		if !ok {
+			continue
+		}
+		progress := state.Progress[item.ItemID] + step
+		if progress < recipe.TicksRequired {
+			state.Progress[item.ItemID] = progress
+			continue
+		}
+		if outputHandle == types.InvalidHandle || !s.convert(state, inputHandle, outputHandle, item, recipe, progress-recipe.TicksRequired) {
+			// Output grid is full: the item waits done and converts once there is space.
+			state.Progress[item.ItemID] = recipe.TicksRequired
+			continue
+		}
+		delete(state.Progress, item.ItemID)
+	}
+	if outputHandle != types.InvalidHandle {
+		s.burnOvercooked(state, outputHandle)
+	}
+}
+
+// convert replaces one input item with recipe output at the input quality.
+// Nothing changes unless every output item fits into the output grid.
+func (s *processorSimulation) convert(
+	state *components.ProcessorBehaviorState,
+	inputHandle types.Handle,
+	outputHandle types.Handle,
+	item components.InvItem,
+	recipe *processdefs.ProcessDef,
+	overcook uint64,
+) bool {
+	outputDef, ok := s.items.GetByKey(recipe.Output.ItemKey)
+	if !ok || s.deps.IDAllocator == nil {
+		return false
+	}
+	count := recipe.Output.Count * max(item.Quantity, 1)
+	created := make([]components.InvItem, 0, count)
+	ecs.WithComponent(s.world, outputHandle, func(container *components.InventoryContainer) {
+		items := container.Items
+		for range count {
+			newItem := components.InvItem{
+				TypeID:   uint32(outputDef.DefID),
+				Resource: outputDef.ResolveResource(false),
+				Quality:  item.Quality,
+				Quantity: 1,
+				W:        uint8(outputDef.Size.W),
+				H:        uint8(outputDef.Size.H),
+			}
+			found, x, y := s.placement.FindFreeSpace(container, newItem.W, newItem.H)
+			if !found {
+				container.Items = items
+				created = created[:0]
+				return
+			}
+			newItem.ItemID = s.deps.IDAllocator.GetFreeID()
+			newItem.X = x
+			newItem.Y = y
+			container.Items = append(container.Items, newItem)
+			created = append(created, newItem)
+		}
+		container.Version++
+	})
+	if len(created) == 0 {
+		return false
+	}
+	ecs.WithComponent(s.world, inputHandle, func(container *components.InventoryContainer) {
+		for index := range container.Items {
+			if container.Items[index].ItemID == item.ItemID {
+				container.Items = append(container.Items[:index], container.Items[index+1:]...)
+				container.Version++
+				return
+			}
+		}
+	})
+	if recipe.BurnAfterTicks > 0 {
+		for _, newItem := range created {
+			state.Overcook[newItem.ItemID] = overcook
+		}
+	}
+	s.markChanged(inputHandle)
+	s.markChanged(outputHandle)
+	return true
+}
+
+func (s *processorSimulation) overcook(state *components.ProcessorBehaviorState, outputHandle types.Handle, step uint64) {
+	output, hasOutput := ecs.GetComponent[components.InventoryContainer](s.world, outputHandle)
+	if !hasOutput {
+		return
+	}
+	for _, item := range output.Items {
+		recipe, ok := s.recipes.GetForOutput(s.config.Machine, s.itemKey(item.TypeID))
+		if !ok || recipe.BurnAfterTicks == 0 {
+			continue
+		}
+		state.Overcook[item.ItemID] += step
+	}
+}
+
+// burnOvercooked turns output left too long in the machine into the recipe waste item,
+// or removes it when the recipe has no waste.
+func (s *processorSimulation) burnOvercooked(state *components.ProcessorBehaviorState, outputHandle types.Handle) {
+	burnt := false
+	ecs.WithComponent(s.world, outputHandle, func(container *components.InventoryContainer) {
+		kept := container.Items[:0]
+		var waste []components.InvItem
+		for _, item := range container.Items {
+			recipe, ok := s.recipes.GetForOutput(s.config.Machine, s.itemKey(item.TypeID))
+			if !ok || recipe.BurnAfterTicks == 0 || state.Overcook[item.ItemID] < recipe.BurnAfterTicks {
+				kept = append(kept, item)
+				continue
+			}
+			delete(state.Overcook, item.ItemID)
+			burnt = true
+			if wasteItem, ok := s.wasteItem(item, recipe.WasteItemKey); ok {
+				waste = append(waste, wasteItem)
+			}
+		}
+		container.Items = kept
+		for _, wasteItem := range waste {
+			// Waste takes the burnt item's place when it fits there, otherwise any free space.
+			if placed := s.placement.CheckGridPlacement(container, &wasteItem, wasteItem.X, wasteItem.Y, false); !placed.Success {
+				found, x, y := s.placement.FindFreeSpace(container, wasteItem.W, wasteItem.H)
+				if !found {
+					continue
+				}
+				wasteItem.X = x
+				wasteItem.Y = y
+			}
+			container.Items = append(container.Items, wasteItem)
+		}
+		if burnt {
+			container.Version++
+		}
+	})
+	if burnt {
+		s.markChanged(outputHandle)
+	}
+}
+
+func (s *processorSimulation) wasteItem(burnt components.InvItem, wasteItemKey string) (components.InvItem, bool) {
+	if wasteItemKey == "" || s.deps.IDAllocator == nil {
+		return components.InvItem{}, false
+	}
+	wasteDef, ok := s.items.GetByKey(wasteItemKey)
+	if !ok {
+		resolveLogger(s.deps.Logger).Warn("processor: unknown waste item", zap.String("item_key", wasteItemKey))
+		return components.InvItem{}, false
+	}
+	return components.InvItem{
+		ItemID:   s.deps.IDAllocator.GetFreeID(),
+		TypeID:   uint32(wasteDef.DefID),
+		Resource: wasteDef.ResolveResource(false),
+		Quality:  burnt.Quality,
+		Quantity: 1,
+		W:        uint8(wasteDef.Size.W),
+		H:        uint8(wasteDef.Size.H),
+		X:        burnt.X,
+		Y:        burnt.Y,
+	}, true
+}
+
+// prune drops progress of items no longer in the machine (taken out by players).
+func (s *processorSimulation) prune(state *components.ProcessorBehaviorState) {
+	pruneItemTicks(s.world, s.container(processorInputInventoryKey), state.Progress)
+	pruneItemTicks(s.world, s.container(processorOutputInventoryKey), state.Overcook)
+}
+
+func pruneItemTicks(world *ecs.World, containerHandle types.Handle, ticks map[types.EntityID]uint64) {
+	if len(ticks) == 0 {
+		return
+	}
+	present := make(map[types.EntityID]struct{})
+	if containerHandle != types.InvalidHandle {
+		if container, ok := ecs.GetComponent[components.InventoryContainer](world, containerHandle); ok {
+			for _, item := range container.Items {
+				present[item.ItemID] = struct{}{}
+			}
+		}
+	}
+	for itemID := range ticks {
+		if _, ok := present[itemID]; !ok {
+			delete(ticks, itemID)
+		}
+	}
+}
+
+func cloneItemTicks(ticks map[types.EntityID]uint64) map[types.EntityID]uint64 {
+	if len(ticks) == 0 {
+		return nil
+	}
+	out := make(map[types.EntityID]uint64, len(ticks))
+	for itemID, value := range ticks {
+		out[itemID] = value
+	}
+	return out
+}
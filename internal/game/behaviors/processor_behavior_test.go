@@ -0,0 +1,407 @@
+package behaviors
+
+import (
+	"strings"
+	"testing"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/timeutil"
+	"origin/internal/types"
+	"origin/internal/weather"
+)
+
+const (
+	testProcWoodDefID     = 9401
+	testProcBranchDefID   = 9402
+	testProcCharcoalDefID = 9403
+	testProcAshDefID      = 9404
+	testProcClayDefID     = 9405
+	testProcBrickDefID    = 9406
+)
+
+func processorTestItemRegistry() *itemdefs.Registry {
+	return itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: testProcWoodDefID, Key: "proc_wood", Name: "Wood", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testProcBranchDefID, Key: "proc_branch", Name: "Branch", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testProcCharcoalDefID, Key: "proc_charcoal", Name: "Charcoal", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testProcAshDefID, Key: "proc_ash", Name: "Ash", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testProcClayDefID, Key: "proc_clay", Name: "Clay", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testProcBrickDefID, Key: "proc_brick", Name: "Brick", Size: itemdefs.Size{W: 1, H: 1}},
+	})
+}
+
+func setupProcessorTestRegistries(t *testing.T, kilnDefID int) {
+	t.Helper()
+
+	previousObjectRegistry := objectdefs.Global()
+	previousItemRegistry := itemdefs.Global()
+	previousProcessRegistry := processdefs.Global()
+	t.Cleanup(func() {
+		objectdefs.SetGlobalForTesting(previousObjectRegistry)
+		itemdefs.SetGlobalForTesting(previousItemRegistry)
+		processdefs.SetGlobalForTesting(previousProcessRegistry)
+	})
+
+	kiln := objectdefs.ObjectDef{DefID: kilnDefID, Key: "proc_test_kiln", IsStatic: true}
+	kiln.SetProcessorBehaviorConfig(contracts.ProcessorBehaviorConfig{
+		Machine: "test_kiln",
+		Fuel:    []contracts.ProcessorFuelConfig{{ItemKey: "proc_branch", Ticks: 100}},
+	})
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{kiln}))
+	itemdefs.SetGlobalForTesting(processorTestItemRegistry())
+	processdefs.SetGlobalForTesting(processdefs.NewRegistry([]processdefs.ProcessDef{
+		{
+			DefID:          1,
+			Key:            "proc_charcoal",
+			Machine:        "test_kiln",
+			Input:          processdefs.ProcessInput{ItemKey: "proc_wood"},
+			Output:         processdefs.ProcessOutput{ItemKey: "proc_charcoal", Count: 2},
+			TicksRequired:  50,
+			BurnAfterTicks: 30,
+			WasteItemKey:   "proc_ash",
+		},
+		{
+			DefID:         2,
+			Key:           "proc_brick",
+			Machine:       "test_kiln",
+			Input:         processdefs.ProcessInput{ItemKey: "proc_clay"},
+			Output:        processdefs.ProcessOutput{ItemKey: "proc_brick", Count: 1},
+			TicksRequired: 80,
+		},
+	}))
+}
+
+type processorTestKiln struct {
+	world  *ecs.World
+	id     types.EntityID
+	handle types.Handle
+	grids  map[uint32]types.Handle
+}
+
+func spawnProcessorTestKiln(kilnDefID int, entityID types.EntityID, input []components.InvItem, fuel []components.InvItem) *processorTestKiln {
+	world := ecs.NewWorldForTesting()
+	handle := world.Spawn(entityID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: uint32(kilnDefID), IsStatic: true})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	kiln := &processorTestKiln{world: world, id: entityID, handle: handle, grids: make(map[uint32]types.Handle)}
+	for key, items := range map[uint32][]components.InvItem{
+		processorInputInventoryKey:  input,
+		processorOutputInventoryKey: nil,
+		processorFuelInventoryKey:   fuel,
+	} {
+		gridHandle := world.SpawnWithoutExternalID()
+		ecs.AddComponent(world, gridHandle, components.InventoryContainer{
+			OwnerID: entityID,
+			Kind:    constt.InventoryGrid,
+			Key:     key,
+			Width:   4,
+			Height:  2,
+			Items:   items,
+		})
+		ecs.GetResource[ecs.InventoryRefIndex](world).Add(constt.InventoryGrid, entityID, key, gridHandle)
+		kiln.grids[key] = gridHandle
+	}
+	return kiln
+}
+
+func (k *processorTestKiln) items(key uint32) []components.InvItem {
+	container, _ := ecs.GetComponent[components.InventoryContainer](k.world, k.grids[key])
+	return container.Items
+}
+
+func (k *processorTestKiln) state() components.ProcessorBehaviorState {
+	return loadProcessorState(k.world, k.handle)
+}
+
+func (k *processorTestKiln) execute(t *testing.T, actionID string, nowTick uint64, deps *contracts.ExecutionDeps) contracts.BehaviorResult {
+	t.Helper()
+	*ecs.GetResource[ecs.TimeState](k.world) = ecs.TimeState{Tick: nowTick}
+	return processorBehavior{}.ExecuteAction(&contracts.BehaviorActionExecuteContext{
+		World:        k.world,
+		TargetID:     k.id,
+		TargetHandle: k.handle,
+		ActionID:     actionID,
+		Deps:         deps,
+	})
+}
+
+func (k *processorTestKiln) tick(t *testing.T, kilnDefID int, currentTick uint64, deps *contracts.ExecutionDeps) contracts.BehaviorTickResult {
+	t.Helper()
+	result, err := processorBehavior{}.OnScheduledTick(&contracts.BehaviorTickContext{
+		World:       k.world,
+		Handle:      k.handle,
+		EntityID:    k.id,
+		EntityType:  uint32(kilnDefID),
+		BehaviorKey: processorBehaviorKey,
+		CurrentTick: currentTick,
+		Deps:        deps,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
+
+func countItemsOfType(items []components.InvItem, typeID uint32) int {
+	count := 0
+	for _, item := range items {
+		if item.TypeID == typeID {
+			count++
+		}
+	}
+	return count
+}
+
+func TestProcessorValidateAndApplyDefConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "valid", raw: `{"machine":"kiln","fuel":[{"itemKey":"proc_branch","ticks":600}]}`},
+		{name: "missing machine", raw: `{"fuel":[{"itemKey":"proc_branch","ticks":600}]}`, wantErr: "processor.machine must not be empty"},
+		{name: "missing fuel", raw: `{"machine":"kiln"}`, wantErr: "processor.fuel is required"},
+		{name: "unknown fuel", raw: `{"machine":"kiln","fuel":[{"itemKey":"coal","ticks":600}]}`, wantErr: `unknown item key "coal"`},
+		{name: "zero ticks", raw: `{"machine":"kiln","fuel":[{"itemKey":"proc_branch"}]}`, wantErr: "fuel[0].ticks must be > 0"},
+		{
+			name:    "duplicate fuel",
+			raw:     `{"machine":"kiln","fuel":[{"itemKey":"proc_branch","ticks":1},{"itemKey":"proc_branch","ticks":2}]}`,
+			wantErr: `duplicate "proc_branch"`,
+		},
+		{name: "unknown field", raw: `{"machine":"kiln","fuel":[],"heat":1}`, wantErr: "invalid processor config"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			def := &objectdefs.ObjectDef{}
+			_, err := processorBehavior{}.ValidateAndApplyDefConfig(&contracts.BehaviorDefConfigContext{
+				BehaviorKey: processorBehaviorKey,
+				RawConfig:   []byte(testCase.raw),
+				Def:         def,
+				Items:       processorTestItemRegistry(),
+			})
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if def.ProcessorConfig == nil || def.ProcessorConfig.Machine != "kiln" || def.ProcessorConfig.FuelTicks("proc_branch") != 600 {
+					t.Fatalf("processor config not applied: %+v", def.ProcessorConfig)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestProcessorLight_RequiresFuelAndBurnsOneUnit(t *testing.T) {
+	kilnDefID := 7501
+	setupProcessorTestRegistries(t, kilnDefID)
+
+	kiln := spawnProcessorTestKiln(kilnDefID, types.EntityID(75010), nil, nil)
+	result := kiln.execute(t, actionLight, 5, nil)
+	if result.OK || result.ReasonCode != "processor_no_fuel" {
+		t.Fatalf("expected processor_no_fuel, got %+v", result)
+	}
+	if kiln.state().Lit {
+		t.Fatalf("kiln must stay unlit without fuel")
+	}
+
+	kiln = spawnProcessorTestKiln(kilnDefID, types.EntityID(75011), nil, []components.InvItem{
+		{ItemID: 1, TypeID: testProcBranchDefID, Quantity: 2, W: 1, H: 1},
+	})
+	if actions := (processorBehavior{}).ProvideActions(&contracts.BehaviorActionListContext{World: kiln.world, TargetHandle: kiln.handle}); !containsAction(actions, actionLight) {
+		t.Fatalf("expected light action on unlit kiln, got %+v", actions)
+	}
+	if result := kiln.execute(t, actionLight, 5, nil); !result.OK {
+		t.Fatalf("expected light to succeed, got %+v", result)
+	}
+	state := kiln.state()
+	if !state.Lit || state.FuelTicksLeft != 100 || state.LastTick != 5 {
+		t.Fatalf("unexpected state after light: %+v", state)
+	}
+	if fuel := kiln.items(processorFuelInventoryKey); len(fuel) != 1 || fuel[0].Quantity != 1 {
+		t.Fatalf("expected one fuel unit burnt, got %+v", fuel)
+	}
+	if pending := ecs.GetResource[ecs.BehaviorTickSchedule](kiln.world).PendingCount(); pending != 1 {
+		t.Fatalf("expected scheduled processor tick, got %d", pending)
+	}
+	if actions := (processorBehavior{}).ProvideActions(&contracts.BehaviorActionListContext{World: kiln.world, TargetHandle: kiln.handle}); !containsAction(actions, actionExtinguish) || containsAction(actions, actionLight) {
+		t.Fatalf("expected only extinguish on lit kiln, got %+v", actions)
+	}
+
+	internalState, _ := ecs.GetComponent[components.ObjectInternalState](kiln.world, kiln.handle)
+	prevState, _ := internalState.State.(*components.RuntimeObjectState)
+	runtime := processorBehavior{}.ApplyRuntime(&contracts.BehaviorRuntimeContext{World: kiln.world, PrevState: prevState})
+	if len(runtime.Flags) != 1 || runtime.Flags[0] != processorLitFlag {
+		t.Fatalf("expected lit flag, got %+v", runtime.Flags)
+	}
+}
+
+func TestProcessorTick_ConvertsOvercooksAndGoesOut(t *testing.T) {
+	kilnDefID := 7502
+	setupProcessorTestRegistries(t, kilnDefID)
+
+	kiln := spawnProcessorTestKiln(kilnDefID, types.EntityID(75020), []components.InvItem{
+		{ItemID: 10, TypeID: testProcWoodDefID, Quality: 30, Quantity: 1, W: 1, H: 1},
+		{ItemID: 11, TypeID: testProcClayDefID, Quality: 12, Quantity: 1, W: 1, H: 1, X: 1},
+	}, []components.InvItem{
+		{ItemID: 1, TypeID: testProcBranchDefID, Quantity: 1, W: 1, H: 1},
+	})
+	var synced int
+	deps := &contracts.ExecutionDeps{
+		IDAllocator: &testIDAllocator{next: 500},
+		SyncInventories: func(_ *ecs.World, containerHandles []types.Handle) {
+			synced += len(containerHandles)
+		},
+	}
+	if result := kiln.execute(t, actionLight, 0, deps); !result.OK {
+		t.Fatalf("expected light to succeed, got %+v", result)
+	}
+
+	kiln.tick(t, kilnDefID, 60, deps)
+	output := kiln.items(processorOutputInventoryKey)
+	if countItemsOfType(output, testProcCharcoalDefID) != 2 || output[0].Quality != 30 {
+		t.Fatalf("expected two charcoal at wood quality, got %+v", output)
+	}
+	state := kiln.state()
+	if state.Progress[11] != 60 || state.Overcook[output[0].ItemID] != 10 {
+		t.Fatalf("unexpected progress after conversion: %+v", state)
+	}
+	if synced == 0 {
+		t.Fatalf("expected changed inventories to be synced")
+	}
+
+	kiln.tick(t, kilnDefID, 85, deps)
+	output = kiln.items(processorOutputInventoryKey)
+	if countItemsOfType(output, testProcAshDefID) != 2 || countItemsOfType(output, testProcCharcoalDefID) != 0 {
+		t.Fatalf("expected overcooked charcoal to turn into ash, got %+v", output)
+	}
+	if countItemsOfType(output, testProcBrickDefID) != 1 || len(kiln.items(processorInputInventoryKey)) != 0 {
+		t.Fatalf("expected clay converted into brick, got output %+v", output)
+	}
+
+	if result := kiln.tick(t, kilnDefID, 150, deps); !result.StateChanged {
+		t.Fatalf("expected state change when fuel runs out")
+	}
+	if state := kiln.state(); state.Lit || state.FuelTicksLeft != 0 {
+		t.Fatalf("expected kiln to go out, got %+v", state)
+	}
+	if pending := ecs.GetResource[ecs.BehaviorTickSchedule](kiln.world).PendingCount(); pending != 0 {
+		t.Fatalf("expected no pending ticks after going out, got %d", pending)
+	}
+}
+
+func TestProcessorExtinguish_KeepsFuelAndProgress(t *testing.T) {
+	kilnDefID := 7503
+	setupProcessorTestRegistries(t, kilnDefID)
+
+	kiln := spawnProcessorTestKiln(kilnDefID, types.EntityID(75030), []components.InvItem{
+		{ItemID: 11, TypeID: testProcClayDefID, Quality: 12, Quantity: 1, W: 1, H: 1},
+	}, []components.InvItem{
+		{ItemID: 1, TypeID: testProcBranchDefID, Quantity: 1, W: 1, H: 1},
+	})
+	kiln.execute(t, actionLight, 0, nil)
+	if result := kiln.execute(t, actionExtinguish, 40, nil); !result.OK {
+		t.Fatalf("expected extinguish to succeed, got %+v", result)
+	}
+	state := kiln.state()
+	if state.Lit || state.FuelTicksLeft != 60 || state.Progress[11] != 40 {
+		t.Fatalf("unexpected state after extinguish: %+v", state)
+	}
+	if pending := ecs.GetResource[ecs.BehaviorTickSchedule](kiln.world).PendingCount(); pending != 0 {
+		t.Fatalf("expected no pending ticks after extinguish, got %d", pending)
+	}
+
+	// Relighting uses the rest of the burning unit instead of a new one.
+	kiln.execute(t, actionLight, 100, nil)
+	if state := kiln.state(); !state.Lit || state.FuelTicksLeft != 60 || state.LastTick != 100 {
+		t.Fatalf("unexpected state after relight: %+v", state)
+	}
+}
+
+func TestProcessorInitObject_RestoreSchedulesCatchUpWhenLit(t *testing.T) {
+	kilnDefID := 7504
+	setupProcessorTestRegistries(t, kilnDefID)
+
+	for _, lit := range []bool{false, true} {
+		kiln := spawnProcessorTestKiln(kilnDefID, types.EntityID(75040), nil, nil)
+		storeProcessorState(kiln.world, kiln.handle, components.ProcessorBehaviorState{Lit: lit, FuelTicksLeft: 50, LastTick: 10})
+		*ecs.GetResource[ecs.TimeState](kiln.world) = ecs.TimeState{Tick: 500}
+
+		err := processorBehavior{}.InitObject(&contracts.BehaviorObjectInitContext{
+			World:      kiln.world,
+			Handle:     kiln.handle,
+			EntityID:   kiln.id,
+			EntityType: uint32(kilnDefID),
+			Reason:     contracts.ObjectBehaviorInitReasonRestore,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		wantPending := 0
+		if lit {
+			wantPending = 1
+		}
+		if pending := ecs.GetResource[ecs.BehaviorTickSchedule](kiln.world).PendingCount(); pending != wantPending {
+			t.Fatalf("lit=%v: expected %d pending ticks, got %d", lit, wantPending, pending)
+		}
+	}
+}
+
+func TestProcessorTick_StormPutsOutFire(t *testing.T) {
+	kilnDefID := 7504
+	setupProcessorTestRegistries(t, kilnDefID)
+
+	kiln := spawnProcessorTestKiln(kilnDefID, types.EntityID(75040), nil, []components.InvItem{
+		{ItemID: 1, TypeID: testProcBranchDefID, Quantity: 2, W: 1, H: 1},
+	})
+	if result := kiln.execute(t, actionLight, 0, nil); !result.OK {
+		t.Fatalf("expected light to succeed in clear weather, got %+v", result)
+	}
+
+	model := weather.NewModel(1, 1)
+	worldTime := timeutil.WorldTime{Day: 1, SecondOfDay: 12 * 3600, DayLengthSeconds: 24 * 3600, Season: timeutil.SeasonSummer, SeasonDays: 7}
+	storm, found := types.ChunkCoord{}, false
+	for x := 0; x < 1000 && !found; x++ {
+		storm = types.ChunkCoord{X: x}
+		found = model.At(storm, worldTime) == weather.Storm
+	}
+	if !found {
+		t.Fatalf("no storm chunk found")
+	}
+	ecs.AddComponent(kiln.world, kiln.handle, components.Transform{
+		X: float64(storm.X*constt.ChunkWorldSize + 1),
+		Y: float64(storm.Y*constt.ChunkWorldSize + 1),
+	})
+	weatherState := ecs.GetResource[ecs.WeatherState](kiln.world)
+	weatherState.SetModel(model)
+	weatherState.Update(worldTime, []types.ChunkCoord{storm})
+
+	if result := kiln.tick(t, kilnDefID, 20, nil); !result.StateChanged {
+		t.Fatalf("expected state change when the storm puts the fire out")
+	}
+	if state := kiln.state(); state.Lit || state.FuelTicksLeft != 80 {
+		t.Fatalf("expected kiln out with unburnt fuel kept, got %+v", state)
+	}
+	if pending := ecs.GetResource[ecs.BehaviorTickSchedule](kiln.world).PendingCount(); pending != 0 {
+		t.Fatalf("expected no pending ticks after storm, got %d", pending)
+	}
+
+	result := kiln.execute(t, actionLight, 30, nil)
+	if result.OK || result.ReasonCode != "processor_storm" {
+		t.Fatalf("expected processor_storm, got %+v", result)
+	}
+	if fuel := kiln.items(processorFuelInventoryKey); len(fuel) != 1 || fuel[0].Quantity != 1 {
+		t.Fatalf("expected no fuel burnt while lighting in a storm, got %+v", fuel)
+	}
+}
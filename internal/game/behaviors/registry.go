@@ -140,9 +140,12 @@ func DefaultRegistry() (*Registry, error) {
 			buildBehavior{},
 			liftBehavior{},
 			treeBehavior{},
+			cropBehavior{},
+			processorBehavior{},
 			takeBehavior{},
 			playerBehavior{},
 			playerDeathBehavior{},
+			animalBehavior{},
 		)
 	})
 	return defaultRegistry, defaultRegistryErr
@@ -21,6 +21,8 @@ type takeBehavior struct{}
 
 func (takeBehavior) Key() string { return takeBehaviorKey }
 
+func (takeBehavior) DefConfigPrototype() any { return contracts.TakeBehaviorConfig{} }
+
 func (takeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("take def config context is nil")
@@ -39,7 +41,7 @@ func (takeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigCo
 
 	seenIDs := make(map[string]int, len(cfg.Items))
 	for index, item := range cfg.Items {
-		if err := validateTakeBehaviorItemConfig(index, item); err != nil {
+		if err := validateTakeBehaviorItemConfig(index, item, ctx.ItemRegistry()); err != nil {
 			return 0, err
 		}
 		itemID := strings.TrimSpace(item.ID)
@@ -56,7 +58,7 @@ func (takeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigCo
 	return cfg.Priority, nil
 }
 
-func validateTakeBehaviorItemConfig(index int, item contracts.TakeConfig) error {
+func validateTakeBehaviorItemConfig(index int, item contracts.TakeConfig, itemRegistry *itemdefs.Registry) error {
 	itemID := strings.TrimSpace(item.ID)
 	if itemID == "" {
 		return fmt.Errorf("take.items[%d].id must not be empty", index)
@@ -72,7 +74,6 @@ func validateTakeBehaviorItemConfig(index int, item contracts.TakeConfig) error
 		return fmt.Errorf("take.items[%d].itemDefKey must not be empty", index)
 	}
 
-	itemRegistry := itemdefs.Global()
 	if itemRegistry == nil {
 		return fmt.Errorf("take.items[%d].itemDefKey validation requires loaded item defs", index)
 	}
@@ -105,6 +106,9 @@ func (takeBehavior) ProvideActions(ctx *contracts.BehaviorActionListContext) []c
 		if takenCountForAction(taken, itemID) >= item.Count {
 			continue
 		}
+		if !takeToolEquipped(ctx.World, ctx.PlayerID, &item) {
+			continue
+		}
 		actions = append(actions, contracts.ContextAction{
 			ActionID: itemID,
 			Title:    strings.TrimSpace(item.Name),
@@ -140,6 +144,9 @@ func (takeBehavior) ValidateAction(ctx *contracts.BehaviorActionValidateContext)
 	if takenCountForAction(takeCountsFromState(ctx.World, ctx.TargetHandle), actionID) >= item.Count {
 		return contracts.BehaviorResult{OK: false}
 	}
+	if !takeToolEquipped(ctx.World, ctx.PlayerID, item) {
+		return contracts.BehaviorResult{OK: false}
+	}
 
 	if ctx.Phase == contracts.BehaviorValidationPhaseExecute {
 		if _, exists := ecs.GetComponent[components.ActiveCyclicAction](ctx.World, ctx.PlayerHandle); exists {
@@ -182,6 +189,9 @@ func (takeBehavior) ExecuteAction(ctx *contracts.BehaviorActionExecuteContext) c
 	if takenCountForAction(takeCountsFromState(ctx.World, ctx.TargetHandle), actionID) >= item.Count {
 		return contracts.BehaviorResult{OK: false}
 	}
+	if !takeToolEquipped(ctx.World, ctx.PlayerID, item) {
+		return contracts.BehaviorResult{OK: false}
+	}
 
 	nowTick := ecs.GetResource[ecs.TimeState](ctx.World).Tick
 	ecs.AddComponent(ctx.World, ctx.PlayerHandle, components.ActiveCyclicAction{
@@ -242,6 +252,9 @@ func (takeBehavior) OnCycleComplete(ctx *contracts.BehaviorCycleContext) contrac
 		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "LOW_STAMINA")
 		return contracts.BehaviorCycleDecisionCanceled
 	}
+	if !wearCycleTool(ctx, deps, item.ToolTag) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
 
 	itemKey := strings.TrimSpace(item.ItemDefKey)
 	if itemKey == "" {
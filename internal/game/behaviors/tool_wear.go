@@ -0,0 +1,50 @@
+package behaviors
+
+import (
+	"strings"
+
+	"origin/internal/ecs"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+// wearCycleTool wears the equipped tool with the tag by one cycle of work and alerts the player
+// when it runs low or breaks. A broken tool still counts for the cycle that broke it.
+// Returns false when no such tool is equipped. An empty tag needs no tool.
+func wearCycleTool(ctx *contracts.BehaviorCycleContext, deps contracts.ExecutionDeps, tag string) bool {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return true
+	}
+	if deps.WearTool == nil {
+		return playerHasEquippedTag(ctx.World, ctx.PlayerID, tag)
+	}
+
+	outcome := deps.WearTool(ctx.World, ctx.PlayerID, ctx.PlayerHandle, tag)
+	if !outcome.Found {
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "TOOL_REQUIRED")
+		return false
+	}
+	switch {
+	case outcome.Broken:
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "TOOL_BROKEN")
+	case outcome.Max > 0 && outcome.Remaining == ToolLowDurability(outcome.Max):
+		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "TOOL_LOW_DURABILITY")
+	}
+	return true
+}
+
+// ToolLowDurability is the remaining durability at which the player is warned once: 10% of max.
+func ToolLowDurability(maxDurability uint32) uint32 {
+	return max(maxDurability/10, 1)
+}
+
+// takeToolEquipped reports whether the player has the tool a take entry requires.
+func takeToolEquipped(world *ecs.World, playerID types.EntityID, takeCfg *objectdefs.TakeConfig) bool {
+	if takeCfg == nil {
+		return false
+	}
+	tag := strings.TrimSpace(takeCfg.ToolTag)
+	return tag == "" || playerHasEquippedTag(world, playerID, tag)
+}
@@ -0,0 +1,151 @@
+package behaviors
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+func wearToolStub(outcome contracts.ToolWearOutcome, calls *int) contracts.WearToolFn {
+	return func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string) contracts.ToolWearOutcome {
+		*calls++
+		return outcome
+	}
+}
+
+func TestWearCycleTool_Alerts(t *testing.T) {
+	cases := []struct {
+		name      string
+		outcome   contracts.ToolWearOutcome
+		wantOK    bool
+		wantAlert string
+	}{
+		{name: "missing", outcome: contracts.ToolWearOutcome{}, wantOK: false, wantAlert: "TOOL_REQUIRED"},
+		{name: "worn", outcome: contracts.ToolWearOutcome{Found: true, Remaining: 50, Max: 100}, wantOK: true},
+		{name: "low", outcome: contracts.ToolWearOutcome{Found: true, Remaining: 10, Max: 100}, wantOK: true, wantAlert: "TOOL_LOW_DURABILITY"},
+		{name: "broken", outcome: contracts.ToolWearOutcome{Found: true, Max: 100, Broken: true}, wantOK: true, wantAlert: "TOOL_BROKEN"},
+		{name: "unbreakable", outcome: contracts.ToolWearOutcome{Found: true}, wantOK: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := &testMiniAlertSender{}
+			calls := 0
+			ok := wearCycleTool(&contracts.BehaviorCycleContext{
+				World:    ecs.NewWorldForTesting(),
+				PlayerID: types.EntityID(100),
+			}, contracts.ExecutionDeps{
+				Alerts:   sender,
+				WearTool: wearToolStub(tc.outcome, &calls),
+			}, "axe")
+			if ok != tc.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tc.wantOK, ok)
+			}
+			if calls != 1 {
+				t.Fatalf("expected one wear call, got %d", calls)
+			}
+			if tc.wantAlert == "" {
+				if len(sender.alerts) != 0 {
+					t.Fatalf("expected no alerts, got %q", sender.alerts[0].ReasonCode)
+				}
+				return
+			}
+			if len(sender.alerts) != 1 || sender.alerts[0].ReasonCode != tc.wantAlert {
+				t.Fatalf("expected %s alert, got %v", tc.wantAlert, sender.alerts)
+			}
+		})
+	}
+}
+
+func TestWearCycleTool_EmptyTagNeedsNoTool(t *testing.T) {
+	calls := 0
+	ok := wearCycleTool(&contracts.BehaviorCycleContext{
+		World: ecs.NewWorldForTesting(),
+	}, contracts.ExecutionDeps{
+		WearTool: wearToolStub(contracts.ToolWearOutcome{}, &calls),
+	}, " ")
+	if !ok {
+		t.Fatalf("expected cycle without tool tag to pass")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no wear call for empty tag, got %d", calls)
+	}
+}
+
+func TestOnTakeCycleComplete_CancelsWithoutRequiredTool(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(9301)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Movement{
+			Mode:  constt.Walk,
+			State: constt.StateInteracting,
+			Speed: constt.PlayerSpeed,
+		})
+		ecs.AddComponent(w, h, components.CharacterProfile{
+			Attributes: characterattrs.Default(),
+		})
+		ecs.AddComponent(w, h, components.EntityStats{
+			Stamina: 150,
+			Energy:  1000,
+		})
+	})
+	targetID := types.EntityID(9302)
+	targetHandle := world.Spawn(targetID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, targetHandle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, treeBehaviorKey, &components.TreeBehaviorState{
+			Stage: 1,
+		})
+	})
+
+	cfg := &objectdefs.TreeBehaviorConfig{
+		Stages: []objectdefs.TreeStageConfig{
+			{
+				ChopPointsTotal: 1,
+				StageDuration:   60,
+				AllowChop:       true,
+				Take: []objectdefs.TakeConfig{
+					{ID: "take_bark", Name: "Take Bark", ItemDefKey: "bark", Count: 1, ToolTag: "knife"},
+				},
+			},
+		},
+	}
+
+	sender := &testMiniAlertSender{}
+	wearCalls := 0
+	giveCalls := 0
+	decision := onTakeCycleComplete(&contracts.BehaviorCycleContext{
+		World:        world,
+		PlayerID:     playerID,
+		PlayerHandle: playerHandle,
+		TargetID:     targetID,
+		TargetHandle: targetHandle,
+		ActionID:     "take_bark",
+	}, contracts.ExecutionDeps{
+		Alerts:   sender,
+		WearTool: wearToolStub(contracts.ToolWearOutcome{}, &wearCalls),
+		GiveItem: func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string, _ uint32, _ uint32) contracts.GiveItemOutcome {
+			giveCalls++
+			return contracts.GiveItemOutcome{Success: true}
+		},
+	}, cfg, 10)
+
+	if decision != contracts.BehaviorCycleDecisionCanceled {
+		t.Fatalf("expected canceled decision without tool, got %v", decision)
+	}
+	if wearCalls != 1 {
+		t.Fatalf("expected one wear call, got %d", wearCalls)
+	}
+	if giveCalls != 0 {
+		t.Fatalf("expected no item given without tool, got %d", giveCalls)
+	}
+	if len(sender.alerts) != 1 || sender.alerts[0].ReasonCode != "TOOL_REQUIRED" {
+		t.Fatalf("expected TOOL_REQUIRED alert, got %v", sender.alerts)
+	}
+}
@@ -39,6 +39,8 @@ type treeBehavior struct{}
 
 func (treeBehavior) Key() string { return treeBehaviorKey }
 
+func (treeBehavior) DefConfigPrototype() any { return contracts.TreeBehaviorConfig{} }
+
 func (treeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigContext) (int, error) {
 	if ctx == nil {
 		return 0, fmt.Errorf("tree def config context is nil")
@@ -73,7 +75,7 @@ func (treeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigCo
 		}
 		seenTakeIDs := make(map[string]int, len(stage.Take))
 		for takeIdx, takeCfg := range stage.Take {
-			if err := validateTakeConfig(idx, takeIdx, takeCfg); err != nil {
+			if err := validateTakeConfig(treeBehaviorKey, idx, takeIdx, takeCfg, ctx.ItemRegistry()); err != nil {
 				return 0, err
 			}
 			takeID := strings.TrimSpace(takeCfg.ID)
@@ -91,27 +93,26 @@ func (treeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConfigCo
 	return cfg.Priority, nil
 }
 
-func validateTakeConfig(stageIndex int, takeIndex int, takeCfg contracts.TakeConfig) error {
+func validateTakeConfig(behaviorKey string, stageIndex int, takeIndex int, takeCfg contracts.TakeConfig, itemRegistry *itemdefs.Registry) error {
 	takeID := strings.TrimSpace(takeCfg.ID)
 	if takeID == "" {
-		return fmt.Errorf("tree.stages[%d].take[%d].id must not be empty", stageIndex, takeIndex)
+		return fmt.Errorf("%s.stages[%d].take[%d].id must not be empty", behaviorKey, stageIndex, takeIndex)
 	}
 	if strings.TrimSpace(takeCfg.Name) == "" {
-		return fmt.Errorf("tree.stages[%d].take[%d].name must not be empty", stageIndex, takeIndex)
+		return fmt.Errorf("%s.stages[%d].take[%d].name must not be empty", behaviorKey, stageIndex, takeIndex)
 	}
 	if takeCfg.Count <= 0 {
-		return fmt.Errorf("tree.stages[%d].take[%d].count must be > 0", stageIndex, takeIndex)
+		return fmt.Errorf("%s.stages[%d].take[%d].count must be > 0", behaviorKey, stageIndex, takeIndex)
 	}
 	itemKey := strings.TrimSpace(takeCfg.ItemDefKey)
 	if itemKey == "" {
-		return fmt.Errorf("tree.stages[%d].take[%d].itemDefKey must not be empty", stageIndex, takeIndex)
+		return fmt.Errorf("%s.stages[%d].take[%d].itemDefKey must not be empty", behaviorKey, stageIndex, takeIndex)
 	}
-	itemRegistry := itemdefs.Global()
 	if itemRegistry == nil {
-		return fmt.Errorf("tree.stages[%d].take[%d].itemDefKey validation requires loaded item defs", stageIndex, takeIndex)
+		return fmt.Errorf("%s.stages[%d].take[%d].itemDefKey validation requires loaded item defs", behaviorKey, stageIndex, takeIndex)
 	}
 	if _, ok := itemRegistry.GetByKey(itemKey); !ok {
-		return fmt.Errorf("tree.stages[%d].take[%d].itemDefKey unknown item key %q", stageIndex, takeIndex, itemKey)
+		return fmt.Errorf("%s.stages[%d].take[%d].itemDefKey unknown item key %q", behaviorKey, stageIndex, takeIndex, itemKey)
 	}
 	return nil
 }
@@ -159,6 +160,22 @@ func (treeBehavior) ApplyRuntime(ctx *contracts.BehaviorRuntimeContext) contract
 	}
 }
 
+// AppearanceFlags returns one stage flag per configured stage.
+func (treeBehavior) AppearanceFlags(ctx *contracts.BehaviorDefConfigContext) []string {
+	if ctx == nil {
+		return nil
+	}
+	var cfg contracts.TreeBehaviorConfig
+	if err := decodeStrictJSON(ctx.RawConfig, &cfg); err != nil {
+		return nil
+	}
+	flags := make([]string, 0, len(cfg.Stages))
+	for stage := 1; stage <= len(cfg.Stages); stage++ {
+		flags = append(flags, treeStageFlag(stage))
+	}
+	return flags
+}
+
 func (treeBehavior) OnScheduledTick(ctx *contracts.BehaviorTickContext) (contracts.BehaviorTickResult, error) {
 	if ctx == nil || ctx.World == nil {
 		return contracts.BehaviorTickResult{}, nil
@@ -281,6 +298,9 @@ func (treeBehavior) ProvideActions(ctx *contracts.BehaviorActionListContext) []c
 		if takenCountForAction(taken, takeID) >= takeCfg.Count {
 			continue
 		}
+		if !takeToolEquipped(ctx.World, ctx.PlayerID, &takeCfg) {
+			continue
+		}
 		actions = append(actions, contracts.ContextAction{
 			ActionID: takeID,
 			Title:    strings.TrimSpace(takeCfg.Name),
@@ -332,6 +352,9 @@ func (treeBehavior) ValidateAction(ctx *contracts.BehaviorActionValidateContext)
 		if taken >= takeCfg.Count {
 			return contracts.BehaviorResult{OK: false}
 		}
+		if !takeToolEquipped(ctx.World, ctx.PlayerID, takeCfg) {
+			return contracts.BehaviorResult{OK: false}
+		}
 	}
 	if ctx.Phase == contracts.BehaviorValidationPhaseExecute {
 		if _, exists := ecs.GetComponent[components.ActiveCyclicAction](ctx.World, ctx.PlayerHandle); exists {
@@ -470,6 +493,9 @@ func (treeBehavior) OnCycleComplete(ctx *contracts.BehaviorCycleContext) contrac
 		sendWarningMiniAlert(ctx.PlayerID, deps.Alerts, "LOW_STAMINA")
 		return contracts.BehaviorCycleDecisionCanceled
 	}
+	if !wearCycleTool(ctx, deps, chopRequiredTag) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
 
 	remaining := 0
 	completed := false
@@ -561,7 +587,7 @@ func (treeBehavior) OnCycleComplete(ctx *contracts.BehaviorCycleContext) contrac
 			deps,
 		)
 	}
-	forceVisionUpdates(ctx.World, deps.VisionForcer)
+	ForceVisionUpdates(ctx.World, deps.VisionForcer)
 	return contracts.BehaviorCycleDecisionComplete
 }
 
@@ -608,6 +634,9 @@ func onTakeCycleComplete(
 	if taken >= takeCfg.Count {
 		return contracts.BehaviorCycleDecisionComplete
 	}
+	if !wearCycleTool(ctx, deps, takeCfg.ToolTag) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
 
 	outcome := deps.GiveItem(ctx.World, ctx.PlayerID, ctx.PlayerHandle, itemKey, 1, parentQuality)
 	if !outcome.Success {
@@ -741,7 +770,8 @@ func sendWarningMiniAlert(playerID types.EntityID, alerts contracts.MiniAlertSen
 	})
 }
 
-func forceVisionUpdates(world *ecs.World, visionForcer contracts.VisionUpdateForcer) {
+// ForceVisionUpdates refreshes vision of every online character, e.g. after an object appeared or vanished.
+func ForceVisionUpdates(world *ecs.World, visionForcer contracts.VisionUpdateForcer) {
 	if visionForcer == nil || world == nil {
 		return
 	}
@@ -804,12 +834,7 @@ func initializeRestoredTreeState(
 		return
 	}
 
-	catchupLimit := uint64(2000)
-	if policy, ok := ecs.TryGetResource[ecs.BehaviorTickPolicy](world); ok && policy != nil {
-		if policy.CatchUpLimitTicks > 0 {
-			catchupLimit = policy.CatchUpLimitTicks
-		}
-	}
+	catchupLimit := resolveGrowthCatchupLimit(world)
 
 	ecs.WithComponent(world, handle, func(state *components.ObjectInternalState) {
 		treeState, hasTreeState := components.GetBehaviorState[components.TreeBehaviorState](*state, treeBehaviorKey)
@@ -969,40 +994,14 @@ func applyGrowthCatchup(
 	if treeConfig == nil {
 		return currentStage, nextGrowthTick, false
 	}
-	maxStage := growthStageMax(treeConfig)
-	if currentStage >= maxStage || nextGrowthTick == 0 {
-		return currentStage, nextGrowthTick, false
-	}
-
-	effectiveNowTick := nowTick
-	if nowTick > nextGrowthTick && catchupLimit > 0 {
-		limitedNowTick := nextGrowthTick + catchupLimit
-		if limitedNowTick < effectiveNowTick {
-			effectiveNowTick = limitedNowTick
-		}
-	}
-
-	stage := currentStage
-	nextTick := nextGrowthTick
-	changed := false
-
-	for stage < maxStage && nextTick > 0 && nextTick <= effectiveNowTick {
-		stage++
-		changed = true
-		if stage >= maxStage {
-			nextTick = 0
-			break
-		}
-
-		transitionDuration := stageTransitionDuration(treeConfig, stage)
-		if transitionDuration == 0 {
-			nextTick = 0
-			break
-		}
-		nextTick += transitionDuration
-	}
-
-	return stage, nextTick, changed
+	return advanceGrowthStages(
+		growthStageMax(treeConfig),
+		currentStage,
+		nextGrowthTick,
+		nowTick,
+		catchupLimit,
+		func(stage int) uint64 { return stageTransitionDuration(treeConfig, stage) },
+	)
 }
 
 func isChopAllowedAtStage(treeConfig *objectdefs.TreeBehaviorConfig, stage int) bool {
@@ -1087,7 +1086,7 @@ func spawnStageObjects(
 	logger := resolveLogger(deps.Logger)
 	dirX, dirY := resolveLogFallAxisDirection(treeTransform.X, treeTransform.Y, playerTransform.X, playerTransform.Y)
 	for index, rawObjectKey := range objectKeys {
-		objectKey := resolveAxisLogDefKey(strings.TrimSpace(rawObjectKey), dirX, dirY)
+		objectKey := ResolveAxisLogDefKey(strings.TrimSpace(rawObjectKey), dirX, dirY)
 		logDef, ok := objectdefs.Global().GetByKey(objectKey)
 		if !ok {
 			logger.Warn("tree chop: spawned object def not found", zap.String("def_key", objectKey))
@@ -1202,7 +1201,9 @@ func logSpawnPosition(
 	return treeX + dirX*distance, treeY + dirY*distance
 }
 
-func resolveAxisLogDefKey(baseDefKey string, dirX, dirY float64) string {
+// ResolveAxisLogDefKey maps a spawnChopObject key to the log object lying along the fall axis:
+// "log" becomes "log_x" or "log_y", and an explicit axis suffix is flipped to match.
+func ResolveAxisLogDefKey(baseDefKey string, dirX, dirY float64) string {
 	if baseDefKey == "" {
 		return baseDefKey
 	}
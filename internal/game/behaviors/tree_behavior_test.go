@@ -110,9 +110,9 @@ func TestResolveAxisLogDefKey(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got := resolveAxisLogDefKey(testCase.baseKey, testCase.dirX, testCase.dirY)
+			got := ResolveAxisLogDefKey(testCase.baseKey, testCase.dirX, testCase.dirY)
 			if got != testCase.expected {
-				t.Fatalf("resolveAxisLogDefKey(%q, %f, %f): got %q, want %q",
+				t.Fatalf("ResolveAxisLogDefKey(%q, %f, %f): got %q, want %q",
 					testCase.baseKey, testCase.dirX, testCase.dirY, got, testCase.expected)
 			}
 		})
@@ -158,7 +158,7 @@ func TestForceVisionUpdatesForAllAliveCharacters(t *testing.T) {
 	characters.Add(types.EntityID(1002), playerB, time.Time{})
 	characters.Add(types.EntityID(1003), playerDead, time.Time{})
 
-	forceVisionUpdates(world, forcer)
+	ForceVisionUpdates(world, forcer)
 
 	if len(forcer.forced) != 2 {
 		t.Fatalf("expected 2 forced updates, got %d", len(forcer.forced))
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/characterattrs"
+	"origin/internal/ecs/components"
+	"origin/internal/persistence"
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+const (
+	characterAttributesChannelSize  = 1024
+	characterAttributesWriteTimeout = 3 * time.Second
+)
+
+// CharacterAttributesRecorder persists changed character attributes together with
+// the experience (LP) that was spent on them.
+type CharacterAttributesRecorder interface {
+	RecordCharacterAttributes(characterID types.EntityID, values characterattrs.Values, exp components.CharacterExperience)
+}
+
+type characterAttributesEntry struct {
+	characterID types.EntityID
+	values      characterattrs.Values
+	exp         components.CharacterExperience
+}
+
+// CharacterAttributesWriter writes character attributes and experience on a background goroutine.
+// Both columns go out in one statement, so a crash cannot keep the spent LP without the trained point.
+// Entries are written in order, so the last recorded values of a character win.
+// RecordCharacterAttributes never blocks the shard tick; a full queue drops the entry
+// with an error log and the periodic character save picks the values up later.
+type CharacterAttributesWriter struct {
+	db     *persistence.Postgres
+	logger *zap.Logger
+	queue  *backgroundWriter[characterAttributesEntry]
+}
+
+func NewCharacterAttributesWriter(db *persistence.Postgres, logger *zap.Logger) *CharacterAttributesWriter {
+	w := &CharacterAttributesWriter{
+		db:     db,
+		logger: logger,
+	}
+	w.queue = newBackgroundWriter(characterAttributesChannelSize, 1, 0, func(batch []characterAttributesEntry) {
+		for _, entry := range batch {
+			w.write(entry)
+		}
+	})
+	return w
+}
+
+func (w *CharacterAttributesWriter) RecordCharacterAttributes(characterID types.EntityID, values characterattrs.Values, exp components.CharacterExperience) {
+	entry := characterAttributesEntry{characterID: characterID, values: characterattrs.Clone(values), exp: exp}
+	if !w.queue.enqueue(entry) {
+		w.logger.Error("Character attributes queue full, dropping entry",
+			zap.Int64("character_id", int64(characterID)))
+	}
+}
+
+// Stop writes all queued entries and stops the writer.
+func (w *CharacterAttributesWriter) Stop() {
+	w.queue.stop()
+}
+
+func (w *CharacterAttributesWriter) write(entry characterAttributesEntry) {
+	if w.db == nil {
+		return
+	}
+	rawAttributes, err := characterattrs.Marshal(entry.values)
+	if err != nil {
+		w.logger.Error("Failed to marshal character attributes",
+			zap.Int64("character_id", int64(entry.characterID)),
+			zap.Error(err))
+		return
+	}
+	rawExp, err := components.MarshalCharacterExperience(entry.exp)
+	if err != nil {
+		w.logger.Error("Failed to marshal character experience",
+			zap.Int64("character_id", int64(entry.characterID)),
+			zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), characterAttributesWriteTimeout)
+	defer cancel()
+	if err := w.db.Queries().UpdateCharacterAttributesAndExp(ctx, repository.UpdateCharacterAttributesAndExpParams{
+		ID:         int64(entry.characterID),
+		Attributes: rawAttributes,
+		Exp:        rawExp,
+	}); err != nil {
+		w.logger.Error("Failed to write character attributes",
+			zap.Int64("character_id", int64(entry.characterID)),
+			zap.Error(err))
+	}
+}
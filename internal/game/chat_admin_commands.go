@@ -5,6 +5,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	constt "origin/internal/const"
 	"origin/internal/core"
@@ -52,11 +53,19 @@ type ChatAdminCommandHandler struct {
 	chunkProvider         AdminSpawnChunkProvider
 	visionForcer          AdminVisionForcer
 	teleportExecutor      AdminTeleportExecutor
+	globalBroadcaster     GlobalChatBroadcaster
+	roleResolver          AdminRoleResolver
+	auditRecorder         AdminAuditRecorder
+	moderation            AdminModerationService
+	defsReloader          AdminDefsReloader
 	behaviorRegistry      contracts.BehaviorRegistry
 	eventBus              *eventbus.EventBus
 	logger                *zap.Logger
 	lifeDeathFactor       float64
 	allowRevive           bool
+
+	// audit is the entry of the command currently being executed (shard tick only).
+	audit *AdminAuditEntry
 }
 
 // AdminAlertSender sends direct error/warning packets to a single player.
@@ -70,6 +79,12 @@ type AdminTeleportExecutor interface {
 	RequestAdminTeleport(playerID types.EntityID, sourceLayer int, targetX, targetY int, targetLayer *int) error
 }
 
+// GlobalChatBroadcaster delivers server-wide messages on CHAT_CHANNEL_GLOBAL.
+type GlobalChatBroadcaster interface {
+	BroadcastGlobalMessage(fromName, text string)
+	ScheduleGlobalCountdown(in time.Duration, text string)
+}
+
 func NewChatAdminCommandHandler(
 	inventoryExecutor *inventory.InventoryExecutor,
 	inventoryResultSender systems.InventoryResultSender,
@@ -102,6 +117,27 @@ func (h *ChatAdminCommandHandler) SetTeleportExecutor(executor AdminTeleportExec
 	h.teleportExecutor = executor
 }
 
+func (h *ChatAdminCommandHandler) SetGlobalBroadcaster(broadcaster GlobalChatBroadcaster) {
+	h.globalBroadcaster = broadcaster
+}
+
+// SetRoleResolver enables per-command permission checks. Without a resolver everyone has the player role.
+func (h *ChatAdminCommandHandler) SetRoleResolver(resolver AdminRoleResolver) {
+	h.roleResolver = resolver
+}
+
+func (h *ChatAdminCommandHandler) SetAuditRecorder(recorder AdminAuditRecorder) {
+	h.auditRecorder = recorder
+}
+
+func (h *ChatAdminCommandHandler) SetModerationService(service AdminModerationService) {
+	h.moderation = service
+}
+
+func (h *ChatAdminCommandHandler) SetDefsReloader(reloader AdminDefsReloader) {
+	h.defsReloader = reloader
+}
+
 func (h *ChatAdminCommandHandler) SetLifeDeathFactor(value float64) {
 	if value <= 0 {
 		h.lifeDeathFactor = 1
@@ -127,6 +163,23 @@ func (h *ChatAdminCommandHandler) HandleCommand(
 		return false
 	}
 
+	requiredRole, known := adminCommandRoles[parts[0]]
+	if !known {
+		return false
+	}
+	role := h.roleOf(playerID)
+	h.beginAudit(playerID, role, parts[0], strings.Join(parts[1:], " "))
+	defer h.finishAudit()
+
+	if role < requiredRole {
+		h.denyCommand(playerID, fmt.Sprintf("permission denied: %s requires %s role", parts[0], requiredRole))
+		h.logger.Warn("Admin command denied",
+			zap.Uint64("player_id", uint64(playerID)),
+			zap.String("command", parts[0]),
+			zap.String("role", role.String()))
+		return true
+	}
+
 	switch parts[0] {
 	case "/give":
 		h.handleGive(w, playerID, playerHandle, parts[1:])
@@ -140,6 +193,27 @@ func (h *ChatAdminCommandHandler) HandleCommand(
 	case "/online":
 		h.handleOnline(w, playerID)
 		return true
+	case "/broadcast":
+		h.handleBroadcast(w, playerID, playerHandle, parts[1:])
+		return true
+	case "/countdown":
+		h.handleCountdown(playerID, parts[1:])
+		return true
+	case "/kick":
+		h.handleKick(playerID, role, parts[1:])
+		return true
+	case "/mute":
+		h.handleMute(playerID, role, parts[1:])
+		return true
+	case "/ban":
+		h.handleBan(playerID, role, parts[1:])
+		return true
+	case "/unban":
+		h.handleUnban(playerID, role, parts[1:])
+		return true
+	case "/reloaddefs":
+		h.handleReloadDefs(playerID)
+		return true
 	case "/error":
 		h.handleError(playerID, parts[1:])
 		return true
@@ -180,7 +254,7 @@ func (h *ChatAdminCommandHandler) handleGive(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /give <item_key> [count] [quality]")
+		h.failCommand(playerID, "usage: /give <item_key> [count] [quality]")
 		return
 	}
 
@@ -192,7 +266,7 @@ func (h *ChatAdminCommandHandler) handleGive(
 		if v, err := strconv.ParseUint(args[1], 10, 32); err == nil && v > 0 {
 			count = uint32(v)
 		} else {
-			h.sendSystemMessage(playerID, "invalid count: "+args[1])
+			h.failCommand(playerID, "invalid count: "+args[1])
 			return
 		}
 	}
@@ -201,14 +275,14 @@ func (h *ChatAdminCommandHandler) handleGive(
 		if v, err := strconv.ParseUint(args[2], 10, 32); err == nil {
 			quality = uint32(v)
 		} else {
-			h.sendSystemMessage(playerID, "invalid quality: "+args[2])
+			h.failCommand(playerID, "invalid quality: "+args[2])
 			return
 		}
 	}
 
-	result := h.inventoryExecutor.GiveItem(w, playerID, playerHandle, itemKey, count, quality)
+	result := h.giveItem(w, playerID, playerHandle, itemKey, count, quality)
 	if !result.Success {
-		h.sendSystemMessage(playerID, "give failed: "+result.Message)
+		h.failCommand(playerID, "give failed: "+result.Message)
 		h.logger.Warn("Admin /give failed",
 			zap.Uint64("player_id", uint64(playerID)),
 			zap.String("item_key", itemKey),
@@ -216,6 +290,50 @@ func (h *ChatAdminCommandHandler) handleGive(
 		return
 	}
 
+	h.sendSystemMessage(playerID, fmt.Sprintf("gave %s x%d q%d — %s", itemKey, count, quality, result.Message))
+
+	h.logger.Info("Admin /give executed",
+		zap.Uint64("player_id", uint64(playerID)),
+		zap.String("item_key", itemKey),
+		zap.Uint32("count", count),
+		zap.Uint32("quality", quality),
+		zap.String("result", result.Message))
+}
+
+// GiveItemToPlayer implements systems.AdminItemGiver for JobAdminGiveItem.
+func (h *ChatAdminCommandHandler) GiveItemToPlayer(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemKey string,
+	count, quality uint32,
+) (string, error) {
+	result := h.giveItem(w, playerID, playerHandle, itemKey, count, quality)
+	if !result.Success {
+		return "", fmt.Errorf("give failed: %s", result.Message)
+	}
+	h.logger.Info("Admin give job executed",
+		zap.Uint64("player_id", uint64(playerID)),
+		zap.String("item_key", itemKey),
+		zap.Uint32("count", count),
+		zap.Uint32("quality", quality),
+		zap.String("result", result.Message))
+	return result.Message, nil
+}
+
+// giveItem gives the item and pushes the resulting inventory and LP changes to the player.
+func (h *ChatAdminCommandHandler) giveItem(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemKey string,
+	count, quality uint32,
+) *inventory.GiveItemResult {
+	result := h.inventoryExecutor.GiveItem(w, playerID, playerHandle, itemKey, count, quality)
+	if !result.Success {
+		return result
+	}
+
 	// Send inventory update to client
 	if len(result.UpdatedContainers) > 0 {
 		h.sendInventoryUpdate(w, playerID, result)
@@ -231,15 +349,7 @@ func (h *ChatAdminCommandHandler) handleGive(
 			})
 		}
 	}
-
-	h.sendSystemMessage(playerID, fmt.Sprintf("gave %s x%d q%d — %s", itemKey, count, quality, result.Message))
-
-	h.logger.Info("Admin /give executed",
-		zap.Uint64("player_id", uint64(playerID)),
-		zap.String("item_key", itemKey),
-		zap.Uint32("count", count),
-		zap.Uint32("quality", quality),
-		zap.String("result", result.Message))
+	return result
 }
 
 // handleSpawn processes: /spawn <object_key> [quality]
@@ -249,7 +359,7 @@ func (h *ChatAdminCommandHandler) handleSpawn(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /spawn <object_key> [quality]")
+		h.failCommand(playerID, "usage: /spawn <object_key> [quality]")
 		return
 	}
 
@@ -260,14 +370,14 @@ func (h *ChatAdminCommandHandler) handleSpawn(
 		if v, err := strconv.ParseUint(args[1], 10, 32); err == nil {
 			quality = uint32(v)
 		} else {
-			h.sendSystemMessage(playerID, "invalid quality: "+args[1])
+			h.failCommand(playerID, "invalid quality: "+args[1])
 			return
 		}
 	}
 
 	def, ok := objectdefs.Global().GetByKey(objectKey)
 	if !ok {
-		h.sendSystemMessage(playerID, "unknown object key: "+objectKey)
+		h.failCommand(playerID, "unknown object key: "+objectKey)
 		return
 	}
 
@@ -299,7 +409,7 @@ func (h *ChatAdminCommandHandler) handleTeleport(
 	args []string,
 ) {
 	if h.teleportExecutor == nil {
-		h.sendSystemMessage(playerID, "teleport service unavailable")
+		h.failCommand(playerID, "teleport service unavailable")
 		return
 	}
 
@@ -312,17 +422,17 @@ func (h *ChatAdminCommandHandler) handleTeleport(
 		h.logger.Info("Admin /tp pending click", zap.Uint64("player_id", uint64(playerID)))
 		return
 	case 1:
-		h.sendSystemMessage(playerID, "missing y coordinate: usage /tp <x> <y> [layer]")
+		h.failCommand(playerID, "missing y coordinate: usage /tp <x> <y> [layer]")
 		return
 	case 2, 3:
 		x, err := strconv.Atoi(args[0])
 		if err != nil {
-			h.sendSystemMessage(playerID, "invalid x: "+args[0])
+			h.failCommand(playerID, "invalid x: "+args[0])
 			return
 		}
 		y, err := strconv.Atoi(args[1])
 		if err != nil {
-			h.sendSystemMessage(playerID, "invalid y: "+args[1])
+			h.failCommand(playerID, "invalid y: "+args[1])
 			return
 		}
 
@@ -330,7 +440,7 @@ func (h *ChatAdminCommandHandler) handleTeleport(
 		if len(args) == 3 {
 			layer, layerErr := strconv.Atoi(args[2])
 			if layerErr != nil {
-				h.sendSystemMessage(playerID, "invalid layer: "+args[2])
+				h.failCommand(playerID, "invalid layer: "+args[2])
 				return
 			}
 			targetLayer = &layer
@@ -341,7 +451,7 @@ func (h *ChatAdminCommandHandler) handleTeleport(
 		ecs.GetResource[ecs.PendingAdminTeleport](w).Clear(playerID)
 
 		if err := h.teleportExecutor.RequestAdminTeleport(playerID, w.Layer, x, y, targetLayer); err != nil {
-			h.sendSystemMessage(playerID, "teleport failed: "+err.Error())
+			h.failCommand(playerID, "teleport failed: "+err.Error())
 			return
 		}
 
@@ -352,7 +462,7 @@ func (h *ChatAdminCommandHandler) handleTeleport(
 		}
 		return
 	default:
-		h.sendSystemMessage(playerID, "usage: /tp | /tp <x> <y> [layer]")
+		h.failCommand(playerID, "usage: /tp | /tp <x> <y> [layer]")
 		return
 	}
 }
@@ -371,11 +481,13 @@ func (h *ChatAdminCommandHandler) ExecutePendingSpawn(
 		return
 	}
 	pending.Clear(playerID)
+	h.beginAudit(playerID, h.roleOf(playerID), "/spawn", fmt.Sprintf("%s q%d at %.0f %.0f", entry.ObjectKey, entry.Quality, targetX, targetY))
+	defer h.finishAudit()
 
 	// Validate target is within one of the player's active chunks
 	chunk := h.findActiveChunkForPoint(playerID, targetX, targetY)
 	if chunk == nil {
-		h.sendSystemMessage(playerID, "Target location is outside your active chunks.")
+		h.failCommand(playerID, "Target location is outside your active chunks.")
 		h.logger.Warn("Admin /spawn: target outside active chunks",
 			zap.Uint64("player_id", uint64(playerID)),
 			zap.Float64("x", targetX), zap.Float64("y", targetY))
@@ -384,7 +496,7 @@ func (h *ChatAdminCommandHandler) ExecutePendingSpawn(
 
 	def, ok := objectdefs.Global().GetByID(entry.DefID)
 	if !ok {
-		h.sendSystemMessage(playerID, "object definition no longer valid")
+		h.failCommand(playerID, "object definition no longer valid")
 		return
 	}
 
@@ -409,7 +521,7 @@ func (h *ChatAdminCommandHandler) ExecutePendingSpawn(
 		BehaviorRegistry: h.behaviorRegistry,
 	})
 	if handle == types.InvalidHandle {
-		h.sendSystemMessage(playerID, "failed to spawn entity")
+		h.failCommand(playerID, "failed to spawn entity")
 		return
 	}
 	ecs.AddComponent(w, handle, components.ChunkRef{
@@ -475,9 +587,11 @@ func (h *ChatAdminCommandHandler) ExecutePendingTeleport(
 		return
 	}
 	pending.Clear(playerID)
+	h.beginAudit(playerID, h.roleOf(playerID), "/tp", fmt.Sprintf("%.0f %.0f", targetX, targetY))
+	defer h.finishAudit()
 
 	if h.teleportExecutor == nil {
-		h.sendSystemMessage(playerID, "teleport service unavailable")
+		h.failCommand(playerID, "teleport service unavailable")
 		return
 	}
 
@@ -485,7 +599,7 @@ func (h *ChatAdminCommandHandler) ExecutePendingTeleport(
 	targetYI := int(math.Round(targetY))
 
 	if err := h.teleportExecutor.RequestAdminTeleport(playerID, w.Layer, targetXI, targetYI, nil); err != nil {
-		h.sendSystemMessage(playerID, "teleport failed: "+err.Error())
+		h.failCommand(playerID, "teleport failed: "+err.Error())
 		return
 	}
 
@@ -508,14 +622,174 @@ func (h *ChatAdminCommandHandler) handleOnline(
 		zap.Int("online_count", onlineCount))
 }
 
+// handleBroadcast processes: /broadcast <text> - sends a global message to all players
+func (h *ChatAdminCommandHandler) handleBroadcast(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	args []string,
+) {
+	if len(args) == 0 {
+		h.failCommand(playerID, "usage: /broadcast <text>")
+		return
+	}
+	if h.globalBroadcaster == nil {
+		h.failCommand(playerID, "broadcast service unavailable")
+		return
+	}
+
+	fromName := announcementSenderName
+	if appearance, ok := ecs.GetComponent[components.Appearance](w, playerHandle); ok && appearance.Name != nil {
+		fromName = *appearance.Name
+	}
+	h.globalBroadcaster.BroadcastGlobalMessage(fromName, strings.Join(args, " "))
+	h.sendSystemMessage(playerID, "broadcast sent")
+
+	h.logger.Info("Admin /broadcast executed",
+		zap.Uint64("player_id", uint64(playerID)))
+}
+
+// handleCountdown processes: /countdown <duration> <text> - e.g. /countdown 10m Server restart in {remaining}
+func (h *ChatAdminCommandHandler) handleCountdown(playerID types.EntityID, args []string) {
+	if len(args) < 2 {
+		h.failCommand(playerID, "usage: /countdown <duration> <text with {remaining}>")
+		return
+	}
+	if h.globalBroadcaster == nil {
+		h.failCommand(playerID, "broadcast service unavailable")
+		return
+	}
+
+	in, err := time.ParseDuration(args[0])
+	if err != nil || in <= 0 {
+		h.failCommand(playerID, "invalid duration: "+args[0])
+		return
+	}
+	h.globalBroadcaster.ScheduleGlobalCountdown(in, strings.Join(args[1:], " "))
+	h.sendSystemMessage(playerID, fmt.Sprintf("countdown scheduled: %s", in))
+
+	h.logger.Info("Admin /countdown scheduled",
+		zap.Uint64("player_id", uint64(playerID)),
+		zap.Duration("in", in))
+}
+
+// handleKick processes: /kick <player> [reason]
+func (h *ChatAdminCommandHandler) handleKick(playerID types.EntityID, role AccountRole, args []string) {
+	if len(args) == 0 {
+		h.failCommand(playerID, "usage: /kick <player> [reason]")
+		return
+	}
+	h.requestModeration(playerID, ModerationRequest{
+		Action:    ModerationKick,
+		ActorID:   playerID,
+		ActorRole: role,
+		Target:    args[0],
+		Reason:    strings.Join(args[1:], " "),
+	})
+}
+
+// handleMute processes: /mute <player> <duration>. Duration 0 lifts the mute.
+func (h *ChatAdminCommandHandler) handleMute(playerID types.EntityID, role AccountRole, args []string) {
+	if len(args) < 2 {
+		h.failCommand(playerID, "usage: /mute <player> <duration>")
+		return
+	}
+	duration, err := ParseModerationDuration(args[1])
+	if err != nil {
+		h.failCommand(playerID, err.Error())
+		return
+	}
+	h.requestModeration(playerID, ModerationRequest{
+		Action:    ModerationMute,
+		ActorID:   playerID,
+		ActorRole: role,
+		Target:    args[0],
+		Duration:  duration,
+	})
+}
+
+// handleBan processes: /ban <player> <duration|perm> <reason>
+func (h *ChatAdminCommandHandler) handleBan(playerID types.EntityID, role AccountRole, args []string) {
+	if len(args) < 3 {
+		h.failCommand(playerID, "usage: /ban <player> <duration|perm> <reason>")
+		return
+	}
+	var duration time.Duration
+	if args[1] != "perm" {
+		d, err := ParseModerationDuration(args[1])
+		if err != nil || d == 0 {
+			h.failCommand(playerID, "invalid duration: "+args[1])
+			return
+		}
+		duration = d
+	}
+	h.requestModeration(playerID, ModerationRequest{
+		Action:    ModerationBan,
+		ActorID:   playerID,
+		ActorRole: role,
+		Target:    args[0],
+		Duration:  duration,
+		Reason:    strings.Join(args[2:], " "),
+	})
+}
+
+// handleUnban processes: /unban <player>
+func (h *ChatAdminCommandHandler) handleUnban(playerID types.EntityID, role AccountRole, args []string) {
+	if len(args) != 1 {
+		h.failCommand(playerID, "usage: /unban <player>")
+		return
+	}
+	h.requestModeration(playerID, ModerationRequest{
+		Action:    ModerationUnban,
+		ActorID:   playerID,
+		ActorRole: role,
+		Target:    args[0],
+	})
+}
+
+// requestModeration hands the request to the moderation service. The audit entry is completed
+// and recorded when the service reports back, since the outcome is only known after DB access.
+func (h *ChatAdminCommandHandler) requestModeration(playerID types.EntityID, req ModerationRequest) {
+	if h.moderation == nil {
+		h.failCommand(playerID, "moderation service unavailable")
+		return
+	}
+
+	complete := h.detachAudit(playerID)
+	h.moderation.ExecuteModeration(req, func(result ModerationResult, err error) {
+		if err != nil {
+			complete(result.TargetID, req.Action.String()+" failed: "+err.Error(), AdminAuditFailed)
+			return
+		}
+		complete(result.TargetID, result.Message, AdminAuditSuccess)
+	})
+}
+
+// handleReloadDefs processes: /reloaddefs
+func (h *ChatAdminCommandHandler) handleReloadDefs(playerID types.EntityID) {
+	if h.defsReloader == nil {
+		h.failCommand(playerID, "definitions reload unavailable")
+		return
+	}
+
+	complete := h.detachAudit(playerID)
+	h.defsReloader.ReloadDefs(func(result DefsReloadResult, err error) {
+		if err != nil {
+			complete(playerID, "reload failed: "+err.Error(), AdminAuditFailed)
+			return
+		}
+		complete(playerID, result.String(), AdminAuditSuccess)
+	})
+}
+
 // handleError processes: /error <text>
 func (h *ChatAdminCommandHandler) handleError(playerID types.EntityID, args []string) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /error <text>")
+		h.failCommand(playerID, "usage: /error <text>")
 		return
 	}
 	if h.alertSender == nil {
-		h.sendSystemMessage(playerID, "error sender unavailable")
+		h.failCommand(playerID, "error sender unavailable")
 		return
 	}
 
@@ -529,11 +803,11 @@ func (h *ChatAdminCommandHandler) handleError(playerID types.EntityID, args []st
 // handleWarn processes: /warn <text>
 func (h *ChatAdminCommandHandler) handleWarn(playerID types.EntityID, args []string) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /warn <text>")
+		h.failCommand(playerID, "usage: /warn <text>")
 		return
 	}
 	if h.alertSender == nil {
-		h.sendSystemMessage(playerID, "warning sender unavailable")
+		h.failCommand(playerID, "warning sender unavailable")
 		return
 	}
 
@@ -552,13 +826,13 @@ func (h *ChatAdminCommandHandler) handleStamina(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /stamina <value>")
+		h.failCommand(playerID, "usage: /stamina <value>")
 		return
 	}
 
 	value, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
-		h.sendSystemMessage(playerID, "invalid stamina value: "+args[0])
+		h.failCommand(playerID, "invalid stamina value: "+args[0])
 		return
 	}
 
@@ -585,13 +859,13 @@ func (h *ChatAdminCommandHandler) handleEnergy(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /energy <value>")
+		h.failCommand(playerID, "usage: /energy <value>")
 		return
 	}
 
 	value, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
-		h.sendSystemMessage(playerID, "invalid energy value: "+args[0])
+		h.failCommand(playerID, "invalid energy value: "+args[0])
 		return
 	}
 
@@ -618,13 +892,13 @@ func (h *ChatAdminCommandHandler) handleSHP(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /shp <value>")
+		h.failCommand(playerID, "usage: /shp <value>")
 		return
 	}
 
 	value, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
-		h.sendSystemMessage(playerID, "invalid SHP value: "+args[0])
+		h.failCommand(playerID, "invalid SHP value: "+args[0])
 		return
 	}
 
@@ -635,7 +909,7 @@ func (h *ChatAdminCommandHandler) handleSHP(
 		health.HHP = hhp
 		return true
 	}) {
-		h.sendSystemMessage(playerID, "health component missing")
+		h.failCommand(playerID, "health component missing")
 		return
 	}
 
@@ -651,13 +925,13 @@ func (h *ChatAdminCommandHandler) handleHHP(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /hhp <value>")
+		h.failCommand(playerID, "usage: /hhp <value>")
 		return
 	}
 
 	value, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
-		h.sendSystemMessage(playerID, "invalid HHP value: "+args[0])
+		h.failCommand(playerID, "invalid HHP value: "+args[0])
 		return
 	}
 
@@ -679,7 +953,7 @@ func (h *ChatAdminCommandHandler) handleHHP(
 		health.HHP = nextHHP
 		return true
 	}) {
-		h.sendSystemMessage(playerID, "health component missing")
+		h.failCommand(playerID, "health component missing")
 		return
 	}
 
@@ -695,19 +969,19 @@ func (h *ChatAdminCommandHandler) handleDamage(
 	args []string,
 ) {
 	if len(args) == 0 {
-		h.sendSystemMessage(playerID, "usage: /damage <soft> [hard]")
+		h.failCommand(playerID, "usage: /damage <soft> [hard]")
 		return
 	}
 	softDamage, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
-		h.sendSystemMessage(playerID, "invalid soft damage: "+args[0])
+		h.failCommand(playerID, "invalid soft damage: "+args[0])
 		return
 	}
 	hardDamage := 0.0
 	if len(args) > 1 {
 		hardDamage, err = strconv.ParseFloat(args[1], 64)
 		if err != nil {
-			h.sendSystemMessage(playerID, "invalid hard damage: "+args[1])
+			h.failCommand(playerID, "invalid hard damage: "+args[1])
 			return
 		}
 	}
@@ -720,7 +994,7 @@ func (h *ChatAdminCommandHandler) handleDamage(
 		health.SHP, health.HHP, _, _ = entityhealth.ApplyDamage(health.SHP, health.HHP, mhp, softDamage, hardDamage)
 		return true
 	}) {
-		h.sendSystemMessage(playerID, "health component missing")
+		h.failCommand(playerID, "health component missing")
 		return
 	}
 
@@ -735,7 +1009,7 @@ func (h *ChatAdminCommandHandler) handleRevive(
 	playerHandle types.Handle,
 ) {
 	if !h.allowRevive {
-		h.sendSystemMessage(playerID, "revive command is disabled")
+		h.failCommand(playerID, "revive command is disabled")
 		return
 	}
 
@@ -746,7 +1020,7 @@ func (h *ChatAdminCommandHandler) handleRevive(
 		health.KOUntilTick = 0
 		return true
 	}) {
-		h.sendSystemMessage(playerID, "health component missing")
+		h.failCommand(playerID, "health component missing")
 		return
 	}
 
@@ -765,7 +1039,7 @@ func (h *ChatAdminCommandHandler) handleHealthSnapshot(
 ) {
 	health, hasHealth := ecs.GetComponent[components.EntityHealth](w, playerHandle)
 	if !hasHealth {
-		h.sendSystemMessage(playerID, "health component missing")
+		h.failCommand(playerID, "health component missing")
 		return
 	}
 	mhp := resolveMaxHHPForHandle(w, playerHandle, h.lifeDeathFactor)
@@ -885,12 +1159,81 @@ func (h *ChatAdminCommandHandler) sendInventoryUpdate(
 	h.inventoryResultSender.SendInventoryUpdate(playerID, updated)
 }
 
+func (h *ChatAdminCommandHandler) roleOf(playerID types.EntityID) AccountRole {
+	if h.roleResolver == nil {
+		return RolePlayer
+	}
+	return h.roleResolver.RoleOf(playerID)
+}
+
+// beginAudit starts recording a command invocation targeting the actor. Moderation commands
+// record their real target through detachAudit.
+func (h *ChatAdminCommandHandler) beginAudit(playerID types.EntityID, role AccountRole, command, args string) {
+	h.audit = &AdminAuditEntry{
+		ActorID:   playerID,
+		ActorRole: role,
+		TargetID:  playerID,
+		Command:   command,
+		Args:      args,
+		Outcome:   AdminAuditSuccess,
+	}
+}
+
+// detachAudit takes over the current audit entry for commands that finish on another goroutine.
+// The returned func replies to the player and records the entry; it does not touch handler state.
+func (h *ChatAdminCommandHandler) detachAudit(playerID types.EntityID) func(targetID types.EntityID, text string, outcome AdminAuditOutcome) {
+	entry := h.audit
+	h.audit = nil
+	return func(targetID types.EntityID, text string, outcome AdminAuditOutcome) {
+		if h.chatDelivery != nil {
+			h.chatDelivery.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, "[Server]", text, 0)
+		}
+		if entry == nil || h.auditRecorder == nil {
+			return
+		}
+		entry.TargetID = targetID
+		entry.Result = text
+		entry.Outcome = outcome
+		entry.At = time.Now()
+		h.auditRecorder.RecordAdminAudit(*entry)
+	}
+}
+
+func (h *ChatAdminCommandHandler) finishAudit() {
+	entry := h.audit
+	h.audit = nil
+	if entry == nil || h.auditRecorder == nil {
+		return
+	}
+	entry.At = time.Now()
+	h.auditRecorder.RecordAdminAudit(*entry)
+}
+
+// failCommand reports a failed command to the player and marks the audit entry as failed.
+func (h *ChatAdminCommandHandler) failCommand(playerID types.EntityID, text string) {
+	if h.audit != nil {
+		h.audit.Outcome = AdminAuditFailed
+	}
+	h.sendSystemMessage(playerID, text)
+}
+
+func (h *ChatAdminCommandHandler) denyCommand(playerID types.EntityID, text string) {
+	if h.audit != nil {
+		h.audit.Outcome = AdminAuditDenied
+	}
+	h.sendSystemMessage(playerID, text)
+}
+
 // sendSystemMessage sends a server-originated chat message to the player.
+// The last message sent while a command runs is stored as the audit result.
 func (h *ChatAdminCommandHandler) sendSystemMessage(playerID types.EntityID, text string) {
+	if h.audit != nil {
+		h.audit.Result = text
+	}
 	if h.chatDelivery == nil {
 		return
 	}
-	h.chatDelivery.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, "[Server]", text)
+	h.chatDelivery.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, "[Server]", text, 0)
 }
 
 // buildInventoryStateFromContainerState converts a systems.InventoryContainerState to proto.
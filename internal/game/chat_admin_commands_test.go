@@ -40,6 +40,7 @@ func TestHandleOnline(t *testing.T) {
 		eventBus,
 		logger,
 	)
+	handler.SetRoleResolver(adminRoleForTesting{})
 
 	// Test 1: No players online
 	handler.HandleCommand(world, 1, types.InvalidHandle, "/online")
@@ -111,6 +112,7 @@ func TestHandleErrorWarn(t *testing.T) {
 		eventBus,
 		logger,
 	)
+	handler.SetRoleResolver(adminRoleForTesting{})
 
 	playerID := types.EntityID(42)
 	handled := handler.HandleCommand(world, playerID, types.InvalidHandle, "/error test error message")
@@ -161,6 +163,7 @@ func TestHandleTeleportPendingClick(t *testing.T) {
 		eventBus,
 		logger,
 	)
+	handler.SetRoleResolver(adminRoleForTesting{})
 	handler.SetAllowReviveCommand(true)
 	handler.SetTeleportExecutor(mockTeleport)
 
@@ -194,6 +197,7 @@ func TestHandleTeleportImmediate(t *testing.T) {
 		eventBus,
 		logger,
 	)
+	handler.SetRoleResolver(adminRoleForTesting{})
 	handler.SetTeleportExecutor(mockTeleport)
 
 	playerID := types.EntityID(78)
@@ -214,6 +218,55 @@ func TestHandleTeleportImmediate(t *testing.T) {
 	}
 }
 
+func TestHandleBroadcastAndCountdown(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	eventBus := eventbus.New(&eventbus.Config{MinWorkers: 1, MaxWorkers: 2})
+	world := ecs.NewWorldWithCapacity(100, eventBus, 0)
+	mockChat := &mockChatDeliveryService{messages: make(map[types.EntityID]string)}
+	mockBroadcaster := &mockGlobalChatBroadcaster{}
+
+	handler := NewChatAdminCommandHandler(
+		nil,
+		nil,
+		mockChat,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		eventBus,
+		logger,
+	)
+	handler.SetRoleResolver(adminRoleForTesting{})
+
+	playerID := types.EntityID(42)
+	if handled := handler.HandleCommand(world, playerID, types.InvalidHandle, "/broadcast hello"); !handled {
+		t.Fatal("expected /broadcast to be recognized")
+	}
+	if mockChat.messages[playerID] != "broadcast service unavailable" {
+		t.Fatalf("unexpected message without broadcaster: %q", mockChat.messages[playerID])
+	}
+
+	handler.SetGlobalBroadcaster(mockBroadcaster)
+	adminName := "Admin"
+	adminHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Appearance{Name: &adminName})
+	})
+	handler.HandleCommand(world, playerID, adminHandle, "/broadcast server   restart soon")
+	if mockBroadcaster.lastFrom != adminName || mockBroadcaster.lastText != "server restart soon" {
+		t.Fatalf("unexpected broadcast: from=%q text=%q", mockBroadcaster.lastFrom, mockBroadcaster.lastText)
+	}
+
+	handler.HandleCommand(world, playerID, adminHandle, "/countdown nope restart")
+	if mockBroadcaster.countdowns != 0 {
+		t.Fatal("expected invalid duration to be rejected")
+	}
+	handler.HandleCommand(world, playerID, adminHandle, "/countdown 5m Restart in {remaining}")
+	if mockBroadcaster.countdowns != 1 || mockBroadcaster.lastIn != 5*time.Minute || mockBroadcaster.lastText != "Restart in {remaining}" {
+		t.Fatalf("unexpected countdown: %+v", mockBroadcaster)
+	}
+}
+
 func TestHandleHealthCommands(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	eventBus := eventbus.New(&eventbus.Config{MinWorkers: 1, MaxWorkers: 2})
@@ -232,6 +285,7 @@ func TestHandleHealthCommands(t *testing.T) {
 		eventBus,
 		logger,
 	)
+	handler.SetRoleResolver(adminRoleForTesting{})
 	handler.SetAllowReviveCommand(true)
 
 	playerID := types.EntityID(9001)
@@ -312,6 +366,7 @@ func TestHandleReviveDisabledOutsideDev(t *testing.T) {
 	mockChat := &mockChatDeliveryService{messages: make(map[types.EntityID]string)}
 
 	handler := NewChatAdminCommandHandler(nil, nil, mockChat, nil, nil, nil, nil, nil, eventBus, logger)
+	handler.SetRoleResolver(adminRoleForTesting{})
 	handler.SetAllowReviveCommand(false)
 
 	playerID := types.EntityID(9002)
@@ -332,7 +387,7 @@ type mockChatDeliveryService struct {
 	messages map[types.EntityID]string
 }
 
-func (m *mockChatDeliveryService) SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string) {
+func (m *mockChatDeliveryService) SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string, toEntityID types.EntityID) {
 	m.messages[entityID] = text
 }
 
@@ -384,3 +439,26 @@ func (m *mockAdminTeleportExecutor) RequestAdminTeleport(
 	m.lastTargetLayer = targetLayer
 	return nil
 }
+
+type mockGlobalChatBroadcaster struct {
+	lastFrom   string
+	lastText   string
+	lastIn     time.Duration
+	countdowns int
+}
+
+func (m *mockGlobalChatBroadcaster) BroadcastGlobalMessage(fromName, text string) {
+	m.lastFrom = fromName
+	m.lastText = text
+}
+
+func (m *mockGlobalChatBroadcaster) ScheduleGlobalCountdown(in time.Duration, text string) {
+	m.countdowns++
+	m.lastIn = in
+	m.lastText = text
+}
+
+// adminRoleForTesting grants every player the admin role.
+type adminRoleForTesting struct{}
+
+func (adminRoleForTesting) RoleOf(types.EntityID) AccountRole { return RoleAdmin }
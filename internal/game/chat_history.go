@@ -0,0 +1,282 @@
+package game
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+	"origin/internal/persistence"
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+const (
+	chatHistoryChannelSize  = 4096
+	chatHistoryBatchSize    = 200
+	chatHistoryBatchTimeout = time.Second
+	chatHistoryQueryTimeout = 3 * time.Second
+	chatHistoryWriteTimeout = 10 * time.Second
+	// chatPartitionsAhead is how many future monthly partitions the retention job keeps pre-created.
+	chatPartitionsAhead = 1
+)
+
+// ChatHistoryWriter persists chat messages in batches on a background goroutine.
+// RecordChatMessage is called from shard ticks and never blocks: when the queue is full
+// the message is dropped from history (it was already delivered to clients).
+type ChatHistoryWriter struct {
+	db     *persistence.Postgres
+	region int
+	logger *zap.Logger
+	queue  *backgroundWriter[systems.ChatHistoryEntry]
+}
+
+func NewChatHistoryWriter(db *persistence.Postgres, region int, logger *zap.Logger) *ChatHistoryWriter {
+	w := &ChatHistoryWriter{
+		db:     db,
+		region: region,
+		logger: logger,
+	}
+	w.queue = newBackgroundWriter(chatHistoryChannelSize, chatHistoryBatchSize, chatHistoryBatchTimeout, w.flush)
+	return w
+}
+
+func (w *ChatHistoryWriter) RecordChatMessage(entry systems.ChatHistoryEntry) {
+	if !w.queue.enqueue(entry) {
+		w.logger.Warn("Chat history queue full, dropping message",
+			zap.Int64("sender_id", int64(entry.SenderID)),
+			zap.Int("queue_size", chatHistoryChannelSize))
+	}
+}
+
+// Stop flushes queued messages and stops the writer.
+func (w *ChatHistoryWriter) Stop() {
+	w.queue.stop()
+}
+
+func (w *ChatHistoryWriter) flush(batch []systems.ChatHistoryEntry) {
+	if len(batch) == 0 || w.db == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), chatHistoryWriteTimeout)
+	defer cancel()
+	params := buildInsertChatMessagesParams(batch, w.region)
+	if err := w.db.Queries().InsertChatMessages(ctx, params); err != nil {
+		w.logger.Error("Failed to persist chat history batch",
+			zap.Int("batch_size", len(batch)),
+			zap.Error(err))
+	}
+}
+
+func buildInsertChatMessagesParams(batch []systems.ChatHistoryEntry, region int) repository.InsertChatMessagesParams {
+	params := repository.InsertChatMessagesParams{
+		Channels:    make([]int, len(batch)),
+		SenderIds:   make([]int64, len(batch)),
+		SenderNames: make([]string, len(batch)),
+		ReceiverIds: make([]int64, len(batch)),
+		Regions:     make([]int, len(batch)),
+		Xs:          make([]int, len(batch)),
+		Ys:          make([]int, len(batch)),
+		Layers:      make([]int, len(batch)),
+		Messages:    make([]string, len(batch)),
+		CreatedAts:  make([]time.Time, len(batch)),
+	}
+	for i, entry := range batch {
+		params.Channels[i] = int(entry.Channel)
+		params.SenderIds[i] = int64(entry.SenderID)
+		params.SenderNames[i] = entry.SenderName
+		params.ReceiverIds[i] = int64(entry.ReceiverID)
+		params.Regions[i] = region
+		params.Xs[i] = entry.X
+		params.Ys[i] = entry.Y
+		params.Layers[i] = entry.Layer
+		params.Messages[i] = entry.Text
+		params.CreatedAts[i] = entry.SentAt
+	}
+	return params
+}
+
+func (g *Game) handleChatHistoryRequest(c *network.Client, sequence uint32, req *netproto.C2S_ChatHistoryRequest) {
+	if c.CharacterID == 0 {
+		g.logger.Warn("Chat history request from unauthenticated client", zap.Uint64("client_id", c.ID))
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if g.db == nil {
+		return
+	}
+
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+
+	var (
+		posX, posY float64
+		hasPos     bool
+	)
+	shard.WithWorldRead(func(w *ecs.World) {
+		handle := w.GetHandleByEntityID(c.CharacterID)
+		if handle == types.InvalidHandle {
+			return
+		}
+		if transform, ok := ecs.GetComponent[components.Transform](w, handle); ok {
+			posX, posY, hasPos = transform.X, transform.Y, true
+		}
+	})
+
+	privateLimit := clampChatHistoryLimit(req.GetPrivateLimit(), g.cfg.Game.ChatHistoryPrivateLimit)
+	localLimit := clampChatHistoryLimit(req.GetLocalLimit(), g.cfg.Game.ChatHistoryLocalLimit)
+	if !hasPos {
+		localLimit = 0
+	}
+	characterID, layer := c.CharacterID, c.Layer
+
+	// The queries run off the packet goroutine so a slow database never stalls the client's reads.
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		entries := g.loadChatHistory(characterID, layer, int(posX), int(posY), privateLimit, localLimit)
+		response := &netproto.ServerMessage{
+			Sequence: sequence,
+			Payload: &netproto.ServerMessage_ChatHistory{
+				ChatHistory: &netproto.S2C_ChatHistory{Messages: entries},
+			},
+		}
+		data, err := proto.Marshal(response)
+		if err != nil {
+			g.logger.Error("Failed to marshal chat history", zap.Uint64("client_id", c.ID), zap.Error(err))
+			return
+		}
+		c.Send(data)
+	}()
+}
+
+// loadChatHistory returns the character's recent private messages and the local chat around
+// (x, y), oldest first. Query failures are logged and leave that part of the history empty.
+func (g *Game) loadChatHistory(characterID types.EntityID, layer, x, y, privateLimit, localLimit int) []*netproto.ChatHistoryEntry {
+	ctx, cancel := context.WithTimeout(g.ctx, chatHistoryQueryTimeout)
+	defer cancel()
+
+	entries := make([]*netproto.ChatHistoryEntry, 0, privateLimit+localLimit)
+	if privateLimit > 0 {
+		rows, err := g.db.Queries().GetRecentPrivateChat(ctx, repository.GetRecentPrivateChatParams{
+			Channel:     int16(netproto.ChatChannel_CHAT_CHANNEL_PRIVATE),
+			CharacterID: int64(characterID),
+			RowLimit:    privateLimit,
+		})
+		if err != nil {
+			g.logger.Error("Failed to load private chat history",
+				zap.Int64("character_id", int64(characterID)),
+				zap.Error(err))
+		}
+		for _, row := range rows {
+			entries = append(entries, chatHistoryEntryProto(row.Channel, row.SenderID, row.SenderName, row.Message, row.ReceiverID.Int64, row.CreatedAt))
+		}
+	}
+	if localLimit > 0 {
+		radius := g.cfg.Game.ChatLocalRadius
+		rows, err := g.db.Queries().GetRecentLocalChatNear(ctx, repository.GetRecentLocalChatNearParams{
+			Channel:  int16(netproto.ChatChannel_CHAT_CHANNEL_LOCAL),
+			Region:   g.cfg.Game.Region,
+			Layer:    int16(layer),
+			MinX:     x - radius,
+			MaxX:     x + radius,
+			MinY:     y - radius,
+			MaxY:     y + radius,
+			Since:    time.Now().Add(-g.cfg.Game.ChatHistoryLocalWindow),
+			RowLimit: localLimit,
+		})
+		if err != nil {
+			g.logger.Error("Failed to load local chat history",
+				zap.Int64("character_id", int64(characterID)),
+				zap.Error(err))
+		}
+		for _, row := range rows {
+			entries = append(entries, chatHistoryEntryProto(row.Channel, row.SenderID, row.SenderName, row.Message, 0, row.CreatedAt))
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].SentAtUnixMs < entries[j].SentAtUnixMs
+	})
+	return entries
+}
+
+// clampChatHistoryLimit applies the server default for 0 and caps client requests at the default.
+func clampChatHistoryLimit(requested uint32, max int) int {
+	if max <= 0 {
+		return 0
+	}
+	if requested == 0 || int(requested) > max {
+		return max
+	}
+	return int(requested)
+}
+
+func chatHistoryEntryProto(channel int16, senderID int64, senderName, text string, receiverID int64, sentAt time.Time) *netproto.ChatHistoryEntry {
+	entry := &netproto.ChatHistoryEntry{
+		Channel:      netproto.ChatChannel(channel),
+		FromEntityId: uint64(senderID),
+		FromName:     senderName,
+		Text:         text,
+		SentAtUnixMs: sentAt.UnixMilli(),
+	}
+	if receiverID != 0 {
+		to := uint64(receiverID)
+		entry.ToEntityId = &to
+	}
+	return entry
+}
+
+// startChatRetentionJob keeps monthly chat partitions pre-created and drops expired ones.
+func (g *Game) startChatRetentionJob() {
+	if g.db == nil {
+		return
+	}
+	interval := g.cfg.Game.ChatRetentionCheckInterval
+	if interval <= 0 {
+		return
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		g.runChatRetention()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				g.runChatRetention()
+			case <-g.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (g *Game) runChatRetention() {
+	ctx, cancel := context.WithTimeout(g.ctx, time.Minute)
+	defer cancel()
+
+	now := time.Now()
+	if err := g.db.EnsureChatPartitions(ctx, now, chatPartitionsAhead); err != nil {
+		g.logger.Warn("Failed to ensure chat partitions", zap.Error(err))
+	}
+	if _, err := g.db.DropExpiredChatPartitions(ctx, now, g.cfg.Game.ChatRetentionMonths); err != nil {
+		g.logger.Error("Failed to apply chat retention", zap.Error(err))
+	}
+}
@@ -0,0 +1,80 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/ecs/systems"
+	netproto "origin/internal/network/proto"
+
+	"go.uber.org/zap"
+)
+
+func TestBuildInsertChatMessagesParams(t *testing.T) {
+	sentAt := time.Unix(1_700_000_000, 0)
+	batch := []systems.ChatHistoryEntry{
+		{Channel: netproto.ChatChannel_CHAT_CHANNEL_LOCAL, SenderID: 1, X: 10, Y: 20, Layer: 0, Text: "hello", SentAt: sentAt},
+		{Channel: netproto.ChatChannel_CHAT_CHANNEL_PRIVATE, SenderID: 2, ReceiverID: 3, X: 5, Y: 6, Layer: 1, Text: "psst", SentAt: sentAt.Add(time.Second)},
+		{Channel: netproto.ChatChannel_CHAT_CHANNEL_GLOBAL, SenderName: "[Server]", Text: "restart", SentAt: sentAt},
+	}
+
+	params := buildInsertChatMessagesParams(batch, 7)
+
+	if len(params.Messages) != 3 || params.Messages[1] != "psst" {
+		t.Fatalf("unexpected messages %v", params.Messages)
+	}
+	if params.Channels[1] != int(netproto.ChatChannel_CHAT_CHANNEL_PRIVATE) {
+		t.Fatalf("unexpected channel %d", params.Channels[1])
+	}
+	if params.ReceiverIds[0] != 0 || params.ReceiverIds[1] != 3 {
+		t.Fatalf("unexpected receivers %v", params.ReceiverIds)
+	}
+	if params.SenderIds[2] != 0 || params.SenderNames[2] != "[Server]" || params.SenderNames[0] != "" {
+		t.Fatalf("unexpected senders: ids=%v names=%v", params.SenderIds, params.SenderNames)
+	}
+	if params.Regions[0] != 7 || params.Regions[1] != 7 {
+		t.Fatalf("expected region 7 for all rows, got %v", params.Regions)
+	}
+	if params.Layers[1] != 1 || params.Xs[0] != 10 || params.Ys[0] != 20 {
+		t.Fatalf("unexpected position columns: layers=%v xs=%v ys=%v", params.Layers, params.Xs, params.Ys)
+	}
+	if !params.CreatedAts[1].Equal(sentAt.Add(time.Second)) {
+		t.Fatalf("unexpected created_at %v", params.CreatedAts[1])
+	}
+}
+
+func TestClampChatHistoryLimit(t *testing.T) {
+	cases := []struct {
+		requested uint32
+		max       int
+		want      int
+	}{
+		{requested: 0, max: 50, want: 50},
+		{requested: 10, max: 50, want: 10},
+		{requested: 500, max: 50, want: 50},
+		{requested: 10, max: 0, want: 0},
+	}
+	for _, tc := range cases {
+		if got := clampChatHistoryLimit(tc.requested, tc.max); got != tc.want {
+			t.Fatalf("clampChatHistoryLimit(%d, %d) = %d, want %d", tc.requested, tc.max, got, tc.want)
+		}
+	}
+}
+
+func TestChatHistoryWriter_RecordNeverBlocksAndStops(t *testing.T) {
+	writer := NewChatHistoryWriter(nil, 1, zap.NewNop())
+	for i := 0; i < chatHistoryChannelSize*2; i++ {
+		writer.RecordChatMessage(systems.ChatHistoryEntry{SenderID: 1, Text: "spam"})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		writer.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("writer did not stop")
+	}
+}
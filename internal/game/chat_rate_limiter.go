@@ -0,0 +1,49 @@
+package game
+
+import (
+	"sync"
+	"time"
+
+	"origin/internal/types"
+)
+
+// chatRateLimiter enforces a minimum interval between chat messages of the same sender.
+// Accessed from per-client read goroutines, so it is guarded by its own mutex.
+type chatRateLimiter struct {
+	minInterval time.Duration
+
+	mu       sync.Mutex
+	lastSent map[types.EntityID]time.Time
+}
+
+func newChatRateLimiter(minInterval time.Duration) *chatRateLimiter {
+	return &chatRateLimiter{
+		minInterval: minInterval,
+		lastSent:    make(map[types.EntityID]time.Time),
+	}
+}
+
+// Allow records a message attempt at now and reports whether it respects the min interval.
+// Rejected attempts do not extend the cooldown.
+func (l *chatRateLimiter) Allow(senderID types.EntityID, now time.Time) bool {
+	if l == nil || l.minInterval <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if last, ok := l.lastSent[senderID]; ok && now.Sub(last) < l.minInterval {
+		return false
+	}
+	l.lastSent[senderID] = now
+	return true
+}
+
+// Forget drops sender state (on disconnect).
+func (l *chatRateLimiter) Forget(senderID types.EntityID) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	delete(l.lastSent, senderID)
+	l.mu.Unlock()
+}
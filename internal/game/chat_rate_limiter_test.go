@@ -0,0 +1,49 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/types"
+)
+
+func TestChatRateLimiter_EnforcesMinInterval(t *testing.T) {
+	limiter := newChatRateLimiter(400 * time.Millisecond)
+	sender := types.EntityID(10)
+	now := time.Unix(1000, 0)
+
+	if !limiter.Allow(sender, now) {
+		t.Fatalf("expected first message to be allowed")
+	}
+	if limiter.Allow(sender, now.Add(100*time.Millisecond)) {
+		t.Fatalf("expected message inside min interval to be rejected")
+	}
+	if !limiter.Allow(types.EntityID(11), now.Add(100*time.Millisecond)) {
+		t.Fatalf("expected other sender to be unaffected")
+	}
+	if !limiter.Allow(sender, now.Add(400*time.Millisecond)) {
+		t.Fatalf("expected message after min interval to be allowed")
+	}
+}
+
+func TestChatRateLimiter_ForgetResetsSender(t *testing.T) {
+	limiter := newChatRateLimiter(time.Second)
+	sender := types.EntityID(20)
+	now := time.Unix(1000, 0)
+
+	limiter.Allow(sender, now)
+	limiter.Forget(sender)
+	if !limiter.Allow(sender, now.Add(10*time.Millisecond)) {
+		t.Fatalf("expected forgotten sender to be allowed immediately")
+	}
+}
+
+func TestChatRateLimiter_ZeroIntervalDisabled(t *testing.T) {
+	limiter := newChatRateLimiter(0)
+	now := time.Unix(1000, 0)
+	for i := 0; i < 3; i++ {
+		if !limiter.Allow(types.EntityID(30), now) {
+			t.Fatalf("expected zero interval limiter to allow all messages")
+		}
+	}
+}
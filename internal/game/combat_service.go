@@ -0,0 +1,344 @@
+package game
+
+import (
+	"math"
+
+	"origin/internal/characterattrs"
+	"origin/internal/combat"
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/entityhealth"
+	"origin/internal/game/behaviors"
+	"origin/internal/itemdefs"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+// combatKnockoutExp is the combat experience an attacker gains for knocking out or killing an opponent.
+const combatKnockoutExp int64 = 20
+
+type combatSender interface {
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendCombatState(entityID types.EntityID, state *netproto.S2C_CombatState)
+	SendExpGained(entityID types.EntityID, gained *netproto.S2C_ExpGained)
+}
+
+// CombatService starts combat between two entities and queues server-timed combat moves.
+// Relations live in components.CombatState on both sides; CombatSystem lands the hits
+// and ends relations that drift apart or lose an opponent.
+type CombatService struct {
+	sender          combatSender
+	lifeDeathFactor float64
+	logger          *zap.Logger
+}
+
+func NewCombatService(sender combatSender, lifeDeathFactor float64, logger *zap.Logger) *CombatService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &CombatService{
+		sender:          sender,
+		lifeDeathFactor: lifeDeathFactor,
+		logger:          logger,
+	}
+}
+
+var _ systems.CombatCommandService = (*CombatService)(nil)
+
+func (s *CombatService) HandleAttack(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.Attack) {
+	if s == nil || msg == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	targetID := types.EntityID(msg.EntityId)
+	if reasonCode := s.StartCombat(w, playerID, playerHandle, targetID); reasonCode != "" {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, reasonCode)
+	}
+}
+
+// StartCombat creates the combat relation between attacker and target on both sides.
+// Returns the mini alert reason code when combat cannot start, or an empty string.
+func (s *CombatService) StartCombat(w *ecs.World, attackerID types.EntityID, attackerHandle types.Handle, targetID types.EntityID) string {
+	if targetID == 0 || targetID == attackerID {
+		return "COMBAT_INVALID_TARGET"
+	}
+	if isCombatDisabled(w, attackerHandle) {
+		return "COMBAT_KNOCKED_OUT"
+	}
+	targetHandle := w.GetHandleByEntityID(targetID)
+	if targetHandle == types.InvalidHandle || !w.Alive(targetHandle) {
+		return "COMBAT_INVALID_TARGET"
+	}
+	if health, hasHealth := ecs.GetComponent[components.EntityHealth](w, targetHandle); !hasHealth || health.HHP <= 0 {
+		return "COMBAT_INVALID_TARGET"
+	}
+	if combatDistance(w, attackerHandle, targetHandle) > combat.DisengageDistance {
+		return "COMBAT_TARGET_TOO_FAR"
+	}
+
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	if addCombatRelation(w, attackerHandle, targetID, nowTick) {
+		s.sendCombatState(w, attackerID, attackerHandle)
+	}
+	if addCombatRelation(w, targetHandle, attackerID, nowTick) {
+		s.sendCombatState(w, targetID, targetHandle)
+	}
+	return ""
+}
+
+func (s *CombatService) HandleCombatMove(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_CombatMove) {
+	if s == nil || msg == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	if reasonCode := s.QueueMove(w, playerID, playerHandle, types.EntityID(msg.TargetId), msg.MoveKey); reasonCode != "" {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, reasonCode)
+	}
+}
+
+// QueueMove starts winding up a move against an opponent, spending its stamina and starting its cooldown.
+// Returns the mini alert reason code when the move cannot be used now, or an empty string.
+func (s *CombatService) QueueMove(w *ecs.World, entityID types.EntityID, handle types.Handle, targetID types.EntityID, moveKey string) string {
+	move, ok := combat.MoveByKey(moveKey)
+	if !ok {
+		return "COMBAT_MOVE_NOT_FOUND"
+	}
+	state, inCombat := ecs.GetComponent[components.CombatState](w, handle)
+	if !inCombat || !state.HasRelation(targetID) {
+		return "COMBAT_NOT_ENGAGED"
+	}
+	if isCombatDisabled(w, handle) {
+		return "COMBAT_KNOCKED_OUT"
+	}
+	if state.PendingMove != "" {
+		return "COMBAT_MOVE_IN_PROGRESS"
+	}
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	if state.Cooldowns[move.Key] > nowTick {
+		return "COMBAT_MOVE_COOLDOWN"
+	}
+	if !behaviors.ConsumePlayerLongActionStamina(w, handle, move.StaminaCost) {
+		return "LOW_STAMINA"
+	}
+
+	ecs.WithComponent(w, handle, func(c *components.CombatState) {
+		if c.Cooldowns == nil {
+			c.Cooldowns = make(map[string]uint64, 1)
+		}
+		c.Cooldowns[move.Key] = nowTick + move.CooldownTicks
+		c.PendingMove = move.Key
+		c.PendingTargetID = targetID
+		c.PendingHitTick = nowTick + move.WindupTicks
+	})
+	s.sendCombatState(w, entityID, handle)
+	return ""
+}
+
+// landPendingMove applies the damage of a wound-up move if the target is still within reach
+// and clears the pending move. Damage only changes health; knockout and death are resolved
+// by PlayerDeathSystem later in the same tick.
+func (s *CombatService) landPendingMove(w *ecs.World, attackerID types.EntityID, attackerHandle types.Handle, state components.CombatState) {
+	ecs.WithComponent(w, attackerHandle, func(c *components.CombatState) {
+		c.ClearPendingMove()
+	})
+	defer s.sendCombatState(w, attackerID, attackerHandle)
+
+	move, ok := combat.MoveByKey(state.PendingMove)
+	if !ok || !state.HasRelation(state.PendingTargetID) {
+		return
+	}
+	targetHandle := w.GetHandleByEntityID(state.PendingTargetID)
+	if targetHandle == types.InvalidHandle || !w.Alive(targetHandle) {
+		return
+	}
+	if combatDistance(w, attackerHandle, targetHandle) > move.Reach {
+		s.sendMiniAlert(attackerID, netproto.AlertSeverity_ALERT_SEVERITY_INFO, "COMBAT_TARGET_OUT_OF_REACH")
+		return
+	}
+
+	attributes := characterattrs.Default()
+	if profile, hasProfile := ecs.GetComponent[components.CharacterProfile](w, attackerHandle); hasProfile {
+		attributes = profile.Attributes
+	}
+	soft, hard := combat.Damage(move, attributes, equippedWeaponDamage(w, attackerID))
+	mhp := resolveMaxHHPForHandle(w, targetHandle, s.lifeDeathFactor)
+
+	hit := false
+	downed := false
+	ecs.WithComponent(w, targetHandle, func(health *components.EntityHealth) {
+		if health.HHP <= 0 {
+			return
+		}
+		wasDown := health.SHP <= 0
+		nextSHP, nextHHP, knockedOut, dead := entityhealth.ApplyDamage(health.SHP, health.HHP, mhp, soft, hard)
+		health.SHP = nextSHP
+		health.HHP = nextHHP
+		hit = true
+		downed = dead || (knockedOut && !wasDown)
+	})
+	if !hit {
+		return
+	}
+	ecs.MarkPlayerStatsDirtyByHandle(w, targetHandle, ecs.ResolvePlayerStatsTTLms(w))
+
+	s.logger.Debug("Combat move landed",
+		zap.Int64("attacker_id", int64(attackerID)),
+		zap.Int64("target_id", int64(state.PendingTargetID)),
+		zap.String("move", move.Key),
+		zap.Float64("soft_damage", soft),
+		zap.Float64("hard_damage", hard))
+	if downed {
+		s.grantKnockoutExp(w, attackerID, attackerHandle)
+	}
+}
+
+func (s *CombatService) grantKnockoutExp(w *ecs.World, attackerID types.EntityID, attackerHandle types.Handle) {
+	if !ecs.MutateComponent[components.CharacterProfile](w, attackerHandle, func(profile *components.CharacterProfile) bool {
+		profile.Experience.Combat += combatKnockoutExp
+		return true
+	}) {
+		return
+	}
+	if s.sender == nil {
+		return
+	}
+	gained := combatKnockoutExp
+	s.sender.SendExpGained(attackerID, &netproto.S2C_ExpGained{
+		EntityId: uint64(attackerID),
+		Combat:   &gained,
+	})
+}
+
+// endCombatRelation removes the relation between two entities on both sides.
+func (s *CombatService) endCombatRelation(w *ecs.World, entityID types.EntityID, handle types.Handle, opponentID types.EntityID) {
+	if removeCombatRelation(w, handle, opponentID) {
+		s.sendCombatState(w, entityID, handle)
+	}
+	opponentHandle := w.GetHandleByEntityID(opponentID)
+	if opponentHandle == types.InvalidHandle || !w.Alive(opponentHandle) {
+		return
+	}
+	if removeCombatRelation(w, opponentHandle, entityID) {
+		s.sendCombatState(w, opponentID, opponentHandle)
+	}
+}
+
+func (s *CombatService) sendCombatState(w *ecs.World, entityID types.EntityID, handle types.Handle) {
+	if s.sender == nil {
+		return
+	}
+	state, _ := ecs.GetComponent[components.CombatState](w, handle)
+	s.sender.SendCombatState(entityID, buildCombatStateProto(&state, ecs.GetResource[ecs.TimeState](w).Tick))
+}
+
+func (s *CombatService) sendMiniAlert(entityID types.EntityID, severity netproto.AlertSeverity, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(entityID, &netproto.S2C_MiniAlert{
+		Severity:   severity,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(severity),
+	})
+}
+
+func buildCombatStateProto(state *components.CombatState, nowTick uint64) *netproto.S2C_CombatState {
+	msg := &netproto.S2C_CombatState{
+		OpponentIds: make([]uint64, 0, len(state.Relations)),
+	}
+	for _, relation := range state.Relations {
+		msg.OpponentIds = append(msg.OpponentIds, uint64(relation.TargetID))
+	}
+	if len(state.Relations) == 0 {
+		return msg
+	}
+	for _, move := range combat.Moves() {
+		cooldown := &netproto.CombatCooldown{
+			MoveKey:    move.Key,
+			TotalTicks: uint32(move.CooldownTicks),
+		}
+		if readyTick := state.Cooldowns[move.Key]; readyTick > nowTick {
+			cooldown.RemainingTicks = uint32(readyTick - nowTick)
+		}
+		msg.Cooldowns = append(msg.Cooldowns, cooldown)
+	}
+	if state.PendingMove != "" {
+		msg.PendingMove = state.PendingMove
+		msg.PendingTargetId = uint64(state.PendingTargetID)
+		if state.PendingHitTick > nowTick {
+			msg.PendingRemainingTicks = uint32(state.PendingHitTick - nowTick)
+		}
+	}
+	return msg
+}
+
+func addCombatRelation(w *ecs.World, handle types.Handle, targetID types.EntityID, nowTick uint64) bool {
+	relation := components.CombatRelation{TargetID: targetID, StartedTick: nowTick}
+	if _, inCombat := ecs.GetComponent[components.CombatState](w, handle); !inCombat {
+		ecs.AddComponent(w, handle, components.CombatState{Relations: []components.CombatRelation{relation}})
+		return true
+	}
+	return ecs.MutateComponent[components.CombatState](w, handle, func(c *components.CombatState) bool {
+		if c.HasRelation(targetID) {
+			return false
+		}
+		c.Relations = append(c.Relations, relation)
+		return true
+	})
+}
+
+// removeCombatRelation drops one relation and removes the component once no opponent is left.
+func removeCombatRelation(w *ecs.World, handle types.Handle, targetID types.EntityID) bool {
+	removed := false
+	empty := false
+	ecs.WithComponent(w, handle, func(c *components.CombatState) {
+		removed = c.RemoveRelation(targetID)
+		empty = len(c.Relations) == 0
+	})
+	if empty {
+		ecs.RemoveComponent[components.CombatState](w, handle)
+	}
+	return removed
+}
+
+// isCombatDisabled reports whether the entity is knocked out or dead and cannot fight.
+func isCombatDisabled(w *ecs.World, handle types.Handle) bool {
+	health, hasHealth := ecs.GetComponent[components.EntityHealth](w, handle)
+	if !hasHealth {
+		return false
+	}
+	return health.HHP <= 0 || health.SHP <= 0 || health.KOUntilTick > 0
+}
+
+func combatDistance(w *ecs.World, a types.Handle, b types.Handle) float64 {
+	ta, hasA := ecs.GetComponent[components.Transform](w, a)
+	tb, hasB := ecs.GetComponent[components.Transform](w, b)
+	if !hasA || !hasB {
+		return math.Inf(1)
+	}
+	return math.Hypot(ta.X-tb.X, ta.Y-tb.Y)
+}
+
+// equippedWeaponDamage returns the highest weapon damage among the equipped items.
+func equippedWeaponDamage(w *ecs.World, entityID types.EntityID) float64 {
+	damage := combat.UnarmedDamage
+	equipmentHandle, found := ecs.GetResource[ecs.InventoryRefIndex](w).Lookup(constt.InventoryEquipment, entityID, 0)
+	if !found || !w.Alive(equipmentHandle) {
+		return damage
+	}
+	container, hasContainer := ecs.GetComponent[components.InventoryContainer](w, equipmentHandle)
+	itemRegistry := itemdefs.Global()
+	if !hasContainer || itemRegistry == nil {
+		return damage
+	}
+	for _, item := range container.Items {
+		itemDef, ok := itemRegistry.GetByID(int(item.TypeID))
+		if !ok || itemDef.Weapon == nil {
+			continue
+		}
+		damage = max(damage, itemDef.Weapon.Damage)
+	}
+	return damage
+}
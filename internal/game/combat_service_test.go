@@ -0,0 +1,185 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/characterattrs"
+	"origin/internal/combat"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+)
+
+type testCombatSender struct {
+	alerts []*netproto.S2C_MiniAlert
+	states map[types.EntityID]*netproto.S2C_CombatState
+	exp    []*netproto.S2C_ExpGained
+}
+
+func newTestCombatSender() *testCombatSender {
+	return &testCombatSender{states: make(map[types.EntityID]*netproto.S2C_CombatState)}
+}
+
+func (s *testCombatSender) SendMiniAlert(_ types.EntityID, alert *netproto.S2C_MiniAlert) {
+	s.alerts = append(s.alerts, alert)
+}
+
+func (s *testCombatSender) SendCombatState(entityID types.EntityID, state *netproto.S2C_CombatState) {
+	s.states[entityID] = state
+}
+
+func (s *testCombatSender) SendExpGained(_ types.EntityID, gained *netproto.S2C_ExpGained) {
+	s.exp = append(s.exp, gained)
+}
+
+func (s *testCombatSender) lastAlert() string {
+	if len(s.alerts) == 0 {
+		return ""
+	}
+	return s.alerts[len(s.alerts)-1].ReasonCode
+}
+
+func spawnCombatTestPlayer(world *ecs.World, playerID types.EntityID, x float64, shp float64, hhp float64) types.Handle {
+	return world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.Transform{X: x})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 1000, Energy: 1000})
+		ecs.AddComponent(w, h, components.EntityHealth{SHP: shp, HHP: hhp})
+	})
+}
+
+func setCombatTestTick(world *ecs.World, tick uint64) {
+	ecs.GetResource[ecs.TimeState](world).Tick = tick
+}
+
+func TestCombatService_AttackCreatesRelationOnBothSides(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	attackerID, targetID, farID := types.EntityID(6001), types.EntityID(6002), types.EntityID(6003)
+	attackerHandle := spawnCombatTestPlayer(world, attackerID, 0, 25, 25)
+	targetHandle := spawnCombatTestPlayer(world, targetID, 10, 25, 25)
+	spawnCombatTestPlayer(world, farID, combat.DisengageDistance+1, 25, 25)
+	sender := newTestCombatSender()
+	service := NewCombatService(sender, 1, nil)
+
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(attackerID)})
+	if got := sender.lastAlert(); got != "COMBAT_INVALID_TARGET" {
+		t.Fatalf("expected COMBAT_INVALID_TARGET for self attack, got %q", got)
+	}
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(farID)})
+	if got := sender.lastAlert(); got != "COMBAT_TARGET_TOO_FAR" {
+		t.Fatalf("expected COMBAT_TARGET_TOO_FAR, got %q", got)
+	}
+
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(targetID)})
+	attackerState, _ := ecs.GetComponent[components.CombatState](world, attackerHandle)
+	targetState, _ := ecs.GetComponent[components.CombatState](world, targetHandle)
+	if !attackerState.HasRelation(targetID) || !targetState.HasRelation(attackerID) {
+		t.Fatalf("expected relation on both sides, got attacker=%+v target=%+v", attackerState, targetState)
+	}
+	state := sender.states[targetID]
+	if state == nil || len(state.OpponentIds) != 1 || state.OpponentIds[0] != uint64(attackerID) {
+		t.Fatalf("expected target to receive combat state, got %+v", state)
+	}
+	if len(state.Cooldowns) != len(combat.Moves()) {
+		t.Fatalf("expected cooldown entry per move, got %d", len(state.Cooldowns))
+	}
+}
+
+func TestCombatService_MoveLandsAfterWindupAndKnocksOut(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	attackerID, targetID := types.EntityID(6101), types.EntityID(6102)
+	attackerHandle := spawnCombatTestPlayer(world, attackerID, 0, 25, 25)
+	targetHandle := spawnCombatTestPlayer(world, targetID, 10, 5, 25)
+	ecs.GetResource[ecs.CharacterEntities](world).Add(targetID, targetHandle, time.Now())
+	sender := newTestCombatSender()
+	service := NewCombatService(sender, 1, nil)
+	combatSystem := NewCombatSystem(service)
+	deathSystem := NewPlayerDeathSystem(&testPlayerDeathHandler{}, PlayerDeathSystemConfig{LifeDeathFactor: 1})
+	setCombatTestTick(world, 101)
+
+	move, _ := combat.MoveByKey("strike")
+	service.HandleCombatMove(world, attackerID, attackerHandle, &netproto.C2S_CombatMove{MoveKey: move.Key, TargetId: uint64(targetID)})
+	if got := sender.lastAlert(); got != "COMBAT_NOT_ENGAGED" {
+		t.Fatalf("expected COMBAT_NOT_ENGAGED before attack, got %q", got)
+	}
+
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(targetID)})
+	service.HandleCombatMove(world, attackerID, attackerHandle, &netproto.C2S_CombatMove{MoveKey: move.Key, TargetId: uint64(targetID)})
+	stats, _ := ecs.GetComponent[components.EntityStats](world, attackerHandle)
+	if stats.Stamina != 1000-move.StaminaCost {
+		t.Fatalf("expected stamina cost %v, got stamina %v", move.StaminaCost, stats.Stamina)
+	}
+	if state := sender.states[attackerID]; state.PendingMove != move.Key || state.PendingRemainingTicks != uint32(move.WindupTicks) {
+		t.Fatalf("expected pending %s, got %+v", move.Key, state)
+	}
+	service.HandleCombatMove(world, attackerID, attackerHandle, &netproto.C2S_CombatMove{MoveKey: "jab", TargetId: uint64(targetID)})
+	if got := sender.lastAlert(); got != "COMBAT_MOVE_IN_PROGRESS" {
+		t.Fatalf("expected COMBAT_MOVE_IN_PROGRESS, got %q", got)
+	}
+
+	setCombatTestTick(world, 101+move.WindupTicks-1)
+	combatSystem.Update(world, 0)
+	if health, _ := ecs.GetComponent[components.EntityHealth](world, targetHandle); health.SHP != 5 {
+		t.Fatalf("hit must not land before windup ends, got SHP %v", health.SHP)
+	}
+
+	setCombatTestTick(world, 101+move.WindupTicks)
+	combatSystem.Update(world, 0)
+	deathSystem.Update(world, 0)
+	health, _ := ecs.GetComponent[components.EntityHealth](world, targetHandle)
+	if health.SHP != 0 || health.HHP != 25-0.8 || health.KOUntilTick == 0 {
+		t.Fatalf("expected knockout with 0.8 hard damage, got %+v", health)
+	}
+	if len(sender.exp) != 1 || sender.exp[0].GetCombat() != combatKnockoutExp {
+		t.Fatalf("expected combat exp for the knockout, got %+v", sender.exp)
+	}
+	if state := sender.states[attackerID]; state.PendingMove != "" || state.Cooldowns[1].RemainingTicks == 0 {
+		t.Fatalf("expected cleared pending move and running cooldown, got %+v", state)
+	}
+
+	service.HandleCombatMove(world, attackerID, attackerHandle, &netproto.C2S_CombatMove{MoveKey: move.Key, TargetId: uint64(targetID)})
+	if got := sender.lastAlert(); got != "COMBAT_MOVE_COOLDOWN" {
+		t.Fatalf("expected COMBAT_MOVE_COOLDOWN, got %q", got)
+	}
+	service.HandleAttack(world, targetID, targetHandle, &netproto.Attack{EntityId: uint64(attackerID)})
+	if got := sender.lastAlert(); got != "COMBAT_KNOCKED_OUT" {
+		t.Fatalf("expected knocked out target to be unable to fight, got %q", got)
+	}
+}
+
+func TestCombatSystem_DisengagesByDistanceAndDeath(t *testing.T) {
+	world := ecs.NewWorldForTesting()
+	attackerID, targetID, victimID := types.EntityID(6201), types.EntityID(6202), types.EntityID(6203)
+	attackerHandle := spawnCombatTestPlayer(world, attackerID, 0, 25, 25)
+	targetHandle := spawnCombatTestPlayer(world, targetID, 10, 25, 25)
+	victimHandle := spawnCombatTestPlayer(world, victimID, 10, 25, 25)
+	sender := newTestCombatSender()
+	service := NewCombatService(sender, 1, nil)
+	system := NewCombatSystem(service)
+
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(targetID)})
+	service.HandleAttack(world, attackerID, attackerHandle, &netproto.Attack{EntityId: uint64(victimID)})
+
+	ecs.WithComponent(world, targetHandle, func(transform *components.Transform) {
+		transform.X = combat.DisengageDistance + 1
+	})
+	ecs.WithComponent(world, victimHandle, func(health *components.EntityHealth) {
+		health.HHP = 0
+	})
+	system.Update(world, 0)
+
+	if _, inCombat := ecs.GetComponent[components.CombatState](world, attackerHandle); inCombat {
+		t.Fatalf("expected attacker to leave combat")
+	}
+	if _, inCombat := ecs.GetComponent[components.CombatState](world, targetHandle); inCombat {
+		t.Fatalf("expected distant target to leave combat")
+	}
+	if _, inCombat := ecs.GetComponent[components.CombatState](world, victimHandle); inCombat {
+		t.Fatalf("expected dead victim to leave combat")
+	}
+	if state := sender.states[attackerID]; len(state.OpponentIds) != 0 || len(state.Cooldowns) != 0 {
+		t.Fatalf("expected empty combat state for attacker, got %+v", state)
+	}
+}
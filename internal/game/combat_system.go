@@ -0,0 +1,116 @@
+package game
+
+import (
+	"origin/internal/combat"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/types"
+)
+
+// CombatSystemPriority runs combat before PlayerDeathSystem, so a hit that empties SHP or HHP
+// is turned into knockout or death in the same tick.
+const CombatSystemPriority = 465
+
+// CombatSystem lands wound-up combat moves and ends combat relations when an opponent
+// is gone, dead, or further away than combat.DisengageDistance.
+type CombatSystem struct {
+	ecs.BaseSystem
+	service *CombatService
+	query   *ecs.PreparedQuery
+	handles []types.Handle
+}
+
+func NewCombatSystem(service *CombatService) *CombatSystem {
+	return &CombatSystem{
+		BaseSystem: ecs.NewBaseSystem("CombatSystem", CombatSystemPriority),
+		service:    service,
+		handles:    make([]types.Handle, 0, 32),
+	}
+}
+
+func (s *CombatSystem) Update(w *ecs.World, dt float64) {
+	_ = dt
+	if s.service == nil {
+		return
+	}
+
+	if s.query == nil {
+		s.query = ecs.NewPreparedQuery(
+			w,
+			(1<<ecs.ExternalIDComponentID)|
+				(1<<components.CombatStateComponentID),
+			0,
+		)
+	}
+
+	s.handles = s.handles[:0]
+	s.query.ForEach(func(h types.Handle) {
+		s.handles = append(s.handles, h)
+	})
+	if len(s.handles) == 0 {
+		return
+	}
+
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	for _, handle := range s.handles {
+		if handle == types.InvalidHandle || !w.Alive(handle) {
+			continue
+		}
+		entityID, hasExternalID := w.GetExternalID(handle)
+		if !hasExternalID {
+			continue
+		}
+
+		s.disengage(w, entityID, handle)
+
+		state, inCombat := ecs.GetComponent[components.CombatState](w, handle)
+		if !inCombat || state.PendingMove == "" {
+			continue
+		}
+		if isCombatDisabled(w, handle) {
+			ecs.WithComponent(w, handle, func(c *components.CombatState) {
+				c.ClearPendingMove()
+			})
+			s.service.sendCombatState(w, entityID, handle)
+			continue
+		}
+		if state.PendingHitTick > nowTick {
+			continue
+		}
+		s.service.landPendingMove(w, entityID, handle, state)
+	}
+}
+
+// disengage ends every relation of the entity whose opponent is gone, dead or too far away.
+// A dead entity leaves all of its fights.
+func (s *CombatSystem) disengage(w *ecs.World, entityID types.EntityID, handle types.Handle) {
+	state, inCombat := ecs.GetComponent[components.CombatState](w, handle)
+	if !inCombat {
+		return
+	}
+	selfDead := false
+	if health, hasHealth := ecs.GetComponent[components.EntityHealth](w, handle); hasHealth && health.HHP <= 0 {
+		selfDead = true
+	}
+
+	opponents := make([]types.EntityID, 0, len(state.Relations))
+	for _, relation := range state.Relations {
+		opponents = append(opponents, relation.TargetID)
+	}
+	for _, opponentID := range opponents {
+		if selfDead || !canStayInCombat(w, handle, opponentID) {
+			s.service.endCombatRelation(w, entityID, handle, opponentID)
+		}
+	}
+}
+
+func canStayInCombat(w *ecs.World, handle types.Handle, opponentID types.EntityID) bool {
+	opponentHandle := w.GetHandleByEntityID(opponentID)
+	if opponentHandle == types.InvalidHandle || !w.Alive(opponentHandle) {
+		return false
+	}
+	if health, hasHealth := ecs.GetComponent[components.EntityHealth](w, opponentHandle); !hasHealth || health.HHP <= 0 {
+		return false
+	}
+	return combatDistance(w, handle, opponentHandle) <= combat.DisengageDistance
+}
@@ -12,6 +12,7 @@ import (
 	"origin/internal/eventbus"
 	"origin/internal/game/behaviors"
 	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
 	"origin/internal/itemdefs"
 	netproto "origin/internal/network/proto"
 	"origin/internal/types"
@@ -41,6 +42,14 @@ type miniAlertSender interface {
 	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
 }
 
+type inventorySyncer interface {
+	SyncInventories(w *ecs.World, containerHandles []types.Handle)
+}
+
+type inventoryUpdateSender interface {
+	SendInventoryUpdate(entityID types.EntityID, states []*netproto.InventoryState)
+}
+
 type cyclicActionFinishSender interface {
 	SendCyclicActionFinished(entityID types.EntityID, finished *netproto.S2C_CyclicActionFinished)
 }
@@ -56,6 +65,9 @@ type ContextActionService struct {
 	crafting         *CraftingService
 	build            *BuildService
 	lift             *LiftService
+	itemActions      *ItemActionService
+	farming          *FarmingService
+	terraform        *TerraformService
 }
 
 func NewContextActionService(
@@ -117,6 +129,9 @@ func NewContextActionService(
 	if buildStateSender, ok := any(alerts).(contracts.BuildStateSender); ok {
 		s.actionDeps.BuildState = buildStateSender
 	}
+	if syncer, ok := any(openSvc).(inventorySyncer); ok {
+		s.actionDeps.SyncInventories = syncer.SyncInventories
+	}
 
 	if eventBus != nil {
 		eventBus.SubscribeSync(ecs.TopicGameplayLinkCreated, eventbus.PriorityHigh, s.onLinkCreated)
@@ -147,6 +162,27 @@ func (s *ContextActionService) SetBuildService(build *BuildService) {
 	s.build = build
 }
 
+func (s *ContextActionService) SetItemActionService(itemActions *ItemActionService) {
+	if s == nil {
+		return
+	}
+	s.itemActions = itemActions
+}
+
+func (s *ContextActionService) SetFarmingService(farming *FarmingService) {
+	if s == nil {
+		return
+	}
+	s.farming = farming
+}
+
+func (s *ContextActionService) SetTerraformService(terraform *TerraformService) {
+	if s == nil {
+		return
+	}
+	s.terraform = terraform
+}
+
 func (s *ContextActionService) SetLiftService(lift *LiftService) {
 	if s == nil {
 		return
@@ -167,6 +203,56 @@ func (s *ContextActionService) SetLiftService(lift *LiftService) {
 	}
 }
 
+// SetWearTool sets how behaviors wear out the tool used for each work cycle.
+func (s *ContextActionService) SetWearTool(wearTool contracts.WearToolFn) {
+	if s == nil {
+		return
+	}
+	s.actionDeps.WearTool = wearTool
+}
+
+func (s *ContextActionService) SetGiveItemOrDrop(giveItemOrDrop contracts.GiveItemFn) {
+	if s == nil {
+		return
+	}
+	s.actionDeps.GiveItemOrDrop = giveItemOrDrop
+}
+
+// newGiveItemOrDropFn adapts InventoryExecutor.GiveCraftOutputOrDrop for behaviors whose yield
+// must not be lost to a full inventory.
+func newGiveItemOrDropFn(invExec *inventory.InventoryExecutor, sender inventoryUpdateSender) contracts.GiveItemFn {
+	return func(
+		w *ecs.World,
+		playerID types.EntityID,
+		playerHandle types.Handle,
+		itemKey string,
+		count uint32,
+		quality uint32,
+	) contracts.GiveItemOutcome {
+		if invExec == nil {
+			return contracts.GiveItemOutcome{Success: false, Message: "inventory executor unavailable"}
+		}
+		result := invExec.GiveCraftOutputOrDrop(w, playerID, playerHandle, itemKey, count, quality)
+		if sender != nil && len(result.UpdatedContainers) > 0 {
+			states := invExec.ConvertContainersToStates(w, result.UpdatedContainers)
+			updated := make([]*netproto.InventoryState, 0, len(states))
+			for _, state := range states {
+				updated = append(updated, systems.BuildInventoryStateProto(state))
+			}
+			if len(updated) > 0 {
+				sender.SendInventoryUpdate(playerID, updated)
+			}
+		}
+		outcome := contracts.GiveItemOutcome{Success: result.Success, AnyDropped: result.AnyDropped}
+		if result.Success {
+			outcome.GrantedCount = count
+		} else {
+			outcome.Message = "failed to give or drop item"
+		}
+		return outcome
+	}
+}
+
 var _ systems.ContextActionResolver = (*ContextActionService)(nil)
 
 func (s *ContextActionService) ComputeActions(
@@ -424,6 +510,15 @@ func (s *ContextActionService) handleCyclicCycleComplete(
 	if s.build != nil && s.build.IsSyntheticBuildAction(action) {
 		return s.build.HandleBuildCycleComplete(w, playerID, playerHandle, action)
 	}
+	if s.itemActions != nil && s.itemActions.IsSyntheticEatAction(action) {
+		return s.itemActions.HandleEatCycleComplete(w, playerID, playerHandle, action)
+	}
+	if s.farming != nil && s.farming.IsSyntheticFarmingAction(action) {
+		return s.farming.HandleFarmingCycleComplete(w, playerID, playerHandle, action)
+	}
+	if s.terraform != nil && s.terraform.IsSyntheticTerraformAction(action) {
+		return s.terraform.HandleTerraformCycleComplete(w, playerID, playerHandle, action)
+	}
 	if action.BehaviorKey == "" || s.behaviorRegistry == nil {
 		return contracts.BehaviorCycleDecisionCanceled
 	}
@@ -482,6 +577,15 @@ func (s *ContextActionService) isActiveCyclicActionStillValid(
 	if s.build != nil && s.build.IsSyntheticBuildAction(action) {
 		return s.build.IsActiveBuildStillValid(w, playerID, playerHandle, action)
 	}
+	if s.itemActions != nil && s.itemActions.IsSyntheticEatAction(action) {
+		return s.itemActions.IsActiveEatStillValid(w, playerID, playerHandle, action)
+	}
+	if s.farming != nil && s.farming.IsSyntheticFarmingAction(action) {
+		return s.farming.IsActiveFarmingStillValid(w, playerID, playerHandle, action)
+	}
+	if s.terraform != nil && s.terraform.IsSyntheticTerraformAction(action) {
+		return s.terraform.IsActiveTerraformStillValid(w, playerID, playerHandle, action)
+	}
 	if w == nil || s.behaviorRegistry == nil || action.BehaviorKey == "" || action.ActionID == "" {
 		return false
 	}
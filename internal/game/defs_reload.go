@@ -0,0 +1,361 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/builddefs"
+	"origin/internal/craftdefs"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
+	"origin/internal/network"
+	"origin/internal/objectdefs"
+	"origin/internal/processdefs"
+	"origin/internal/skilldefs"
+	"origin/internal/tiledefs"
+	"origin/internal/types"
+)
+
+const defsReloadTimeout = 30 * time.Second
+
+// DefsPaths are the data directories definitions are loaded from.
+type DefsPaths struct {
+	Items     string
+	Objects   string
+	Crafts    string
+	Builds    string
+	Processes string
+	Skills    string
+	Tiles     string
+}
+
+func DefaultDefsPaths() DefsPaths {
+	return DefsPaths{
+		Items:     "./data/items",
+		Objects:   "./data/objects",
+		Crafts:    "./data/crafts",
+		Builds:    "./data/builds",
+		Processes: "./data/processes",
+		Skills:    "./data/skills",
+		Tiles:     "./data/tiles",
+	}
+}
+
+func (p DefsPaths) dirs() []string {
+	return []string{p.Items, p.Objects, p.Crafts, p.Builds, p.Processes, p.Skills, p.Tiles}
+}
+
+// DefsSnapshot is a fully loaded and cross-validated set of definition registries.
+type DefsSnapshot struct {
+	Items     *itemdefs.Registry
+	Objects   *objectdefs.Registry
+	Crafts    *craftdefs.Registry
+	Builds    *builddefs.Registry
+	Processes *processdefs.Registry
+	Skills    *skilldefs.Registry
+	Tiles     *tiledefs.Registry
+}
+
+// LoadDefsSnapshot loads all definitions without touching the global registries.
+// References between definitions are validated against the new registries, not the live ones.
+func LoadDefsSnapshot(paths DefsPaths, behaviorRegistry contracts.BehaviorRegistry, logger *zap.Logger) (*DefsSnapshot, error) {
+	items, err := itemdefs.LoadFromDirectory(paths.Items, logger)
+	if err != nil {
+		return nil, fmt.Errorf("items: %w", err)
+	}
+	objects, err := objectdefs.LoadFromDirectoryWithItems(paths.Objects, behaviorRegistry, items, logger)
+	if err != nil {
+		return nil, fmt.Errorf("objects: %w", err)
+	}
+	crafts, err := craftdefs.LoadFromDirectoryWithRefs(paths.Crafts, items, objects, logger)
+	if err != nil {
+		return nil, fmt.Errorf("crafts: %w", err)
+	}
+	builds, err := builddefs.LoadFromDirectoryWithRefs(paths.Builds, items, objects, logger)
+	if err != nil {
+		return nil, fmt.Errorf("builds: %w", err)
+	}
+	processes, err := processdefs.LoadFromDirectoryWithRefs(paths.Processes, items, logger)
+	if err != nil {
+		return nil, fmt.Errorf("processes: %w", err)
+	}
+	skills, err := skilldefs.LoadFromDirectory(paths.Skills, logger)
+	if err != nil {
+		return nil, fmt.Errorf("skills: %w", err)
+	}
+	tiles, err := tiledefs.LoadFromDirectory(paths.Tiles, logger)
+	if err != nil {
+		return nil, fmt.Errorf("tiles: %w", err)
+	}
+	return &DefsSnapshot{Items: items, Objects: objects, Crafts: crafts, Builds: builds, Processes: processes, Skills: skills, Tiles: tiles}, nil
+}
+
+// apply makes the snapshot global. Must run between ticks.
+func (s *DefsSnapshot) apply() {
+	itemdefs.Replace(s.Items)
+	objectdefs.Replace(s.Objects)
+	craftdefs.Replace(s.Crafts)
+	builddefs.Replace(s.Builds)
+	processdefs.Replace(s.Processes)
+	skilldefs.Replace(s.Skills)
+	tiledefs.Replace(s.Tiles)
+}
+
+// DefsInUse collects definition ids referenced by world state.
+type DefsInUse struct {
+	ObjectIDs map[int]struct{}
+	ItemIDs   map[int]struct{}
+}
+
+func NewDefsInUse() DefsInUse {
+	return DefsInUse{
+		ObjectIDs: make(map[int]struct{}),
+		ItemIDs:   make(map[int]struct{}),
+	}
+}
+
+// collectLiveDefsInUse adds object and item defIds of all entities of the world.
+func collectLiveDefsInUse(w *ecs.World, inUse DefsInUse) {
+	ecs.NewQuery(w).
+		With(components.EntityInfoComponentID).
+		ForEach(func(h types.Handle) {
+			if info, ok := ecs.GetComponent[components.EntityInfo](w, h); ok && info.TypeID != 0 {
+				inUse.ObjectIDs[int(info.TypeID)] = struct{}{}
+			}
+		})
+	ecs.NewQuery(w).
+		With(components.InventoryContainerComponentID).
+		ForEach(func(h types.Handle) {
+			container, ok := ecs.GetComponent[components.InventoryContainer](w, h)
+			if !ok {
+				return
+			}
+			for _, item := range container.Items {
+				inUse.ItemIDs[int(item.TypeID)] = struct{}{}
+			}
+		})
+}
+
+// CheckRemovals rejects the snapshot if it drops a defId that is still in use.
+func (s *DefsSnapshot) CheckRemovals(inUse DefsInUse) error {
+	var missingObjects, missingItems []int
+	for id := range inUse.ObjectIDs {
+		if _, ok := s.Objects.GetByID(id); !ok {
+			missingObjects = append(missingObjects, id)
+		}
+	}
+	for id := range inUse.ItemIDs {
+		if _, ok := s.Items.GetByID(id); !ok {
+			missingItems = append(missingItems, id)
+		}
+	}
+	if len(missingObjects) == 0 && len(missingItems) == 0 {
+		return nil
+	}
+
+	var parts []string
+	if len(missingObjects) > 0 {
+		parts = append(parts, "object defIds "+joinSortedIDs(missingObjects))
+	}
+	if len(missingItems) > 0 {
+		parts = append(parts, "item defIds "+joinSortedIDs(missingItems))
+	}
+	return fmt.Errorf("removed definitions still in use: %s", strings.Join(parts, "; "))
+}
+
+func joinSortedIDs(ids []int) string {
+	sort.Ints(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ", ")
+}
+
+type DefsReloadResult struct {
+	Items     int
+	Objects   int
+	Crafts    int
+	Builds    int
+	Processes int
+	Skills    int
+	Tiles     int
+}
+
+func (r DefsReloadResult) String() string {
+	return fmt.Sprintf("definitions reloaded: %d items, %d objects, %d crafts, %d builds, %d processes, %d skills, %d tiles",
+		r.Items, r.Objects, r.Crafts, r.Builds, r.Processes, r.Skills, r.Tiles)
+}
+
+// AdminDefsReloader reloads definitions off the shard tick and reports the result through done
+// from another goroutine.
+type AdminDefsReloader interface {
+	ReloadDefs(done func(DefsReloadResult, error))
+}
+
+// defsReloadRequest hands a loaded snapshot to the game loop.
+type defsReloadRequest struct {
+	snapshot *DefsSnapshot
+	// persisted holds defIds referenced by saved objects and inventories that may not be loaded.
+	persisted DefsInUse
+	result    chan error
+}
+
+// ReloadDefs implements AdminDefsReloader.
+func (g *Game) ReloadDefs(done func(DefsReloadResult, error)) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		result, err := g.reloadDefs()
+		if err != nil {
+			g.logger.Warn("Definitions reload rejected", zap.Error(err))
+		} else {
+			g.logger.Info("Definitions reloaded",
+				zap.Int("items", result.Items),
+				zap.Int("objects", result.Objects),
+				zap.Int("crafts", result.Crafts),
+				zap.Int("builds", result.Builds),
+				zap.Int("processes", result.Processes),
+				zap.Int("skills", result.Skills),
+				zap.Int("tiles", result.Tiles))
+		}
+		done(result, err)
+	}()
+}
+
+// reloadDefs loads and validates definitions on the calling goroutine, then waits for the game
+// loop to check live usage and swap the registries between ticks.
+func (g *Game) reloadDefs() (DefsReloadResult, error) {
+	if !g.defsReloadMu.TryLock() {
+		return DefsReloadResult{}, fmt.Errorf("reload already in progress")
+	}
+	defer g.defsReloadMu.Unlock()
+
+	behaviorRegistry, err := behaviors.DefaultRegistry()
+	if err != nil {
+		return DefsReloadResult{}, fmt.Errorf("behavior registry: %w", err)
+	}
+	snapshot, err := LoadDefsSnapshot(g.defsPaths, behaviorRegistry, g.logger)
+	if err != nil {
+		return DefsReloadResult{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(g.ctx, defsReloadTimeout)
+	defer cancel()
+	persisted, err := g.loadPersistedDefsInUse(ctx)
+	if err != nil {
+		return DefsReloadResult{}, err
+	}
+
+	req := &defsReloadRequest{snapshot: snapshot, persisted: persisted, result: make(chan error, 1)}
+	select {
+	case g.defsReloads <- req:
+	case <-ctx.Done():
+		return DefsReloadResult{}, ctx.Err()
+	}
+	select {
+	case err := <-req.result:
+		if err != nil {
+			return DefsReloadResult{}, err
+		}
+	case <-ctx.Done():
+		return DefsReloadResult{}, ctx.Err()
+	}
+
+	return DefsReloadResult{
+		Items:     snapshot.Items.Count(),
+		Objects:   snapshot.Objects.Count(),
+		Crafts:    snapshot.Crafts.Count(),
+		Builds:    snapshot.Builds.Count(),
+		Processes: snapshot.Processes.Count(),
+		Skills:    snapshot.Skills.Count(),
+		Tiles:     snapshot.Tiles.Count(),
+	}, nil
+}
+
+func (g *Game) loadPersistedDefsInUse(ctx context.Context) (DefsInUse, error) {
+	inUse := NewDefsInUse()
+	if g.db == nil {
+		return inUse, nil
+	}
+	objectIDs, err := g.db.Queries().ListObjectTypeIDsInUse(ctx)
+	if err != nil {
+		return inUse, fmt.Errorf("load object type ids: %w", err)
+	}
+	for _, id := range objectIDs {
+		inUse.ObjectIDs[id] = struct{}{}
+	}
+	itemIDs, err := g.db.Queries().ListInventoryItemTypeIDsInUse(ctx)
+	if err != nil {
+		return inUse, fmt.Errorf("load item type ids: %w", err)
+	}
+	for _, id := range itemIDs {
+		inUse.ItemIDs[id] = struct{}{}
+	}
+	return inUse, nil
+}
+
+// applyPendingDefsReload runs on the game loop before shards tick.
+func (g *Game) applyPendingDefsReload() {
+	select {
+	case req := <-g.defsReloads:
+		req.result <- g.applyDefsReload(req)
+	default:
+	}
+}
+
+func (g *Game) applyDefsReload(req *defsReloadRequest) error {
+	inUse := req.persisted
+	shards := g.shardManager.GetShards()
+	for _, shard := range shards {
+		shard.WithWorldRead(func(w *ecs.World) {
+			collectLiveDefsInUse(w, inUse)
+		})
+	}
+	if err := req.snapshot.CheckRemovals(inUse); err != nil {
+		return err
+	}
+
+	req.snapshot.apply()
+
+	for layer, shard := range shards {
+		players := make(map[types.EntityID]types.Handle)
+		shard.WithWorldRead(func(w *ecs.World) {
+			for entityID, charEntity := range ecs.GetResource[ecs.CharacterEntities](w).Map {
+				players[entityID] = charEntity.Handle
+			}
+		})
+		for playerID, handle := range players {
+			enqueueDefsListSnapshots(shard, layer, playerID, handle)
+		}
+	}
+	return nil
+}
+
+// enqueueDefsListSnapshots re-sends craft and build lists, both depend on the definitions.
+func enqueueDefsListSnapshots(shard *Shard, layer int, playerID types.EntityID, handle types.Handle) {
+	now := time.Now()
+	_ = shard.ServerInbox().Enqueue(&network.ServerJob{
+		JobType:   network.JobSendCraftListSnapshot,
+		TargetID:  playerID,
+		Payload:   &network.CraftListSnapshotJobPayload{Handle: handle},
+		CreatedAt: now,
+		Layer:     layer,
+	})
+	_ = shard.ServerInbox().Enqueue(&network.ServerJob{
+		JobType:   network.JobSendBuildListSnapshot,
+		TargetID:  playerID,
+		Payload:   &network.BuildListSnapshotJobPayload{Handle: handle},
+		CreatedAt: now,
+		Layer:     layer,
+	})
+}
@@ -0,0 +1,155 @@
+package game
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+type syncDefsReloader struct {
+	calls  int
+	result DefsReloadResult
+	err    error
+}
+
+func (r *syncDefsReloader) ReloadDefs(done func(DefsReloadResult, error)) {
+	r.calls++
+	done(r.result, r.err)
+}
+
+func TestLoadDefsSnapshot_RepoDataWithoutGlobals(t *testing.T) {
+	prevItems := itemdefs.Global()
+	prevObjects := objectdefs.Global()
+	itemdefs.SetGlobalForTesting(nil)
+	objectdefs.SetGlobalForTesting(nil)
+	t.Cleanup(func() {
+		itemdefs.SetGlobalForTesting(prevItems)
+		objectdefs.SetGlobalForTesting(prevObjects)
+	})
+
+	dataDir := filepath.Join("..", "..", "data")
+	paths := DefsPaths{
+		Items:     filepath.Join(dataDir, "items"),
+		Objects:   filepath.Join(dataDir, "objects"),
+		Crafts:    filepath.Join(dataDir, "crafts"),
+		Builds:    filepath.Join(dataDir, "builds"),
+		Processes: filepath.Join(dataDir, "processes"),
+		Skills:    filepath.Join(dataDir, "skills"),
+		Tiles:     filepath.Join(dataDir, "tiles"),
+	}
+	snapshot, err := LoadDefsSnapshot(paths, behaviors.MustDefaultRegistry(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("load snapshot: %v", err)
+	}
+	if snapshot.Items.Count() == 0 || snapshot.Objects.Count() == 0 {
+		t.Fatalf("expected items and objects to load, got %d items, %d objects", snapshot.Items.Count(), snapshot.Objects.Count())
+	}
+	if itemdefs.Global() != nil || objectdefs.Global() != nil {
+		t.Fatal("loading a snapshot must not touch global registries")
+	}
+}
+
+func TestDefsSnapshot_CheckRemovalsRejectsDefsInUse(t *testing.T) {
+	snapshot := &DefsSnapshot{
+		Items:   itemdefs.NewRegistry([]itemdefs.ItemDef{{DefID: 1, Key: "stone"}, {DefID: 2, Key: "branch"}}),
+		Objects: objectdefs.NewRegistry([]objectdefs.ObjectDef{{DefID: 10, Key: "box"}}),
+	}
+
+	inUse := NewDefsInUse()
+	inUse.ObjectIDs[10] = struct{}{}
+	inUse.ItemIDs[2] = struct{}{}
+	if err := snapshot.CheckRemovals(inUse); err != nil {
+		t.Fatalf("expected reload to be accepted, got %v", err)
+	}
+
+	inUse.ObjectIDs[12] = struct{}{}
+	inUse.ObjectIDs[11] = struct{}{}
+	inUse.ItemIDs[3] = struct{}{}
+	err := snapshot.CheckRemovals(inUse)
+	if err == nil {
+		t.Fatal("expected reload removing used defs to be rejected")
+	}
+	if want := "object defIds 11, 12; item defIds 3"; !strings.Contains(err.Error(), want) {
+		t.Fatalf("expected %q in error, got %q", want, err.Error())
+	}
+}
+
+func TestCollectLiveDefsInUse(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	w.Spawn(types.EntityID(100), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: 10})
+	})
+	containerHandle := w.SpawnWithoutExternalID()
+	ecs.AddComponent(w, containerHandle, components.InventoryContainer{
+		OwnerID: 100,
+		Items:   []components.InvItem{{ItemID: 1, TypeID: 3}, {ItemID: 2, TypeID: 4}},
+	})
+
+	inUse := NewDefsInUse()
+	collectLiveDefsInUse(w, inUse)
+
+	if _, ok := inUse.ObjectIDs[10]; !ok || len(inUse.ObjectIDs) != 1 {
+		t.Fatalf("unexpected object ids: %v", inUse.ObjectIDs)
+	}
+	if _, ok := inUse.ItemIDs[3]; !ok || len(inUse.ItemIDs) != 2 {
+		t.Fatalf("unexpected item ids: %v", inUse.ItemIDs)
+	}
+}
+
+func TestHandleCommand_ReloadDefsAudited(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, mockChat := newPermissionTestHandler(t, roles, audit)
+	reloader := &syncDefsReloader{result: DefsReloadResult{Items: 5, Objects: 4, Crafts: 3, Builds: 2, Processes: 1, Skills: 6, Tiles: 7}}
+	handler.SetDefsReloader(reloader)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleAdmin)
+
+	if handled := handler.HandleCommand(world, playerID, types.InvalidHandle, "/reloaddefs"); !handled {
+		t.Fatal("expected /reloaddefs to be handled")
+	}
+	if reloader.calls != 1 {
+		t.Fatalf("expected 1 reload, got %d", reloader.calls)
+	}
+	if want := "definitions reloaded: 5 items, 4 objects, 3 crafts, 2 builds, 1 processes, 6 skills, 7 tiles"; mockChat.messages[playerID] != want {
+		t.Fatalf("unexpected reply: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Outcome != AdminAuditSuccess {
+		t.Fatalf("expected one successful audit entry, got %+v", audit.entries)
+	}
+
+	reloader.err = errors.New("removed definitions still in use: item defIds 3")
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/reloaddefs")
+	if want := "reload failed: removed definitions still in use: item defIds 3"; mockChat.messages[playerID] != want {
+		t.Fatalf("unexpected reply: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 2 || audit.entries[1].Outcome != AdminAuditFailed {
+		t.Fatalf("expected failed audit entry, got %+v", audit.entries)
+	}
+}
+
+func TestHandleCommand_ReloadDefsRequiresAdmin(t *testing.T) {
+	roles := NewPlayerRoles()
+	handler, world, _ := newPermissionTestHandler(t, roles, &recordingAdminAudit{})
+	reloader := &syncDefsReloader{}
+	handler.SetDefsReloader(reloader)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleGamemaster)
+
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/reloaddefs")
+	if reloader.calls != 0 {
+		t.Fatal("gamemaster must not be able to reload definitions")
+	}
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// defsWatchDebounce collapses the burst of events an editor produces on save into one reload.
+const defsWatchDebounce = 500 * time.Millisecond
+
+// startDefsWatcher reloads definitions whenever a JSON file in the data directories changes.
+// Dev only: a rejected reload just leaves the previous definitions in place.
+func (g *Game) startDefsWatcher() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		g.logger.Warn("Definitions watcher disabled", zap.Error(err))
+		return
+	}
+	for _, dir := range g.defsPaths.dirs() {
+		if err := watcher.Add(dir); err != nil {
+			g.logger.Warn("Definitions directory not watched", zap.String("dir", dir), zap.Error(err))
+		}
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer watcher.Close()
+
+		var reloadC <-chan time.Time
+		for {
+			select {
+			case <-g.ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !isDefsFile(event.Name) || event.Op == fsnotify.Chmod {
+					continue
+				}
+				reloadC = time.After(defsWatchDebounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				g.logger.Warn("Definitions watcher error", zap.Error(err))
+			case <-reloadC:
+				reloadC = nil
+				g.logger.Info("Definitions changed on disk, reloading")
+				g.ReloadDefs(func(DefsReloadResult, error) {})
+			}
+		}
+	}()
+	g.logger.Info("Definitions watcher started", zap.Strings("dirs", g.defsPaths.dirs()))
+}
+
+func isDefsFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".json" || ext == ".jsonc"
+}
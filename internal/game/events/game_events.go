@@ -41,6 +41,8 @@ func (d *NetworkVisibilityDispatcher) Subscribe(eventBus *eventbus.EventBus) {
 	eventBus.SubscribeAsync(ecs.TopicGameplayEntityAppearance, eventbus.PriorityMedium, d.handleEntityAppearanceChanged)
 	eventBus.SubscribeAsync(ecs.TopicGameplayChunkUnload, eventbus.PriorityMedium, d.handleChunkUnload)
 	eventBus.SubscribeAsync(ecs.TopicGameplayChunkLoad, eventbus.PriorityMedium, d.handleChunkLoad)
+	eventBus.SubscribeAsync(ecs.TopicGameplayChunkTileUpdate, eventbus.PriorityMedium, d.handleChunkTileUpdate)
+	eventBus.SubscribeAsync(ecs.TopicGameplayChunkWeather, eventbus.PriorityMedium, d.handleChunkWeather)
 }
 
 func (d *NetworkVisibilityDispatcher) handleObjectMoveBatch(ctx context.Context, e eventbus.Event) error {
@@ -511,6 +513,7 @@ func (d *NetworkVisibilityDispatcher) handleChunkLoad(ctx context.Context, e eve
 					Tiles:   event.Tiles,
 					Version: event.Version,
 				},
+				Weather: netproto.WeatherType(event.Weather),
 			},
 		},
 	}
@@ -536,6 +539,97 @@ func (d *NetworkVisibilityDispatcher) handleChunkLoad(ctx context.Context, e eve
 	return nil
 }
 
+func (d *NetworkVisibilityDispatcher) handleChunkTileUpdate(ctx context.Context, e eventbus.Event) error {
+	event, ok := e.(*ecs.ChunkTileUpdateEvent)
+	if !ok {
+		return nil
+	}
+
+	shard := d.shardManager.GetShard(event.Layer)
+	if shard == nil {
+		return nil
+	}
+
+	msg := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_TileUpdate{
+			TileUpdate: &netproto.S2C_TileUpdate{
+				Coord: &netproto.ChunkCoord{
+					X: int32(event.X),
+					Y: int32(event.Y),
+				},
+				Version: event.Version,
+				Tiles: []*netproto.TileChange{{
+					X:    uint32(event.LocalX),
+					Y:    uint32(event.LocalY),
+					Tile: uint32(event.TileID),
+				}},
+			},
+		},
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		d.logger.Error("failed to marshal TileUpdate message",
+			zap.Error(err),
+			zap.Int64("entity_id", int64(event.EntityID)),
+			zap.Int("x", event.X),
+			zap.Int("y", event.Y),
+		)
+		return nil
+	}
+
+	shard.ClientsMu.RLock()
+	defer shard.ClientsMu.RUnlock()
+	client, exists := shard.Clients[event.EntityID]
+	if !exists || !client.InWorld.Load() || event.Epoch != client.StreamEpoch.Load() {
+		return nil
+	}
+	client.Send(data)
+	return nil
+}
+
+func (d *NetworkVisibilityDispatcher) handleChunkWeather(ctx context.Context, e eventbus.Event) error {
+	event, ok := e.(*ecs.ChunkWeatherEvent)
+	if !ok {
+		return nil
+	}
+
+	shard := d.shardManager.GetShard(event.Layer)
+	if shard == nil {
+		return nil
+	}
+
+	msg := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_Weather{
+			Weather: &netproto.S2C_Weather{
+				Coord: &netproto.ChunkCoord{
+					X: int32(event.X),
+					Y: int32(event.Y),
+				},
+				Weather: netproto.WeatherType(event.Weather),
+			},
+		},
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		d.logger.Error("failed to marshal Weather message",
+			zap.Error(err),
+			zap.Int64("entity_id", int64(event.EntityID)),
+			zap.Int("x", event.X),
+			zap.Int("y", event.Y),
+		)
+		return nil
+	}
+
+	shard.ClientsMu.RLock()
+	defer shard.ClientsMu.RUnlock()
+	client, exists := shard.Clients[event.EntityID]
+	if !exists || !client.InWorld.Load() || event.Epoch != client.StreamEpoch.Load() {
+		return nil
+	}
+	client.Send(data)
+	return nil
+}
+
 func convertMoveMode(mode constt.MoveMode) netproto.MovementMode {
 	switch mode {
 	case constt.Crawl: // Crawl
@@ -0,0 +1,444 @@
+package game
+
+import (
+	"math"
+
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	gameworld "origin/internal/game/world"
+	"origin/internal/itemdefs"
+	"origin/internal/mathutil"
+	netproto "origin/internal/network/proto"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	plowItemActionID     = "plow"
+	plowItemActionTitle  = "Plow"
+	plantItemActionID    = "plant"
+	plantItemActionTitle = "Plant"
+	plowRequiredTag      = "hoe"
+
+	plowCycleDurationTicks  uint32 = 30
+	plowCycleStaminaCost           = 40.0
+	plantCycleDurationTicks uint32 = 10
+	plantCycleStaminaCost          = 10.0
+)
+
+type farmingTiles interface {
+	GetTileID(tileX, tileY int) (byte, bool)
+	SetTileID(tileX, tileY int, tileID byte, tick uint64) bool
+	GetChunkFast(coord types.ChunkCoord) *core.Chunk
+}
+
+type farmingSender interface {
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendInventoryUpdate(entityID types.EntityID, states []*netproto.InventoryState)
+}
+
+// FarmingService runs item-driven farming on the tile under the player: plowing grass or dirt
+// with a hoe and planting seeds on plowed tiles. Both are synthetic cyclic actions targeting
+// the used item. A planted crop gets the seed quality and grows through the crop behavior.
+type FarmingService struct {
+	world            *ecs.World
+	tiles            farmingTiles
+	invExec          *inventory.InventoryExecutor
+	idAllocator      contracts.EntityIDAllocator
+	behaviorRegistry contracts.BehaviorRegistry
+	visionForcer     contracts.VisionUpdateForcer
+	sender           farmingSender
+	logger           *zap.Logger
+}
+
+func NewFarmingService(
+	world *ecs.World,
+	tiles farmingTiles,
+	invExec *inventory.InventoryExecutor,
+	idAllocator contracts.EntityIDAllocator,
+	behaviorRegistry contracts.BehaviorRegistry,
+	visionForcer contracts.VisionUpdateForcer,
+	sender farmingSender,
+	logger *zap.Logger,
+) *FarmingService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &FarmingService{
+		world:            world,
+		tiles:            tiles,
+		invExec:          invExec,
+		idAllocator:      idAllocator,
+		behaviorRegistry: behaviorRegistry,
+		visionForcer:     visionForcer,
+		sender:           sender,
+		logger:           logger,
+	}
+}
+
+// ItemActions lists farming actions available for an item definition.
+func (s *FarmingService) ItemActions(itemDef *itemdefs.ItemDef) []systems.ContextAction {
+	if s == nil || itemDef == nil {
+		return nil
+	}
+	var actions []systems.ContextAction
+	if hasItemDefTag(itemDef, plowRequiredTag) {
+		actions = append(actions, systems.ContextAction{ActionID: plowItemActionID, Title: plowItemActionTitle})
+	}
+	if _, ok := objectdefs.Global().GetCropBySeed(itemDef.Key); ok {
+		actions = append(actions, systems.ContextAction{ActionID: plantItemActionID, Title: plantItemActionTitle})
+	}
+	return actions
+}
+
+// StartItemAction starts a farming action for an item; returns false for non-farming action ids.
+func (s *FarmingService) StartItemAction(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+	actionID string,
+) bool {
+	if s == nil {
+		return false
+	}
+	switch actionID {
+	case plowItemActionID, plantItemActionID:
+	default:
+		return false
+	}
+	if w == nil || playerID == 0 || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return true
+	}
+	if _, has := ecs.GetComponent[components.ActiveCyclicAction](w, playerHandle); has {
+		s.sendWarning(playerID, "ACTION_BUSY")
+		return true
+	}
+
+	action := components.ActiveCyclicAction{
+		ActionID:   actionID,
+		TargetKind: components.CyclicActionTargetItem,
+		TargetID:   itemID,
+	}
+	if !s.IsActiveFarmingStillValid(w, playerID, playerHandle, action) {
+		// Item moved away or changed since the menu was shown: silent ignore.
+		return true
+	}
+	tileX, tileY, ok := playerTile(w, playerHandle)
+	if !ok {
+		return true
+	}
+	switch actionID {
+	case plowItemActionID:
+		action.CycleDurationTicks = plowCycleDurationTicks
+		if reason := s.plowBlockReason(tileX, tileY); reason != "" {
+			s.sendWarning(playerID, reason)
+			return true
+		}
+	case plantItemActionID:
+		action.CycleDurationTicks = plantCycleDurationTicks
+		if reason := s.plantBlockReason(w, tileX, tileY); reason != "" {
+			s.sendWarning(playerID, reason)
+			return true
+		}
+	}
+
+	action.CycleIndex = 1
+	action.StartedTick = ecs.GetResource[ecs.TimeState](w).Tick
+	ecs.AddComponent(w, playerHandle, action)
+	ecs.MutateComponent[components.Movement](w, playerHandle, func(m *components.Movement) bool {
+		m.State = constt.StateInteracting
+		return true
+	})
+	return true
+}
+
+func (s *FarmingService) IsSyntheticFarmingAction(action components.ActiveCyclicAction) bool {
+	return action.BehaviorKey == "" && action.TargetKind == components.CyclicActionTargetItem &&
+		(action.ActionID == plowItemActionID || action.ActionID == plantItemActionID)
+}
+
+func (s *FarmingService) IsActiveFarmingStillValid(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) bool {
+	if s == nil || s.invExec == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return false
+	}
+	item, found := s.invExec.FindPlayerItem(w, playerID, playerHandle, action.TargetID)
+	if !found {
+		return false
+	}
+	itemRegistry := itemdefs.Global()
+	if itemRegistry == nil {
+		return false
+	}
+	itemDef, ok := itemRegistry.GetByID(int(item.TypeID))
+	if !ok || itemDef == nil {
+		return false
+	}
+	switch action.ActionID {
+	case plowItemActionID:
+		return hasItemDefTag(itemDef, plowRequiredTag)
+	case plantItemActionID:
+		_, isSeed := objectdefs.Global().GetCropBySeed(itemDef.Key)
+		return isSeed
+	}
+	return false
+}
+
+// HandleFarmingCycleComplete applies a finished plow or plant cycle. Each action handles one tile.
+func (s *FarmingService) HandleFarmingCycleComplete(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) contracts.BehaviorCycleDecision {
+	if !s.IsActiveFarmingStillValid(w, playerID, playerHandle, action) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	tileX, tileY, ok := playerTile(w, playerHandle)
+	if !ok {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	switch action.ActionID {
+	case plowItemActionID:
+		return s.completePlow(w, playerID, playerHandle, action.TargetID, tileX, tileY)
+	case plantItemActionID:
+		return s.completePlant(w, playerID, playerHandle, action.TargetID, tileX, tileY)
+	}
+	return contracts.BehaviorCycleDecisionCanceled
+}
+
+func (s *FarmingService) completePlow(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	hoeItemID types.EntityID,
+	tileX, tileY int,
+) contracts.BehaviorCycleDecision {
+	if reason := s.plowBlockReason(tileX, tileY); reason != "" {
+		s.sendWarning(playerID, reason)
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if !behaviors.ConsumePlayerLongActionStamina(w, playerHandle, plowCycleStaminaCost) {
+		s.sendWarning(playerID, "LOW_STAMINA")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	if !s.tiles.SetTileID(tileX, tileY, types.TilePlowed, nowTick) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	s.wearTool(w, playerID, playerHandle, hoeItemID)
+	return contracts.BehaviorCycleDecisionComplete
+}
+
+func (s *FarmingService) completePlant(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	seedItemID types.EntityID,
+	tileX, tileY int,
+) contracts.BehaviorCycleDecision {
+	if reason := s.plantBlockReason(w, tileX, tileY); reason != "" {
+		s.sendWarning(playerID, reason)
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	seed, found := s.invExec.FindPlayerItem(w, playerID, playerHandle, seedItemID)
+	if !found {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	seedDef, ok := itemdefs.Global().GetByID(int(seed.TypeID))
+	if !ok {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	cropDef, ok := objectdefs.Global().GetCropBySeed(seedDef.Key)
+	if !ok {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	worldX := float64(tileX*constt.CoordPerTile + constt.CoordPerTile/2)
+	worldY := float64(tileY*constt.CoordPerTile + constt.CoordPerTile/2)
+	chunkX := mathutil.FloorDiv(int(worldX), constt.ChunkWorldSize)
+	chunkY := mathutil.FloorDiv(int(worldY), constt.ChunkWorldSize)
+	chunk := s.tiles.GetChunkFast(types.ChunkCoord{X: chunkX, Y: chunkY})
+	if chunk == nil || chunk.GetState() != types.ChunkStateActive || s.idAllocator == nil {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if !behaviors.ConsumePlayerLongActionStamina(w, playerHandle, plantCycleStaminaCost) {
+		s.sendWarning(playerID, "LOW_STAMINA")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	consumed := s.invExec.ConsumePlayerItemUnit(w, playerID, playerHandle, seedItemID)
+	if !consumed.Success {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	s.sendInventoryUpdate(w, playerID, consumed.UpdatedContainers)
+
+	handle := gameworld.SpawnEntityFromDef(w, cropDef, gameworld.DefSpawnParams{
+		EntityID:         s.idAllocator.GetFreeID(),
+		X:                worldX,
+		Y:                worldY,
+		Quality:          consumed.Item.Quality,
+		Region:           chunk.Region,
+		Layer:            chunk.Layer,
+		InitReason:       contracts.ObjectBehaviorInitReasonSpawn,
+		BehaviorRegistry: s.behaviorRegistry,
+	})
+	if handle == types.InvalidHandle {
+		s.logger.Warn("farming: failed to spawn crop", zap.String("def_key", cropDef.Key))
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	ecs.AddComponent(w, handle, components.ChunkRef{
+		CurrentChunkX: chunkX,
+		CurrentChunkY: chunkY,
+		PrevChunkX:    chunkX,
+		PrevChunkY:    chunkY,
+	})
+	if cropDef.IsStatic {
+		chunk.Spatial().AddStatic(handle, int(worldX), int(worldY))
+	} else {
+		chunk.Spatial().AddDynamic(handle, int(worldX), int(worldY))
+	}
+	chunk.MarkRawDataDirty()
+	ecs.MarkObjectBehaviorDirty(w, handle)
+	behaviors.ForceVisionUpdates(w, s.visionForcer)
+	return contracts.BehaviorCycleDecisionComplete
+}
+
+func (s *FarmingService) plowBlockReason(tileX, tileY int) string {
+	if s.tiles == nil {
+		return "PLOW_UNAVAILABLE"
+	}
+	tileID, ok := s.tiles.GetTileID(tileX, tileY)
+	if !ok {
+		return "PLOW_UNAVAILABLE"
+	}
+	if tileID != types.TileGrass && tileID != types.TileDirt {
+		return "PLOW_TILE_NOT_ALLOWED"
+	}
+	return ""
+}
+
+func (s *FarmingService) plantBlockReason(w *ecs.World, tileX, tileY int) string {
+	if s.tiles == nil {
+		return "PLANT_UNAVAILABLE"
+	}
+	tileID, ok := s.tiles.GetTileID(tileX, tileY)
+	if !ok {
+		return "PLANT_UNAVAILABLE"
+	}
+	if tileID != types.TilePlowed {
+		return "PLANT_TILE_NOT_PLOWED"
+	}
+	if s.isTileOccupied(w, tileX, tileY) {
+		return "PLANT_TILE_OCCUPIED"
+	}
+	return ""
+}
+
+// isTileOccupied reports whether a static object (e.g. another crop) stands inside the tile.
+func (s *FarmingService) isTileOccupied(w *ecs.World, tileX, tileY int) bool {
+	minX := tileX * constt.CoordPerTile
+	minY := tileY * constt.CoordPerTile
+	maxX := minX + constt.CoordPerTile
+	maxY := minY + constt.CoordPerTile
+	chunk := s.tiles.GetChunkFast(types.ChunkCoord{
+		X: mathutil.FloorDiv(minX, constt.ChunkWorldSize),
+		Y: mathutil.FloorDiv(minY, constt.ChunkWorldSize),
+	})
+	if chunk == nil {
+		return false
+	}
+	var candidates []types.Handle
+	chunk.Spatial().QueryAABB(minX, minY, maxX, maxY, &candidates)
+	for _, h := range candidates {
+		if !w.Alive(h) {
+			continue
+		}
+		info, hasInfo := ecs.GetComponent[components.EntityInfo](w, h)
+		if !hasInfo || !info.IsStatic {
+			continue
+		}
+		transform, hasTransform := ecs.GetComponent[components.Transform](w, h)
+		if !hasTransform {
+			continue
+		}
+		if transform.X >= float64(minX) && transform.X < float64(maxX) &&
+			transform.Y >= float64(minY) && transform.Y < float64(maxY) {
+			return true
+		}
+	}
+	return false
+}
+
+// wearTool wears the hoe the plow was started with by one cycle and alerts the player
+// when it runs low or breaks.
+func (s *FarmingService) wearTool(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, toolID types.EntityID) {
+	result := s.invExec.WearPlayerItem(w, playerID, playerHandle, toolID, 1)
+	if !result.Found {
+		return
+	}
+	s.sendInventoryUpdate(w, playerID, result.UpdatedContainers)
+	switch {
+	case result.Broken:
+		s.sendWarning(playerID, "TOOL_BROKEN")
+	case result.MaxDurability > 0 && result.Remaining() == behaviors.ToolLowDurability(result.MaxDurability):
+		s.sendWarning(playerID, "TOOL_LOW_DURABILITY")
+	}
+}
+
+func (s *FarmingService) sendInventoryUpdate(w *ecs.World, playerID types.EntityID, updated []*inventory.ContainerInfo) {
+	if s.sender == nil || len(updated) == 0 {
+		return
+	}
+	states := s.invExec.ConvertContainersToStates(w, updated)
+	protoStates := make([]*netproto.InventoryState, 0, len(states))
+	for _, st := range states {
+		protoStates = append(protoStates, systems.BuildInventoryStateProto(st))
+	}
+	if len(protoStates) > 0 {
+		s.sender.SendInventoryUpdate(playerID, protoStates)
+	}
+}
+
+func (s *FarmingService) sendWarning(playerID types.EntityID, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(playerID, &netproto.S2C_MiniAlert{
+		Severity:   netproto.AlertSeverity_ALERT_SEVERITY_WARNING,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(netproto.AlertSeverity_ALERT_SEVERITY_WARNING),
+	})
+}
+
+// playerTile returns the tile the player stands on.
+func playerTile(w *ecs.World, playerHandle types.Handle) (int, int, bool) {
+	transform, ok := ecs.GetComponent[components.Transform](w, playerHandle)
+	if !ok {
+		return 0, 0, false
+	}
+	tileX := int(math.Floor(transform.X / float64(constt.CoordPerTile)))
+	tileY := int(math.Floor(transform.Y / float64(constt.CoordPerTile)))
+	return tileX, tileY, true
+}
+
+func hasItemDefTag(itemDef *itemdefs.ItemDef, tag string) bool {
+	for _, itemTag := range itemDef.Tags {
+		if itemTag == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+package game
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+const (
+	testFarmPlayerDefID = 93000
+	testFarmCropDefID   = 93001
+	testHoeItemDefID    = 93101
+	testSeedItemDefID   = 93102
+	testHoeItemID       = types.EntityID(778001)
+	testSeedItemID      = types.EntityID(778002)
+)
+
+type testFarmingTiles struct {
+	tiles map[[2]int]byte
+	chunk *core.Chunk
+}
+
+func (f *testFarmingTiles) GetTileID(tileX, tileY int) (byte, bool) {
+	tileID, ok := f.tiles[[2]int{tileX, tileY}]
+	return tileID, ok
+}
+
+func (f *testFarmingTiles) SetTileID(tileX, tileY int, tileID byte, _ uint64) bool {
+	f.tiles[[2]int{tileX, tileY}] = tileID
+	return true
+}
+
+func (f *testFarmingTiles) GetChunkFast(_ types.ChunkCoord) *core.Chunk {
+	return f.chunk
+}
+
+type testFarmIDAllocator struct {
+	next types.EntityID
+}
+
+func (a *testFarmIDAllocator) GetFreeID() types.EntityID {
+	a.next++
+	return a.next
+}
+
+func setFarmingTestRegistries(t *testing.T) {
+	t.Helper()
+	prevItems := itemdefs.Global()
+	prevObjects := objectdefs.Global()
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: testHoeItemDefID, Key: "farm_test_hoe", Name: "Hoe", Tags: []string{"hoe"}, Size: itemdefs.Size{W: 1, H: 1}, Durability: 2},
+		{DefID: testSeedItemDefID, Key: "farm_test_seed", Name: "Seed", Tags: []string{"seed"}, Size: itemdefs.Size{W: 1, H: 1}},
+	}))
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{DefID: testFarmPlayerDefID, Key: "player", Name: "Player"},
+		{
+			DefID:         testFarmCropDefID,
+			Key:           "farm_test_crop",
+			Name:          "Crop",
+			IsStatic:      true,
+			BehaviorOrder: []string{"crop"},
+			CropConfig: &objectdefs.CropBehaviorConfig{
+				SeedItemKey: "farm_test_seed",
+				Stages:      []objectdefs.CropStageConfig{{StageDuration: 100}, {Harvest: []string{"farm_test_seed"}}},
+			},
+		},
+	}))
+	t.Cleanup(func() {
+		itemdefs.SetGlobalForTesting(prevItems)
+		objectdefs.SetGlobalForTesting(prevObjects)
+	})
+}
+
+func spawnFarmTestPlayer(world *ecs.World, playerID types.EntityID, x, y float64) types.Handle {
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: testFarmPlayerDefID})
+		ecs.AddComponent(w, h, components.Transform{X: x, Y: y})
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, State: constt.StateIdle, Speed: 1})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 500, Energy: 1000})
+	})
+	gridHandle := world.Spawn(types.EntityID(uint64(playerID)+100000), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.InventoryContainer{
+			OwnerID: playerID,
+			Kind:    constt.InventoryGrid,
+			Width:   4,
+			Height:  4,
+			Items: []components.InvItem{
+				{ItemID: testHoeItemID, TypeID: testHoeItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1},
+				{ItemID: testSeedItemID, TypeID: testSeedItemDefID, Quality: 37, Quantity: 2, W: 1, H: 1, X: 1},
+			},
+		})
+	})
+	ecs.AddComponent(world, playerHandle, components.InventoryOwner{
+		Inventories: []components.InventoryLink{
+			{Kind: constt.InventoryGrid, OwnerID: playerID, Handle: gridHandle},
+		},
+	})
+	return playerHandle
+}
+
+func newFarmingTestService(world *ecs.World, tiles *testFarmingTiles, sender *testItemActionSender) *FarmingService {
+	invExec := inventory.NewInventoryExecutor(nil, nil, nil, nil, nil)
+	return NewFarmingService(
+		world,
+		tiles,
+		invExec,
+		&testFarmIDAllocator{next: 880000},
+		behaviors.MustDefaultRegistry(),
+		nil,
+		sender,
+		nil,
+	)
+}
+
+func lastAlertCode(sender *testItemActionSender) string {
+	if len(sender.alerts) == 0 {
+		return ""
+	}
+	return sender.alerts[len(sender.alerts)-1].ReasonCode
+}
+
+func TestFarmingService_PlowTurnsGrassIntoPlowedTile(t *testing.T) {
+	setFarmingTestRegistries(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93500)
+	// Tile (2,3) center.
+	playerHandle := spawnFarmTestPlayer(world, playerID, 2*constt.CoordPerTile+6, 3*constt.CoordPerTile+6)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileGrass}}
+	sender := &testItemActionSender{}
+	service := newFarmingTestService(world, tiles, sender)
+
+	if !service.StartItemAction(world, playerID, playerHandle, testHoeItemID, plowItemActionID) {
+		t.Fatalf("plow must be handled by farming service")
+	}
+	action, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+	if !ok || !service.IsSyntheticFarmingAction(action) {
+		t.Fatalf("expected plow cyclic action, got %+v", action)
+	}
+	if decision := service.HandleFarmingCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete decision, got %v", decision)
+	}
+	if tiles.tiles[[2]int{2, 3}] != types.TilePlowed {
+		t.Fatalf("expected plowed tile, got %d", tiles.tiles[[2]int{2, 3}])
+	}
+}
+
+func TestFarmingService_PlowWearsHoe(t *testing.T) {
+	setFarmingTestRegistries(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93502)
+	playerHandle := spawnFarmTestPlayer(world, playerID, 2*constt.CoordPerTile+6, 3*constt.CoordPerTile+6)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileGrass}}
+	sender := &testItemActionSender{}
+	service := newFarmingTestService(world, tiles, sender)
+
+	plowOnce := func() {
+		t.Helper()
+		tiles.tiles[[2]int{2, 3}] = types.TileGrass
+		if !service.StartItemAction(world, playerID, playerHandle, testHoeItemID, plowItemActionID) {
+			t.Fatalf("plow must be handled by farming service")
+		}
+		action, _ := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+		if decision := service.HandleFarmingCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionComplete {
+			t.Fatalf("expected complete decision, got %v", decision)
+		}
+		ecs.RemoveComponent[components.ActiveCyclicAction](world, playerHandle)
+	}
+
+	plowOnce()
+	hoe, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testHoeItemID)
+	if !found || hoe.Wear != 1 {
+		t.Fatalf("expected hoe wear 1, got found=%v item=%+v", found, hoe)
+	}
+	if got := lastAlertCode(sender); got != "TOOL_LOW_DURABILITY" {
+		t.Fatalf("expected TOOL_LOW_DURABILITY, got %q", got)
+	}
+
+	plowOnce()
+	if _, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testHoeItemID); found {
+		t.Fatalf("expected worn out hoe to break")
+	}
+	if got := lastAlertCode(sender); got != "TOOL_BROKEN" {
+		t.Fatalf("expected TOOL_BROKEN, got %q", got)
+	}
+	if sender.updates != 2 {
+		t.Fatalf("expected inventory update per worn cycle, got %d", sender.updates)
+	}
+}
+
+func TestFarmingService_PlowRefusesOtherTiles(t *testing.T) {
+	setFarmingTestRegistries(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93501)
+	playerHandle := spawnFarmTestPlayer(world, playerID, 6, 6)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{0, 0}: types.TilePlowed}}
+	sender := &testItemActionSender{}
+	service := newFarmingTestService(world, tiles, sender)
+
+	service.StartItemAction(world, playerID, playerHandle, testHoeItemID, plowItemActionID)
+	if _, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); ok {
+		t.Fatalf("plow must not start on a plowed tile")
+	}
+	if got := lastAlertCode(sender); got != "PLOW_TILE_NOT_ALLOWED" {
+		t.Fatalf("expected PLOW_TILE_NOT_ALLOWED, got %q", got)
+	}
+}
+
+func TestFarmingService_PlantSpawnsCropWithSeedQuality(t *testing.T) {
+	setFarmingTestRegistries(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93502)
+	playerHandle := spawnFarmTestPlayer(world, playerID, 6, 6)
+	chunk := core.NewChunk(types.ChunkCoord{X: 0, Y: 0}, 1, 0, constt.ChunkSize)
+	chunk.SetState(types.ChunkStateActive)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{0, 0}: types.TileGrass}, chunk: chunk}
+	sender := &testItemActionSender{}
+	service := newFarmingTestService(world, tiles, sender)
+
+	service.StartItemAction(world, playerID, playerHandle, testSeedItemID, plantItemActionID)
+	if _, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); ok {
+		t.Fatalf("planting must not start on an unplowed tile")
+	}
+	if got := lastAlertCode(sender); got != "PLANT_TILE_NOT_PLOWED" {
+		t.Fatalf("expected PLANT_TILE_NOT_PLOWED, got %q", got)
+	}
+
+	tiles.tiles[[2]int{0, 0}] = types.TilePlowed
+	service.StartItemAction(world, playerID, playerHandle, testSeedItemID, plantItemActionID)
+	action, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+	if !ok {
+		t.Fatalf("expected plant cyclic action")
+	}
+	if decision := service.HandleFarmingCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete decision, got %v", decision)
+	}
+
+	cropHandle := world.GetHandleByEntityID(880001)
+	if cropHandle == types.InvalidHandle || !world.Alive(cropHandle) {
+		t.Fatalf("expected spawned crop")
+	}
+	info, _ := ecs.GetComponent[components.EntityInfo](world, cropHandle)
+	if info.TypeID != testFarmCropDefID || info.Quality != 37 {
+		t.Fatalf("unexpected crop info: %+v", info)
+	}
+	seed, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testSeedItemID)
+	if !found || seed.Quantity != 1 {
+		t.Fatalf("expected one seed left, got %+v (found=%v)", seed, found)
+	}
+
+	ecs.RemoveComponent[components.ActiveCyclicAction](world, playerHandle)
+	service.StartItemAction(world, playerID, playerHandle, testSeedItemID, plantItemActionID)
+	if got := lastAlertCode(sender); got != "PLANT_TILE_OCCUPIED" {
+		t.Fatalf("expected PLANT_TILE_OCCUPIED, got %q", got)
+	}
+}
+
+func TestCropHarvest_FullInventoryDropsYieldNextToPlayer(t *testing.T) {
+	setFarmingTestRegistries(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93510)
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: testFarmPlayerDefID})
+		ecs.AddComponent(w, h, components.Transform{X: 2*constt.CoordPerTile + 6, Y: 3*constt.CoordPerTile + 6})
+		ecs.AddComponent(w, h, components.ChunkRef{})
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, State: constt.StateIdle, Speed: 1})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 500, Energy: 1000})
+	})
+	// A 1x1 grid holding the hoe leaves no room for the harvest.
+	gridHandle := world.Spawn(types.EntityID(uint64(playerID)+100000), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.InventoryContainer{
+			OwnerID: playerID,
+			Kind:    constt.InventoryGrid,
+			Width:   1,
+			Height:  1,
+			Items:   []components.InvItem{{ItemID: testHoeItemID, TypeID: testHoeItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1}},
+		})
+	})
+	ecs.AddComponent(world, playerHandle, components.InventoryOwner{
+		Inventories: []components.InventoryLink{{Kind: constt.InventoryGrid, OwnerID: playerID, Handle: gridHandle}},
+	})
+	cropID := types.EntityID(93511)
+	cropHandle := world.Spawn(cropID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: testFarmCropDefID, IsStatic: true, Quality: 37})
+		ecs.AddComponent(w, h, components.Transform{X: 2*constt.CoordPerTile + 6, Y: 3*constt.CoordPerTile + 6})
+		ecs.AddComponent(w, h, components.ChunkRef{})
+		ecs.AddComponent(w, h, components.ObjectInternalState{})
+	})
+	ecs.WithComponent(world, cropHandle, func(state *components.ObjectInternalState) {
+		components.SetBehaviorState(state, "crop", &components.CropBehaviorState{Stage: 2})
+	})
+
+	idAlloc := &testFarmIDAllocator{next: 881000}
+	invExec := inventory.NewInventoryExecutor(nil, idAlloc, nil, nil, nil)
+	sender := &testItemActionSender{}
+	behavior, ok := behaviors.MustDefaultRegistry().GetBehavior("crop")
+	if !ok {
+		t.Fatalf("crop behavior must be registered")
+	}
+	decision := behavior.(contracts.CyclicActionHandler).OnCycleComplete(&contracts.BehaviorCycleContext{
+		World:        world,
+		PlayerID:     playerID,
+		PlayerHandle: playerHandle,
+		TargetID:     cropID,
+		TargetHandle: cropHandle,
+		ActionID:     "harvest",
+		Deps: &contracts.ExecutionDeps{
+			GiveItem: func(_ *ecs.World, _ types.EntityID, _ types.Handle, _ string, _ uint32, _ uint32) contracts.GiveItemOutcome {
+				return contracts.GiveItemOutcome{Success: false, Message: "inventory full"}
+			},
+			GiveItemOrDrop: newGiveItemOrDropFn(invExec, sender),
+			Alerts:         sender,
+		},
+	})
+
+	if decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete decision, got %v (alerts %+v)", decision, sender.alerts)
+	}
+	if world.Alive(cropHandle) {
+		t.Fatalf("expected crop to be removed after harvest")
+	}
+	droppedHandle := world.GetHandleByEntityID(idAlloc.next - 1)
+	dropped, hasDropped := ecs.GetComponent[components.EntityInfo](world, droppedHandle)
+	if droppedHandle == types.InvalidHandle || !hasDropped || dropped.Quality != 37 {
+		t.Fatalf("expected harvest dropped next to the player at crop quality, got handle=%v info=%+v", droppedHandle, dropped)
+	}
+}
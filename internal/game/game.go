@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"origin/internal/ecs"
@@ -81,6 +82,8 @@ type Game struct {
 	timeStateMu         sync.RWMutex
 	runtimeSecondsTotal int64
 	runtimeRemainder    time.Duration
+	calendar            timeutil.Calendar
+	worldTimeSyncTicks  uint64
 
 	clock             timeutil.Clock
 	startTime         time.Time
@@ -94,6 +97,13 @@ type Game struct {
 	transferMu        sync.Mutex
 	transferInFlight  map[types.EntityID]struct{}
 	transferService   *PlayerTransferService
+	chatLimiter       *chatRateLimiter
+	announcements     *AnnouncementScheduler
+	roles             *PlayerRoles
+	mutes             *AccountMutes
+	defsPaths         DefsPaths
+	defsReloadMu      sync.Mutex // serializes definition reloads
+	defsReloads       chan *defsReloadRequest
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -128,6 +138,8 @@ func NewGame(cfg *config.Config, db *persistence.Postgres, objectFactory *world.
 		tickPeriod:          tickPeriod,
 		currentTick:         bootstrap.InitialTick,
 		runtimeSecondsTotal: bootstrap.RuntimeSecondsTotal,
+		calendar:            timeutil.NewCalendar(cfg.Game.DayLength, cfg.Game.SeasonDays),
+		worldTimeSyncTicks:  worldTimeSyncTicks(cfg.Game.WorldTimeSyncInterval, tickPeriod),
 		ctx:                 ctx,
 		cancel:              cancel,
 		enableStats:         enableStats,
@@ -138,6 +150,12 @@ func NewGame(cfg *config.Config, db *persistence.Postgres, objectFactory *world.
 			systemStats: make(map[string]ecs.SystemTimingStat),
 		},
 		transferInFlight: make(map[types.EntityID]struct{}),
+		chatLimiter:      newChatRateLimiter(time.Duration(cfg.Game.ChatMinIntervalMs) * time.Millisecond),
+		announcements:    NewAnnouncementScheduler(cfg.Game.Announcements, time.Now()),
+		roles:            NewPlayerRoles(),
+		mutes:            NewAccountMutes(),
+		defsPaths:        DefaultDefsPaths(),
+		defsReloads:      make(chan *defsReloadRequest, 1),
 	}
 	g.state.Store(int32(GameStateStarting))
 
@@ -150,6 +168,10 @@ func NewGame(cfg *config.Config, db *persistence.Postgres, objectFactory *world.
 	g.setupNetworkHandlers()
 	for _, shard := range g.shardManager.GetShards() {
 		shard.SetAdminTeleportExecutor(g)
+		shard.SetGlobalChatBroadcaster(g)
+		shard.SetAdminPermissions(g.roles, g.shardManager.AdminAudit())
+		shard.SetModerationService(g)
+		shard.SetDefsReloader(g)
 	}
 
 	g.resetOnlinePlayers()
@@ -210,6 +232,10 @@ func (g *Game) handlePacket(c *network.Client, data []byte) {
 		g.handleMovementMode(c, msg.Sequence, payload.MovementMode)
 	case *netproto.ClientMessage_Chat:
 		g.handleChatMessage(c, msg.Sequence, payload.Chat)
+	case *netproto.ClientMessage_ChatHistory:
+		g.handleChatHistoryRequest(c, msg.Sequence, payload.ChatHistory)
+	case *netproto.ClientMessage_PartyCommand:
+		g.handlePartyCommand(c, msg.Sequence, payload.PartyCommand)
 	case *netproto.ClientMessage_InventoryOp:
 		g.handleInventoryOp(c, msg.Sequence, payload.InventoryOp)
 	case *netproto.ClientMessage_OpenContainer:
@@ -232,6 +258,18 @@ func (g *Game) handlePacket(c *network.Client, data []byte) {
 		g.handleOpenWindow(c, msg.Sequence, payload.OpenWindow)
 	case *netproto.ClientMessage_CloseWindow:
 		g.handleCloseWindow(c, msg.Sequence, payload.CloseWindow)
+	case *netproto.ClientMessage_ItemContextMenu:
+		g.handleItemContextMenu(c, msg.Sequence, payload.ItemContextMenu)
+	case *netproto.ClientMessage_ItemAction:
+		g.handleItemAction(c, msg.Sequence, payload.ItemAction)
+	case *netproto.ClientMessage_SkillList:
+		g.handleSkillList(c, msg.Sequence, payload.SkillList)
+	case *netproto.ClientMessage_LearnSkill:
+		g.handleLearnSkill(c, msg.Sequence, payload.LearnSkill)
+	case *netproto.ClientMessage_TrainAttribute:
+		g.handleTrainAttribute(c, msg.Sequence, payload.TrainAttribute)
+	case *netproto.ClientMessage_CombatMove:
+		g.handleCombatMove(c, msg.Sequence, payload.CombatMove)
 	default:
 		g.logger.Warn("Unknown packet type", zap.Uint64("client_id", c.ID), zap.Any("payload", msg.Payload))
 	}
@@ -293,6 +331,12 @@ func (g *Game) handlePlayerAction(c *network.Client, sequence uint32, action *ne
 	case *netproto.C2S_PlayerAction_SelectContextAction:
 		cmdType = network.CmdSelectContextAction
 		payload = act.SelectContextAction
+	case *netproto.C2S_PlayerAction_Attack:
+		cmdType = network.CmdAttack
+		payload = act.Attack
+	case *netproto.C2S_PlayerAction_Follow:
+		cmdType = network.CmdFollow
+		payload = act.Follow
 	default:
 		g.logger.Warn("Unknown player action type",
 			zap.Uint64("client_id", c.ID),
@@ -395,12 +439,30 @@ func (g *Game) handleChatMessage(c *network.Client, sequence uint32, chat *netpr
 		return
 	}
 
-	// Validate channel (only LOCAL supported for now)
-	if chat.Channel != netproto.ChatChannel_CHAT_CHANNEL_LOCAL {
+	// Validate channel (LOCAL, PRIVATE and PARTY supported for now)
+	var targetID types.EntityID
+	switch chat.Channel {
+	case netproto.ChatChannel_CHAT_CHANNEL_LOCAL:
+	case netproto.ChatChannel_CHAT_CHANNEL_GLOBAL:
+		// Global channel is server-only; admins use /broadcast.
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Global channel is read-only")
+		return
+	case netproto.ChatChannel_CHAT_CHANNEL_PARTY:
+		if !g.shardManager.Parties().IsMember(c.CharacterID) {
+			c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Not in a party")
+			return
+		}
+	case netproto.ChatChannel_CHAT_CHANNEL_PRIVATE:
+		targetID = types.EntityID(chat.GetPrivateEntityId())
+		if targetID == 0 || targetID == c.CharacterID {
+			c.SendError(netproto.ErrorCode_ERROR_CODE_TARGET_INVALID, "Unknown target")
+			return
+		}
+	default:
 		g.logger.Debug("Unsupported chat channel",
 			zap.Uint64("client_id", c.ID),
 			zap.String("channel", chat.Channel.String()))
-		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Only local chat is supported")
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Unsupported chat channel")
 		return
 	}
 
@@ -420,6 +482,21 @@ func (g *Game) handleChatMessage(c *network.Client, sequence uint32, chat *netpr
 	text = strings.ReplaceAll(text, "\r", "")
 	text = strings.TrimSpace(text)
 
+	if until, muted := g.mutes.MutedUntil(c.AccountID, time.Now()); muted {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_MUTED, "You are muted until "+until.UTC().Format(time.RFC3339))
+		return
+	}
+
+	if !g.chatLimiter.Allow(c.CharacterID, time.Now()) {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_COOLDOWN_ACTIVE, "You are sending messages too fast")
+		return
+	}
+
+	if targetID != 0 && g.shardManager.FindPlayerShard(targetID) == nil {
+		g.sendPrivateChatTargetError(c, targetID)
+		return
+	}
+
 	// Get shard
 	shard := g.shardManager.GetShard(c.Layer)
 	if shard == nil {
@@ -437,8 +514,9 @@ func (g *Game) handleChatMessage(c *network.Client, sequence uint32, chat *netpr
 		CommandID:   uint64(sequence),
 		CommandType: network.CmdChat,
 		Payload: &network.ChatCommandPayload{
-			Channel: netproto.ChatChannel_CHAT_CHANNEL_LOCAL,
-			Text:    text,
+			Channel:  chat.Channel,
+			Text:     text,
+			TargetID: targetID,
 		},
 		ReceivedAt: time.Now(),
 		Layer:      c.Layer,
@@ -465,6 +543,35 @@ func (g *Game) handleChatMessage(c *network.Client, sequence uint32, chat *netpr
 		zap.Int("text_len", len(text)))
 }
 
+// sendPrivateChatTargetError rejects a whisper to a character that is not online.
+// The character lookup runs off the packet goroutine so a slow database never stalls the client's reads.
+func (g *Game) sendPrivateChatTargetError(c *network.Client, targetID types.EntityID) {
+	if g.db == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_TARGET_INVALID, "Player offline")
+		return
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		c.SendError(netproto.ErrorCode_ERROR_CODE_TARGET_INVALID, g.privateChatTargetError(targetID))
+	}()
+}
+
+// privateChatTargetError distinguishes an offline character from an id that does not exist.
+func (g *Game) privateChatTargetError(targetID types.EntityID) string {
+	ctx, cancel := context.WithTimeout(g.ctx, 2*time.Second)
+	defer cancel()
+	if _, err := g.db.Queries().GetCharacter(ctx, int64(targetID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "Unknown target"
+		}
+		g.logger.Warn("Failed to look up private chat target",
+			zap.Int64("target_id", int64(targetID)),
+			zap.Error(err))
+	}
+	return "Player offline"
+}
+
 func (g *Game) handleInventoryOp(c *network.Client, sequence uint32, inventoryOp *netproto.C2S_InventoryOp) {
 	if c.CharacterID == 0 {
 		g.logger.Warn("Inventory op from unauthenticated client", zap.Uint64("client_id", c.ID))
@@ -780,10 +887,159 @@ func (g *Game) handleCloseWindow(c *network.Client, sequence uint32, msg *netpro
 	})
 }
 
+func (g *Game) handleItemContextMenu(c *network.Client, sequence uint32, msg *netproto.C2S_ItemContextMenu) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if msg == nil || msg.ItemId == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Invalid item context menu request")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdItemContextMenu,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
+func (g *Game) handleItemAction(c *network.Client, sequence uint32, msg *netproto.C2S_ItemAction) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if msg == nil || msg.ItemId == 0 || strings.TrimSpace(msg.ActionId) == "" {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Invalid item action request")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdItemAction,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
+func (g *Game) handleSkillList(c *network.Client, sequence uint32, msg *netproto.C2S_SkillList) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdSkillList,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
+func (g *Game) handleLearnSkill(c *network.Client, sequence uint32, msg *netproto.C2S_LearnSkill) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if msg == nil || strings.TrimSpace(msg.SkillKey) == "" {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Invalid learn skill request")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdLearnSkill,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
+func (g *Game) handleTrainAttribute(c *network.Client, sequence uint32, msg *netproto.C2S_TrainAttribute) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if msg == nil || msg.Key == netproto.CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_UNSPECIFIED {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Invalid train attribute request")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdTrainAttribute,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
+func (g *Game) handleCombatMove(c *network.Client, sequence uint32, msg *netproto.C2S_CombatMove) {
+	if c.CharacterID == 0 {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+	if msg == nil || msg.TargetId == 0 || strings.TrimSpace(msg.MoveKey) == "" {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Invalid combat move request")
+		return
+	}
+	shard := g.shardManager.GetShard(c.Layer)
+	if shard == nil {
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INTERNAL_ERROR, "Invalid shard")
+		return
+	}
+	_ = shard.PlayerInbox().Enqueue(&network.PlayerCommand{
+		ClientID:    c.ID,
+		CharacterID: c.CharacterID,
+		CommandID:   uint64(sequence),
+		CommandType: network.CmdCombatMove,
+		Payload:     msg,
+		ReceivedAt:  time.Now(),
+		Layer:       c.Layer,
+	})
+}
+
 func (g *Game) handleDisconnect(c *network.Client) {
 	g.logger.Info("Client disconnected", zap.Uint64("client_id", c.ID))
 
 	if c.CharacterID != 0 {
+		g.chatLimiter.Forget(c.CharacterID)
+		g.roles.Remove(c.CharacterID)
+		g.shardManager.Parties().MarkOffline(c.CharacterID)
 		if c.IsDeadObserverMode() {
 			if shard := g.shardManager.GetShard(c.Layer); shard != nil {
 				playerEntityID := c.CharacterID
@@ -810,6 +1066,7 @@ func (g *Game) handleDisconnect(c *network.Client) {
 			c.InWorld.Store(false)
 			c.StreamEpoch.Store(0)
 			c.CharacterID = 0
+			c.AccountID = 0
 			return
 		}
 
@@ -946,6 +1203,11 @@ func (g *Game) resetOnlinePlayers() {
 func (g *Game) StartGameLoop() {
 	g.setState(GameStateRunning)
 	g.startPeriodicServerTimePersist()
+	g.startChatRetentionJob()
+	g.startAnnouncementScheduler()
+	if g.cfg.Game.Env == "dev" {
+		g.startDefsWatcher()
+	}
 	g.wg.Add(1)
 	go g.gameLoop()
 
@@ -1010,6 +1272,7 @@ func (g *Game) gameLoop() {
 				UnixMs:              wallUnixMs,
 				WallNow:             nowWall,
 				RuntimeSecondsTotal: currentRuntimeSeconds,
+				WorldTime:           g.calendar.At(currentRuntimeSeconds),
 				Uptime:              runtimeNow.Sub(g.startTime),
 			}
 
@@ -1045,6 +1308,9 @@ func (g *Game) accumulateStat(name string, d time.Duration) {
 func (g *Game) update(ts ecs.TimeState) {
 	start := time.Now()
 
+	// Definition swaps happen here so a shard tick never sees two different registries.
+	g.applyPendingDefsReload()
+
 	schedResult := g.shardManager.Update(ts)
 	schedDuration := schedResult.TotalDuration
 
@@ -1067,6 +1333,7 @@ func (g *Game) update(ts ecs.TimeState) {
 	deadObserverTimeoutStart := time.Now()
 	g.enforceDeadObserverTimeouts(ts.UnixMs)
 	deadObserverTimeoutDuration := time.Since(deadObserverTimeoutStart)
+	g.syncWorldTime(ts)
 
 	duration := time.Since(start)
 
@@ -19,6 +19,7 @@ import (
 	netproto "origin/internal/network/proto"
 	"origin/internal/objectdefs"
 	"origin/internal/persistence/repository"
+	"origin/internal/skilldefs"
 	"origin/internal/types"
 	"time"
 
@@ -38,7 +39,11 @@ func (g *Game) handleAuth(c *network.Client, sequence uint32, auth *netproto.C2S
 		return
 	}
 
-	var character repository.Character
+	var (
+		character repository.Character
+		account   repository.Account
+		banMsg    string
+	)
 
 	// Use transaction with FOR UPDATE lock to prevent race conditions
 	err := g.db.WithTx(g.ctx, func(q *repository.Queries) error {
@@ -58,6 +63,15 @@ func (g *Game) handleAuth(c *network.Client, sequence uint32, auth *netproto.C2S
 			return fmt.Errorf("character already online")
 		}
 
+		account, err = q.GetAccountByID(g.ctx, character.AccountID)
+		if err != nil {
+			return fmt.Errorf("get account: %w", err)
+		}
+		if banned, msg := AccountBanMessage(account, time.Now()); banned {
+			banMsg = msg
+			return errAccountBanned
+		}
+
 		normalizedAttributes, changed := characterattrs.FromRaw(character.Attributes)
 		rawAttributes, err := characterattrs.Marshal(normalizedAttributes)
 		if err != nil {
@@ -86,6 +100,10 @@ func (g *Game) handleAuth(c *network.Client, sequence uint32, auth *netproto.C2S
 			g.sendAuthResult(c, sequence, false, "Invalid token")
 			return
 		}
+		if errors.Is(err, errAccountBanned) {
+			g.sendAuthResult(c, sequence, false, banMsg)
+			return
+		}
 		errMsg := err.Error()
 		if errMsg == "token expired" {
 			g.sendAuthResult(c, sequence, false, "Token expired")
@@ -102,6 +120,13 @@ func (g *Game) handleAuth(c *network.Client, sequence uint32, auth *netproto.C2S
 
 	// Set character as online and update client association
 	c.CharacterID = types.EntityID(character.ID)
+	c.AccountID = account.ID
+	g.roles.Set(c.CharacterID, account.ID, AccountRole(account.Role))
+	if account.MutedUntil.Valid {
+		g.mutes.Set(account.ID, account.MutedUntil.Time)
+	} else {
+		g.mutes.Set(account.ID, time.Time{})
+	}
 	c.Layer = character.Layer
 	// Client is not yet in world during spawn attempts
 	c.InWorld.Store(false)
@@ -171,6 +196,7 @@ func (g *Game) spawnAndLogin(c *network.Client, character repository.Character)
 	// Normal spawn flow
 	normalizedAttributes, _ := characterattrs.FromRaw(character.Attributes)
 	profileExperience, profileSkills, profileDiscovery := loadCharacterProfileData(character, g.logger)
+	profileSkills = g.loadLearnedSkills(ctx, character.ID, profileSkills)
 	candidates := g.generateSpawnCandidates(character.X, character.Y)
 	spawned := false
 	var playerHandle *types.Handle
@@ -422,6 +448,21 @@ func loadCharacterProfileData(character repository.Character, logger *zap.Logger
 	return experience, skills, discovery
 }
 
+// loadLearnedSkills merges skills recorded in the skill table into the skills column set.
+func (g *Game) loadLearnedSkills(ctx context.Context, characterID int64, skills []string) []string {
+	if g.db == nil {
+		return skills
+	}
+	rows, err := g.db.Queries().GetSkillsByCharacter(ctx, characterID)
+	if err != nil {
+		g.logger.Warn("Failed to load character skill rows, using skills column",
+			zap.Int64("character_id", characterID),
+			zap.Error(err))
+		return skills
+	}
+	return mergeSkillRows(skills, rows, skilldefs.Global())
+}
+
 func (g *Game) buildPlayerSetupFunc(
 	ctx context.Context,
 	character repository.Character,
@@ -799,6 +840,7 @@ func (g *Game) sendPlayerEnterWorld(c *network.Client, entityID types.EntityID,
 				ChunkSize:    _const.ChunkSize,
 				TickRate:     uint32(g.cfg.Game.TickRate),
 				StreamEpoch:  c.StreamEpoch.Load(),
+				WorldTime:    worldTimeProto(g.currentWorldTime()),
 			},
 		},
 	}
@@ -834,6 +876,8 @@ func (g *Game) attachClientToWorld(
 	shard.ChunkManager().EnableChunkLoadEvents(playerEntityID, client.StreamEpoch.Load())
 
 	g.enqueuePlayerBootstrapSnapshots(shard, playerEntityID, handle)
+	// Party roster is not part of the bootstrap snapshots: force a re-send after (re)attach.
+	g.shardManager.Parties().Touch(playerEntityID)
 }
 
 func (g *Game) enqueuePlayerBootstrapSnapshots(shard *Shard, playerEntityID types.EntityID, handle types.Handle) {
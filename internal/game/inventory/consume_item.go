@@ -0,0 +1,170 @@
+package inventory
+
+import (
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/itemdefs"
+	"origin/internal/types"
+)
+
+type ConsumeItemResult struct {
+	Success bool
+	// Item is the consumed item as it was before consumption.
+	Item              components.InvItem
+	UpdatedContainers []*ContainerInfo
+}
+
+// FindPlayerItem looks up an item instance in the player inventory tree (root grids + nested + hand)
+// and equipment.
+func (e *InventoryExecutor) FindPlayerItem(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) (components.InvItem, bool) {
+	handle, idx, ok := findPlayerItemSlot(w, playerID, playerHandle, itemID)
+	if !ok {
+		return components.InvItem{}, false
+	}
+	container, _ := ecs.GetComponent[components.InventoryContainer](w, handle)
+	return container.Items[idx], true
+}
+
+// SettlePlayerItemSpoilage brings spoilage of the container holding the item up to date, so the
+// item is read with its current spoil (or already replaced by its spoiled item). Returns the
+// container when it changed visibly and must be sent to the client.
+func (e *InventoryExecutor) SettlePlayerItemSpoilage(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) []*ContainerInfo {
+	handle, _, ok := findPlayerItemSlot(w, playerID, playerHandle, itemID)
+	if !ok || !SettleContainerSpoilage(w, handle) {
+		return nil
+	}
+	owner, _ := ecs.GetComponent[components.InventoryOwner](w, playerHandle)
+	current, _ := ecs.GetComponent[components.InventoryContainer](w, handle)
+	return []*ContainerInfo{{
+		Handle:    handle,
+		Container: &current,
+		Owner:     &owner,
+	}}
+}
+
+// FindPlayerItemByKey returns the first item of the definition key in the player inventory tree
+// (same order as FindPlayerItem).
+func (e *InventoryExecutor) FindPlayerItemByKey(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemKey string,
+) (components.InvItem, bool) {
+	itemRegistry := itemdefs.Global()
+	if itemKey == "" || itemRegistry == nil {
+		return components.InvItem{}, false
+	}
+	handle, idx, ok := findPlayerItemSlotFunc(w, playerID, playerHandle, func(item components.InvItem) bool {
+		itemDef, found := itemRegistry.GetByID(int(item.TypeID))
+		return found && itemDef.Key == itemKey
+	})
+	if !ok {
+		return components.InvItem{}, false
+	}
+	container, _ := ecs.GetComponent[components.InventoryContainer](w, handle)
+	return container.Items[idx], true
+}
+
+// ConsumePlayerItemUnit removes one unit of an item instance from the player inventory tree.
+// The item disappears when its last unit is consumed.
+func (e *InventoryExecutor) ConsumePlayerItemUnit(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) ConsumeItemResult {
+	result := ConsumeItemResult{}
+	if e == nil || w == nil {
+		return result
+	}
+	handle, idx, ok := findPlayerItemSlot(w, playerID, playerHandle, itemID)
+	if !ok {
+		return result
+	}
+
+	ecs.MutateComponent[components.InventoryContainer](w, handle, func(c *components.InventoryContainer) bool {
+		result.Item = c.Items[idx]
+		if c.Items[idx].Quantity > 1 {
+			c.Items[idx].Quantity--
+		} else {
+			c.Items = append(c.Items[:idx], c.Items[idx+1:]...)
+			if c.Kind == constt.InventoryHand && len(c.Items) == 0 {
+				c.HandMouseOffsetX = 0
+				c.HandMouseOffsetY = 0
+			}
+		}
+		c.Version++
+		return true
+	})
+	result.Success = true
+
+	owner, _ := ecs.GetComponent[components.InventoryOwner](w, playerHandle)
+	current, _ := ecs.GetComponent[components.InventoryContainer](w, handle)
+	result.UpdatedContainers = e.applyNestedCascade(w, playerID, []*ContainerInfo{{
+		Handle:    handle,
+		Container: &current,
+		Owner:     &owner,
+	}})
+	return result
+}
+
+func findPlayerItemSlot(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) (types.Handle, int, bool) {
+	if itemID == 0 {
+		return types.InvalidHandle, 0, false
+	}
+	return findPlayerItemSlotFunc(w, playerID, playerHandle, func(item components.InvItem) bool {
+		return item.ItemID == itemID
+	})
+}
+
+func findPlayerItemSlotFunc(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	match func(item components.InvItem) bool,
+) (types.Handle, int, bool) {
+	if w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return types.InvalidHandle, 0, false
+	}
+	owner, hasOwner := ecs.GetComponent[components.InventoryOwner](w, playerHandle)
+	if !hasOwner {
+		return types.InvalidHandle, 0, false
+	}
+	links := craftOrderedInventoryLinks(owner, playerID)
+	for _, link := range owner.Inventories {
+		if link.Kind == constt.InventoryEquipment && link.OwnerID == playerID {
+			links = append(links, link)
+		}
+	}
+	for _, link := range links {
+		if !w.Alive(link.Handle) {
+			continue
+		}
+		container, ok := ecs.GetComponent[components.InventoryContainer](w, link.Handle)
+		if !ok {
+			continue
+		}
+		for idx := range container.Items {
+			if match(container.Items[idx]) {
+				return link.Handle, idx, true
+			}
+		}
+	}
+	return types.InvalidHandle, 0, false
+}
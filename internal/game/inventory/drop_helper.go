@@ -17,6 +17,9 @@ type SpawnDroppedEntityParams struct {
 	Resource        string
 	Quality         uint32
 	Quantity        uint32
+	Wear            uint32
+	Spoil           uint64
+	SpoilTick       uint64
 	W, H            uint8
 	DropX, DropY    int
 	Region          int
@@ -71,13 +74,16 @@ func SpawnDroppedEntity(w *ecs.World, p SpawnDroppedEntityParams) (SpawnDroppedE
 			Version: 1,
 			Items: []components.InvItem{
 				{
-					ItemID:   p.ItemID,
-					TypeID:   p.TypeID,
-					Resource: p.Resource,
-					Quality:  p.Quality,
-					Quantity: p.Quantity,
-					W:        p.W,
-					H:        p.H,
+					ItemID:    p.ItemID,
+					TypeID:    p.TypeID,
+					Resource:  p.Resource,
+					Quality:   p.Quality,
+					Quantity:  p.Quantity,
+					Wear:      p.Wear,
+					Spoil:     p.Spoil,
+					SpoilTick: p.SpoilTick,
+					W:         p.W,
+					H:         p.H,
 				},
 			},
 		}
@@ -117,6 +123,9 @@ func PersistDroppedEntity(
 		TypeID:          p.TypeID,
 		Quality:         p.Quality,
 		Quantity:        p.Quantity,
+		Wear:            p.Wear,
+		Spoil:           p.Spoil,
+		SpoilTick:       p.SpoilTick,
 		NestedInventory: nestedInvData,
 	}
 	invData := InventoryDataV1{
@@ -72,6 +72,10 @@ func (e *InventoryExecutor) ExecuteOperation(
 	e.collectClosedContainerRefs(w, result)
 	opResult.ClosedContainerRefs = result.ClosedContainerRefs
 
+	if result.Success {
+		settleUpdatedContainersSpoilage(w, result.UpdatedContainers)
+	}
+
 	// Mark changed world-object roots for deferred behavior recompute.
 	e.markBehaviorDirtyForUpdatedRoots(w, result)
 
@@ -390,6 +394,9 @@ func (e *InventoryExecutor) ExecutePickupFromWorld(
 	e.collectClosedContainerRefs(w, result)
 	opResult.ClosedContainerRefs = result.ClosedContainerRefs
 
+	if result.Success {
+		settleUpdatedContainersSpoilage(w, result.UpdatedContainers)
+	}
 	e.markBehaviorDirtyForUpdatedRoots(w, result)
 
 	for _, container := range result.UpdatedContainers {
@@ -450,6 +457,8 @@ func (e *InventoryExecutor) convertContainerToState(w *ecs.World, info *Containe
 			Resource:  item.Resource,
 			Quality:   item.Quality,
 			Quantity:  item.Quantity,
+			Wear:      item.Wear,
+			Spoil:     item.Spoil,
 			W:         item.W,
 			H:         item.H,
 			X:         item.X,
@@ -78,12 +78,13 @@ func (s *InventoryOperationService) GiveItem(
 		}
 
 		newItem := components.InvItem{
-			TypeID:   uint32(itemDef.DefID),
-			Resource: resource,
-			Quality:  quality,
-			Quantity: 1,
-			W:        uint8(itemDef.Size.W),
-			H:        uint8(itemDef.Size.H),
+			TypeID:    uint32(itemDef.DefID),
+			Resource:  resource,
+			Quality:   quality,
+			Quantity:  1,
+			SpoilTick: NewItemSpoilTick(w, itemDef),
+			W:         uint8(itemDef.Size.W),
+			H:         uint8(itemDef.Size.H),
 		}
 
 		updated := s.tryAddToEligibleGrid(w, playerID, playerHandle, &owner, &newItem, itemDef)
@@ -164,12 +165,13 @@ func (s *InventoryOperationService) GiveItemToHandOnly(
 	}
 
 	newItem := components.InvItem{
-		TypeID:   uint32(itemDef.DefID),
-		Resource: itemDef.ResolveResource(false),
-		Quality:  quality,
-		Quantity: 1,
-		W:        uint8(itemDef.Size.W),
-		H:        uint8(itemDef.Size.H),
+		TypeID:    uint32(itemDef.DefID),
+		Resource:  itemDef.ResolveResource(false),
+		Quality:   quality,
+		Quantity:  1,
+		SpoilTick: NewItemSpoilTick(w, itemDef),
+		W:         uint8(itemDef.Size.W),
+		H:         uint8(itemDef.Size.H),
 	}
 	updated := s.tryAddToHand(w, playerID, playerHandle, &owner, &newItem, itemDef)
 	if len(updated) == 0 {
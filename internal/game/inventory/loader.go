@@ -100,6 +100,9 @@ func (il *InventoryLoader) loadInventoryRecursive(
 			Resource:  itemDef.ResolveResource(hasNestedItems),
 			Quality:   dbItem.Quality,
 			Quantity:  dbItem.Quantity,
+			Wear:      dbItem.Wear,
+			Spoil:     dbItem.Spoil,
+			SpoilTick: dbItem.SpoilTick,
 			W:         uint8(itemDef.Size.W),
 			H:         uint8(itemDef.Size.H),
 			X:         dbItem.X,
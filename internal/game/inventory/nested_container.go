@@ -103,12 +103,15 @@ func serializeNestedForDrop(w *ecs.World, itemID types.EntityID) *InventoryDataV
 	items := make([]InventoryItemV1, 0, len(container.Items))
 	for _, invItem := range container.Items {
 		items = append(items, InventoryItemV1{
-			ItemID:   uint64(invItem.ItemID),
-			TypeID:   invItem.TypeID,
-			Quality:  invItem.Quality,
-			Quantity: invItem.Quantity,
-			X:        invItem.X,
-			Y:        invItem.Y,
+			ItemID:    uint64(invItem.ItemID),
+			TypeID:    invItem.TypeID,
+			Quality:   invItem.Quality,
+			Quantity:  invItem.Quantity,
+			Wear:      invItem.Wear,
+			Spoil:     invItem.Spoil,
+			SpoilTick: invItem.SpoilTick,
+			X:         invItem.X,
+			Y:         invItem.Y,
 		})
 	}
 
@@ -158,6 +158,7 @@ func (s *InventoryOperationService) ExecuteMove(
 			Message:   "Item not found in source container",
 		}
 	}
+	settleMovedItemSpoil(w, srcInfo.Container, srcItem)
 
 	// 5. Validate item can be placed in destination
 	dstEquipSlot := netproto.EquipSlot_EQUIP_SLOT_NONE
@@ -325,6 +326,7 @@ func (s *InventoryOperationService) executeSwap(
 	// Get the items to swap
 	srcItem := srcInfo.Container.Items[srcItemIndex]
 	swapItem := *placement.SwapItem
+	settleMovedItemSpoil(w, dstInfo.Container, &swapItem)
 
 	// Store original positions
 	origSrcX, origSrcY := srcItem.X, srcItem.Y
@@ -500,6 +502,7 @@ func (s *InventoryOperationService) ExecuteDropToWorld(
 			Message:   "Item not found in source container",
 		}
 	}
+	settleMovedItemSpoil(w, srcInfo.Container, srcItem)
 
 	// 2. Validate expected versions
 	if len(expected) > 0 {
@@ -578,6 +581,9 @@ func (s *InventoryOperationService) ExecuteDropToWorld(
 		Resource:        resource,
 		Quality:         srcItem.Quality,
 		Quantity:        srcItem.Quantity,
+		Wear:            srcItem.Wear,
+		Spoil:           srcItem.Spoil,
+		SpoilTick:       srcItem.SpoilTick,
 		W:               srcItem.W,
 		H:               srcItem.H,
 		DropX:           dropX,
@@ -701,6 +707,7 @@ func (s *InventoryOperationService) ExecutePickupFromWorld(
 	}
 
 	srcItem := droppedContainer.Items[0]
+	settleMovedItemSpoil(w, &droppedContainer, &srcItem)
 
 	// 4. Resolve destination container
 	dstInfo, verr := s.validator.ResolveContainer(w, dstRef, playerID, playerHandle)
@@ -82,6 +82,9 @@ func (is *InventorySaver) serializeContainer(
 			TypeID:    invItem.TypeID,
 			Quality:   invItem.Quality,
 			Quantity:  invItem.Quantity,
+			Wear:      invItem.Wear,
+			Spoil:     invItem.Spoil,
+			SpoilTick: invItem.SpoilTick,
 			X:         invItem.X,
 			Y:         invItem.Y,
 			EquipSlot: is.convertEquipSlot(invItem.EquipSlot),
@@ -137,6 +140,9 @@ func (is *InventorySaver) serializeNestedInventory(
 			TypeID:    invItem.TypeID,
 			Quality:   invItem.Quality,
 			Quantity:  invItem.Quantity,
+			Wear:      invItem.Wear,
+			Spoil:     invItem.Spoil,
+			SpoilTick: invItem.SpoilTick,
 			X:         invItem.X,
 			Y:         invItem.Y,
 			EquipSlot: is.convertEquipSlot(invItem.EquipSlot),
@@ -4,6 +4,7 @@ import (
 	constt "origin/internal/const"
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
 	"origin/internal/itemdefs"
 	"origin/internal/network"
 	netproto "origin/internal/network/proto"
@@ -44,6 +45,7 @@ func (ss *SnapshotSender) SendInventorySnapshots(
 		if !world.Alive(link.Handle) {
 			continue
 		}
+		SettleContainerSpoilage(world, link.Handle)
 
 		container, hasContainer := ecs.GetComponent[components.InventoryContainer](world, link.Handle)
 		if !hasContainer {
@@ -195,6 +197,7 @@ func (ss *SnapshotSender) buildItemInstance(
 		Resource: invItem.Resource,
 		Quality:  invItem.Quality,
 		Quantity: invItem.Quantity,
+		Wear:     invItem.Wear,
 		W:        uint32(invItem.W),
 		H:        uint32(invItem.H),
 	}
@@ -202,6 +205,8 @@ func (ss *SnapshotSender) buildItemInstance(
 	// Set name from item definition
 	if def, ok := itemdefs.Global().GetByID(int(invItem.TypeID)); ok {
 		itemInstance.Name = def.Name
+		itemInstance.MaxDurability = def.MaxDurability(invItem.Quality)
+		itemInstance.HintExt = systems.ItemHintExt(def, invItem.Spoil)
 	}
 
 	// Check if this item has a nested container via index (O(1))
@@ -0,0 +1,179 @@
+package inventory
+
+import (
+	"math"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+// Spoilage is lazy: items store the spoilage accumulated up to a server tick and it is brought
+// up to date only when the item is observed or moves to a container with another spoil rate.
+// Because SpoilTick is an absolute tick, time spent in unloaded chunks is caught up the same way.
+
+// NewItemSpoilTick returns the SpoilTick for an item created now: the current tick for items that spoil.
+func NewItemSpoilTick(w *ecs.World, itemDef *itemdefs.ItemDef) uint64 {
+	if w == nil || itemDef == nil || itemDef.Spoil == nil {
+		return 0
+	}
+	return ecs.GetResource[ecs.TimeState](w).Tick
+}
+
+// ContainerSpoilRate returns how fast items spoil inside the container: the spoilRate of the
+// owning object's inventory def or of the nested container item, itemdefs.DefaultSpoilRate otherwise.
+func ContainerSpoilRate(w *ecs.World, container *components.InventoryContainer) float64 {
+	if w == nil || container == nil || container.Kind != constt.InventoryGrid || container.OwnerID == 0 {
+		return itemdefs.DefaultSpoilRate
+	}
+
+	handle := w.GetHandleByEntityID(container.OwnerID)
+	if handle != types.InvalidHandle && w.Alive(handle) {
+		entityInfo, ok := ecs.GetComponent[components.EntityInfo](w, handle)
+		if !ok {
+			return itemdefs.DefaultSpoilRate
+		}
+		objectRegistry := objectdefs.Global()
+		if objectRegistry == nil {
+			return itemdefs.DefaultSpoilRate
+		}
+		objectDef, found := objectRegistry.GetByID(int(entityInfo.TypeID))
+		if !found || objectDef == nil || objectDef.Components == nil {
+			return itemdefs.DefaultSpoilRate
+		}
+		for _, invDef := range objectDef.Components.Inventory {
+			if (invDef.Kind == "" || invDef.Kind == "grid") && invDef.Key == container.Key && invDef.SpoilRate != nil {
+				return *invDef.SpoilRate
+			}
+		}
+		return itemdefs.DefaultSpoilRate
+	}
+
+	// Nested container owner is item_id.
+	if typeID, found := findItemTypeIDByItemID(w, container.OwnerID); found && itemdefs.Global() != nil {
+		if itemDef, ok := itemdefs.Global().GetByID(int(typeID)); ok {
+			return itemDef.Container.ItemSpoilRate()
+		}
+	}
+	return itemdefs.DefaultSpoilRate
+}
+
+// settleItemSpoil brings item spoilage up to nowTick at the given rate. Items that never spoil
+// and items without a stamp yet are only stamped. Returns false for items that never spoil.
+// SpoilTick advances only by the ticks converted into whole spoil points: the remainder is carried
+// to the next settle, so frequent observation at a slow rate still spoils the item.
+func settleItemSpoil(item *components.InvItem, itemDef *itemdefs.ItemDef, rate float64, nowTick uint64) bool {
+	if item == nil || itemDef == nil || itemDef.Spoil == nil {
+		return false
+	}
+	if item.SpoilTick == 0 || nowTick <= item.SpoilTick || rate <= 0 {
+		item.SpoilTick = nowTick
+		return true
+	}
+	elapsed := nowTick - item.SpoilTick
+	gained := uint64(float64(elapsed) * rate)
+	converted := uint64(math.Ceil(float64(gained) / rate))
+	if converted > elapsed {
+		converted = elapsed
+	}
+	item.Spoil += gained
+	item.SpoilTick += converted
+	if item.Spoil >= itemDef.Spoil.Ticks {
+		item.Spoil = itemDef.Spoil.Ticks
+		item.SpoilTick = nowTick
+	}
+	return true
+}
+
+// settleMovedItemSpoil brings spoilage of an item that is about to leave the container up to date,
+// so the time spent there counts at the container's rate.
+func settleMovedItemSpoil(w *ecs.World, container *components.InventoryContainer, item *components.InvItem) {
+	if w == nil || container == nil || item == nil || itemdefs.Global() == nil {
+		return
+	}
+	itemDef, ok := itemdefs.Global().GetByID(int(item.TypeID))
+	if !ok || itemDef.Spoil == nil {
+		return
+	}
+	settleItemSpoil(item, itemDef, ContainerSpoilRate(w, container), ecs.GetResource[ecs.TimeState](w).Tick)
+}
+
+// SettleContainerSpoilage brings spoilage of every item in the container up to date and replaces
+// fully spoiled items with their spoiled item, or removes them. Returns true when items changed
+// visibly (the container version is bumped then).
+func SettleContainerSpoilage(w *ecs.World, containerHandle types.Handle) bool {
+	if w == nil || containerHandle == types.InvalidHandle || !w.Alive(containerHandle) {
+		return false
+	}
+	itemRegistry := itemdefs.Global()
+	if itemRegistry == nil {
+		return false
+	}
+	container, ok := ecs.GetComponent[components.InventoryContainer](w, containerHandle)
+	if !ok || !containerHasSpoilingItems(&container, itemRegistry) {
+		return false
+	}
+
+	rate := ContainerSpoilRate(w, &container)
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	changed := false
+	ecs.MutateComponent[components.InventoryContainer](w, containerHandle, func(c *components.InventoryContainer) bool {
+		kept := c.Items[:0]
+		for _, item := range c.Items {
+			itemDef, ok := itemRegistry.GetByID(int(item.TypeID))
+			if !ok || !settleItemSpoil(&item, itemDef, rate, nowTick) || item.Spoil < itemDef.Spoil.Ticks {
+				kept = append(kept, item)
+				continue
+			}
+			changed = true
+			spoiledDef, ok := itemRegistry.GetByKey(itemDef.Spoil.SpoiledItemKey)
+			if !ok {
+				continue
+			}
+			item.TypeID = uint32(spoiledDef.DefID)
+			item.Resource = spoiledDef.ResolveResource(false)
+			item.Spoil = 0
+			item.SpoilTick = NewItemSpoilTick(w, spoiledDef)
+			kept = append(kept, item)
+		}
+		c.Items = kept
+		if changed {
+			c.Version++
+		}
+		return true
+	})
+	if changed && container.Kind == constt.InventoryGrid && container.Key == 0 {
+		ownerHandle := w.GetHandleByEntityID(container.OwnerID)
+		if ownerHandle != types.InvalidHandle && w.Alive(ownerHandle) {
+			if _, hasObjectState := ecs.GetComponent[components.ObjectInternalState](w, ownerHandle); hasObjectState {
+				ecs.MarkObjectBehaviorDirty(w, ownerHandle)
+			}
+		}
+	}
+	return changed
+}
+
+// settleUpdatedContainersSpoilage brings spoilage of containers touched by an operation up to date
+// before their state is sent, refreshing the infos of containers that changed.
+func settleUpdatedContainersSpoilage(w *ecs.World, containers []*ContainerInfo) {
+	for _, info := range containers {
+		if info == nil || !SettleContainerSpoilage(w, info.Handle) {
+			continue
+		}
+		if updated, ok := ecs.GetComponent[components.InventoryContainer](w, info.Handle); ok {
+			info.Container = &updated
+		}
+	}
+}
+
+func containerHasSpoilingItems(container *components.InventoryContainer, itemRegistry *itemdefs.Registry) bool {
+	for _, item := range container.Items {
+		if itemDef, ok := itemRegistry.GetByID(int(item.TypeID)); ok && itemDef.Spoil != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package inventory
+
+import (
+	"testing"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSpoilageItemRegistry(t *testing.T) {
+	t.Helper()
+	prev := itemdefs.Global()
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1, Key: "apple", Name: "Apple", Size: itemdefs.Size{W: 1, H: 1},
+			Spoil: &itemdefs.SpoilDef{Ticks: 100, SpoiledItemKey: "spoiled_apple"}},
+		{DefID: 2, Key: "spoiled_apple", Name: "Spoiled Apple", Resource: "spoiled_apple", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: 3, Key: "berry", Name: "Berry", Size: itemdefs.Size{W: 1, H: 1},
+			Spoil: &itemdefs.SpoilDef{Ticks: 50}},
+		{DefID: 4, Key: "stone", Name: "Stone", Size: itemdefs.Size{W: 1, H: 1}},
+	}))
+	t.Cleanup(func() { itemdefs.SetGlobalForTesting(prev) })
+}
+
+func setWorldTick(w *ecs.World, tick uint64) {
+	ecs.GetResource[ecs.TimeState](w).Tick = tick
+}
+
+func TestSettleContainerSpoilage_AccumulatesAndConverts(t *testing.T) {
+	setupSpoilageItemRegistry(t)
+	world, playerID, _ := setupTestWorld(t)
+	gridHandle := createGridContainer(world, playerID, 0, 4, 4)
+	ecs.WithComponent(world, gridHandle, func(c *components.InventoryContainer) {
+		c.Items = []components.InvItem{
+			{ItemID: 10, TypeID: 1, Quantity: 1, W: 1, H: 1, X: 0, SpoilTick: 1000},
+			{ItemID: 11, TypeID: 3, Quantity: 1, W: 1, H: 1, X: 1, SpoilTick: 1000},
+			{ItemID: 12, TypeID: 4, Quantity: 1, W: 1, H: 1, X: 2},
+		}
+	})
+
+	setWorldTick(world, 1040)
+	assert.False(t, SettleContainerSpoilage(world, gridHandle))
+	container, _ := ecs.GetComponent[components.InventoryContainer](world, gridHandle)
+	require.Len(t, container.Items, 3)
+	assert.Equal(t, uint64(40), container.Items[0].Spoil)
+	assert.Equal(t, uint64(1040), container.Items[0].SpoilTick)
+	assert.Equal(t, uint64(40), container.Items[1].Spoil)
+	assert.Equal(t, uint64(0), container.Items[2].SpoilTick)
+	assert.Equal(t, uint64(1), container.Version)
+
+	setWorldTick(world, 1100)
+	assert.True(t, SettleContainerSpoilage(world, gridHandle))
+	container, _ = ecs.GetComponent[components.InventoryContainer](world, gridHandle)
+	require.Len(t, container.Items, 2)
+	assert.Equal(t, uint64(2), container.Version)
+
+	spoiled := container.Items[0]
+	assert.Equal(t, types.EntityID(10), spoiled.ItemID)
+	assert.Equal(t, uint32(2), spoiled.TypeID)
+	assert.Equal(t, "spoiled_apple", spoiled.Resource)
+	assert.Equal(t, uint64(0), spoiled.Spoil)
+	assert.Equal(t, uint32(4), container.Items[1].TypeID)
+}
+
+func TestSettleContainerSpoilage_StampsLegacyItems(t *testing.T) {
+	setupSpoilageItemRegistry(t)
+	world, playerID, _ := setupTestWorld(t)
+	gridHandle := createGridContainer(world, playerID, 0, 4, 4)
+	ecs.WithComponent(world, gridHandle, func(c *components.InventoryContainer) {
+		c.Items = []components.InvItem{{ItemID: 10, TypeID: 1, Quantity: 1, W: 1, H: 1}}
+	})
+
+	setWorldTick(world, 5000)
+	SettleContainerSpoilage(world, gridHandle)
+	container, _ := ecs.GetComponent[components.InventoryContainer](world, gridHandle)
+	assert.Equal(t, uint64(0), container.Items[0].Spoil)
+	assert.Equal(t, uint64(5000), container.Items[0].SpoilTick)
+}
+
+func TestSettleItemSpoil_CarriesFractionalSpoil(t *testing.T) {
+	itemDef := &itemdefs.ItemDef{Spoil: &itemdefs.SpoilDef{Ticks: 100}}
+	item := components.InvItem{SpoilTick: 1000}
+
+	// Observed every tick at a quarter rate: the remainder must add up instead of being dropped.
+	for tick := uint64(1001); tick <= 1010; tick++ {
+		require.True(t, settleItemSpoil(&item, itemDef, 0.25, tick))
+	}
+	assert.Equal(t, uint64(2), item.Spoil)
+	assert.Equal(t, uint64(1008), item.SpoilTick)
+
+	require.True(t, settleItemSpoil(&item, itemDef, 0.25, 1012))
+	assert.Equal(t, uint64(3), item.Spoil)
+	assert.Equal(t, uint64(1012), item.SpoilTick)
+}
+
+func TestContainerSpoilRate_UsesObjectInventoryDef(t *testing.T) {
+	setupSpoilageItemRegistry(t)
+	prev := objectdefs.Global()
+	rate := 0.25
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{{
+		DefID: 16,
+		Key:   "barrel",
+		Components: &objectdefs.Components{
+			Inventory: []objectdefs.InventoryDef{{W: 3, H: 3, Kind: "grid", SpoilRate: &rate}},
+		},
+	}}))
+	t.Cleanup(func() { objectdefs.SetGlobalForTesting(prev) })
+
+	world := ecs.NewWorldForTesting()
+	barrelID := types.EntityID(2000)
+	world.Spawn(barrelID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: 16})
+	})
+	gridHandle := createGridContainer(world, barrelID, 0, 3, 3)
+	ecs.WithComponent(world, gridHandle, func(c *components.InventoryContainer) {
+		c.Items = []components.InvItem{{ItemID: 10, TypeID: 1, Quantity: 1, W: 1, H: 1, SpoilTick: 100}}
+	})
+
+	container, _ := ecs.GetComponent[components.InventoryContainer](world, gridHandle)
+	assert.Equal(t, 0.25, ContainerSpoilRate(world, &container))
+	assert.Equal(t, itemdefs.DefaultSpoilRate, ContainerSpoilRate(world, &components.InventoryContainer{
+		OwnerID: barrelID,
+		Kind:    constt.InventoryHand,
+	}))
+
+	setWorldTick(world, 300)
+	SettleContainerSpoilage(world, gridHandle)
+	container, _ = ecs.GetComponent[components.InventoryContainer](world, gridHandle)
+	assert.Equal(t, uint64(50), container.Items[0].Spoil)
+}
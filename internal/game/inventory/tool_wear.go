@@ -0,0 +1,149 @@
+package inventory
+
+import (
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/itemdefs"
+	"origin/internal/types"
+)
+
+type ToolWearResult struct {
+	// Found is false when no item with the tag is equipped.
+	Found bool
+	// Tool is the worn item after wear was applied (as it was before removal if it broke).
+	Tool components.InvItem
+	// MaxDurability is 0 for tools that never wear out.
+	MaxDurability uint32
+	Broken        bool
+
+	UpdatedContainers []*ContainerInfo
+}
+
+// Remaining returns durability left on the tool.
+func (r ToolWearResult) Remaining() uint32 {
+	if r.Tool.Wear >= r.MaxDurability {
+		return 0
+	}
+	return r.MaxDurability - r.Tool.Wear
+}
+
+// WearEquippedTool uses up durability of the first equipped item with the tag.
+// A tool whose wear reaches its max durability breaks and is removed from equipment.
+// Tools without durability are found but never change.
+func (e *InventoryExecutor) WearEquippedTool(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	tag string,
+	amount uint32,
+) ToolWearResult {
+	result := ToolWearResult{}
+	if e == nil || w == nil || tag == "" || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return result
+	}
+	equipmentHandle, found := ecs.GetResource[ecs.InventoryRefIndex](w).Lookup(constt.InventoryEquipment, playerID, 0)
+	if !found || !w.Alive(equipmentHandle) {
+		return result
+	}
+	itemRegistry := itemdefs.Global()
+	if itemRegistry == nil {
+		return result
+	}
+
+	changed := false
+	ecs.MutateComponent[components.InventoryContainer](w, equipmentHandle, func(c *components.InventoryContainer) bool {
+		for idx := range c.Items {
+			itemDef, ok := itemRegistry.GetByID(int(c.Items[idx].TypeID))
+			if !ok || !itemDefHasTag(itemDef, tag) {
+				continue
+			}
+			result.Found = true
+			result.MaxDurability = itemDef.MaxDurability(c.Items[idx].Quality)
+			if result.MaxDurability == 0 || amount == 0 {
+				result.Tool = c.Items[idx]
+				return false
+			}
+			c.Items[idx].Wear += amount
+			result.Tool = c.Items[idx]
+			if c.Items[idx].Wear >= result.MaxDurability {
+				result.Broken = true
+				c.Items = append(c.Items[:idx], c.Items[idx+1:]...)
+			}
+			c.Version++
+			changed = true
+			return true
+		}
+		return false
+	})
+	if !changed {
+		return result
+	}
+
+	owner, _ := ecs.GetComponent[components.InventoryOwner](w, playerHandle)
+	current, _ := ecs.GetComponent[components.InventoryContainer](w, equipmentHandle)
+	result.UpdatedContainers = []*ContainerInfo{{
+		Handle:    equipmentHandle,
+		Container: &current,
+		Owner:     &owner,
+	}}
+	return result
+}
+
+// WearPlayerItem uses up durability of a tool instance anywhere in the player inventory tree
+// (the tool a synthetic item action was started with). It breaks like WearEquippedTool.
+func (e *InventoryExecutor) WearPlayerItem(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+	amount uint32,
+) ToolWearResult {
+	result := ToolWearResult{}
+	if e == nil || w == nil {
+		return result
+	}
+	itemRegistry := itemdefs.Global()
+	if itemRegistry == nil {
+		return result
+	}
+	handle, idx, ok := findPlayerItemSlot(w, playerID, playerHandle, itemID)
+	if !ok {
+		return result
+	}
+
+	changed := false
+	ecs.MutateComponent[components.InventoryContainer](w, handle, func(c *components.InventoryContainer) bool {
+		itemDef, found := itemRegistry.GetByID(int(c.Items[idx].TypeID))
+		if !found {
+			return false
+		}
+		result.Found = true
+		result.MaxDurability = itemDef.MaxDurability(c.Items[idx].Quality)
+		result.Tool = c.Items[idx]
+		if result.MaxDurability == 0 || amount == 0 {
+			return false
+		}
+		c.Items[idx].Wear += amount
+		result.Tool = c.Items[idx]
+		if c.Items[idx].Wear >= result.MaxDurability {
+			result.Broken = true
+			c.Items = append(c.Items[:idx], c.Items[idx+1:]...)
+		}
+		c.Version++
+		changed = true
+		return true
+	})
+	if !changed {
+		return result
+	}
+
+	owner, _ := ecs.GetComponent[components.InventoryOwner](w, playerHandle)
+	current, _ := ecs.GetComponent[components.InventoryContainer](w, handle)
+	result.UpdatedContainers = e.applyNestedCascade(w, playerID, []*ContainerInfo{{
+		Handle:    handle,
+		Container: &current,
+		Owner:     &owner,
+	}})
+	return result
+}
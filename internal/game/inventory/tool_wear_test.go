@@ -0,0 +1,125 @@
+package inventory
+
+import (
+	"testing"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/itemdefs"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupToolWearTest(t *testing.T, tools ...components.InvItem) (*ecs.World, types.EntityID, types.Handle, types.Handle) {
+	t.Helper()
+	prev := itemdefs.Global()
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1, Key: "axe", Name: "Axe", Tags: []string{"axe"}, Size: itemdefs.Size{W: 1, H: 1}, Durability: 3},
+		{DefID: 2, Key: "club", Name: "Club", Tags: []string{"club"}, Size: itemdefs.Size{W: 1, H: 1}},
+	}))
+	t.Cleanup(func() { itemdefs.SetGlobalForTesting(prev) })
+
+	world, playerID, playerHandle := setupTestWorld(t)
+	equipmentHandle := world.SpawnWithoutExternalID()
+	ecs.AddComponent(world, equipmentHandle, components.InventoryContainer{
+		OwnerID: playerID,
+		Kind:    constt.InventoryEquipment,
+		Version: 1,
+		Items:   tools,
+	})
+	ecs.AddComponent(world, playerHandle, components.InventoryOwner{
+		Inventories: []components.InventoryLink{
+			{Kind: constt.InventoryEquipment, OwnerID: playerID, Handle: equipmentHandle},
+		},
+	})
+	ecs.GetResource[ecs.InventoryRefIndex](world).Add(constt.InventoryEquipment, playerID, 0, equipmentHandle)
+	return world, playerID, playerHandle, equipmentHandle
+}
+
+func TestWearEquippedTool_WearsAndBreaks(t *testing.T) {
+	world, playerID, playerHandle, equipmentHandle := setupToolWearTest(t, components.InvItem{
+		ItemID: 100, TypeID: 1, Quality: 10, Quantity: 1, W: 1, H: 1, EquipSlot: netproto.EquipSlot_EQUIP_SLOT_RIGHT_HAND,
+	})
+	executor := NewInventoryExecutor(nil, nil, nil, nil, nil)
+
+	result := executor.WearEquippedTool(world, playerID, playerHandle, "axe", 1)
+	require.True(t, result.Found)
+	assert.False(t, result.Broken)
+	assert.Equal(t, uint32(3), result.MaxDurability)
+	assert.Equal(t, uint32(2), result.Remaining())
+	require.Len(t, result.UpdatedContainers, 1)
+	assert.Equal(t, uint32(1), result.UpdatedContainers[0].Container.Items[0].Wear)
+
+	result = executor.WearEquippedTool(world, playerID, playerHandle, "axe", 2)
+	require.True(t, result.Found)
+	assert.True(t, result.Broken)
+	assert.Equal(t, uint32(0), result.Remaining())
+
+	equipment, _ := ecs.GetComponent[components.InventoryContainer](world, equipmentHandle)
+	assert.Empty(t, equipment.Items)
+	assert.Equal(t, uint64(3), equipment.Version)
+
+	result = executor.WearEquippedTool(world, playerID, playerHandle, "axe", 1)
+	assert.False(t, result.Found)
+}
+
+func TestWearEquippedTool_ToolWithoutDurabilityNeverWears(t *testing.T) {
+	world, playerID, playerHandle, equipmentHandle := setupToolWearTest(t, components.InvItem{
+		ItemID: 100, TypeID: 2, Quality: 10, Quantity: 1, W: 1, H: 1, EquipSlot: netproto.EquipSlot_EQUIP_SLOT_RIGHT_HAND,
+	})
+	executor := NewInventoryExecutor(nil, nil, nil, nil, nil)
+
+	result := executor.WearEquippedTool(world, playerID, playerHandle, "club", 1)
+	require.True(t, result.Found)
+	assert.Equal(t, uint32(0), result.MaxDurability)
+	assert.Empty(t, result.UpdatedContainers)
+
+	equipment, _ := ecs.GetComponent[components.InventoryContainer](world, equipmentHandle)
+	assert.Equal(t, uint32(0), equipment.Items[0].Wear)
+	assert.Equal(t, uint64(1), equipment.Version)
+}
+
+func TestWearPlayerItem_WearsTheGivenToolAndBreaks(t *testing.T) {
+	world, playerID, playerHandle, equipmentHandle := setupToolWearTest(t,
+		components.InvItem{ItemID: 100, TypeID: 1, Quality: 10, Quantity: 1, W: 1, H: 1, EquipSlot: netproto.EquipSlot_EQUIP_SLOT_RIGHT_HAND},
+		components.InvItem{ItemID: 101, TypeID: 1, Quality: 10, Quantity: 1, W: 1, H: 1, EquipSlot: netproto.EquipSlot_EQUIP_SLOT_LEFT_HAND},
+	)
+	executor := NewInventoryExecutor(nil, nil, nil, nil, nil)
+
+	result := executor.WearPlayerItem(world, playerID, playerHandle, 101, 2)
+	require.True(t, result.Found)
+	assert.False(t, result.Broken)
+	assert.Equal(t, uint32(1), result.Remaining())
+	require.Len(t, result.UpdatedContainers, 1)
+
+	equipment, _ := ecs.GetComponent[components.InventoryContainer](world, equipmentHandle)
+	assert.Equal(t, uint32(0), equipment.Items[0].Wear)
+	assert.Equal(t, uint32(2), equipment.Items[1].Wear)
+
+	result = executor.WearPlayerItem(world, playerID, playerHandle, 101, 1)
+	assert.True(t, result.Broken)
+	equipment, _ = ecs.GetComponent[components.InventoryContainer](world, equipmentHandle)
+	require.Len(t, equipment.Items, 1)
+	assert.Equal(t, types.EntityID(100), equipment.Items[0].ItemID)
+
+	result = executor.WearPlayerItem(world, playerID, playerHandle, 101, 1)
+	assert.False(t, result.Found)
+}
+
+func TestFindPlayerItemByKey(t *testing.T) {
+	world, playerID, playerHandle, _ := setupToolWearTest(t,
+		components.InvItem{ItemID: 100, TypeID: 2, Quality: 10, Quantity: 1, W: 1, H: 1, EquipSlot: netproto.EquipSlot_EQUIP_SLOT_RIGHT_HAND},
+	)
+	executor := NewInventoryExecutor(nil, nil, nil, nil, nil)
+
+	item, found := executor.FindPlayerItemByKey(world, playerID, playerHandle, "club")
+	require.True(t, found)
+	assert.Equal(t, types.EntityID(100), item.ItemID)
+
+	_, found = executor.FindPlayerItemByKey(world, playerID, playerHandle, "axe")
+	assert.False(t, found)
+}
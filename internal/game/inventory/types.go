@@ -82,6 +82,9 @@ type InventoryItemV1 struct {
 	TypeID          uint32           `json:"type_id"`
 	Quality         uint32           `json:"quality"`
 	Quantity        uint32           `json:"quantity"`
+	Wear            uint32           `json:"wear,omitempty"`
+	Spoil           uint64           `json:"spoil,omitempty"`
+	SpoilTick       uint64           `json:"last_tick,omitempty"`
 	X               uint8            `json:"x,omitempty"`
 	Y               uint8            `json:"y,omitempty"`
 	EquipSlot       string           `json:"equip_slot,omitempty"`
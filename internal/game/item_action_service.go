@@ -0,0 +1,344 @@
+package game
+
+import (
+	"math"
+
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	eatItemActionID    = "eat"
+	eatItemActionTitle = "Eat"
+)
+
+type itemActionSender interface {
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendInventoryUpdate(entityID types.EntityID, states []*netproto.InventoryState)
+	SendExpGained(entityID types.EntityID, gained *netproto.S2C_ExpGained)
+	SendContextMenu(entityID types.EntityID, menu *netproto.S2C_ContextMenu)
+}
+
+// ItemActionService provides context actions on items in the player's own inventory.
+// Eating runs as a synthetic cyclic action targeting the item: one unit per cycle.
+type ItemActionService struct {
+	world     *ecs.World
+	invExec   *inventory.InventoryExecutor
+	sender    itemActionSender
+	farming   *FarmingService
+	terraform *TerraformService
+	logger    *zap.Logger
+}
+
+func NewItemActionService(
+	world *ecs.World,
+	invExec *inventory.InventoryExecutor,
+	sender itemActionSender,
+	logger *zap.Logger,
+) *ItemActionService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &ItemActionService{
+		world:   world,
+		invExec: invExec,
+		sender:  sender,
+		logger:  logger,
+	}
+}
+
+var _ systems.ItemActionCommandService = (*ItemActionService)(nil)
+
+// SetFarmingService adds plow/plant actions for hoes and seeds.
+func (s *ItemActionService) SetFarmingService(farming *FarmingService) {
+	if s == nil {
+		return
+	}
+	s.farming = farming
+}
+
+// SetTerraformService adds dig/pave/fill actions for shovels and hammers.
+func (s *ItemActionService) SetTerraformService(terraform *TerraformService) {
+	if s == nil {
+		return
+	}
+	s.terraform = terraform
+}
+
+func (s *ItemActionService) IsSyntheticEatAction(action components.ActiveCyclicAction) bool {
+	return action.BehaviorKey == "" && action.ActionID == eatItemActionID &&
+		action.TargetKind == components.CyclicActionTargetItem
+}
+
+func (s *ItemActionService) HandleItemContextMenu(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	msg *netproto.C2S_ItemContextMenu,
+) {
+	if s == nil || msg == nil || s.sender == nil {
+		return
+	}
+	_, itemDef, ok := s.resolvePlayerItem(w, playerID, playerHandle, types.EntityID(msg.ItemId))
+	if !ok {
+		return
+	}
+	actions := s.itemContextActions(itemDef)
+	if len(actions) == 0 {
+		return
+	}
+	menu := &netproto.S2C_ContextMenu{
+		ItemId:  msg.ItemId,
+		Actions: make([]*netproto.ContextMenuAction, 0, len(actions)),
+	}
+	for _, action := range actions {
+		menu.Actions = append(menu.Actions, &netproto.ContextMenuAction{
+			ActionId: action.ActionID,
+			Title:    action.Title,
+		})
+	}
+	s.sender.SendContextMenu(playerID, menu)
+}
+
+func (s *ItemActionService) HandleItemAction(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	msg *netproto.C2S_ItemAction,
+) {
+	if s == nil || msg == nil {
+		return
+	}
+	switch msg.ActionId {
+	case eatItemActionID:
+		s.startEating(w, playerID, playerHandle, types.EntityID(msg.ItemId))
+	default:
+		if !s.farming.StartItemAction(w, playerID, playerHandle, types.EntityID(msg.ItemId), msg.ActionId) {
+			s.terraform.StartItemAction(w, playerID, playerHandle, types.EntityID(msg.ItemId), msg.ActionId)
+		}
+	}
+}
+
+func (s *ItemActionService) itemContextActions(itemDef *itemdefs.ItemDef) []systems.ContextAction {
+	if itemDef == nil {
+		return nil
+	}
+	var actions []systems.ContextAction
+	if itemDef.Food != nil {
+		actions = append(actions, systems.ContextAction{ActionID: eatItemActionID, Title: eatItemActionTitle})
+	}
+	actions = append(actions, s.farming.ItemActions(itemDef)...)
+	return append(actions, s.terraform.ItemActions(itemDef)...)
+}
+
+func (s *ItemActionService) startEating(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) {
+	if w == nil || playerID == 0 || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	if _, has := ecs.GetComponent[components.ActiveCyclicAction](w, playerHandle); has {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, "ACTION_BUSY")
+		return
+	}
+	_, itemDef, ok := s.resolvePlayerItem(w, playerID, playerHandle, itemID)
+	if !ok || itemDef.Food == nil {
+		// Item moved away or changed since the menu was shown: silent ignore.
+		return
+	}
+	if isEnergyFull(w, playerHandle) {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, "FOOD_FULL")
+		return
+	}
+
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	ecs.AddComponent(w, playerHandle, components.ActiveCyclicAction{
+		ActionID:           eatItemActionID,
+		TargetKind:         components.CyclicActionTargetItem,
+		TargetID:           itemID,
+		CycleDurationTicks: itemDef.Food.EatTicks,
+		CycleElapsedTicks:  0,
+		CycleIndex:         1,
+		StartedTick:        nowTick,
+	})
+	ecs.MutateComponent[components.Movement](w, playerHandle, func(m *components.Movement) bool {
+		m.State = constt.StateInteracting
+		return true
+	})
+}
+
+// HandleEatCycleComplete eats one unit of the target item. Eating continues with the same
+// item while units remain and energy is not full.
+func (s *ItemActionService) HandleEatCycleComplete(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) contracts.BehaviorCycleDecision {
+	if s == nil || s.invExec == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	_, itemDef, ok := s.resolvePlayerItem(w, playerID, playerHandle, action.TargetID)
+	if !ok || itemDef.Food == nil {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if isEnergyFull(w, playerHandle) {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_INFO, "FOOD_FULL")
+		return contracts.BehaviorCycleDecisionComplete
+	}
+
+	consumed := s.invExec.ConsumePlayerItemUnit(w, playerID, playerHandle, action.TargetID)
+	if !consumed.Success {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	s.sendInventoryUpdate(w, playerID, consumed.UpdatedContainers)
+
+	multiplier := itemDef.Food.QualityMultiplier(consumed.Item.Quality)
+	behaviors.RestorePlayerEnergy(w, playerHandle, itemDef.Food.Energy*multiplier)
+	s.grantFoodExp(w, playerID, playerHandle, itemDef.Food.Exp, multiplier)
+
+	if _, stillHas := s.invExec.FindPlayerItem(w, playerID, playerHandle, action.TargetID); !stillHas {
+		return contracts.BehaviorCycleDecisionComplete
+	}
+	if isEnergyFull(w, playerHandle) {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_INFO, "FOOD_FULL")
+		return contracts.BehaviorCycleDecisionComplete
+	}
+	return contracts.BehaviorCycleDecisionContinue
+}
+
+func (s *ItemActionService) IsActiveEatStillValid(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) bool {
+	if s == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return false
+	}
+	_, itemDef, ok := s.resolvePlayerItem(w, playerID, playerHandle, action.TargetID)
+	return ok && itemDef.Food != nil
+}
+
+func (s *ItemActionService) resolvePlayerItem(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+) (components.InvItem, *itemdefs.ItemDef, bool) {
+	if s.invExec == nil || w == nil || itemID == 0 {
+		return components.InvItem{}, nil, false
+	}
+	// Spoilage is lazy: bring it up to date first, the item may have turned into its spoiled item.
+	s.sendInventoryUpdate(w, playerID, s.invExec.SettlePlayerItemSpoilage(w, playerID, playerHandle, itemID))
+	item, found := s.invExec.FindPlayerItem(w, playerID, playerHandle, itemID)
+	if !found {
+		return components.InvItem{}, nil, false
+	}
+	registry := itemdefs.Global()
+	if registry == nil {
+		return components.InvItem{}, nil, false
+	}
+	itemDef, ok := registry.GetByID(int(item.TypeID))
+	if !ok || itemDef == nil {
+		return components.InvItem{}, nil, false
+	}
+	return item, itemDef, true
+}
+
+func (s *ItemActionService) grantFoodExp(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	exp *itemdefs.FoodExp,
+	multiplier float64,
+) {
+	if exp == nil {
+		return
+	}
+	gained := components.CharacterExperience{
+		LP:       scaleFoodExp(exp.LP, multiplier),
+		Nature:   scaleFoodExp(exp.Nature, multiplier),
+		Industry: scaleFoodExp(exp.Industry, multiplier),
+		Combat:   scaleFoodExp(exp.Combat, multiplier),
+	}
+	if gained == (components.CharacterExperience{}) {
+		return
+	}
+	if !ecs.MutateComponent[components.CharacterProfile](w, playerHandle, func(profile *components.CharacterProfile) bool {
+		profile.Experience.LP += gained.LP
+		profile.Experience.Nature += gained.Nature
+		profile.Experience.Industry += gained.Industry
+		profile.Experience.Combat += gained.Combat
+		return true
+	}) {
+		return
+	}
+	if s.sender == nil {
+		return
+	}
+	msg := &netproto.S2C_ExpGained{EntityId: uint64(playerID)}
+	if gained.LP > 0 {
+		msg.Lp = &gained.LP
+	}
+	if gained.Nature > 0 {
+		msg.Nature = &gained.Nature
+	}
+	if gained.Industry > 0 {
+		msg.Industry = &gained.Industry
+	}
+	if gained.Combat > 0 {
+		msg.Combat = &gained.Combat
+	}
+	s.sender.SendExpGained(playerID, msg)
+}
+
+func scaleFoodExp(value int64, multiplier float64) int64 {
+	if value <= 0 {
+		return 0
+	}
+	return int64(math.Round(float64(value) * multiplier))
+}
+
+func isEnergyFull(w *ecs.World, playerHandle types.Handle) bool {
+	stats, hasStats := ecs.GetComponent[components.EntityStats](w, playerHandle)
+	return hasStats && stats.Energy >= constt.EnergyMax
+}
+
+func (s *ItemActionService) sendInventoryUpdate(w *ecs.World, playerID types.EntityID, updated []*inventory.ContainerInfo) {
+	if s.sender == nil || len(updated) == 0 {
+		return
+	}
+	states := s.invExec.ConvertContainersToStates(w, updated)
+	protoStates := make([]*netproto.InventoryState, 0, len(states))
+	for _, st := range states {
+		protoStates = append(protoStates, systems.BuildInventoryStateProto(st))
+	}
+	if len(protoStates) > 0 {
+		s.sender.SendInventoryUpdate(playerID, protoStates)
+	}
+}
+
+func (s *ItemActionService) sendMiniAlert(entityID types.EntityID, severity netproto.AlertSeverity, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(entityID, &netproto.S2C_MiniAlert{
+		Severity:   severity,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(severity),
+	})
+}
@@ -0,0 +1,318 @@
+package game
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	netproto "origin/internal/network/proto"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+const (
+	testFoodPlayerDefID = 92000
+	testFoodItemDefID   = 92001
+	testStoneItemDefID  = 92002
+	testRottenItemDefID = 92003
+	testFoodItemID      = types.EntityID(777001)
+	testStoneItemID     = types.EntityID(777002)
+)
+
+type testItemActionSender struct {
+	alerts    []*netproto.S2C_MiniAlert
+	updates   int
+	expGained []*netproto.S2C_ExpGained
+	menus     []*netproto.S2C_ContextMenu
+}
+
+func (s *testItemActionSender) SendMiniAlert(_ types.EntityID, alert *netproto.S2C_MiniAlert) {
+	s.alerts = append(s.alerts, alert)
+}
+
+func (s *testItemActionSender) SendInventoryUpdate(_ types.EntityID, _ []*netproto.InventoryState) {
+	s.updates++
+}
+
+func (s *testItemActionSender) SendExpGained(_ types.EntityID, gained *netproto.S2C_ExpGained) {
+	s.expGained = append(s.expGained, gained)
+}
+
+func (s *testItemActionSender) SendContextMenu(_ types.EntityID, menu *netproto.S2C_ContextMenu) {
+	s.menus = append(s.menus, menu)
+}
+
+func setFoodTestItemRegistry(t *testing.T) {
+	t.Helper()
+	prev := itemdefs.Global()
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{
+			DefID: testFoodItemDefID,
+			Key:   "food_test_apple",
+			Name:  "Apple",
+			Size:  itemdefs.Size{W: 1, H: 1},
+			Food: &itemdefs.FoodDef{
+				Energy:         60,
+				EatTicks:       3,
+				QualityScaling: itemdefs.FoodQualityScalingSqrt,
+				Exp:            &itemdefs.FoodExp{Nature: 2},
+			},
+			Spoil: &itemdefs.SpoilDef{Ticks: 100, SpoiledItemKey: "food_test_rotten"},
+		},
+		{
+			DefID: testRottenItemDefID,
+			Key:   "food_test_rotten",
+			Name:  "Rotten Apple",
+			Size:  itemdefs.Size{W: 1, H: 1},
+		},
+		{
+			DefID: testStoneItemDefID,
+			Key:   "food_test_stone",
+			Name:  "Stone",
+			Size:  itemdefs.Size{W: 1, H: 1},
+		},
+	}))
+	prevObjects := objectdefs.Global()
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{DefID: testFoodPlayerDefID, Key: "player", Name: "Player"},
+	}))
+	t.Cleanup(func() {
+		itemdefs.SetGlobalForTesting(prev)
+		objectdefs.SetGlobalForTesting(prevObjects)
+	})
+}
+
+func spawnFoodTestPlayer(world *ecs.World, playerID types.EntityID, energy float64, foodQuantity uint32) types.Handle {
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: testFoodPlayerDefID})
+		ecs.AddComponent(w, h, components.Movement{
+			Mode:  constt.Walk,
+			State: constt.StateIdle,
+			Speed: 1,
+		})
+		ecs.AddComponent(w, h, components.CharacterProfile{
+			Attributes: characterattrs.Default(),
+		})
+		ecs.AddComponent(w, h, components.EntityStats{
+			Stamina: 500,
+			Energy:  energy,
+		})
+	})
+	gridHandle := world.Spawn(types.EntityID(uint64(playerID)+100000), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.InventoryContainer{
+			OwnerID: playerID,
+			Kind:    constt.InventoryGrid,
+			Width:   4,
+			Height:  4,
+			Items: []components.InvItem{
+				{ItemID: testFoodItemID, TypeID: testFoodItemDefID, Quality: 40, Quantity: foodQuantity, W: 1, H: 1},
+				{ItemID: testStoneItemID, TypeID: testStoneItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1, X: 1},
+			},
+		})
+	})
+	ecs.AddComponent(world, playerHandle, components.InventoryOwner{
+		Inventories: []components.InventoryLink{
+			{Kind: constt.InventoryGrid, OwnerID: playerID, Handle: gridHandle},
+		},
+	})
+	return playerHandle
+}
+
+func newFoodTestService(world *ecs.World, sender *testItemActionSender) *ItemActionService {
+	invExec := inventory.NewInventoryExecutor(nil, nil, nil, nil, nil)
+	return NewItemActionService(world, invExec, sender, nil)
+}
+
+func TestItemActionService_ContextMenuOffersEatForFoodOnly(t *testing.T) {
+	setFoodTestItemRegistry(t)
+	world := ecs.NewWorldForTesting()
+	sender := &testItemActionSender{}
+	service := newFoodTestService(world, sender)
+
+	playerID := types.EntityID(3001)
+	playerHandle := spawnFoodTestPlayer(world, playerID, 500, 1)
+
+	service.HandleItemContextMenu(world, playerID, playerHandle, &netproto.C2S_ItemContextMenu{ItemId: uint64(testStoneItemID)})
+	if len(sender.menus) != 0 {
+		t.Fatalf("expected no menu for non-food item, got %+v", sender.menus)
+	}
+
+	service.HandleItemContextMenu(world, playerID, playerHandle, &netproto.C2S_ItemContextMenu{ItemId: uint64(testFoodItemID)})
+	if len(sender.menus) != 1 {
+		t.Fatalf("expected one menu, got %d", len(sender.menus))
+	}
+	menu := sender.menus[0]
+	if menu.ItemId != uint64(testFoodItemID) || menu.EntityId != 0 {
+		t.Fatalf("unexpected menu target: item=%d entity=%d", menu.ItemId, menu.EntityId)
+	}
+	if len(menu.Actions) != 1 || menu.Actions[0].ActionId != eatItemActionID {
+		t.Fatalf("expected eat action, got %+v", menu.Actions)
+	}
+}
+
+func TestItemActionService_EatConsumesUnitsAndRestoresEnergy(t *testing.T) {
+	setFoodTestItemRegistry(t)
+	world := ecs.NewWorldForTesting()
+	sender := &testItemActionSender{}
+	service := newFoodTestService(world, sender)
+
+	playerID := types.EntityID(3002)
+	playerHandle := spawnFoodTestPlayer(world, playerID, 500, 2)
+
+	service.HandleItemAction(world, playerID, playerHandle, &netproto.C2S_ItemAction{
+		ItemId:   uint64(testFoodItemID),
+		ActionId: eatItemActionID,
+	})
+	action, hasAction := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+	if !hasAction {
+		t.Fatalf("expected eat cyclic action to start")
+	}
+	if !service.IsSyntheticEatAction(action) || action.TargetID != testFoodItemID || action.CycleDurationTicks != 3 {
+		t.Fatalf("unexpected cyclic action: %+v", action)
+	}
+
+	// Quality 40 with sqrt scaling doubles the base values.
+	if decision := service.HandleEatCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionContinue {
+		t.Fatalf("expected continue while units remain, got %v", decision)
+	}
+	stats, _ := ecs.GetComponent[components.EntityStats](world, playerHandle)
+	if stats.Energy != 620 {
+		t.Fatalf("expected energy 620 after first unit, got %v", stats.Energy)
+	}
+	item, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testFoodItemID)
+	if !found || item.Quantity != 1 {
+		t.Fatalf("expected one unit left, got found=%v item=%+v", found, item)
+	}
+	profile, _ := ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if profile.Experience.Nature != 4 {
+		t.Fatalf("expected nature exp 4, got %d", profile.Experience.Nature)
+	}
+	if len(sender.expGained) != 1 || sender.expGained[0].Nature == nil || *sender.expGained[0].Nature != 4 {
+		t.Fatalf("expected exp gained message with nature 4, got %+v", sender.expGained)
+	}
+
+	if decision := service.HandleEatCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete after last unit, got %v", decision)
+	}
+	if _, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testFoodItemID); found {
+		t.Fatalf("expected food item to be consumed")
+	}
+	if service.IsActiveEatStillValid(world, playerID, playerHandle, action) {
+		t.Fatalf("expected eat action to be invalid without the item")
+	}
+	if sender.updates != 2 {
+		t.Fatalf("expected inventory update per eaten unit, got %d", sender.updates)
+	}
+}
+
+func TestItemActionService_EatSettlesSpoilageFirst(t *testing.T) {
+	setFoodTestItemRegistry(t)
+	world := ecs.NewWorldForTesting()
+	sender := &testItemActionSender{}
+	service := newFoodTestService(world, sender)
+
+	playerID := types.EntityID(3004)
+	playerHandle := spawnFoodTestPlayer(world, playerID, 500, 1)
+	inventoryOwner, _ := ecs.GetComponent[components.InventoryOwner](world, playerHandle)
+	ecs.WithComponent(world, inventoryOwner.Inventories[0].Handle, func(c *components.InventoryContainer) {
+		c.Items[0].SpoilTick = 1
+	})
+	ecs.GetResource[ecs.TimeState](world).Tick = 500
+
+	service.HandleItemAction(world, playerID, playerHandle, &netproto.C2S_ItemAction{
+		ItemId:   uint64(testFoodItemID),
+		ActionId: eatItemActionID,
+	})
+	if _, hasAction := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); hasAction {
+		t.Fatalf("expected a fully spoiled item not to be eaten")
+	}
+	item, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testFoodItemID)
+	if !found || item.TypeID != testRottenItemDefID {
+		t.Fatalf("expected the item to turn into its spoiled item, got found=%v item=%+v", found, item)
+	}
+	if sender.updates != 1 {
+		t.Fatalf("expected the spoiled container to be sent, got %d updates", sender.updates)
+	}
+}
+
+func TestItemActionService_EatCapsEnergyAndRefusesWhenFull(t *testing.T) {
+	setFoodTestItemRegistry(t)
+	world := ecs.NewWorldForTesting()
+	sender := &testItemActionSender{}
+	service := newFoodTestService(world, sender)
+
+	playerID := types.EntityID(3003)
+	playerHandle := spawnFoodTestPlayer(world, playerID, constt.EnergyMax-50, 3)
+
+	service.HandleItemAction(world, playerID, playerHandle, &netproto.C2S_ItemAction{
+		ItemId:   uint64(testFoodItemID),
+		ActionId: eatItemActionID,
+	})
+	action, hasAction := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+	if !hasAction {
+		t.Fatalf("expected eat cyclic action to start")
+	}
+	if decision := service.HandleEatCycleComplete(world, playerID, playerHandle, action); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete once energy is full, got %v", decision)
+	}
+	stats, _ := ecs.GetComponent[components.EntityStats](world, playerHandle)
+	if stats.Energy != constt.EnergyMax {
+		t.Fatalf("expected energy capped at %v, got %v", constt.EnergyMax, stats.Energy)
+	}
+	movement, _ := ecs.GetComponent[components.Movement](world, playerHandle)
+	if movement.Mode != constt.Crawl {
+		t.Fatalf("expected overfed player to be limited to crawl, got %v", movement.Mode)
+	}
+
+	ecs.RemoveComponent[components.ActiveCyclicAction](world, playerHandle)
+	sender.alerts = nil
+	service.HandleItemAction(world, playerID, playerHandle, &netproto.C2S_ItemAction{
+		ItemId:   uint64(testFoodItemID),
+		ActionId: eatItemActionID,
+	})
+	if _, hasAction := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); hasAction {
+		t.Fatalf("expected eating to be refused at full energy")
+	}
+	if len(sender.alerts) != 1 || sender.alerts[0].ReasonCode != "FOOD_FULL" {
+		t.Fatalf("expected FOOD_FULL alert, got %+v", sender.alerts)
+	}
+}
+
+func TestCyclicActionSystem_RunsEatAction(t *testing.T) {
+	setFoodTestItemRegistry(t)
+	world := ecs.NewWorldForTesting()
+	sender := &testItemActionSender{}
+	itemActions := newFoodTestService(world, sender)
+	finished := &testCyclicActionFinishSender{}
+	contextActions := newTeachTestService(world, nil, finished)
+	contextActions.SetItemActionService(itemActions)
+	system := NewCyclicActionSystem(contextActions, nil, nil)
+
+	playerID := types.EntityID(3004)
+	playerHandle := spawnFoodTestPlayer(world, playerID, 500, 1)
+	itemActions.HandleItemAction(world, playerID, playerHandle, &netproto.C2S_ItemAction{
+		ItemId:   uint64(testFoodItemID),
+		ActionId: eatItemActionID,
+	})
+
+	for i := 0; i < 3; i++ {
+		system.Update(world, 0)
+	}
+
+	if _, hasAction := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); hasAction {
+		t.Fatalf("expected eat action to finish")
+	}
+	if len(finished.messages) != 1 ||
+		finished.messages[0].Result != netproto.CyclicActionFinishResult_CYCLIC_ACTION_FINISH_RESULT_COMPLETED {
+		t.Fatalf("expected completed finish message, got %+v", finished.messages)
+	}
+	stats, _ := ecs.GetComponent[components.EntityStats](world, playerHandle)
+	if stats.Energy != 620 {
+		t.Fatalf("expected energy 620, got %v", stats.Energy)
+	}
+}
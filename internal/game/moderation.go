@@ -0,0 +1,328 @@
+package game
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+// account.status values.
+const (
+	AccountStatusActive    int16 = 0
+	AccountStatusBanned    int16 = 1
+	AccountStatusSuspended int16 = 2
+)
+
+const (
+	moderationTimeout = 5 * time.Second
+	// kickCloseDelay gives the write loop time to flush the kick reason before the socket is closed.
+	kickCloseDelay = 200 * time.Millisecond
+)
+
+var errAccountBanned = errors.New("account banned")
+
+// AccountBanMessage reports whether the account must be refused at login and the reason shown to the user.
+// A ban whose banned_until is in the past is treated as expired.
+func AccountBanMessage(account repository.Account, now time.Time) (bool, string) {
+	if !account.Status.Valid || account.Status.Int16 == AccountStatusActive {
+		return false, ""
+	}
+	if account.BannedUntil.Valid && !account.BannedUntil.Time.After(now) {
+		return false, ""
+	}
+
+	msg := "Account banned"
+	if account.Status.Int16 == AccountStatusSuspended {
+		msg = "Account suspended"
+	}
+	if account.BannedUntil.Valid {
+		msg += " until " + account.BannedUntil.Time.UTC().Format(time.RFC3339)
+	}
+	if account.BanReason.Valid && account.BanReason.String != "" {
+		msg += ": " + account.BanReason.String
+	}
+	return true, msg
+}
+
+// ParseModerationDuration accepts Go durations plus a day suffix ("7d").
+func ParseModerationDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	return d, nil
+}
+
+// AccountMutes caches chat mute expiry of authenticated accounts. Mutes are stored on the account,
+// so they are keyed by account id: every character of a muted account stays muted.
+// Written from auth and moderation goroutines, read from network handlers.
+type AccountMutes struct {
+	mu    sync.RWMutex
+	until map[int64]time.Time
+}
+
+func NewAccountMutes() *AccountMutes {
+	return &AccountMutes{until: make(map[int64]time.Time)}
+}
+
+// Set stores mute expiry; a zero time removes the mute.
+func (m *AccountMutes) Set(accountID int64, until time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if until.IsZero() {
+		delete(m.until, accountID)
+		return
+	}
+	m.until[accountID] = until
+}
+
+// MutedUntil returns the mute expiry if the account is muted at now.
+func (m *AccountMutes) MutedUntil(accountID int64, now time.Time) (time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	until, ok := m.until[accountID]
+	if !ok || !until.After(now) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+type ModerationAction int
+
+const (
+	ModerationKick ModerationAction = iota
+	ModerationMute
+	ModerationBan
+	ModerationUnban
+)
+
+func (a ModerationAction) String() string {
+	switch a {
+	case ModerationKick:
+		return "kick"
+	case ModerationMute:
+		return "mute"
+	case ModerationBan:
+		return "ban"
+	case ModerationUnban:
+		return "unban"
+	default:
+		return "unknown"
+	}
+}
+
+// ModerationRequest is issued by /kick, /mute, /ban and /unban.
+// Target is a character name or numeric character id.
+type ModerationRequest struct {
+	Action    ModerationAction
+	ActorID   types.EntityID
+	ActorRole AccountRole
+	Target    string
+	// Duration of mute/ban. 0 = unmute for /mute, permanent for /ban.
+	Duration time.Duration
+	Reason   string
+}
+
+type ModerationResult struct {
+	TargetID types.EntityID
+	Message  string
+}
+
+// AdminModerationService executes moderation requests off the shard tick (they need DB access)
+// and reports the result through done from another goroutine.
+type AdminModerationService interface {
+	ExecuteModeration(req ModerationRequest, done func(ModerationResult, error))
+}
+
+// ExecuteModeration implements AdminModerationService.
+func (g *Game) ExecuteModeration(req ModerationRequest, done func(ModerationResult, error)) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		result, err := g.moderate(req)
+		if err != nil {
+			g.logger.Warn("Moderation failed",
+				zap.String("action", req.Action.String()),
+				zap.Int64("actor_id", int64(req.ActorID)),
+				zap.String("target", req.Target),
+				zap.Error(err))
+		} else {
+			g.logger.Info("Moderation applied",
+				zap.String("action", req.Action.String()),
+				zap.Int64("actor_id", int64(req.ActorID)),
+				zap.Int64("target_id", int64(result.TargetID)),
+				zap.Duration("duration", req.Duration),
+				zap.String("reason", req.Reason))
+		}
+		done(result, err)
+	}()
+}
+
+type moderationTarget struct {
+	id        types.EntityID
+	accountID int64
+	name      string
+}
+
+func (g *Game) moderate(req ModerationRequest) (ModerationResult, error) {
+	if g.db == nil {
+		return ModerationResult{}, fmt.Errorf("database unavailable")
+	}
+	ctx, cancel := context.WithTimeout(g.ctx, moderationTimeout)
+	defer cancel()
+
+	target, err := g.resolveModerationTarget(ctx, req.Target)
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	result := ModerationResult{TargetID: target.id}
+	if target.id == req.ActorID {
+		return result, fmt.Errorf("cannot %s yourself", req.Action)
+	}
+
+	account, err := g.db.Queries().GetAccountByID(ctx, target.accountID)
+	if err != nil {
+		return result, fmt.Errorf("load account: %w", err)
+	}
+	if targetRole := AccountRole(account.Role); targetRole >= req.ActorRole {
+		return result, fmt.Errorf("cannot %s a %s", req.Action, targetRole)
+	}
+
+	now := time.Now()
+	switch req.Action {
+	case ModerationKick:
+		if !g.kickPlayer(target.id, kickMessage("Kicked", req.Reason)) {
+			return result, fmt.Errorf("%s is offline", target.name)
+		}
+		result.Message = fmt.Sprintf("%s kicked", target.name)
+
+	case ModerationMute:
+		var until time.Time
+		if req.Duration > 0 {
+			until = now.Add(req.Duration)
+		}
+		if err := g.db.Queries().SetAccountMutedUntil(ctx, repository.SetAccountMutedUntilParams{
+			MutedUntil: sql.NullTime{Time: until, Valid: !until.IsZero()},
+			ID:         target.accountID,
+		}); err != nil {
+			return result, fmt.Errorf("update mute: %w", err)
+		}
+		g.mutes.Set(target.accountID, until)
+		if until.IsZero() {
+			result.Message = fmt.Sprintf("%s unmuted", target.name)
+			g.sendServerNotice(target.id, "You are no longer muted.")
+		} else {
+			result.Message = fmt.Sprintf("%s muted for %s", target.name, req.Duration)
+			g.sendServerNotice(target.id, fmt.Sprintf("You are muted for %s.", req.Duration))
+		}
+
+	case ModerationBan:
+		bannedUntil := sql.NullTime{}
+		if req.Duration > 0 {
+			bannedUntil = sql.NullTime{Time: now.Add(req.Duration), Valid: true}
+		}
+		if err := g.db.Queries().BanAccount(ctx, repository.BanAccountParams{
+			BannedUntil: bannedUntil,
+			BanReason:   sql.NullString{String: req.Reason, Valid: req.Reason != ""},
+			ID:          target.accountID,
+		}); err != nil {
+			return result, fmt.Errorf("update ban: %w", err)
+		}
+		g.kickPlayer(target.id, kickMessage("Banned", req.Reason))
+		if bannedUntil.Valid {
+			result.Message = fmt.Sprintf("%s banned for %s", target.name, req.Duration)
+		} else {
+			result.Message = fmt.Sprintf("%s banned permanently", target.name)
+		}
+
+	case ModerationUnban:
+		affected, err := g.db.Queries().UnbanAccount(ctx, target.accountID)
+		if err != nil {
+			return result, fmt.Errorf("update ban: %w", err)
+		}
+		if affected == 0 {
+			return result, fmt.Errorf("%s is not banned", target.name)
+		}
+		result.Message = fmt.Sprintf("%s unbanned", target.name)
+
+	default:
+		return result, fmt.Errorf("unknown moderation action")
+	}
+	return result, nil
+}
+
+func (g *Game) resolveModerationTarget(ctx context.Context, target string) (moderationTarget, error) {
+	if id, err := strconv.ParseInt(target, 10, 64); err == nil {
+		character, err := g.db.Queries().GetCharacter(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return moderationTarget{}, fmt.Errorf("unknown player: %s", target)
+			}
+			return moderationTarget{}, fmt.Errorf("load character: %w", err)
+		}
+		return moderationTarget{id: types.EntityID(character.ID), accountID: character.AccountID, name: character.Name}, nil
+	}
+
+	row, err := g.db.Queries().FindCharacterByName(ctx, target)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return moderationTarget{}, fmt.Errorf("unknown player: %s", target)
+		}
+		return moderationTarget{}, fmt.Errorf("load character: %w", err)
+	}
+	return moderationTarget{id: types.EntityID(row.ID), accountID: row.AccountID, name: row.Name}, nil
+}
+
+func kickMessage(prefix, reason string) string {
+	if reason == "" {
+		return prefix + " by moderator"
+	}
+	return prefix + " by moderator: " + reason
+}
+
+// kickPlayer tells the client why and closes its connection. Closing goes through the regular
+// disconnect handler, so the character enters detached mode and is saved like on any disconnect.
+func (g *Game) kickPlayer(playerID types.EntityID, reason string) bool {
+	client := g.findPlayerClient(playerID)
+	if client == nil {
+		return false
+	}
+	client.SendError(netproto.ErrorCode_ERROR_CODE_KICKED, reason)
+	time.AfterFunc(kickCloseDelay, client.Close)
+	return true
+}
+
+func (g *Game) findPlayerClient(playerID types.EntityID) *network.Client {
+	shard := g.shardManager.FindPlayerShard(playerID)
+	if shard == nil {
+		return nil
+	}
+	shard.ClientsMu.RLock()
+	defer shard.ClientsMu.RUnlock()
+	return shard.Clients[playerID]
+}
+
+func (g *Game) sendServerNotice(playerID types.EntityID, text string) {
+	if shard := g.shardManager.FindPlayerShard(playerID); shard != nil {
+		shard.SendChatMessage(playerID, netproto.ChatChannel_CHAT_CHANNEL_LOCAL, 0, announcementSenderName, text, 0)
+	}
+}
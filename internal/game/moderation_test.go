@@ -0,0 +1,176 @@
+package game
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"origin/internal/persistence/repository"
+	"origin/internal/types"
+)
+
+type syncModerationService struct {
+	requests []ModerationRequest
+	result   ModerationResult
+	err      error
+}
+
+func (s *syncModerationService) ExecuteModeration(req ModerationRequest, done func(ModerationResult, error)) {
+	s.requests = append(s.requests, req)
+	done(s.result, s.err)
+}
+
+func TestParseModerationDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30m": 30 * time.Minute,
+		"2h":  2 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+		"0":   0,
+	}
+	for input, want := range cases {
+		got, err := ParseModerationDuration(input)
+		if err != nil || got != want {
+			t.Fatalf("parse %q: got %v, %v; want %v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "abc", "-1h", "xd", "-2d"} {
+		if _, err := ParseModerationDuration(input); err == nil {
+			t.Fatalf("parse %q: expected error", input)
+		}
+	}
+}
+
+func TestAccountBanMessage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	active := repository.Account{Status: sql.NullInt16{Int16: AccountStatusActive, Valid: true}}
+	if banned, _ := AccountBanMessage(active, now); banned {
+		t.Fatal("active account must not be banned")
+	}
+
+	permanent := repository.Account{
+		Status:    sql.NullInt16{Int16: AccountStatusBanned, Valid: true},
+		BanReason: sql.NullString{String: "cheating", Valid: true},
+	}
+	banned, msg := AccountBanMessage(permanent, now)
+	if !banned || msg != "Account banned: cheating" {
+		t.Fatalf("unexpected permanent ban result: %v %q", banned, msg)
+	}
+
+	temporary := permanent
+	temporary.BannedUntil = sql.NullTime{Time: now.Add(time.Hour), Valid: true}
+	banned, msg = AccountBanMessage(temporary, now)
+	if !banned || !strings.Contains(msg, "until 2026-01-01T13:00:00Z") {
+		t.Fatalf("unexpected temporary ban result: %v %q", banned, msg)
+	}
+
+	if banned, _ := AccountBanMessage(temporary, now.Add(2*time.Hour)); banned {
+		t.Fatal("expired ban must not refuse login")
+	}
+}
+
+func TestAccountMutes_Expire(t *testing.T) {
+	mutes := NewAccountMutes()
+	now := time.Now()
+	mutes.Set(1, now.Add(time.Minute))
+
+	if _, muted := mutes.MutedUntil(1, now); !muted {
+		t.Fatal("expected account to be muted")
+	}
+	if _, muted := mutes.MutedUntil(1, now.Add(2*time.Minute)); muted {
+		t.Fatal("mute must expire")
+	}
+	mutes.Set(1, time.Time{})
+	if _, muted := mutes.MutedUntil(1, now); muted {
+		t.Fatal("zero time must lift the mute")
+	}
+}
+
+func TestHandleCommand_BanRequestsModerationAndAudits(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, mockChat := newPermissionTestHandler(t, roles, audit)
+	service := &syncModerationService{result: ModerationResult{TargetID: 42, Message: "bob banned for 168h0m0s"}}
+	handler.SetModerationService(service)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleGamemaster)
+
+	if handled := handler.HandleCommand(world, playerID, types.InvalidHandle, "/ban bob 7d spam and abuse"); !handled {
+		t.Fatal("expected /ban to be handled")
+	}
+	if len(service.requests) != 1 {
+		t.Fatalf("expected 1 moderation request, got %d", len(service.requests))
+	}
+	req := service.requests[0]
+	if req.Action != ModerationBan || req.Target != "bob" || req.Duration != 7*24*time.Hour ||
+		req.Reason != "spam and abuse" || req.ActorRole != RoleGamemaster {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if mockChat.messages[playerID] != "bob banned for 168h0m0s" {
+		t.Fatalf("unexpected reply: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(audit.entries))
+	}
+	entry := audit.entries[0]
+	if entry.Outcome != AdminAuditSuccess || entry.TargetID != 42 || entry.Command != "/ban" || entry.At.IsZero() {
+		t.Fatalf("unexpected audit entry: %+v", entry)
+	}
+}
+
+func TestHandleCommand_FailedModerationIsAudited(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, mockChat := newPermissionTestHandler(t, roles, audit)
+	handler.SetModerationService(&syncModerationService{err: errors.New("bob is offline")})
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleModerator)
+
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/kick bob")
+	if mockChat.messages[playerID] != "kick failed: bob is offline" {
+		t.Fatalf("unexpected reply: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Outcome != AdminAuditFailed {
+		t.Fatalf("expected one failed audit entry, got %+v", audit.entries)
+	}
+}
+
+func TestHandleCommand_ModeratorCannotBan(t *testing.T) {
+	roles := NewPlayerRoles()
+	handler, world, _ := newPermissionTestHandler(t, roles, &recordingAdminAudit{})
+	service := &syncModerationService{}
+	handler.SetModerationService(service)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleModerator)
+
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/ban bob perm griefing")
+	if len(service.requests) != 0 {
+		t.Fatal("moderator must not be able to ban")
+	}
+}
+
+func TestHandleCommand_MuteRejectsInvalidDuration(t *testing.T) {
+	roles := NewPlayerRoles()
+	audit := &recordingAdminAudit{}
+	handler, world, mockChat := newPermissionTestHandler(t, roles, audit)
+	service := &syncModerationService{}
+	handler.SetModerationService(service)
+
+	playerID := types.EntityID(10)
+	roles.Set(playerID, 1, RoleModerator)
+
+	handler.HandleCommand(world, playerID, types.InvalidHandle, "/mute bob forever")
+	if len(service.requests) != 0 {
+		t.Fatal("invalid duration must not reach the moderation service")
+	}
+	if mockChat.messages[playerID] != "invalid duration: forever" {
+		t.Fatalf("unexpected reply: %q", mockChat.messages[playerID])
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Outcome != AdminAuditFailed {
+		t.Fatalf("expected one failed audit entry, got %+v", audit.entries)
+	}
+}
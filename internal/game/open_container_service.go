@@ -401,6 +401,16 @@ func (s *OpenContainerService) openRootForPlayer(
 	if err := s.openAnyRefForPlayer(w, playerID, constt.InventoryGrid, rootOwnerID, 0, true); err != nil {
 		return err
 	}
+	// Machines keep extra grids next to the root (e.g. kiln output and fuel); they open together.
+	for _, link := range s.objectExtraGridLinks(w, rootOwnerID) {
+		if err := s.openAnyRefForPlayer(w, playerID, link.Kind, rootOwnerID, link.Key, true); err != nil {
+			s.logger.Warn("failed to open extra object grid",
+				zap.Uint64("owner_id", uint64(rootOwnerID)),
+				zap.Uint32("key", link.Key),
+				zap.String("message", err.Message),
+			)
+		}
+	}
 
 	openState.SetRootOpened(playerID, rootOwnerID)
 	s.markRootObjectBehaviorDirty(w, rootOwnerID)
@@ -424,6 +434,7 @@ func (s *OpenContainerService) openAnyRefForPlayer(
 		}
 	}
 
+	inventory.SettleContainerSpoilage(w, containerHandle)
 	container, hasContainer := ecs.GetComponent[components.InventoryContainer](w, containerHandle)
 	if !hasContainer {
 		return &systems.OpenContainerError{
@@ -446,6 +457,63 @@ func (s *OpenContainerService) openAnyRefForPlayer(
 	return nil
 }
 
+// objectExtraGridLinks returns object-owned grid inventories other than the root (key 0).
+func (s *OpenContainerService) objectExtraGridLinks(w *ecs.World, ownerID types.EntityID) []components.InventoryLink {
+	handle := w.GetHandleByEntityID(ownerID)
+	if handle == types.InvalidHandle || !w.Alive(handle) {
+		return nil
+	}
+	owner, hasOwner := ecs.GetComponent[components.InventoryOwner](w, handle)
+	if !hasOwner {
+		return nil
+	}
+	var links []components.InventoryLink
+	for _, link := range owner.Inventories {
+		if link.Kind != constt.InventoryGrid || link.Key == 0 || link.OwnerID != ownerID {
+			continue
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// SyncInventories sends the current state of changed containers to every player
+// who has them open. Used when containers change without a player move (machine ticks).
+func (s *OpenContainerService) SyncInventories(w *ecs.World, containerHandles []types.Handle) {
+	if w != s.world || len(containerHandles) == 0 || s.sender == nil {
+		return
+	}
+
+	openState := ecs.GetResource[ecs.OpenContainerState](w)
+	perPlayer := make(map[types.EntityID][]*netproto.InventoryState, 4)
+	for _, containerHandle := range containerHandles {
+		if containerHandle == types.InvalidHandle || !w.Alive(containerHandle) {
+			continue
+		}
+		inventory.SettleContainerSpoilage(w, containerHandle)
+		container, hasContainer := ecs.GetComponent[components.InventoryContainer](w, containerHandle)
+		if !hasContainer {
+			continue
+		}
+		players := openState.PlayersOpenedRef(ecs.InventoryRefKey{
+			Kind:    container.Kind,
+			OwnerID: container.OwnerID,
+			Key:     container.Key,
+		})
+		if len(players) == 0 {
+			continue
+		}
+		state := buildInventoryStateFromContainer(w, container)
+		for playerID := range players {
+			perPlayer[playerID] = append(perPlayer[playerID], state)
+		}
+	}
+
+	for playerID, states := range perPlayer {
+		s.sender.SendInventoryUpdate(playerID, states)
+	}
+}
+
 func (s *OpenContainerService) isContainerObjectOwner(w *ecs.World, ownerID types.EntityID) bool {
 	targetHandle := w.GetHandleByEntityID(ownerID)
 	if targetHandle == types.InvalidHandle || !w.Alive(targetHandle) {
@@ -548,12 +616,15 @@ func buildInventoryStateFromContainer(w *ecs.World, container components.Invento
 				Resource: item.Resource,
 				Quality:  item.Quality,
 				Quantity: item.Quantity,
+				Wear:     item.Wear,
 				W:        uint32(item.W),
 				H:        uint32(item.H),
 			}
 			// Set name from item definition
 			if def, ok := itemdefs.Global().GetByID(int(item.TypeID)); ok {
 				itemProto.Name = def.Name
+				itemProto.MaxDurability = def.MaxDurability(item.Quality)
+				itemProto.HintExt = systems.ItemHintExt(def, item.Spoil)
 			}
 			if _, hasNested := refIndex.Lookup(constt.InventoryGrid, item.ItemID, 0); hasNested {
 				itemProto.NestedRef = &netproto.InventoryRef{
@@ -585,12 +656,15 @@ func buildInventoryStateFromContainer(w *ecs.World, container components.Invento
 				Resource: item.Resource,
 				Quality:  item.Quality,
 				Quantity: item.Quantity,
+				Wear:     item.Wear,
 				W:        uint32(item.W),
 				H:        uint32(item.H),
 			}
 			// Set name from item definition
 			if def, ok := itemdefs.Global().GetByID(int(item.TypeID)); ok {
 				handState.Item.Name = def.Name
+				handState.Item.MaxDurability = def.MaxDurability(item.Quality)
+				handState.Item.HintExt = systems.ItemHintExt(def, item.Spoil)
 			}
 			handState.HandPos = &netproto.HandPos{
 				MouseOffsetX: int32(container.HandMouseOffsetX),
@@ -609,6 +683,7 @@ func buildInventoryStateFromContainer(w *ecs.World, container components.Invento
 					Resource: item.Resource,
 					Quality:  item.Quality,
 					Quantity: item.Quantity,
+					Wear:     item.Wear,
 					W:        uint32(item.W),
 					H:        uint32(item.H),
 				},
@@ -616,6 +691,8 @@ func buildInventoryStateFromContainer(w *ecs.World, container components.Invento
 			// Set name from item definition
 			if def, ok := itemdefs.Global().GetByID(int(item.TypeID)); ok {
 				items[len(items)-1].Item.Name = def.Name
+				items[len(items)-1].Item.MaxDurability = def.MaxDurability(item.Quality)
+				items[len(items)-1].Item.HintExt = systems.ItemHintExt(def, item.Spoil)
 			}
 		}
 		invState.State = &netproto.InventoryState_Equipment{
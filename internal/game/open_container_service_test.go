@@ -12,7 +12,8 @@ import (
 )
 
 type testContainerSender struct {
-	closed []closedEvent
+	closed  []closedEvent
+	updated map[types.EntityID]int
 }
 
 type closedEvent struct {
@@ -23,6 +24,10 @@ type closedEvent struct {
 func (s *testContainerSender) SendContainerOpened(entityID types.EntityID, state *netproto.InventoryState) {
 }
 func (s *testContainerSender) SendInventoryUpdate(entityID types.EntityID, states []*netproto.InventoryState) {
+	if s.updated == nil {
+		s.updated = make(map[types.EntityID]int)
+	}
+	s.updated[entityID] += len(states)
 }
 func (s *testContainerSender) SendContainerClosed(entityID types.EntityID, ref *netproto.InventoryRef) {
 	s.closed = append(s.closed, closedEvent{playerID: entityID, ref: ref})
@@ -129,6 +134,56 @@ func TestOpenContainerService_OnLinkBroken_MarksRootBehaviorDirty(t *testing.T)
 	}
 }
 
+func TestOpenContainerService_HandleOpenRequest_OpensExtraObjectGridsAndSyncs(t *testing.T) {
+	w := ecs.NewWorldForTesting()
+	sender := &testContainerSender{}
+	service := NewOpenContainerService(w, nil, sender, nil)
+	setObjectDefsForContainerTests()
+
+	objectID := types.EntityID(2001)
+	objectHandle := spawnContainerObjectForTest(w, objectID)
+	rootHandle, _ := ecs.GetResource[ecs.InventoryRefIndex](w).Lookup(constt.InventoryGrid, objectID, 0)
+	outputHandle := w.SpawnWithoutExternalID()
+	ecs.AddComponent(w, outputHandle, components.InventoryContainer{
+		OwnerID: objectID,
+		Kind:    constt.InventoryGrid,
+		Key:     1,
+		Width:   2,
+		Height:  2,
+	})
+	ecs.GetResource[ecs.InventoryRefIndex](w).Add(constt.InventoryGrid, objectID, 1, outputHandle)
+	ecs.AddComponent(w, objectHandle, components.InventoryOwner{Inventories: []components.InventoryLink{
+		{Kind: constt.InventoryGrid, Key: 0, OwnerID: objectID, Handle: rootHandle},
+		{Kind: constt.InventoryGrid, Key: 1, OwnerID: objectID, Handle: outputHandle},
+	}})
+	playerID := types.EntityID(1001)
+	playerHandle := w.Spawn(playerID, nil)
+	ecs.GetResource[ecs.LinkState](w).SetLink(ecs.PlayerLink{
+		PlayerID:     playerID,
+		PlayerHandle: playerHandle,
+		TargetID:     objectID,
+		TargetHandle: objectHandle,
+	})
+
+	openErr := service.HandleOpenRequest(w, playerID, playerHandle, &netproto.InventoryRef{
+		Kind:         netproto.InventoryKind_INVENTORY_KIND_GRID,
+		OwnerId:      uint64(objectID),
+		InventoryKey: 0,
+	})
+	if openErr != nil {
+		t.Fatalf("expected open request to succeed, got error: %+v", openErr)
+	}
+	outputKey := ecs.InventoryRefKey{Kind: constt.InventoryGrid, OwnerID: objectID, Key: 1}
+	if !ecs.GetResource[ecs.OpenContainerState](w).IsRefOpened(playerID, outputKey) {
+		t.Fatalf("expected extra grid to open together with the root")
+	}
+
+	service.SyncInventories(w, []types.Handle{outputHandle})
+	if sender.updated[playerID] != 1 {
+		t.Fatalf("expected one synced inventory for player, got %d", sender.updated[playerID])
+	}
+}
+
 func spawnContainerObjectForTest(w *ecs.World, objectID types.EntityID) types.Handle {
 	objectHandle := w.Spawn(objectID, func(w *ecs.World, h types.Handle) {
 		ecs.AddComponent(w, h, components.EntityInfo{
@@ -0,0 +1,376 @@
+package game
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+)
+
+const (
+	partyMaxMembers = 8
+	partyInviteTTL  = 60 * time.Second
+)
+
+var (
+	ErrPartyInvalidTarget = errors.New("invalid party target")
+	ErrPartyAlreadyMember = errors.New("player is already in a party")
+	ErrPartyNotLeader     = errors.New("only the party leader can do that")
+	ErrPartyFull          = errors.New("party is full")
+	ErrPartyNoInvite      = errors.New("no pending party invite")
+	ErrPartyNotMember     = errors.New("not in a party")
+)
+
+// PartyMemberSnapshot is the last known roster info of a member, refreshed by PartySyncSystem
+// on whatever shard the member currently lives.
+type PartyMemberSnapshot struct {
+	EntityID types.EntityID
+	Name     string
+	Online   bool
+	Layer    int
+	X, Y     int
+	SHP      uint32
+	HHP      uint32
+	MHP      uint32
+}
+
+// PartyRoster is an immutable copy of a party for delivery.
+type PartyRoster struct {
+	ID       uint64
+	LeaderID types.EntityID
+	Members  []PartyMemberSnapshot
+}
+
+// PartyUpdates is what must be pushed to clients after membership/snapshot changes.
+type PartyUpdates struct {
+	Rosters []PartyRoster
+	// Left lists players that are no longer in a party and must receive an empty state.
+	Left []types.EntityID
+}
+
+type party struct {
+	id       uint64
+	leaderID types.EntityID
+	members  []types.EntityID
+}
+
+type partyInvite struct {
+	inviterID types.EntityID
+	expiresAt time.Time
+}
+
+// PartyManager owns party membership for all shards. Membership is keyed by character id only,
+// so it is unaffected by shard transfers and detached-mode reconnects.
+type PartyManager struct {
+	mu sync.Mutex
+
+	nextID    uint64
+	parties   map[uint64]*party
+	memberOf  map[types.EntityID]uint64
+	invites   map[types.EntityID]partyInvite // invitee -> invite
+	snapshots map[types.EntityID]PartyMemberSnapshot
+
+	dirty map[uint64]struct{}
+	left  map[types.EntityID]struct{}
+}
+
+func NewPartyManager() *PartyManager {
+	return &PartyManager{
+		parties:   make(map[uint64]*party),
+		memberOf:  make(map[types.EntityID]uint64),
+		invites:   make(map[types.EntityID]partyInvite),
+		snapshots: make(map[types.EntityID]PartyMemberSnapshot),
+		dirty:     make(map[uint64]struct{}),
+		left:      make(map[types.EntityID]struct{}),
+	}
+}
+
+// Invite records a pending invite. The inviter must be solo or the leader of a non-full party.
+func (m *PartyManager) Invite(inviterID, inviteeID types.EntityID, now time.Time) error {
+	if inviterID == 0 || inviteeID == 0 || inviterID == inviteeID {
+		return ErrPartyInvalidTarget
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, inParty := m.memberOf[inviteeID]; inParty {
+		return ErrPartyAlreadyMember
+	}
+	if partyID, inParty := m.memberOf[inviterID]; inParty {
+		p := m.parties[partyID]
+		if p.leaderID != inviterID {
+			return ErrPartyNotLeader
+		}
+		if len(p.members) >= partyMaxMembers {
+			return ErrPartyFull
+		}
+	}
+
+	m.invites[inviteeID] = partyInvite{inviterID: inviterID, expiresAt: now.Add(partyInviteTTL)}
+	return nil
+}
+
+// Accept joins the inviter's party, creating it with the inviter as leader when needed.
+func (m *PartyManager) Accept(inviteeID, inviterID types.EntityID, now time.Time) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	invite, ok := m.invites[inviteeID]
+	if !ok || invite.inviterID != inviterID || now.After(invite.expiresAt) {
+		delete(m.invites, inviteeID)
+		return 0, ErrPartyNoInvite
+	}
+	delete(m.invites, inviteeID)
+
+	if _, inParty := m.memberOf[inviteeID]; inParty {
+		return 0, ErrPartyAlreadyMember
+	}
+
+	partyID, inParty := m.memberOf[inviterID]
+	if !inParty {
+		m.nextID++
+		partyID = m.nextID
+		m.parties[partyID] = &party{
+			id:       partyID,
+			leaderID: inviterID,
+			members:  []types.EntityID{inviterID},
+		}
+		m.memberOf[inviterID] = partyID
+		delete(m.left, inviterID)
+	}
+
+	p := m.parties[partyID]
+	if len(p.members) >= partyMaxMembers {
+		return 0, ErrPartyFull
+	}
+	p.members = append(p.members, inviteeID)
+	m.memberOf[inviteeID] = partyID
+	delete(m.left, inviteeID)
+	m.dirty[partyID] = struct{}{}
+	return partyID, nil
+}
+
+// Decline drops a pending invite from the given inviter.
+func (m *PartyManager) Decline(inviteeID, inviterID types.EntityID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	invite, ok := m.invites[inviteeID]
+	if !ok || invite.inviterID != inviterID {
+		return ErrPartyNoInvite
+	}
+	delete(m.invites, inviteeID)
+	return nil
+}
+
+// Leave removes the member from its party. A leaving leader hands leadership to the next member;
+// a party left with a single member is disbanded.
+func (m *PartyManager) Leave(memberID types.EntityID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, inParty := m.memberOf[memberID]; !inParty {
+		return ErrPartyNotMember
+	}
+	m.removeLocked(memberID)
+	return nil
+}
+
+// Kick removes targetID from the leader's party.
+func (m *PartyManager) Kick(leaderID, targetID types.EntityID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partyID, inParty := m.memberOf[leaderID]
+	if !inParty {
+		return ErrPartyNotMember
+	}
+	if m.parties[partyID].leaderID != leaderID {
+		return ErrPartyNotLeader
+	}
+	if targetID == leaderID || m.memberOf[targetID] != partyID {
+		return ErrPartyInvalidTarget
+	}
+	m.removeLocked(targetID)
+	return nil
+}
+
+// RemovePlayer drops all party state of a player (permanent death, logout after detach expiry).
+func (m *PartyManager) RemovePlayer(playerID types.EntityID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, inParty := m.memberOf[playerID]; inParty {
+		m.removeLocked(playerID)
+	}
+	delete(m.invites, playerID)
+	for inviteeID, invite := range m.invites {
+		if invite.inviterID == playerID {
+			delete(m.invites, inviteeID)
+		}
+	}
+	delete(m.snapshots, playerID)
+}
+
+func (m *PartyManager) removeLocked(memberID types.EntityID) {
+	partyID := m.memberOf[memberID]
+	p := m.parties[partyID]
+	delete(m.memberOf, memberID)
+	delete(m.snapshots, memberID)
+	m.left[memberID] = struct{}{}
+
+	for i, id := range p.members {
+		if id == memberID {
+			p.members = append(p.members[:i], p.members[i+1:]...)
+			break
+		}
+	}
+
+	if len(p.members) <= 1 {
+		for _, id := range p.members {
+			delete(m.memberOf, id)
+			delete(m.snapshots, id)
+			m.left[id] = struct{}{}
+		}
+		delete(m.parties, partyID)
+		delete(m.dirty, partyID)
+		return
+	}
+	if p.leaderID == memberID {
+		p.leaderID = p.members[0]
+	}
+	m.dirty[partyID] = struct{}{}
+}
+
+// IsMember reports whether the player is in any party.
+func (m *PartyManager) IsMember(playerID types.EntityID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.memberOf[playerID]
+	return ok
+}
+
+// Members returns a copy of the member ids of the player's party, or nil.
+func (m *PartyManager) Members(playerID types.EntityID) []types.EntityID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partyID, ok := m.memberOf[playerID]
+	if !ok {
+		return nil
+	}
+	members := m.parties[partyID].members
+	out := make([]types.EntityID, len(members))
+	copy(out, members)
+	return out
+}
+
+// UpdateSnapshot stores member roster info and marks the party dirty when it changed.
+func (m *PartyManager) UpdateSnapshot(snapshot PartyMemberSnapshot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partyID, ok := m.memberOf[snapshot.EntityID]
+	if !ok {
+		return
+	}
+	if prev, had := m.snapshots[snapshot.EntityID]; had && prev == snapshot {
+		return
+	}
+	m.snapshots[snapshot.EntityID] = snapshot
+	m.dirty[partyID] = struct{}{}
+}
+
+// MarkOffline flags a member as disconnected (detached) without removing it from the party.
+func (m *PartyManager) MarkOffline(playerID types.EntityID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partyID, ok := m.memberOf[playerID]
+	if !ok {
+		return
+	}
+	snapshot, had := m.snapshots[playerID]
+	if had && !snapshot.Online {
+		return
+	}
+	snapshot.EntityID = playerID
+	snapshot.Online = false
+	m.snapshots[playerID] = snapshot
+	m.dirty[partyID] = struct{}{}
+}
+
+// Touch forces the player's party roster to be re-sent (e.g. after the client re-attached).
+func (m *PartyManager) Touch(playerID types.EntityID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if partyID, ok := m.memberOf[playerID]; ok {
+		m.dirty[partyID] = struct{}{}
+	}
+}
+
+// TakeUpdates returns and clears pending roster changes.
+func (m *PartyManager) TakeUpdates() PartyUpdates {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.dirty) == 0 && len(m.left) == 0 {
+		return PartyUpdates{}
+	}
+
+	updates := PartyUpdates{
+		Rosters: make([]PartyRoster, 0, len(m.dirty)),
+		Left:    make([]types.EntityID, 0, len(m.left)),
+	}
+	for partyID := range m.dirty {
+		if p, ok := m.parties[partyID]; ok {
+			updates.Rosters = append(updates.Rosters, m.rosterLocked(p))
+		}
+		delete(m.dirty, partyID)
+	}
+	for id := range m.left {
+		updates.Left = append(updates.Left, id)
+		delete(m.left, id)
+	}
+	return updates
+}
+
+func (m *PartyManager) rosterLocked(p *party) PartyRoster {
+	roster := PartyRoster{
+		ID:       p.id,
+		LeaderID: p.leaderID,
+		Members:  make([]PartyMemberSnapshot, 0, len(p.members)),
+	}
+	for _, id := range p.members {
+		snapshot, ok := m.snapshots[id]
+		if !ok {
+			snapshot = PartyMemberSnapshot{EntityID: id}
+		}
+		roster.Members = append(roster.Members, snapshot)
+	}
+	return roster
+}
+
+func partyStateProto(roster PartyRoster) *netproto.S2C_PartyState {
+	state := &netproto.S2C_PartyState{
+		PartyId:  roster.ID,
+		LeaderId: uint64(roster.LeaderID),
+		Members:  make([]*netproto.PartyMember, 0, len(roster.Members)),
+	}
+	for _, member := range roster.Members {
+		state.Members = append(state.Members, &netproto.PartyMember{
+			EntityId: uint64(member.EntityID),
+			Name:     member.Name,
+			Online:   member.Online,
+			Layer:    int32(member.Layer),
+			Position: &netproto.Vector2{X: int32(member.X), Y: int32(member.Y)},
+			Shp:      member.SHP,
+			Hhp:      member.HHP,
+			Mhp:      member.MHP,
+		})
+	}
+	return state
+}
@@ -0,0 +1,110 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/network"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+)
+
+func (g *Game) handlePartyCommand(c *network.Client, sequence uint32, cmd *netproto.C2S_PartyCommand) {
+	if c.CharacterID == 0 {
+		g.logger.Warn("Party command from unauthenticated client", zap.Uint64("client_id", c.ID))
+		c.SendError(netproto.ErrorCode_ERROR_CODE_NOT_AUTHENTICATED, "Not authenticated")
+		return
+	}
+
+	parties := g.shardManager.Parties()
+	targetID := types.EntityID(cmd.GetTargetEntityId())
+	now := time.Now()
+
+	var err error
+	switch cmd.GetAction() {
+	case netproto.PartyAction_PARTY_ACTION_INVITE:
+		targetShard := g.shardManager.FindPlayerShard(targetID)
+		if targetID == 0 || targetID == c.CharacterID || targetShard == nil {
+			c.SendError(netproto.ErrorCode_ERROR_CODE_TARGET_INVALID, "Player offline")
+			return
+		}
+		if err = parties.Invite(c.CharacterID, targetID, now); err == nil {
+			targetShard.SendPartyInvite(targetID, &netproto.S2C_PartyInvite{
+				FromEntityId: uint64(c.CharacterID),
+				FromName:     g.shardManager.playerName(c.CharacterID),
+				ExpiresInMs:  uint32(partyInviteTTL.Milliseconds()),
+			})
+		}
+	case netproto.PartyAction_PARTY_ACTION_ACCEPT:
+		_, err = parties.Accept(c.CharacterID, targetID, now)
+	case netproto.PartyAction_PARTY_ACTION_DECLINE:
+		err = parties.Decline(c.CharacterID, targetID)
+	case netproto.PartyAction_PARTY_ACTION_LEAVE:
+		err = parties.Leave(c.CharacterID)
+	case netproto.PartyAction_PARTY_ACTION_KICK:
+		err = parties.Kick(c.CharacterID, targetID)
+	default:
+		c.SendError(netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST, "Unknown party action")
+		return
+	}
+
+	if err != nil {
+		g.logger.Debug("Party command rejected",
+			zap.Int64("character_id", int64(c.CharacterID)),
+			zap.String("action", cmd.GetAction().String()),
+			zap.Int64("target_id", int64(targetID)),
+			zap.Error(err))
+		c.SendError(partyErrorCode(err), partyErrorMessage(err))
+	}
+}
+
+func partyErrorCode(err error) netproto.ErrorCode {
+	switch {
+	case errors.Is(err, ErrPartyInvalidTarget), errors.Is(err, ErrPartyAlreadyMember):
+		return netproto.ErrorCode_ERROR_CODE_TARGET_INVALID
+	default:
+		return netproto.ErrorCode_ERROR_CODE_INVALID_REQUEST
+	}
+}
+
+func partyErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrPartyInvalidTarget):
+		return "Invalid target"
+	case errors.Is(err, ErrPartyAlreadyMember):
+		return "Player is already in a party"
+	case errors.Is(err, ErrPartyNotLeader):
+		return "Only the party leader can do that"
+	case errors.Is(err, ErrPartyFull):
+		return "Party is full"
+	case errors.Is(err, ErrPartyNoInvite):
+		return "No pending party invite"
+	case errors.Is(err, ErrPartyNotMember):
+		return "Not in a party"
+	default:
+		return "Party command failed"
+	}
+}
+
+// playerName resolves a connected player's display name from its shard world.
+func (sm *ShardManager) playerName(entityID types.EntityID) string {
+	shard := sm.FindPlayerShard(entityID)
+	if shard == nil {
+		return ""
+	}
+	var name string
+	shard.WithWorldRead(func(w *ecs.World) {
+		handle := w.GetHandleByEntityID(entityID)
+		if handle == types.InvalidHandle {
+			return
+		}
+		if appearance, ok := ecs.GetComponent[components.Appearance](w, handle); ok && appearance.Name != nil {
+			name = *appearance.Name
+		}
+	})
+	return name
+}
@@ -0,0 +1,80 @@
+package game
+
+import (
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/entitystats"
+	"origin/internal/types"
+)
+
+const PartySyncSystemPriority = 495
+
+// partySyncIntervalTicks controls how often member positions/health are refreshed (1s at 10 TPS).
+const partySyncIntervalTicks = 10
+
+type partyClientLookup interface {
+	HasClient(entityID types.EntityID) bool
+}
+
+// PartySyncSystem publishes roster snapshots (name, position, health) of party members
+// living on this shard into the shared PartyManager.
+type PartySyncSystem struct {
+	ecs.BaseSystem
+	parties         *PartyManager
+	clients         partyClientLookup
+	layer           int
+	lifeDeathFactor float64
+}
+
+func NewPartySyncSystem(parties *PartyManager, clients partyClientLookup, layer int, lifeDeathFactor float64) *PartySyncSystem {
+	return &PartySyncSystem{
+		BaseSystem:      ecs.NewBaseSystem("PartySyncSystem", PartySyncSystemPriority),
+		parties:         parties,
+		clients:         clients,
+		layer:           layer,
+		lifeDeathFactor: lifeDeathFactor,
+	}
+}
+
+func (s *PartySyncSystem) Update(w *ecs.World, dt float64) {
+	_ = dt
+	if s.parties == nil {
+		return
+	}
+	if ecs.GetResource[ecs.TimeState](w).Tick%partySyncIntervalTicks != 0 {
+		return
+	}
+
+	characters := ecs.GetResource[ecs.CharacterEntities](w)
+	for entityID, tracked := range characters.Map {
+		if !s.parties.IsMember(entityID) {
+			continue
+		}
+		handle := tracked.Handle
+		if handle == types.InvalidHandle || !w.Alive(handle) {
+			continue
+		}
+		s.parties.UpdateSnapshot(s.buildSnapshot(w, entityID, handle))
+	}
+}
+
+func (s *PartySyncSystem) buildSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle) PartyMemberSnapshot {
+	snapshot := PartyMemberSnapshot{
+		EntityID: entityID,
+		Layer:    s.layer,
+		Online:   s.clients == nil || s.clients.HasClient(entityID),
+	}
+	if appearance, ok := ecs.GetComponent[components.Appearance](w, handle); ok && appearance.Name != nil {
+		snapshot.Name = *appearance.Name
+	}
+	if transform, ok := ecs.GetComponent[components.Transform](w, handle); ok {
+		snapshot.X = int(transform.X)
+		snapshot.Y = int(transform.Y)
+	}
+	if health, ok := ecs.GetComponent[components.EntityHealth](w, handle); ok {
+		snapshot.SHP = entitystats.RoundToUint32(health.SHP)
+		snapshot.HHP = entitystats.RoundToUint32(health.HHP)
+		snapshot.MHP = entitystats.RoundToUint32(resolveMaxHHPForHandle(w, handle, s.lifeDeathFactor))
+	}
+	return snapshot
+}
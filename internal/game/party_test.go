@@ -0,0 +1,226 @@
+package game
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"origin/internal/types"
+)
+
+func formParty(t *testing.T, m *PartyManager, leader types.EntityID, members ...types.EntityID) uint64 {
+	t.Helper()
+	now := time.Now()
+	var partyID uint64
+	for _, member := range members {
+		if err := m.Invite(leader, member, now); err != nil {
+			t.Fatalf("invite %d: %v", member, err)
+		}
+		id, err := m.Accept(member, leader, now)
+		if err != nil {
+			t.Fatalf("accept %d: %v", member, err)
+		}
+		partyID = id
+	}
+	return partyID
+}
+
+func TestPartyManager_InviteAcceptCreatesPartyWithLeader(t *testing.T) {
+	m := NewPartyManager()
+	partyID := formParty(t, m, 1, 2)
+	if partyID == 0 {
+		t.Fatal("expected non-zero party id")
+	}
+
+	updates := m.TakeUpdates()
+	if len(updates.Rosters) != 1 {
+		t.Fatalf("expected 1 roster update, got %d", len(updates.Rosters))
+	}
+	roster := updates.Rosters[0]
+	if roster.LeaderID != 1 || len(roster.Members) != 2 {
+		t.Fatalf("unexpected roster: %+v", roster)
+	}
+	if len(m.TakeUpdates().Rosters) != 0 {
+		t.Fatal("updates must be cleared after TakeUpdates")
+	}
+}
+
+func TestPartyManager_InviteValidation(t *testing.T) {
+	m := NewPartyManager()
+	now := time.Now()
+
+	if err := m.Invite(1, 1, now); !errors.Is(err, ErrPartyInvalidTarget) {
+		t.Fatalf("self invite: expected ErrPartyInvalidTarget, got %v", err)
+	}
+
+	formParty(t, m, 1, 2)
+	if err := m.Invite(2, 3, now); !errors.Is(err, ErrPartyNotLeader) {
+		t.Fatalf("member invite: expected ErrPartyNotLeader, got %v", err)
+	}
+	if err := m.Invite(3, 2, now); !errors.Is(err, ErrPartyAlreadyMember) {
+		t.Fatalf("invite member of other party: expected ErrPartyAlreadyMember, got %v", err)
+	}
+}
+
+func TestPartyManager_PartyFull(t *testing.T) {
+	m := NewPartyManager()
+	members := make([]types.EntityID, 0, partyMaxMembers-1)
+	for i := 2; i <= partyMaxMembers; i++ {
+		members = append(members, types.EntityID(i))
+	}
+	formParty(t, m, 1, members...)
+
+	if err := m.Invite(1, 100, time.Now()); !errors.Is(err, ErrPartyFull) {
+		t.Fatalf("expected ErrPartyFull, got %v", err)
+	}
+}
+
+func TestPartyManager_InviteExpires(t *testing.T) {
+	m := NewPartyManager()
+	now := time.Now()
+	if err := m.Invite(1, 2, now); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	if _, err := m.Accept(2, 1, now.Add(partyInviteTTL+time.Second)); !errors.Is(err, ErrPartyNoInvite) {
+		t.Fatalf("expected ErrPartyNoInvite for expired invite, got %v", err)
+	}
+	if m.IsMember(1) || m.IsMember(2) {
+		t.Fatal("expired invite must not create a party")
+	}
+}
+
+func TestPartyManager_DeclineDropsInvite(t *testing.T) {
+	m := NewPartyManager()
+	now := time.Now()
+	if err := m.Invite(1, 2, now); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	if err := m.Decline(2, 1); err != nil {
+		t.Fatalf("decline: %v", err)
+	}
+	if _, err := m.Accept(2, 1, now); !errors.Is(err, ErrPartyNoInvite) {
+		t.Fatalf("expected ErrPartyNoInvite after decline, got %v", err)
+	}
+}
+
+func TestPartyManager_LeaderLeavePromotesNextMember(t *testing.T) {
+	m := NewPartyManager()
+	formParty(t, m, 1, 2, 3)
+	m.TakeUpdates()
+
+	if err := m.Leave(1); err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+	updates := m.TakeUpdates()
+	if len(updates.Rosters) != 1 || updates.Rosters[0].LeaderID != 2 {
+		t.Fatalf("expected leadership passed to 2, got %+v", updates.Rosters)
+	}
+	if len(updates.Left) != 1 || updates.Left[0] != 1 {
+		t.Fatalf("expected leaver in Left, got %v", updates.Left)
+	}
+}
+
+func TestPartyManager_LastMemberLeaveDisbands(t *testing.T) {
+	m := NewPartyManager()
+	formParty(t, m, 1, 2)
+	m.TakeUpdates()
+
+	if err := m.Leave(2); err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+	if m.IsMember(1) || m.IsMember(2) {
+		t.Fatal("party with a single member must be disbanded")
+	}
+	updates := m.TakeUpdates()
+	if len(updates.Rosters) != 0 || len(updates.Left) != 2 {
+		t.Fatalf("expected both players in Left and no rosters, got %+v", updates)
+	}
+}
+
+func TestPartyManager_KickRequiresLeader(t *testing.T) {
+	m := NewPartyManager()
+	formParty(t, m, 1, 2, 3)
+
+	if err := m.Kick(2, 3); !errors.Is(err, ErrPartyNotLeader) {
+		t.Fatalf("expected ErrPartyNotLeader, got %v", err)
+	}
+	if err := m.Kick(1, 1); !errors.Is(err, ErrPartyInvalidTarget) {
+		t.Fatalf("expected ErrPartyInvalidTarget for self kick, got %v", err)
+	}
+	if err := m.Kick(1, 3); err != nil {
+		t.Fatalf("kick: %v", err)
+	}
+	if m.IsMember(3) {
+		t.Fatal("kicked player must leave the party")
+	}
+}
+
+func TestPartyManager_RemovePlayerDropsPendingInvites(t *testing.T) {
+	m := NewPartyManager()
+	now := time.Now()
+	formParty(t, m, 1, 2, 3)
+	if err := m.Invite(1, 4, now); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+
+	m.RemovePlayer(1)
+
+	if m.IsMember(1) {
+		t.Fatal("removed player must not be a member")
+	}
+	if _, err := m.Accept(4, 1, now); !errors.Is(err, ErrPartyNoInvite) {
+		t.Fatalf("invites from removed player must be dropped, got %v", err)
+	}
+	if members := m.Members(2); len(members) != 2 {
+		t.Fatalf("expected remaining party of 2, got %v", members)
+	}
+}
+
+func TestPartyManager_SnapshotChangesMarkDirty(t *testing.T) {
+	m := NewPartyManager()
+	formParty(t, m, 1, 2)
+	m.TakeUpdates()
+
+	snapshot := PartyMemberSnapshot{EntityID: 2, Name: "bob", Online: true, X: 10, Y: 20}
+	m.UpdateSnapshot(snapshot)
+	if len(m.TakeUpdates().Rosters) != 1 {
+		t.Fatal("new snapshot must produce a roster update")
+	}
+	m.UpdateSnapshot(snapshot)
+	if len(m.TakeUpdates().Rosters) != 0 {
+		t.Fatal("unchanged snapshot must not produce an update")
+	}
+
+	m.MarkOffline(2)
+	updates := m.TakeUpdates()
+	if len(updates.Rosters) != 1 {
+		t.Fatal("going offline must produce a roster update")
+	}
+	for _, member := range updates.Rosters[0].Members {
+		if member.EntityID == 2 && (member.Online || member.Name != "bob") {
+			t.Fatalf("offline member must keep last snapshot with Online=false, got %+v", member)
+		}
+	}
+
+	m.Touch(2)
+	if len(m.TakeUpdates().Rosters) != 1 {
+		t.Fatal("Touch must force a roster re-send")
+	}
+}
+
+func TestPartyStateProto(t *testing.T) {
+	state := partyStateProto(PartyRoster{
+		ID:       7,
+		LeaderID: 1,
+		Members: []PartyMemberSnapshot{
+			{EntityID: 1, Name: "alice", Online: true, Layer: 1, X: 5, Y: 6, SHP: 10, HHP: 20, MHP: 30},
+		},
+	})
+	if state.PartyId != 7 || state.LeaderId != 1 || len(state.Members) != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	member := state.Members[0]
+	if member.Name != "alice" || member.Position.X != 5 || member.Position.Y != 6 || member.Mhp != 30 || member.Layer != 1 {
+		t.Fatalf("unexpected member: %+v", member)
+	}
+}
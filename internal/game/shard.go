@@ -28,6 +28,7 @@ import (
 	"origin/internal/game/world"
 	"origin/internal/objectdefs"
 	"origin/internal/persistence"
+	"origin/internal/weather"
 	"time"
 )
 
@@ -53,13 +54,16 @@ type Shard struct {
 	characterSaver *systems.CharacterSaver
 
 	// Command queues for network/ECS separation
-	playerInbox     *network.PlayerCommandInbox
-	serverInbox     *network.ServerJobInbox
-	snapshotSender  *inventory.SnapshotSender
-	adminHandler    *ChatAdminCommandHandler
-	craftingService *CraftingService
-	buildService    *BuildService
-	liftService     *LiftService
+	playerInbox       *network.PlayerCommandInbox
+	serverInbox       *network.ServerJobInbox
+	snapshotSender    *inventory.SnapshotSender
+	adminHandler      *ChatAdminCommandHandler
+	networkCmd        *systems.NetworkCommandSystem
+	parties           *PartyManager
+	craftingService   *CraftingService
+	buildService      *BuildService
+	liftService       *LiftService
+	attributeTraining *AttributeTrainingService
 
 	Clients   map[types.EntityID]*network.Client
 	ClientsMu sync.RWMutex
@@ -98,6 +102,7 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 		GlobalBudgetPerTick: cfg.Game.BehaviorTickGlobalBudget,
 		CatchUpLimitTicks:   uint64(cfg.Game.BehaviorTickCatchupLimit),
 	})
+	ecs.GetResource[ecs.WeatherState](s.world).SetModel(weather.NewModel(cfg.Game.WeatherSeed, cfg.Game.WeatherCellChunks))
 
 	behaviorRegistry := behaviors.MustDefaultRegistry()
 	s.chunkManager = world.NewChunkManager(cfg, db, s.world, s, layer, cfg.Game.Region, objectFactory, behaviorRegistry, eb, logger)
@@ -197,7 +202,39 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 		logger,
 	)
 	contextActionService.SetCraftingService(craftingService)
+	contextActionService.SetGiveItemOrDrop(newGiveItemOrDropFn(inventoryExecutor, s))
 	contextActionService.SetSoundEventSender(s)
+	contextActionService.SetWearTool(func(
+		w *ecs.World,
+		playerID types.EntityID,
+		playerHandle types.Handle,
+		tag string,
+	) contracts.ToolWearOutcome {
+		if inventoryExecutor == nil {
+			return contracts.ToolWearOutcome{}
+		}
+		result := inventoryExecutor.WearEquippedTool(w, playerID, playerHandle, tag, 1)
+		if len(result.UpdatedContainers) > 0 {
+			states := inventoryExecutor.ConvertContainersToStates(w, result.UpdatedContainers)
+			updated := make([]*netproto.InventoryState, 0, len(states))
+			for _, state := range states {
+				updated = append(updated, systems.BuildInventoryStateProto(state))
+			}
+			if len(updated) > 0 {
+				s.SendInventoryOpResult(playerID, &netproto.S2C_InventoryOpResult{
+					OpId:    0,
+					Success: true,
+					Updated: updated,
+				})
+			}
+		}
+		return contracts.ToolWearOutcome{
+			Found:     result.Found,
+			Remaining: result.Remaining(),
+			Max:       result.MaxDurability,
+			Broken:    result.Broken,
+		}
+	})
 	s.craftingService = craftingService
 	buildService := NewBuildService(
 		s.world,
@@ -224,12 +261,35 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 	)
 	s.liftService = liftService
 	contextActionService.SetLiftService(liftService)
+	itemActionService := NewItemActionService(s.world, inventoryExecutor, s, logger)
+	contextActionService.SetItemActionService(itemActionService)
+	farmingService := NewFarmingService(
+		s.world,
+		s.chunkManager,
+		inventoryExecutor,
+		s.entityIDManager,
+		behaviorRegistry,
+		visionSystem,
+		s,
+		logger,
+	)
+	itemActionService.SetFarmingService(farmingService)
+	contextActionService.SetFarmingService(farmingService)
+	terraformService := NewTerraformService(s.world, s.chunkManager, inventoryExecutor, s, logger)
+	itemActionService.SetTerraformService(terraformService)
+	contextActionService.SetTerraformService(terraformService)
 	networkCmdSystem.SetOpenContainerService(openContainerService)
 	networkCmdSystem.SetContextActionService(contextActionService)
 	networkCmdSystem.SetContextMenuSender(s)
 	networkCmdSystem.SetCraftCommandService(craftingService)
 	networkCmdSystem.SetBuildCommandService(buildService)
 	networkCmdSystem.SetLiftCommandService(liftService)
+	networkCmdSystem.SetItemActionCommandService(itemActionService)
+	networkCmdSystem.SetSkillCommandService(NewSkillService(s, logger))
+	s.attributeTraining = NewAttributeTrainingService(s, logger)
+	networkCmdSystem.SetAttributeCommandService(s.attributeTraining)
+	combatService := NewCombatService(s, cfg.Game.LifeDeathFactor, logger)
+	networkCmdSystem.SetCombatCommandService(combatService)
 	networkCmdSystem.SetContextPendingTTL(cfg.Game.InteractionPendingTimeout)
 
 	adminHandler := NewChatAdminCommandHandler(inventoryExecutor, s, s, s, entityIDManager, s.chunkManager, visionSystem, behaviorRegistry, s.eventBus, logger)
@@ -237,10 +297,24 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 	adminHandler.SetAllowReviveCommand(strings.EqualFold(cfg.Game.Env, "dev"))
 	s.adminHandler = adminHandler
 	networkCmdSystem.SetAdminHandler(adminHandler)
+	networkCmdSystem.SetAdminItemGiver(adminHandler)
+	s.networkCmd = networkCmdSystem
 	networkCmdSystem.SetInventorySnapshotSender(s)
 
 	s.world.AddSystem(networkCmdSystem)
 	s.world.AddSystem(systems.NewResetSystem(logger))
+	s.world.AddSystem(systems.NewWeatherSystem(s.chunkManager, logger))
+	s.world.AddSystem(systems.NewAnimalAISystem(logger, systems.AnimalAISystemConfig{
+		BudgetPerTick: cfg.Game.AnimalAIBudgetPerTick,
+		ChunkManager:  s.chunkManager,
+	}))
+	s.world.AddSystem(systems.NewFollowSystem(s.world, s, logger))
+	s.world.AddSystem(systems.NewPathfindingSystem(logger, systems.PathfindingSystemConfig{
+		NodeBudgetPerTick: cfg.Game.PathfindingNodeBudget,
+		MaxNodesPerSearch: cfg.Game.PathfindingMaxNodes,
+		ChunkManager:      s.chunkManager,
+		Sender:            s,
+	}))
 	s.world.AddSystem(systems.NewMovementSystem(s.world, s.chunkManager, logger))
 	s.world.AddSystem(systems.NewCollisionSystem(s.world, s.chunkManager, logger, worldMinX, worldMaxX, worldMinY, worldMaxY, cfg.Game.WorldMarginTiles))
 	s.world.AddSystem(systems.NewBuildPlacementSystem(s.world, buildService, logger))
@@ -254,6 +328,13 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 	s.world.AddSystem(systems.NewBehaviorTickSystem(logger, systems.BehaviorTickSystemConfig{
 		BudgetPerTick:    cfg.Game.BehaviorTickGlobalBudget,
 		BehaviorRegistry: behaviorRegistry,
+		Deps: &contracts.ExecutionDeps{
+			EventBus:         s.eventBus,
+			IDAllocator:      entityIDManager,
+			BehaviorRegistry: behaviorRegistry,
+			SyncInventories:  openContainerService.SyncInventories,
+			Logger:           logger,
+		},
 	}))
 	s.world.AddSystem(systems.NewObjectBehaviorSystem(s.eventBus, logger, systems.ObjectBehaviorConfig{
 		BudgetPerTick:       cfg.Game.ObjectBehaviorBudgetPerTick,
@@ -264,9 +345,11 @@ func NewShard(layer int, cfg *config.Config, db *persistence.Postgres, entityIDM
 
 	inventorySaver := inventory.NewInventorySaver(logger)
 	s.characterSaver = systems.NewCharacterSaver(db, cfg.Game.SaveWorkers, inventorySaver, logger)
+	networkCmdSystem.SetCharacterSaver(s.characterSaver)
 	s.world.AddSystem(systems.NewEntityStatsRegenSystem())
 	s.world.AddSystem(systems.NewPlayerStatsPushSystem(s))
 	s.world.AddSystem(systems.NewCharacterSaveSystem(s.characterSaver, cfg.Game.PlayerSaveInterval, logger))
+	s.world.AddSystem(NewCombatSystem(combatService))
 	s.world.AddSystem(NewPlayerDeathSystem(s, PlayerDeathSystemConfig{
 		LifeDeathFactor:                 cfg.Game.LifeDeathFactor,
 		ShpRegenIntervalTicks:           uint64(cfg.Game.ShpRegenIntervalTicks),
@@ -291,6 +374,84 @@ func (s *Shard) SetAdminTeleportExecutor(executor AdminTeleportExecutor) {
 	}
 }
 
+func (s *Shard) SetGlobalChatBroadcaster(broadcaster GlobalChatBroadcaster) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.adminHandler != nil {
+		s.adminHandler.SetGlobalBroadcaster(broadcaster)
+	}
+}
+
+// SetAdminPermissions enables role checks and auditing of admin chat commands.
+func (s *Shard) SetAdminPermissions(roles AdminRoleResolver, audit AdminAuditRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.adminHandler != nil {
+		s.adminHandler.SetRoleResolver(roles)
+		s.adminHandler.SetAuditRecorder(audit)
+	}
+}
+
+// SetModerationService enables /kick, /mute, /ban and /unban.
+func (s *Shard) SetModerationService(service AdminModerationService) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.adminHandler != nil {
+		s.adminHandler.SetModerationService(service)
+	}
+}
+
+func (s *Shard) SetDefsReloader(reloader AdminDefsReloader) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.adminHandler != nil {
+		s.adminHandler.SetDefsReloader(reloader)
+	}
+}
+
+func (s *Shard) SetPrivateChatRouter(router systems.PrivateChatRouter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.networkCmd != nil {
+		s.networkCmd.SetPrivateChatRouter(router)
+	}
+}
+
+// SetPartyManager wires the shared party registry: party chat routing, roster sync and cleanup.
+func (s *Shard) SetPartyManager(parties *PartyManager, chatRouter systems.PartyChatRouter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.parties = parties
+	if s.networkCmd != nil {
+		s.networkCmd.SetPartyChatRouter(chatRouter)
+	}
+	s.world.AddSystem(NewPartySyncSystem(parties, s, s.layer, s.cfg.Game.LifeDeathFactor))
+}
+
+func (s *Shard) SetChatHistoryRecorder(recorder systems.ChatHistoryRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.networkCmd != nil {
+		s.networkCmd.SetChatHistoryRecorder(recorder)
+	}
+}
+
+func (s *Shard) SetCharacterAttributesRecorder(recorder CharacterAttributesRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributeTraining != nil {
+		s.attributeTraining.SetRecorder(recorder)
+	}
+}
+
+// HasClient reports whether a connected client is bound to the given character on this shard.
+func (s *Shard) HasClient(entityID types.EntityID) bool {
+	s.ClientsMu.RLock()
+	defer s.ClientsMu.RUnlock()
+	client, ok := s.Clients[entityID]
+	return ok && client != nil
+}
+
 func (s *Shard) World() *ecs.World {
 	return s.world
 }
@@ -564,6 +725,9 @@ func (s *Shard) UnregisterEntityAOI(entityID types.EntityID) {
 // onDetachedEntityExpired is called when a detached entity's TTL expires.
 // It handles per-entity spatial cleanup before despawn.
 func (s *Shard) onDetachedEntityExpired(entityID types.EntityID, handle types.Handle) {
+	if s.parties != nil {
+		s.parties.RemovePlayer(entityID)
+	}
 	if s.liftService != nil {
 		_ = s.liftService.ForceDropCarryAtPlayerPosition(s.world, entityID, handle, false)
 	}
@@ -606,6 +770,9 @@ func (s *Shard) HandlePlayerPermanentDeath(w *ecs.World, playerID types.EntityID
 	hasObserverClient := s.enterClientObserverModeAfterPermanentDeath(w, playerID)
 
 	ecs.GetResource[ecs.DetachedEntities](w).RemoveDetachedEntity(playerID)
+	if s.parties != nil {
+		s.parties.RemovePlayer(playerID)
+	}
 	if !hasObserverClient {
 		cleanupObserverModeStateForHandle(w, playerHandle)
 		s.UnregisterEntityAOI(playerID)
@@ -834,8 +1001,9 @@ func cleanupObserverModeStateForHandle(w *ecs.World, observerHandle types.Handle
 	}
 }
 
-// SendChatMessage sends a chat message to a single entity
-func (s *Shard) SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string) {
+// SendChatMessage sends a chat message to a single entity.
+// toEntityID is set on private messages so both the recipient and the echoed sender see the addressee.
+func (s *Shard) SendChatMessage(entityID types.EntityID, channel netproto.ChatChannel, fromEntityID types.EntityID, fromName, text string, toEntityID types.EntityID) {
 	s.ClientsMu.RLock()
 	client, ok := s.Clients[entityID]
 	s.ClientsMu.RUnlock()
@@ -850,6 +1018,10 @@ func (s *Shard) SendChatMessage(entityID types.EntityID, channel netproto.ChatCh
 		FromName:     fromName,
 		Text:         text,
 	}
+	if toEntityID != 0 {
+		to := uint64(toEntityID)
+		msg.ToEntityId = &to
+	}
 
 	response := &netproto.ServerMessage{
 		Payload: &netproto.ServerMessage_Chat{
@@ -868,6 +1040,54 @@ func (s *Shard) SendChatMessage(entityID types.EntityID, channel netproto.ChatCh
 	client.Send(data)
 }
 
+// SendPartyState sends the party roster (or an empty state) to a single entity.
+func (s *Shard) SendPartyState(entityID types.EntityID, state *netproto.S2C_PartyState) {
+	s.ClientsMu.RLock()
+	client, ok := s.Clients[entityID]
+	s.ClientsMu.RUnlock()
+	if !ok || client == nil {
+		return
+	}
+
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_PartyState{
+			PartyState: state,
+		},
+	}
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("Failed to marshal party state",
+			zap.Int64("entity_id", int64(entityID)),
+			zap.Error(err))
+		return
+	}
+	client.Send(data)
+}
+
+// SendPartyInvite notifies a player about a pending party invite.
+func (s *Shard) SendPartyInvite(entityID types.EntityID, invite *netproto.S2C_PartyInvite) {
+	s.ClientsMu.RLock()
+	client, ok := s.Clients[entityID]
+	s.ClientsMu.RUnlock()
+	if !ok || client == nil {
+		return
+	}
+
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_PartyInvite{
+			PartyInvite: invite,
+		},
+	}
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("Failed to marshal party invite",
+			zap.Int64("entity_id", int64(entityID)),
+			zap.Error(err))
+		return
+	}
+	client.Send(data)
+}
+
 // SendInventoryOpResult sends an inventory operation result to a client
 func (s *Shard) SendInventoryOpResult(entityID types.EntityID, result *netproto.S2C_InventoryOpResult) {
 	s.ClientsMu.RLock()
@@ -1013,6 +1233,66 @@ func (s *Shard) SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniA
 	client.Send(data)
 }
 
+// SendCombatState sends the combat relations, pending move and cooldowns of a player.
+func (s *Shard) SendCombatState(entityID types.EntityID, state *netproto.S2C_CombatState) {
+	if state == nil {
+		return
+	}
+
+	s.ClientsMu.RLock()
+	client, ok := s.Clients[entityID]
+	s.ClientsMu.RUnlock()
+	if !ok || client == nil {
+		return
+	}
+
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_CombatState{
+			CombatState: state,
+		},
+	}
+
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("Failed to marshal combat state",
+			zap.Int64("entity_id", int64(entityID)),
+			zap.Error(err))
+		return
+	}
+
+	client.Send(data)
+}
+
+// SendFollowState tells a player that following started or ended.
+func (s *Shard) SendFollowState(entityID types.EntityID, state *netproto.S2C_FollowState) {
+	if state == nil {
+		return
+	}
+
+	s.ClientsMu.RLock()
+	client, ok := s.Clients[entityID]
+	s.ClientsMu.RUnlock()
+	if !ok || client == nil {
+		return
+	}
+
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_FollowState{
+			FollowState: state,
+		},
+	}
+
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("Failed to marshal follow state",
+			zap.Int64("entity_id", int64(entityID)),
+			zap.Error(err))
+		return
+	}
+
+	client.Send(data)
+}
+
 func (s *Shard) SendCyclicActionProgress(entityID types.EntityID, progress *netproto.S2C_CyclicActionProgress) {
 	if progress == nil {
 		return
@@ -1187,6 +1467,33 @@ func (s *Shard) SendBuildList(entityID types.EntityID, list *netproto.S2C_BuildL
 	client.Send(data)
 }
 
+// SendSkillList sends skill definitions with learned/learnable state and current LP to a client.
+func (s *Shard) SendSkillList(entityID types.EntityID, list *netproto.S2C_SkillList) {
+	if list == nil {
+		return
+	}
+	s.ClientsMu.RLock()
+	client, ok := s.Clients[entityID]
+	s.ClientsMu.RUnlock()
+	if !ok || client == nil {
+		return
+	}
+
+	response := &netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_SkillList{
+			SkillList: list,
+		},
+	}
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("Failed to marshal skill list",
+			zap.Int64("entity_id", int64(entityID)),
+			zap.Error(err))
+		return
+	}
+	client.Send(data)
+}
+
 func (s *Shard) SendBuildState(entityID types.EntityID, state *netproto.S2C_BuildState) {
 	if state == nil {
 		return
@@ -1364,10 +1671,16 @@ func (s *Shard) SendCharacterProfileSnapshot(w *ecs.World, entityID types.Entity
 	}
 
 	entries := make([]*netproto.CharacterAttributeEntry, 0, len(characterattrs.RequiredNames()))
+	trainCosts := make([]*netproto.CharacterAttributeTrainCost, 0, len(characterattrs.RequiredNames()))
 	for _, name := range characterattrs.RequiredNames() {
+		value := characterattrs.Get(values, name)
 		entries = append(entries, &netproto.CharacterAttributeEntry{
 			Key:   characterAttributeNameToProtoKey(name),
-			Value: int32(characterattrs.Get(values, name)),
+			Value: int32(value),
+		})
+		trainCosts = append(trainCosts, &netproto.CharacterAttributeTrainCost{
+			Key:    characterAttributeNameToProtoKey(name),
+			LpCost: characterattrs.TrainCost(value),
 		})
 	}
 
@@ -1375,6 +1688,7 @@ func (s *Shard) SendCharacterProfileSnapshot(w *ecs.World, entityID types.Entity
 		Payload: &netproto.ServerMessage_CharacterProfile{
 			CharacterProfile: &netproto.S2C_CharacterProfile{
 				Attributes: entries,
+				TrainCosts: trainCosts,
 				Exp: &netproto.CharacterExperience{
 					Lp:       exp.LP,
 					Nature:   exp.Nature,
@@ -1593,6 +1907,15 @@ func characterAttributeNameToProtoKey(name characterattrs.Name) netproto.Charact
 	}
 }
 
+func characterAttributeNameFromProtoKey(key netproto.CharacterAttributeKey) (characterattrs.Name, bool) {
+	for _, name := range characterattrs.RequiredNames() {
+		if characterAttributeNameToProtoKey(name) == key {
+			return name, true
+		}
+	}
+	return "", false
+}
+
 // SendError sends S2C_Error to a single entity.
 func (s *Shard) SendError(entityID types.EntityID, errorCode netproto.ErrorCode, message string) {
 	s.ClientsMu.RLock()
@@ -12,7 +12,9 @@ import (
 	"origin/internal/eventbus"
 	"origin/internal/game/inventory"
 	"origin/internal/game/world"
+	netproto "origin/internal/network/proto"
 	"origin/internal/persistence"
+	"origin/internal/types"
 )
 
 type ShardUpdateResult struct {
@@ -36,8 +38,12 @@ type ShardManager struct {
 
 	shards map[int]*Shard
 
-	workerPool *WorkerPool
-	eventBus   *eventbus.EventBus
+	workerPool  *WorkerPool
+	eventBus    *eventbus.EventBus
+	chatHistory *ChatHistoryWriter
+	parties     *PartyManager
+	adminAudit  *AdminAuditWriter
+	attributes  *CharacterAttributesWriter
 }
 
 func NewShardManager(cfg *config.Config, db *persistence.Postgres, entityIDManager *EntityIDManager, objectFactory *world.ObjectFactory, snapshotSender *inventory.SnapshotSender, enableVisionStats bool, logger *zap.Logger) *ShardManager {
@@ -65,10 +71,18 @@ func NewShardManager(cfg *config.Config, db *persistence.Postgres, entityIDManag
 		shards:            make(map[int]*Shard),
 		workerPool:        NewWorkerPool(cfg.Game.WorkerPoolSize),
 		eventBus:          eventbus.New(ebCfg),
+		chatHistory:       NewChatHistoryWriter(db, cfg.Game.Region, logger.Named("chat_history")),
+		parties:           NewPartyManager(),
+		adminAudit:        NewAdminAuditWriter(db, logger.Named("admin_audit")),
+		attributes:        NewCharacterAttributesWriter(db, logger.Named("character_attributes")),
 	}
 
 	for layer := 0; layer < cfg.Game.MaxLayers; layer++ {
 		sm.shards[layer] = NewShard(layer, cfg, db, entityIDManager, objectFactory, snapshotSender, sm.eventBus, enableVisionStats, logger.Named("shard"))
+		sm.shards[layer].SetPrivateChatRouter(sm)
+		sm.shards[layer].SetChatHistoryRecorder(sm.chatHistory)
+		sm.shards[layer].SetPartyManager(sm.parties, sm)
+		sm.shards[layer].SetCharacterAttributesRecorder(sm.attributes)
 	}
 
 	return sm
@@ -82,6 +96,81 @@ func (sm *ShardManager) GetShards() map[int]*Shard {
 	return sm.shards
 }
 
+// FindPlayerShard returns the shard that currently has a connected client bound to the character.
+func (sm *ShardManager) FindPlayerShard(entityID types.EntityID) *Shard {
+	for _, s := range sm.shards {
+		if s.HasClient(entityID) {
+			return s
+		}
+	}
+	return nil
+}
+
+// RoutePrivateChatMessage delivers a whisper to the target on whatever shard it is on
+// and echoes it back to the sender. Reports "Player offline" to the sender when the
+// target has no connected client.
+func (sm *ShardManager) RoutePrivateChatMessage(fromEntityID types.EntityID, fromName string, toEntityID types.EntityID, text string) bool {
+	senderShard := sm.FindPlayerShard(fromEntityID)
+	targetShard := sm.FindPlayerShard(toEntityID)
+	if targetShard == nil {
+		if senderShard != nil {
+			senderShard.SendError(fromEntityID, netproto.ErrorCode_ERROR_CODE_TARGET_INVALID, "Player offline")
+		}
+		return false
+	}
+
+	targetShard.SendChatMessage(toEntityID, netproto.ChatChannel_CHAT_CHANNEL_PRIVATE, fromEntityID, fromName, text, toEntityID)
+	if senderShard != nil {
+		senderShard.SendChatMessage(fromEntityID, netproto.ChatChannel_CHAT_CHANNEL_PRIVATE, fromEntityID, fromName, text, toEntityID)
+	}
+	return true
+}
+
+func (sm *ShardManager) AdminAudit() *AdminAuditWriter {
+	return sm.adminAudit
+}
+
+func (sm *ShardManager) ChatHistory() *ChatHistoryWriter {
+	return sm.chatHistory
+}
+
+func (sm *ShardManager) Parties() *PartyManager {
+	return sm.parties
+}
+
+// RoutePartyChatMessage delivers a party message to every online member, including the sender.
+func (sm *ShardManager) RoutePartyChatMessage(fromEntityID types.EntityID, fromName string, text string) bool {
+	members := sm.parties.Members(fromEntityID)
+	if len(members) == 0 {
+		return false
+	}
+	for _, memberID := range members {
+		if shard := sm.FindPlayerShard(memberID); shard != nil {
+			shard.SendChatMessage(memberID, netproto.ChatChannel_CHAT_CHANNEL_PARTY, fromEntityID, fromName, text, 0)
+		}
+	}
+	return true
+}
+
+// flushPartyUpdates pushes changed rosters to all online members and empty states to players
+// that left a party. Runs after all shards finished their tick.
+func (sm *ShardManager) flushPartyUpdates() {
+	updates := sm.parties.TakeUpdates()
+	for _, roster := range updates.Rosters {
+		state := partyStateProto(roster)
+		for _, member := range roster.Members {
+			if shard := sm.FindPlayerShard(member.EntityID); shard != nil {
+				shard.SendPartyState(member.EntityID, state)
+			}
+		}
+	}
+	for _, entityID := range updates.Left {
+		if shard := sm.FindPlayerShard(entityID); shard != nil {
+			shard.SendPartyState(entityID, &netproto.S2C_PartyState{})
+		}
+	}
+}
+
 func (sm *ShardManager) Update(ts ecs.TimeState) ShardUpdateResult {
 	shards := make([]*Shard, 0, len(sm.shards))
 	for _, s := range sm.shards {
@@ -111,6 +200,8 @@ func (sm *ShardManager) Update(ts ecs.TimeState) ShardUpdateResult {
 	}
 	wg.Wait()
 
+	sm.flushPartyUpdates()
+
 	return ShardUpdateResult{
 		TotalDuration:  time.Since(schedStart),
 		ShardDurations: shardDurations,
@@ -123,6 +214,9 @@ func (sm *ShardManager) Stop() {
 	for _, s := range sm.shards {
 		s.Stop()
 	}
+	sm.chatHistory.Stop()
+	sm.adminAudit.Stop()
+	sm.attributes.Stop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*1e9)
 	defer cancel()
@@ -0,0 +1,176 @@
+package game
+
+import (
+	"slices"
+	"strings"
+
+	"origin/internal/characterattrs"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	netproto "origin/internal/network/proto"
+	"origin/internal/persistence/repository"
+	"origin/internal/skilldefs"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type skillSender interface {
+	SendSkillList(entityID types.EntityID, list *netproto.S2C_SkillList)
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendCharacterProfileSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle)
+	SendCraftListSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle)
+	SendBuildListSnapshot(w *ecs.World, entityID types.EntityID, handle types.Handle)
+}
+
+// SkillService lists skill definitions and learns skills for LP.
+// Learned skill keys live in CharacterProfile.Skills and are saved with the character.
+type SkillService struct {
+	sender skillSender
+	logger *zap.Logger
+}
+
+func NewSkillService(sender skillSender, logger *zap.Logger) *SkillService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &SkillService{
+		sender: sender,
+		logger: logger,
+	}
+}
+
+var _ systems.SkillCommandService = (*SkillService)(nil)
+
+func (s *SkillService) HandleSkillList(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, _ *netproto.C2S_SkillList) {
+	if s == nil {
+		return
+	}
+	s.sendSkillList(w, playerID, playerHandle)
+}
+
+func (s *SkillService) HandleLearnSkill(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, msg *netproto.C2S_LearnSkill) {
+	if s == nil || msg == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	skill, ok := skilldefs.Global().GetByKey(strings.TrimSpace(msg.SkillKey))
+	if !ok {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, "SKILL_NOT_FOUND")
+		return
+	}
+
+	reasonCode := ""
+	learned := ecs.MutateComponent[components.CharacterProfile](w, playerHandle, func(profile *components.CharacterProfile) bool {
+		reasonCode = skillLearnBlocker(profile, skill)
+		if reasonCode != "" {
+			return false
+		}
+		profile.Experience.LP -= skill.LPCost
+		profile.Skills = append(append([]string(nil), profile.Skills...), skill.Key)
+		return true
+	})
+	if reasonCode != "" {
+		s.sendMiniAlert(playerID, netproto.AlertSeverity_ALERT_SEVERITY_WARNING, reasonCode)
+		return
+	}
+	if !learned {
+		return
+	}
+
+	s.logger.Debug("Skill learned",
+		zap.Int64("character_id", int64(playerID)),
+		zap.String("skill", skill.Key),
+		zap.Int64("lp_cost", skill.LPCost))
+	if s.sender == nil {
+		return
+	}
+	s.sender.SendCharacterProfileSnapshot(w, playerID, playerHandle)
+	s.sendSkillList(w, playerID, playerHandle)
+	s.sender.SendCraftListSnapshot(w, playerID, playerHandle)
+	s.sender.SendBuildListSnapshot(w, playerID, playerHandle)
+}
+
+func (s *SkillService) sendSkillList(w *ecs.World, playerID types.EntityID, playerHandle types.Handle) {
+	if s.sender == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return
+	}
+	profile, hasProfile := ecs.GetComponent[components.CharacterProfile](w, playerHandle)
+	if !hasProfile {
+		return
+	}
+	s.sender.SendSkillList(playerID, buildSkillList(&profile, skilldefs.Global()))
+}
+
+func buildSkillList(profile *components.CharacterProfile, registry *skilldefs.Registry) *netproto.S2C_SkillList {
+	all := registry.All()
+	list := &netproto.S2C_SkillList{
+		Skills: make([]*netproto.SkillEntry, 0, len(all)),
+		Lp:     profile.Experience.LP,
+	}
+	for _, skill := range all {
+		entry := &netproto.SkillEntry{
+			SkillKey:           skill.Key,
+			Name:               skill.Name,
+			LpCost:             skill.LPCost,
+			RequiredSkills:     append([]string(nil), skill.RequiredSkills...),
+			RequiredAttributes: make([]*netproto.CharacterAttributeEntry, 0, len(skill.RequiredAttributes)),
+			Learned:            slices.Contains(profile.Skills, skill.Key),
+			CanLearn:           skillLearnBlocker(profile, skill) == "",
+		}
+		for _, name := range characterattrs.RequiredNames() {
+			if value, ok := skill.RequiredAttributes[name]; ok {
+				entry.RequiredAttributes = append(entry.RequiredAttributes, &netproto.CharacterAttributeEntry{
+					Key:   characterAttributeNameToProtoKey(name),
+					Value: int32(value),
+				})
+			}
+		}
+		list.Skills = append(list.Skills, entry)
+	}
+	return list
+}
+
+// skillLearnBlocker returns the mini alert reason code that prevents learning the skill,
+// or an empty string when it can be learned now.
+func skillLearnBlocker(profile *components.CharacterProfile, skill *skilldefs.SkillDef) string {
+	if slices.Contains(profile.Skills, skill.Key) {
+		return "SKILL_ALREADY_LEARNED"
+	}
+	if !containsAllStrings(profile.Skills, skill.RequiredSkills) {
+		return "SKILL_REQUIRES_SKILLS"
+	}
+	for name, value := range skill.RequiredAttributes {
+		if characterattrs.Get(profile.Attributes, name) < value {
+			return "SKILL_REQUIRES_ATTRIBUTES"
+		}
+	}
+	if profile.Experience.LP < skill.LPCost {
+		return "SKILL_NOT_ENOUGH_LP"
+	}
+	return ""
+}
+
+// mergeSkillRows adds skills recorded in the skill table to the skills column set.
+// Rows of skills without a definition are skipped.
+func mergeSkillRows(skills []string, rows []repository.Skill, registry *skilldefs.Registry) []string {
+	for _, row := range rows {
+		skill, ok := registry.GetByID(row.SkillID)
+		if !ok || slices.Contains(skills, skill.Key) {
+			continue
+		}
+		skills = append(skills, skill.Key)
+	}
+	return skills
+}
+
+func (s *SkillService) sendMiniAlert(entityID types.EntityID, severity netproto.AlertSeverity, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(entityID, &netproto.S2C_MiniAlert{
+		Severity:   severity,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(severity),
+	})
+}
@@ -0,0 +1,190 @@
+package game
+
+import (
+	"slices"
+	"testing"
+
+	"origin/internal/characterattrs"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	netproto "origin/internal/network/proto"
+	"origin/internal/persistence/repository"
+	"origin/internal/skilldefs"
+	"origin/internal/types"
+)
+
+type testSkillSender struct {
+	alerts         []*netproto.S2C_MiniAlert
+	lists          []*netproto.S2C_SkillList
+	profiles       int
+	craftSnapshots int
+	buildSnapshots int
+}
+
+func (s *testSkillSender) SendSkillList(_ types.EntityID, list *netproto.S2C_SkillList) {
+	s.lists = append(s.lists, list)
+}
+
+func (s *testSkillSender) SendMiniAlert(_ types.EntityID, alert *netproto.S2C_MiniAlert) {
+	s.alerts = append(s.alerts, alert)
+}
+
+func (s *testSkillSender) SendCharacterProfileSnapshot(_ *ecs.World, _ types.EntityID, _ types.Handle) {
+	s.profiles++
+}
+
+func (s *testSkillSender) SendCraftListSnapshot(_ *ecs.World, _ types.EntityID, _ types.Handle) {
+	s.craftSnapshots++
+}
+
+func (s *testSkillSender) SendBuildListSnapshot(_ *ecs.World, _ types.EntityID, _ types.Handle) {
+	s.buildSnapshots++
+}
+
+func setSkillTestRegistry(t *testing.T) {
+	t.Helper()
+	prev := skilldefs.Global()
+	skilldefs.SetGlobalForTesting(skilldefs.NewRegistry([]skilldefs.SkillDef{
+		{DefID: 1, Key: "lumberjacking", Name: "Lumberjacking", LPCost: 100},
+		{
+			DefID:              2,
+			Key:                "carpentry",
+			Name:               "Carpentry",
+			LPCost:             300,
+			RequiredSkills:     []string{"lumberjacking"},
+			RequiredAttributes: map[characterattrs.Name]int{characterattrs.STR: 3},
+		},
+	}))
+	t.Cleanup(func() { skilldefs.SetGlobalForTesting(prev) })
+}
+
+func spawnSkillTestPlayer(world *ecs.World, playerID types.EntityID, lp int64, strength int) types.Handle {
+	attributes := characterattrs.Default()
+	attributes[characterattrs.STR] = strength
+	return world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.CharacterProfile{
+			Attributes: attributes,
+			Experience: components.CharacterExperience{LP: lp},
+		})
+	})
+}
+
+func lastSkillAlert(sender *testSkillSender) string {
+	if len(sender.alerts) == 0 {
+		return ""
+	}
+	return sender.alerts[len(sender.alerts)-1].ReasonCode
+}
+
+func TestSkillService_LearnSpendsLPAndResnapshotsLists(t *testing.T) {
+	setSkillTestRegistry(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(5001)
+	playerHandle := spawnSkillTestPlayer(world, playerID, 450, 3)
+	sender := &testSkillSender{}
+	service := NewSkillService(sender, nil)
+
+	service.HandleLearnSkill(world, playerID, playerHandle, &netproto.C2S_LearnSkill{SkillKey: "carpentry"})
+	if got := lastSkillAlert(sender); got != "SKILL_REQUIRES_SKILLS" {
+		t.Fatalf("expected SKILL_REQUIRES_SKILLS, got %q", got)
+	}
+
+	service.HandleLearnSkill(world, playerID, playerHandle, &netproto.C2S_LearnSkill{SkillKey: " lumberjacking "})
+	profile, _ := ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if profile.Experience.LP != 350 || !slices.Contains(profile.Skills, "lumberjacking") {
+		t.Fatalf("expected lumberjacking learned for 100 LP, got lp=%d skills=%v", profile.Experience.LP, profile.Skills)
+	}
+	if sender.profiles != 1 || sender.craftSnapshots != 1 || sender.buildSnapshots != 1 || len(sender.lists) != 1 {
+		t.Fatalf("expected profile, skill, craft and build snapshots, got %+v", sender)
+	}
+
+	service.HandleLearnSkill(world, playerID, playerHandle, &netproto.C2S_LearnSkill{SkillKey: "lumberjacking"})
+	if got := lastSkillAlert(sender); got != "SKILL_ALREADY_LEARNED" {
+		t.Fatalf("expected SKILL_ALREADY_LEARNED, got %q", got)
+	}
+
+	service.HandleLearnSkill(world, playerID, playerHandle, &netproto.C2S_LearnSkill{SkillKey: "carpentry"})
+	profile, _ = ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+	if profile.Experience.LP != 50 || !slices.Contains(profile.Skills, "carpentry") {
+		t.Fatalf("expected carpentry learned for 300 LP, got lp=%d skills=%v", profile.Experience.LP, profile.Skills)
+	}
+}
+
+func TestSkillService_LearnRejectsMissingRequirements(t *testing.T) {
+	setSkillTestRegistry(t)
+	cases := []struct {
+		name      string
+		lp        int64
+		strength  int
+		skills    []string
+		skillKey  string
+		wantAlert string
+	}{
+		{name: "unknown", lp: 1000, strength: 5, skillKey: "smithing", wantAlert: "SKILL_NOT_FOUND"},
+		{name: "attributes", lp: 1000, strength: 2, skills: []string{"lumberjacking"}, skillKey: "carpentry", wantAlert: "SKILL_REQUIRES_ATTRIBUTES"},
+		{name: "lp", lp: 299, strength: 5, skills: []string{"lumberjacking"}, skillKey: "carpentry", wantAlert: "SKILL_NOT_ENOUGH_LP"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			world := ecs.NewWorldForTesting()
+			playerID := types.EntityID(5002)
+			playerHandle := spawnSkillTestPlayer(world, playerID, tc.lp, tc.strength)
+			ecs.WithComponent(world, playerHandle, func(profile *components.CharacterProfile) {
+				profile.Skills = tc.skills
+			})
+			sender := &testSkillSender{}
+
+			NewSkillService(sender, nil).HandleLearnSkill(world, playerID, playerHandle, &netproto.C2S_LearnSkill{SkillKey: tc.skillKey})
+
+			if got := lastSkillAlert(sender); got != tc.wantAlert {
+				t.Fatalf("expected %s, got %q", tc.wantAlert, got)
+			}
+			profile, _ := ecs.GetComponent[components.CharacterProfile](world, playerHandle)
+			if profile.Experience.LP != tc.lp || len(profile.Skills) != len(tc.skills) {
+				t.Fatalf("rejected learn must not change the profile, got lp=%d skills=%v", profile.Experience.LP, profile.Skills)
+			}
+			if sender.craftSnapshots != 0 || sender.buildSnapshots != 0 {
+				t.Fatalf("rejected learn must not re-snapshot lists")
+			}
+		})
+	}
+}
+
+func TestSkillService_SkillListReportsState(t *testing.T) {
+	setSkillTestRegistry(t)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(5003)
+	playerHandle := spawnSkillTestPlayer(world, playerID, 150, 1)
+	sender := &testSkillSender{}
+
+	NewSkillService(sender, nil).HandleSkillList(world, playerID, playerHandle, &netproto.C2S_SkillList{})
+
+	if len(sender.lists) != 1 {
+		t.Fatalf("expected one skill list, got %d", len(sender.lists))
+	}
+	list := sender.lists[0]
+	if list.Lp != 150 || len(list.Skills) != 2 {
+		t.Fatalf("unexpected skill list: %+v", list)
+	}
+	lumberjacking, carpentry := list.Skills[0], list.Skills[1]
+	if lumberjacking.Learned || !lumberjacking.CanLearn {
+		t.Fatalf("lumberjacking must be learnable, got %+v", lumberjacking)
+	}
+	if carpentry.CanLearn || len(carpentry.RequiredAttributes) != 1 ||
+		carpentry.RequiredAttributes[0].Key != netproto.CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_STR ||
+		carpentry.RequiredAttributes[0].Value != 3 {
+		t.Fatalf("carpentry must list its STR requirement and not be learnable, got %+v", carpentry)
+	}
+}
+
+func TestMergeSkillRows_AddsKnownSkillsOnce(t *testing.T) {
+	setSkillTestRegistry(t)
+	skills := mergeSkillRows([]string{"lumberjacking"}, []repository.Skill{
+		{SkillID: 1, Level: 1},
+		{SkillID: 2, Level: 1},
+		{SkillID: 99, Level: 1},
+	}, skilldefs.Global())
+	if !slices.Equal(skills, []string{"lumberjacking", "carpentry"}) {
+		t.Fatalf("unexpected merged skills: %v", skills)
+	}
+}
@@ -0,0 +1,386 @@
+package game
+
+import (
+	"slices"
+
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/ecs/systems"
+	"origin/internal/game/behaviors"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	"origin/internal/mathutil"
+	netproto "origin/internal/network/proto"
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	digItemActionID  = "dig"
+	paveItemActionID = "pave"
+	fillItemActionID = "fill"
+
+	// terraformYieldQuality is the quality of items dug out of the ground.
+	terraformYieldQuality uint32 = 10
+)
+
+// terraformAction turns one tile into another with a tool; it may use up a material item
+// or yield one.
+type terraformAction struct {
+	id          string
+	title       string
+	toolTag     string
+	fromTiles   []byte
+	toTile      byte
+	materialKey string
+	yieldKey    string
+	cycleTicks  uint32
+	staminaCost float64
+}
+
+var terraformActions = []terraformAction{
+	{
+		id: digItemActionID, title: "Dig", toolTag: "shovel",
+		fromTiles: []byte{types.TileGrass}, toTile: types.TileDirt,
+		yieldKey: "soil", cycleTicks: 30, staminaCost: 40,
+	},
+	{
+		id: paveItemActionID, title: "Pave", toolTag: "hammer",
+		fromTiles: []byte{types.TileDirt}, toTile: types.TileStonePaving,
+		materialKey: "stone", cycleTicks: 40, staminaCost: 50,
+	},
+	{
+		id: fillItemActionID, title: "Fill", toolTag: "shovel",
+		fromTiles: []byte{types.TileShallowWater}, toTile: types.TileDirt,
+		materialKey: "soil", cycleTicks: 40, staminaCost: 50,
+	},
+}
+
+// TerraformItemKeys lists the item keys terraform actions use up or yield, so defs tooling
+// can check them against the item catalog.
+func TerraformItemKeys() []string {
+	keys := make([]string, 0, 2*len(terraformActions))
+	for _, action := range terraformActions {
+		for _, key := range []string{action.materialKey, action.yieldKey} {
+			if key != "" && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func findTerraformAction(actionID string) (terraformAction, bool) {
+	for _, action := range terraformActions {
+		if action.id == actionID {
+			return action, true
+		}
+	}
+	return terraformAction{}, false
+}
+
+type terraformTiles interface {
+	GetTileID(tileX, tileY int) (byte, bool)
+	SetTileID(tileX, tileY int, tileID byte, tick uint64) bool
+	GetChunkFast(coord types.ChunkCoord) *core.Chunk
+}
+
+type terraformSender interface {
+	SendMiniAlert(entityID types.EntityID, alert *netproto.S2C_MiniAlert)
+	SendInventoryUpdate(entityID types.EntityID, states []*netproto.InventoryState)
+}
+
+// TerraformService changes the tile under the player with a tool: digging grass to dirt
+// (yields soil), paving dirt with stone and filling shallow water with soil.
+// Each is a synthetic cyclic action targeting the tool item; one cycle changes one tile,
+// costs stamina and wears the tool. Tiles with static objects on them cannot be changed.
+type TerraformService struct {
+	world   *ecs.World
+	tiles   terraformTiles
+	invExec *inventory.InventoryExecutor
+	sender  terraformSender
+	logger  *zap.Logger
+}
+
+func NewTerraformService(
+	world *ecs.World,
+	tiles terraformTiles,
+	invExec *inventory.InventoryExecutor,
+	sender terraformSender,
+	logger *zap.Logger,
+) *TerraformService {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &TerraformService{
+		world:   world,
+		tiles:   tiles,
+		invExec: invExec,
+		sender:  sender,
+		logger:  logger,
+	}
+}
+
+// ItemActions lists terraform actions available for a tool definition.
+func (s *TerraformService) ItemActions(itemDef *itemdefs.ItemDef) []systems.ContextAction {
+	if s == nil || itemDef == nil {
+		return nil
+	}
+	var actions []systems.ContextAction
+	for _, action := range terraformActions {
+		if hasItemDefTag(itemDef, action.toolTag) {
+			actions = append(actions, systems.ContextAction{ActionID: action.id, Title: action.title})
+		}
+	}
+	return actions
+}
+
+// StartItemAction starts a terraform action for a tool; returns false for other action ids.
+func (s *TerraformService) StartItemAction(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	itemID types.EntityID,
+	actionID string,
+) bool {
+	if s == nil {
+		return false
+	}
+	def, ok := findTerraformAction(actionID)
+	if !ok {
+		return false
+	}
+	if w == nil || playerID == 0 || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return true
+	}
+	if _, has := ecs.GetComponent[components.ActiveCyclicAction](w, playerHandle); has {
+		s.sendWarning(playerID, "ACTION_BUSY")
+		return true
+	}
+
+	action := components.ActiveCyclicAction{
+		ActionID:           actionID,
+		TargetKind:         components.CyclicActionTargetItem,
+		TargetID:           itemID,
+		CycleDurationTicks: def.cycleTicks,
+	}
+	if !s.IsActiveTerraformStillValid(w, playerID, playerHandle, action) {
+		// Tool moved away or changed since the menu was shown: silent ignore.
+		return true
+	}
+	tileX, tileY, ok := playerTile(w, playerHandle)
+	if !ok {
+		return true
+	}
+	if reason := s.blockReason(w, playerID, playerHandle, def, tileX, tileY); reason != "" {
+		s.sendWarning(playerID, reason)
+		return true
+	}
+
+	action.CycleIndex = 1
+	action.StartedTick = ecs.GetResource[ecs.TimeState](w).Tick
+	ecs.AddComponent(w, playerHandle, action)
+	ecs.MutateComponent[components.Movement](w, playerHandle, func(m *components.Movement) bool {
+		m.State = constt.StateInteracting
+		return true
+	})
+	return true
+}
+
+func (s *TerraformService) IsSyntheticTerraformAction(action components.ActiveCyclicAction) bool {
+	if action.BehaviorKey != "" || action.TargetKind != components.CyclicActionTargetItem {
+		return false
+	}
+	_, ok := findTerraformAction(action.ActionID)
+	return ok
+}
+
+// IsActiveTerraformStillValid checks that the player still carries the tool with the action's tag.
+func (s *TerraformService) IsActiveTerraformStillValid(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) bool {
+	if s == nil || s.invExec == nil || w == nil || playerHandle == types.InvalidHandle || !w.Alive(playerHandle) {
+		return false
+	}
+	def, ok := findTerraformAction(action.ActionID)
+	if !ok {
+		return false
+	}
+	item, found := s.invExec.FindPlayerItem(w, playerID, playerHandle, action.TargetID)
+	if !found {
+		return false
+	}
+	itemRegistry := itemdefs.Global()
+	if itemRegistry == nil {
+		return false
+	}
+	itemDef, ok := itemRegistry.GetByID(int(item.TypeID))
+	return ok && itemDef != nil && hasItemDefTag(itemDef, def.toolTag)
+}
+
+// HandleTerraformCycleComplete changes the tile under the player. Each action handles one tile.
+func (s *TerraformService) HandleTerraformCycleComplete(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	action components.ActiveCyclicAction,
+) contracts.BehaviorCycleDecision {
+	if !s.IsActiveTerraformStillValid(w, playerID, playerHandle, action) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	def, _ := findTerraformAction(action.ActionID)
+	tileX, tileY, ok := playerTile(w, playerHandle)
+	if !ok {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if reason := s.blockReason(w, playerID, playerHandle, def, tileX, tileY); reason != "" {
+		s.sendWarning(playerID, reason)
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	if !behaviors.ConsumePlayerLongActionStamina(w, playerHandle, def.staminaCost) {
+		s.sendWarning(playerID, "LOW_STAMINA")
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+	nowTick := ecs.GetResource[ecs.TimeState](w).Tick
+	if !s.tiles.SetTileID(tileX, tileY, def.toTile, nowTick) {
+		return contracts.BehaviorCycleDecisionCanceled
+	}
+
+	if def.materialKey != "" {
+		if material, found := s.invExec.FindPlayerItemByKey(w, playerID, playerHandle, def.materialKey); found {
+			consumed := s.invExec.ConsumePlayerItemUnit(w, playerID, playerHandle, material.ItemID)
+			s.sendInventoryUpdate(w, playerID, consumed.UpdatedContainers)
+		}
+	}
+	if def.yieldKey != "" {
+		// A full inventory drops the yield next to the player, as crafting does.
+		given := s.invExec.GiveCraftOutputOrDrop(w, playerID, playerHandle, def.yieldKey, 1, terraformYieldQuality)
+		s.sendInventoryUpdate(w, playerID, given.UpdatedContainers)
+	}
+	s.wearTool(w, playerID, playerHandle, action.TargetID)
+	return contracts.BehaviorCycleDecisionComplete
+}
+
+func (s *TerraformService) blockReason(
+	w *ecs.World,
+	playerID types.EntityID,
+	playerHandle types.Handle,
+	def terraformAction,
+	tileX, tileY int,
+) string {
+	if s.tiles == nil {
+		return "TERRAFORM_UNAVAILABLE"
+	}
+	tileID, ok := s.tiles.GetTileID(tileX, tileY)
+	if !ok {
+		return "TERRAFORM_UNAVAILABLE"
+	}
+	if !slices.Contains(def.fromTiles, tileID) {
+		return "TERRAFORM_TILE_NOT_ALLOWED"
+	}
+	if def.materialKey != "" {
+		if _, found := s.invExec.FindPlayerItemByKey(w, playerID, playerHandle, def.materialKey); !found {
+			return "TERRAFORM_NO_MATERIAL"
+		}
+	}
+	if s.isTileBlockedByObject(w, tileX, tileY) {
+		return "TERRAFORM_TILE_OCCUPIED"
+	}
+	return ""
+}
+
+// isTileBlockedByObject reports whether a static object stands on the tile: its collider
+// overlaps the tile, or its position lies in it when it has no collider.
+func (s *TerraformService) isTileBlockedByObject(w *ecs.World, tileX, tileY int) bool {
+	minX := float64(tileX * constt.CoordPerTile)
+	minY := float64(tileY * constt.CoordPerTile)
+	maxX := minX + constt.CoordPerTile
+	maxY := minY + constt.CoordPerTile
+	// Objects are indexed by position, so large colliders centered outside the tile need a wider query.
+	const reach = 2 * constt.CoordPerTile
+
+	var candidates []types.Handle
+	minChunkX := mathutil.FloorDiv(int(minX)-reach, constt.ChunkWorldSize)
+	minChunkY := mathutil.FloorDiv(int(minY)-reach, constt.ChunkWorldSize)
+	maxChunkX := mathutil.FloorDiv(int(maxX)+reach, constt.ChunkWorldSize)
+	maxChunkY := mathutil.FloorDiv(int(maxY)+reach, constt.ChunkWorldSize)
+	for chunkY := minChunkY; chunkY <= maxChunkY; chunkY++ {
+		for chunkX := minChunkX; chunkX <= maxChunkX; chunkX++ {
+			chunk := s.tiles.GetChunkFast(types.ChunkCoord{X: chunkX, Y: chunkY})
+			if chunk == nil {
+				continue
+			}
+			chunk.Spatial().QueryStaticAABB(int(minX)-reach, int(minY)-reach, int(maxX)+reach, int(maxY)+reach, &candidates)
+		}
+	}
+
+	for _, h := range candidates {
+		if !w.Alive(h) {
+			continue
+		}
+		transform, hasTransform := ecs.GetComponent[components.Transform](w, h)
+		if !hasTransform {
+			continue
+		}
+		collider, hasCollider := ecs.GetComponent[components.Collider](w, h)
+		if !hasCollider || collider.Phantom != nil {
+			if transform.X >= minX && transform.X < maxX && transform.Y >= minY && transform.Y < maxY {
+				return true
+			}
+			continue
+		}
+		if transform.X+collider.HalfWidth > minX && transform.X-collider.HalfWidth < maxX &&
+			transform.Y+collider.HalfHeight > minY && transform.Y-collider.HalfHeight < maxY {
+			return true
+		}
+	}
+	return false
+}
+
+// wearTool wears the tool the action was started with by one cycle and alerts the player
+// when it runs low or breaks.
+func (s *TerraformService) wearTool(w *ecs.World, playerID types.EntityID, playerHandle types.Handle, toolID types.EntityID) {
+	result := s.invExec.WearPlayerItem(w, playerID, playerHandle, toolID, 1)
+	if !result.Found {
+		return
+	}
+	s.sendInventoryUpdate(w, playerID, result.UpdatedContainers)
+	switch {
+	case result.Broken:
+		s.sendWarning(playerID, "TOOL_BROKEN")
+	case result.MaxDurability > 0 && result.Remaining() == behaviors.ToolLowDurability(result.MaxDurability):
+		s.sendWarning(playerID, "TOOL_LOW_DURABILITY")
+	}
+}
+
+func (s *TerraformService) sendInventoryUpdate(w *ecs.World, playerID types.EntityID, updated []*inventory.ContainerInfo) {
+	if s.sender == nil || len(updated) == 0 {
+		return
+	}
+	states := s.invExec.ConvertContainersToStates(w, updated)
+	protoStates := make([]*netproto.InventoryState, 0, len(states))
+	for _, st := range states {
+		protoStates = append(protoStates, systems.BuildInventoryStateProto(st))
+	}
+	if len(protoStates) > 0 {
+		s.sender.SendInventoryUpdate(playerID, protoStates)
+	}
+}
+
+func (s *TerraformService) sendWarning(playerID types.EntityID, reasonCode string) {
+	if s.sender == nil || reasonCode == "" {
+		return
+	}
+	s.sender.SendMiniAlert(playerID, &netproto.S2C_MiniAlert{
+		Severity:   netproto.AlertSeverity_ALERT_SEVERITY_WARNING,
+		ReasonCode: reasonCode,
+		TtlMs:      ttlBySeverity(netproto.AlertSeverity_ALERT_SEVERITY_WARNING),
+	})
+}
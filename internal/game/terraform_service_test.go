@@ -0,0 +1,220 @@
+package game
+
+import (
+	"testing"
+
+	"origin/internal/characterattrs"
+	constt "origin/internal/const"
+	"origin/internal/core"
+	"origin/internal/ecs"
+	"origin/internal/ecs/components"
+	"origin/internal/game/behaviors/contracts"
+	"origin/internal/game/inventory"
+	"origin/internal/itemdefs"
+	"origin/internal/objectdefs"
+	"origin/internal/types"
+)
+
+const (
+	testShovelItemDefID      = 93201
+	testHammerItemDefID      = 93202
+	testSoilItemDefID        = 93203
+	testPavingStoneItemDefID = 93204
+	testShovelItemID         = types.EntityID(779001)
+	testHammerItemID         = types.EntityID(779002)
+	testPavingStoneItemID    = types.EntityID(779003)
+)
+
+func setTerraformTestRegistry(t *testing.T, shovelDurability uint32) {
+	t.Helper()
+	prev := itemdefs.Global()
+	prevObjects := objectdefs.Global()
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{
+		{DefID: testFarmPlayerDefID, Key: "player", Name: "Player"},
+	}))
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: testShovelItemDefID, Key: "shovel", Name: "Shovel", Tags: []string{"shovel"}, Size: itemdefs.Size{W: 1, H: 1}, Durability: shovelDurability},
+		{DefID: testHammerItemDefID, Key: "hammer", Name: "Hammer", Tags: []string{"hammer"}, Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testSoilItemDefID, Key: "soil", Name: "Soil", Size: itemdefs.Size{W: 1, H: 1}},
+		{DefID: testPavingStoneItemDefID, Key: "stone", Name: "Stone", Size: itemdefs.Size{W: 1, H: 1}},
+	}))
+	t.Cleanup(func() {
+		itemdefs.SetGlobalForTesting(prev)
+		objectdefs.SetGlobalForTesting(prevObjects)
+	})
+}
+
+// spawnTerraformTestPlayer places the player in the center of tile (2,3) with a shovel and a hammer.
+func spawnTerraformTestPlayer(world *ecs.World, playerID types.EntityID, items ...components.InvItem) types.Handle {
+	playerHandle := world.Spawn(playerID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: testFarmPlayerDefID})
+		ecs.AddComponent(w, h, components.Transform{X: 2*constt.CoordPerTile + 6, Y: 3*constt.CoordPerTile + 6})
+		ecs.AddComponent(w, h, components.Movement{Mode: constt.Walk, State: constt.StateIdle, Speed: 1})
+		ecs.AddComponent(w, h, components.CharacterProfile{Attributes: characterattrs.Default()})
+		ecs.AddComponent(w, h, components.EntityStats{Stamina: 500, Energy: 1000})
+	})
+	gridHandle := world.Spawn(types.EntityID(uint64(playerID)+100000), func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.InventoryContainer{
+			OwnerID: playerID,
+			Kind:    constt.InventoryGrid,
+			Width:   4,
+			Height:  4,
+			Items: append([]components.InvItem{
+				{ItemID: testShovelItemID, TypeID: testShovelItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1},
+				{ItemID: testHammerItemID, TypeID: testHammerItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1, X: 1},
+			}, items...),
+		})
+	})
+	ecs.AddComponent(world, playerHandle, components.InventoryOwner{
+		Inventories: []components.InventoryLink{
+			{Kind: constt.InventoryGrid, OwnerID: playerID, Handle: gridHandle},
+		},
+	})
+	return playerHandle
+}
+
+func newTerraformTestService(tiles *testFarmingTiles, sender *testItemActionSender) *TerraformService {
+	invExec := inventory.NewInventoryExecutor(nil, &testFarmIDAllocator{next: 890000}, nil, nil, nil)
+	return NewTerraformService(nil, tiles, invExec, sender, nil)
+}
+
+func runTerraformTestAction(t *testing.T, world *ecs.World, service *TerraformService, playerID types.EntityID, playerHandle types.Handle, toolID types.EntityID, actionID string) contracts.BehaviorCycleDecision {
+	t.Helper()
+	if !service.StartItemAction(world, playerID, playerHandle, toolID, actionID) {
+		t.Fatalf("%s must be handled by terraform service", actionID)
+	}
+	action, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle)
+	if !ok || !service.IsSyntheticTerraformAction(action) {
+		t.Fatalf("expected %s cyclic action, got %+v", actionID, action)
+	}
+	ecs.RemoveComponent[components.ActiveCyclicAction](world, playerHandle)
+	return service.HandleTerraformCycleComplete(world, playerID, playerHandle, action)
+}
+
+func TestTerraformService_DigYieldsSoilAndWearsShovel(t *testing.T) {
+	setTerraformTestRegistry(t, 10)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93600)
+	playerHandle := spawnTerraformTestPlayer(world, playerID)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileGrass}}
+	sender := &testItemActionSender{}
+	service := newTerraformTestService(tiles, sender)
+
+	if decision := runTerraformTestAction(t, world, service, playerID, playerHandle, testShovelItemID, digItemActionID); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected complete decision, got %v", decision)
+	}
+	if tiles.tiles[[2]int{2, 3}] != types.TileDirt {
+		t.Fatalf("expected dirt tile, got %d", tiles.tiles[[2]int{2, 3}])
+	}
+	if _, found := service.invExec.FindPlayerItemByKey(world, playerID, playerHandle, "soil"); !found {
+		t.Fatalf("expected soil in inventory")
+	}
+	shovel, _ := service.invExec.FindPlayerItem(world, playerID, playerHandle, testShovelItemID)
+	if shovel.Wear != 1 {
+		t.Fatalf("expected shovel wear 1, got %d", shovel.Wear)
+	}
+	stats, _ := ecs.GetComponent[components.EntityStats](world, playerHandle)
+	if stats.Stamina >= 500 {
+		t.Fatalf("expected stamina to be spent, got %v", stats.Stamina)
+	}
+
+	service.StartItemAction(world, playerID, playerHandle, testShovelItemID, digItemActionID)
+	if got := lastAlertCode(sender); got != "TERRAFORM_TILE_NOT_ALLOWED" {
+		t.Fatalf("expected TERRAFORM_TILE_NOT_ALLOWED on dirt, got %q", got)
+	}
+}
+
+func TestTerraformService_FillAndPaveUseMaterial(t *testing.T) {
+	setTerraformTestRegistry(t, 10)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93601)
+	playerHandle := spawnTerraformTestPlayer(world, playerID,
+		components.InvItem{ItemID: testPavingStoneItemID, TypeID: testPavingStoneItemDefID, Quality: 10, Quantity: 1, W: 1, H: 1, X: 2},
+	)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileShallowWater}}
+	sender := &testItemActionSender{}
+	service := newTerraformTestService(tiles, sender)
+
+	service.StartItemAction(world, playerID, playerHandle, testShovelItemID, fillItemActionID)
+	if got := lastAlertCode(sender); got != "TERRAFORM_NO_MATERIAL" {
+		t.Fatalf("expected TERRAFORM_NO_MATERIAL without soil, got %q", got)
+	}
+	service.StartItemAction(world, playerID, playerHandle, testHammerItemID, digItemActionID)
+	if _, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); ok {
+		t.Fatalf("dig must not start with a hammer")
+	}
+
+	service.invExec.GiveItem(world, playerID, playerHandle, "soil", 1, 10)
+	if decision := runTerraformTestAction(t, world, service, playerID, playerHandle, testShovelItemID, fillItemActionID); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected fill to complete, got %v", decision)
+	}
+	if tiles.tiles[[2]int{2, 3}] != types.TileDirt {
+		t.Fatalf("expected filled water to become dirt, got %d", tiles.tiles[[2]int{2, 3}])
+	}
+	if _, found := service.invExec.FindPlayerItemByKey(world, playerID, playerHandle, "soil"); found {
+		t.Fatalf("expected soil to be used up")
+	}
+
+	if decision := runTerraformTestAction(t, world, service, playerID, playerHandle, testHammerItemID, paveItemActionID); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected pave to complete, got %v", decision)
+	}
+	if tiles.tiles[[2]int{2, 3}] != types.TileStonePaving {
+		t.Fatalf("expected stone paving, got %d", tiles.tiles[[2]int{2, 3}])
+	}
+	if _, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testPavingStoneItemID); found {
+		t.Fatalf("expected stone to be used up")
+	}
+}
+
+func TestTerraformService_RefusesTileWithObject(t *testing.T) {
+	setTerraformTestRegistry(t, 10)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93602)
+	playerHandle := spawnTerraformTestPlayer(world, playerID)
+	chunk := core.NewChunk(types.ChunkCoord{X: 0, Y: 0}, 1, 0, constt.ChunkSize)
+	chunk.SetState(types.ChunkStateActive)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileGrass}, chunk: chunk}
+	sender := &testItemActionSender{}
+	service := newTerraformTestService(tiles, sender)
+
+	// A collider centered on the next tile that reaches into (2,3).
+	x, y := 3*constt.CoordPerTile+4, 3*constt.CoordPerTile+6
+	obstacle := world.Spawn(93700, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{IsStatic: true})
+		ecs.AddComponent(w, h, components.Transform{X: float64(x), Y: float64(y)})
+		ecs.AddComponent(w, h, components.Collider{HalfWidth: 6, HalfHeight: 6, Layer: 1, Mask: 1})
+	})
+	chunk.Spatial().AddStatic(obstacle, x, y)
+
+	service.StartItemAction(world, playerID, playerHandle, testShovelItemID, digItemActionID)
+	if _, ok := ecs.GetComponent[components.ActiveCyclicAction](world, playerHandle); ok {
+		t.Fatalf("dig must not start under an object")
+	}
+	if got := lastAlertCode(sender); got != "TERRAFORM_TILE_OCCUPIED" {
+		t.Fatalf("expected TERRAFORM_TILE_OCCUPIED, got %q", got)
+	}
+
+	chunk.Spatial().RemoveStatic(obstacle, x, y)
+	world.Despawn(obstacle)
+	if decision := runTerraformTestAction(t, world, service, playerID, playerHandle, testShovelItemID, digItemActionID); decision != contracts.BehaviorCycleDecisionComplete {
+		t.Fatalf("expected dig to complete once the object is gone, got %v", decision)
+	}
+}
+
+func TestTerraformService_ShovelBreaks(t *testing.T) {
+	setTerraformTestRegistry(t, 1)
+	world := ecs.NewWorldForTesting()
+	playerID := types.EntityID(93603)
+	playerHandle := spawnTerraformTestPlayer(world, playerID)
+	tiles := &testFarmingTiles{tiles: map[[2]int]byte{{2, 3}: types.TileGrass}}
+	sender := &testItemActionSender{}
+	service := newTerraformTestService(tiles, sender)
+
+	runTerraformTestAction(t, world, service, playerID, playerHandle, testShovelItemID, digItemActionID)
+	if _, found := service.invExec.FindPlayerItem(world, playerID, playerHandle, testShovelItemID); found {
+		t.Fatalf("expected shovel to break")
+	}
+	if got := lastAlertCode(sender); got != "TOOL_BROKEN" {
+		t.Fatalf("expected TOOL_BROKEN, got %q", got)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"origin/internal/mathutil"
 	"origin/internal/persistence"
 	"origin/internal/persistence/repository"
+	"origin/internal/weather"
 
 	lru "github.com/hashicorp/golang-lru/v2/expirable"
 	"go.uber.org/zap"
@@ -258,7 +259,7 @@ func (cm *ChunkManager) EnableChunkLoadEvents(entityID types.EntityID, epoch uin
 						tiles = append([]byte(nil), chunk.Tiles...) // Create copy of tiles
 						version = chunk.Version
 					}
-					cm.eventBus.PublishAsync(ecs.NewChunkLoadEvent(entityID, coord.X, coord.Y, cm.layer, tiles, epoch, version), eventbus.PriorityMedium)
+					cm.eventBus.PublishAsync(ecs.NewChunkLoadEvent(entityID, coord.X, coord.Y, cm.layer, tiles, epoch, version, cm.weatherAt(coord)), eventbus.PriorityMedium)
 				}
 			}
 		}
@@ -453,7 +454,7 @@ func (cm *ChunkManager) updateEntityAOI(entityID types.EntityID, newCenter types
 				tiles = append([]byte(nil), chunk.Tiles...) // Create copy of tiles
 				version = chunk.Version
 			}
-			cm.eventBus.PublishAsync(ecs.NewChunkLoadEvent(entityID, coord.X, coord.Y, cm.layer, tiles, epoch, version), eventbus.PriorityMedium)
+			cm.eventBus.PublishAsync(ecs.NewChunkLoadEvent(entityID, coord.X, coord.Y, cm.layer, tiles, epoch, version, cm.weatherAt(coord)), eventbus.PriorityMedium)
 		}
 	}
 }
@@ -885,6 +886,12 @@ func (cm *ChunkManager) activateChunkInternal(coord types.ChunkCoord, chunk *cor
 	preRecomputeDirty := make(map[types.Handle]bool, len(rawObjects))
 
 	for _, raw := range rawObjects {
+		// A moving object may have migrated out of this chunk before its new chunk
+		// was saved; the stale row must not spawn a second copy of it.
+		if existing := cm.world.GetHandleByEntityID(types.EntityID(raw.ID)); existing != types.InvalidHandle && cm.world.Alive(existing) {
+			continue
+		}
+
 		h, err := cm.objectFactory.Build(cm.world, raw, rawInventoriesByOwner[types.EntityID(raw.ID)])
 		if err != nil {
 			cm.logger.Error("failed to build object",
@@ -1304,6 +1311,101 @@ func (cm *ChunkManager) GetTileID(tileX, tileY int) (byte, bool) {
 	return chunk.TileID(localTileX, localTileY, chunkSize)
 }
 
+// SetTileID changes one tile of an active chunk. The chunk is persisted through its dirty tiles
+// and the change is sent to every client that has the chunk in the active zone.
+func (cm *ChunkManager) SetTileID(tileX, tileY int, tileID byte, tick uint64) bool {
+	chunkSize := _const.ChunkSize
+	chunkCoord := types.ChunkCoord{
+		X: mathutil.FloorDiv(tileX, chunkSize),
+		Y: mathutil.FloorDiv(tileY, chunkSize),
+	}
+	chunk := cm.GetChunk(chunkCoord)
+	if chunk == nil || chunk.GetState() != types.ChunkStateActive {
+		return false
+	}
+	localTileX := tileX - chunkCoord.X*chunkSize
+	localTileY := tileY - chunkCoord.Y*chunkSize
+	if !chunk.SetTile(localTileX, localTileY, chunkSize, tileID, tick) {
+		return false
+	}
+	cm.publishTileUpdate(chunkCoord, localTileX, localTileY, tileID, chunk.TilesVersion())
+	return true
+}
+
+// publishTileUpdate sends a changed tile to entities streaming its chunk.
+func (cm *ChunkManager) publishTileUpdate(coord types.ChunkCoord, localTileX, localTileY int, tileID byte, version uint32) {
+	if cm.eventBus == nil {
+		return
+	}
+
+	cm.interestMu.RLock()
+	var observers []types.EntityID
+	if interest, exists := cm.chunkInterests[coord]; exists {
+		observers = make([]types.EntityID, 0, len(interest.activeEntities))
+		for entityID := range interest.activeEntities {
+			observers = append(observers, entityID)
+		}
+	}
+	cm.interestMu.RUnlock()
+	if len(observers) == 0 {
+		return
+	}
+
+	cm.aoiMu.RLock()
+	defer cm.aoiMu.RUnlock()
+	for _, entityID := range observers {
+		aoi, exists := cm.entityAOIs[entityID]
+		if !exists || !aoi.SendChunkLoadEvents {
+			continue
+		}
+		cm.eventBus.PublishAsync(
+			ecs.NewChunkTileUpdateEvent(entityID, coord.X, coord.Y, cm.layer, localTileX, localTileY, tileID, aoi.StreamEpoch, version),
+			eventbus.PriorityMedium,
+		)
+	}
+}
+
+// weatherAt returns the weather clients see over the chunk.
+func (cm *ChunkManager) weatherAt(coord types.ChunkCoord) weather.Type {
+	if cm.world == nil {
+		return weather.Clear
+	}
+	return ecs.GetResource[ecs.WeatherState](cm.world).At(coord)
+}
+
+// PublishWeatherUpdate sends a chunk's new weather to entities streaming it.
+func (cm *ChunkManager) PublishWeatherUpdate(coord types.ChunkCoord, weatherType weather.Type) {
+	if cm.eventBus == nil {
+		return
+	}
+
+	cm.interestMu.RLock()
+	var observers []types.EntityID
+	if interest, exists := cm.chunkInterests[coord]; exists {
+		observers = make([]types.EntityID, 0, len(interest.activeEntities))
+		for entityID := range interest.activeEntities {
+			observers = append(observers, entityID)
+		}
+	}
+	cm.interestMu.RUnlock()
+	if len(observers) == 0 {
+		return
+	}
+
+	cm.aoiMu.RLock()
+	defer cm.aoiMu.RUnlock()
+	for _, entityID := range observers {
+		aoi, exists := cm.entityAOIs[entityID]
+		if !exists || !aoi.SendChunkLoadEvents {
+			continue
+		}
+		cm.eventBus.PublishAsync(
+			ecs.NewChunkWeatherEvent(entityID, coord.X, coord.Y, cm.layer, weatherType, aoi.StreamEpoch),
+			eventbus.PriorityMedium,
+		)
+	}
+}
+
 func (cm *ChunkManager) IsTilePassable(tileX, tileY int) bool {
 	chunkSize := _const.ChunkSize
 	chunkCoord := types.ChunkCoord{
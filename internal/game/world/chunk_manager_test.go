@@ -2,9 +2,11 @@ package world
 
 import (
 	"origin/internal/config"
+	_const "origin/internal/const"
 	"origin/internal/core"
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
+	ecssystems "origin/internal/ecs/systems"
 	"origin/internal/eventbus"
 	"origin/internal/objectdefs"
 	"origin/internal/persistence/repository"
@@ -437,3 +439,85 @@ func TestChunkManager_DeactivateChunkInternal_TracksOnlyDirtyRawObjects(t *testi
 		t.Fatalf("clean object id %d must not be marked dirty", cleanEntityID)
 	}
 }
+
+func TestChunkManager_AnimalMigrationPersistsAcrossReload(t *testing.T) {
+	const animalDefID = 960
+	previous := objectdefs.Global()
+	t.Cleanup(func() { objectdefs.SetGlobalForTesting(previous) })
+	objectdefs.SetGlobalForTesting(objectdefs.NewRegistry([]objectdefs.ObjectDef{{DefID: animalDefID, Key: "test_deer"}}))
+
+	cm := newTestChunkManager()
+	defer cm.Stop()
+
+	fromCoord := types.ChunkCoord{X: 2, Y: 2}
+	toCoord := types.ChunkCoord{X: 3, Y: 2}
+	fromChunk := core.NewChunk(fromCoord, 0, 0, _const.ChunkSize)
+	toChunk := core.NewChunk(toCoord, 0, 0, _const.ChunkSize)
+	for _, chunk := range []*core.Chunk{fromChunk, toChunk} {
+		chunk.SetState(types.ChunkStateActive)
+		cm.chunks[chunk.Coord] = chunk
+	}
+
+	animalID := types.EntityID(2001)
+	oldX, newX, y := toCoord.X*_const.ChunkWorldSize-4, toCoord.X*_const.ChunkWorldSize+4, fromCoord.Y*_const.ChunkWorldSize+10
+	animal := cm.world.Spawn(animalID, func(w *ecs.World, h types.Handle) {
+		ecs.AddComponent(w, h, components.EntityInfo{TypeID: animalDefID, Region: 0, Layer: 0})
+		ecs.AddComponent(w, h, components.Transform{X: float64(oldX), Y: float64(y)})
+		ecs.AddComponent(w, h, components.ChunkRef{CurrentChunkX: fromCoord.X, CurrentChunkY: fromCoord.Y})
+		ecs.AddComponent(w, h, components.ObjectInternalState{IsDirty: false})
+	})
+	fromChunk.Spatial().AddDynamic(animal, oldX, y)
+
+	// Movement applied by the transform system, then chunk migration.
+	fromChunk.Spatial().UpdateDynamic(animal, oldX, y, newX, y)
+	ecs.WithComponent(cm.world, animal, func(tr *components.Transform) {
+		tr.X = float64(newX)
+	})
+	ecs.GetResource[ecs.MovedEntities](cm.world).Add(animal, float64(newX), float64(y))
+	ecssystems.NewChunkSystem(cm, zap.NewNop()).Update(cm.world, 0)
+
+	if !fromChunk.IsDirty(cm.world) || !toChunk.IsDirty(cm.world) {
+		t.Fatalf("both chunks must be dirty after migration: from=%v to=%v", fromChunk.IsDirty(cm.world), toChunk.IsDirty(cm.world))
+	}
+
+	for _, chunk := range []*core.Chunk{fromChunk, toChunk} {
+		if err := cm.deactivateChunkInternal(chunk); err != nil {
+			t.Fatalf("deactivateChunkInternal(%v) failed: %v", chunk.Coord, err)
+		}
+	}
+	if len(fromChunk.GetRawObjects()) != 0 {
+		t.Fatalf("source chunk must not keep the migrated animal, got %d raw objects", len(fromChunk.GetRawObjects()))
+	}
+	toRaw := toChunk.GetRawObjects()
+	if len(toRaw) != 1 || toRaw[0].ID != int64(animalID) {
+		t.Fatalf("target chunk raw objects = %+v, want animal %d", toRaw, animalID)
+	}
+	if toRaw[0].ChunkX != toCoord.X || toRaw[0].X != newX {
+		t.Fatalf("saved animal at chunk %d x=%d, want chunk %d x=%d", toRaw[0].ChunkX, toRaw[0].X, toCoord.X, newX)
+	}
+	if _, dirty := toChunk.GetRawDirtyObjectIDs()[animalID]; !dirty {
+		t.Fatalf("migrated animal must be queued for save")
+	}
+
+	// Reload: the source chunk still has the pre-migration DB row until the target chunk is saved.
+	staleRow := *toRaw[0]
+	staleRow.ChunkX, staleRow.X = fromCoord.X, oldX
+	fromChunk.SetRawObjects([]*repository.Object{&staleRow})
+	for _, chunk := range []*core.Chunk{toChunk, fromChunk} {
+		if err := cm.activateChunkInternal(chunk.Coord, chunk); err != nil {
+			t.Fatalf("activateChunkInternal(%v) failed: %v", chunk.Coord, err)
+		}
+	}
+
+	if handles := fromChunk.GetHandles(); len(handles) != 0 {
+		t.Fatalf("stale row spawned a duplicate animal in source chunk: %d handles", len(handles))
+	}
+	handles := toChunk.GetHandles()
+	if len(handles) != 1 {
+		t.Fatalf("target chunk handles = %d, want 1", len(handles))
+	}
+	transform, _ := ecs.GetComponent[components.Transform](cm.world, handles[0])
+	if int(transform.X) != newX {
+		t.Fatalf("reloaded animal x = %v, want %d", transform.X, newX)
+	}
+}
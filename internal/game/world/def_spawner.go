@@ -1,6 +1,7 @@
 package world
 
 import (
+	constt "origin/internal/const"
 	"origin/internal/ecs"
 	"origin/internal/ecs/components"
 	"origin/internal/game/behaviors/contracts"
@@ -45,6 +46,16 @@ func SpawnEntityFromDef(w *ecs.World, def *objectdefs.ObjectDef, params DefSpawn
 		if def.Components != nil && def.Components.Collider != nil {
 			ecs.AddComponent(w, h, objectdefs.BuildColliderComponent(def.Components.Collider))
 		}
+		if def.Components != nil && def.Components.Movement != nil {
+			ecs.AddComponent(w, h, components.Movement{
+				Mode:         constt.Walk,
+				State:        constt.StateIdle,
+				Speed:        def.Components.Movement.Speed,
+				TargetType:   constt.TargetNone,
+				TargetHandle: types.InvalidHandle,
+			})
+			ecs.AddComponent(w, h, components.CollisionResult{})
+		}
 
 		resource := objectdefs.ResolveAppearanceResource(def, nil)
 		if resource == "" {
@@ -368,6 +368,9 @@ type objectInventoryItem struct {
 	TypeID          uint32                 `json:"type_id"`
 	Quality         uint32                 `json:"quality"`
 	Quantity        uint32                 `json:"quantity"`
+	Wear            uint32                 `json:"wear,omitempty"`
+	Spoil           uint64                 `json:"spoil,omitempty"`
+	SpoilTick       uint64                 `json:"last_tick,omitempty"`
 	X               uint8                  `json:"x,omitempty"`
 	Y               uint8                  `json:"y,omitempty"`
 	EquipSlot       string                 `json:"equip_slot,omitempty"`
@@ -530,6 +533,9 @@ func (f *ObjectFactory) spawnContainerTreeFromData(
 			Resource:  itemDef.ResolveResource(hasNestedItems),
 			Quality:   dbItem.Quality,
 			Quantity:  dbItem.Quantity,
+			Wear:      dbItem.Wear,
+			Spoil:     dbItem.Spoil,
+			SpoilTick: dbItem.SpoilTick,
 			W:         uint8(itemDef.Size.W),
 			H:         uint8(itemDef.Size.H),
 			X:         dbItem.X,
@@ -731,6 +737,18 @@ func (f *ObjectFactory) DeserializeObjectState(raw *repository.Object) (any, err
 				return nil, fmt.Errorf("failed to decode take state: %w", err)
 			}
 			runtimeState.Behaviors[behaviorKey] = &takeState
+		case "crop":
+			var cropState components.CropBehaviorState
+			if err := json.Unmarshal(rawBehaviorState, &cropState); err != nil {
+				return nil, fmt.Errorf("failed to decode crop state: %w", err)
+			}
+			runtimeState.Behaviors[behaviorKey] = &cropState
+		case "processor":
+			var processorState components.ProcessorBehaviorState
+			if err := json.Unmarshal(rawBehaviorState, &processorState); err != nil {
+				return nil, fmt.Errorf("failed to decode processor state: %w", err)
+			}
+			runtimeState.Behaviors[behaviorKey] = &processorState
 		case "build":
 			var buildState components.BuildBehaviorState
 			if err := json.Unmarshal(rawBehaviorState, &buildState); err != nil {
@@ -1003,6 +1021,9 @@ func (f *ObjectFactory) serializeContainerData(
 			TypeID:    item.TypeID,
 			Quality:   item.Quality,
 			Quantity:  item.Quantity,
+			Wear:      item.Wear,
+			Spoil:     item.Spoil,
+			SpoilTick: item.SpoilTick,
 			X:         item.X,
 			Y:         item.Y,
 			EquipSlot: equipSlotToString(item.EquipSlot),
@@ -1044,6 +1065,9 @@ func (f *ObjectFactory) serializeContainerDataLevel1(
 			TypeID:    item.TypeID,
 			Quality:   item.Quality,
 			Quantity:  item.Quantity,
+			Wear:      item.Wear,
+			Spoil:     item.Spoil,
+			SpoilTick: item.SpoilTick,
 			X:         item.X,
 			Y:         item.Y,
 			EquipSlot: equipSlotToString(item.EquipSlot),
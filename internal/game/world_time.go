@@ -0,0 +1,70 @@
+package game
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"origin/internal/ecs"
+	netproto "origin/internal/network/proto"
+	"origin/internal/timeutil"
+)
+
+// worldTimeSyncTicks converts the S2C_WorldTime broadcast period into ticks, at least one.
+func worldTimeSyncTicks(interval time.Duration, tickPeriod time.Duration) uint64 {
+	if tickPeriod <= 0 || interval <= tickPeriod {
+		return 1
+	}
+	return uint64(interval / tickPeriod)
+}
+
+func worldTimeProto(t timeutil.WorldTime) *netproto.S2C_WorldTime {
+	return &netproto.S2C_WorldTime{
+		Day:              uint64(t.Day),
+		SecondOfDay:      uint32(t.SecondOfDay),
+		DayLengthSeconds: uint32(t.DayLengthSeconds),
+		Season:           netproto.Season(t.Season),
+		DayOfSeason:      uint32(t.DayOfSeason),
+		SeasonDays:       uint32(t.SeasonDays),
+		Year:             uint64(t.Year),
+	}
+}
+
+// currentWorldTime returns the calendar time of the persisted runtime, safe off the game loop.
+func (g *Game) currentWorldTime() timeutil.WorldTime {
+	g.timeStateMu.RLock()
+	runtimeSeconds := g.runtimeSecondsTotal
+	g.timeStateMu.RUnlock()
+	return g.calendar.At(runtimeSeconds)
+}
+
+// syncWorldTime periodically sends the world time to every player in the world so client
+// clocks do not drift. Runs on the game loop after shards tick.
+func (g *Game) syncWorldTime(ts ecs.TimeState) {
+	if g.shardManager == nil || g.worldTimeSyncTicks == 0 || ts.Tick%g.worldTimeSyncTicks != 0 {
+		return
+	}
+
+	data, err := proto.Marshal(&netproto.ServerMessage{
+		Payload: &netproto.ServerMessage_WorldTime{
+			WorldTime: worldTimeProto(ts.WorldTime),
+		},
+	})
+	if err != nil {
+		g.logger.Error("Failed to marshal world time", zap.Error(err))
+		return
+	}
+	for _, shard := range g.shardManager.GetShards() {
+		if shard == nil {
+			continue
+		}
+		shard.ClientsMu.RLock()
+		for _, client := range shard.Clients {
+			if client != nil && client.InWorld.Load() {
+				client.Send(data)
+			}
+		}
+		shard.ClientsMu.RUnlock()
+	}
+}
@@ -0,0 +1,30 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	netproto "origin/internal/network/proto"
+	"origin/internal/timeutil"
+)
+
+func TestWorldTimeSyncTicks(t *testing.T) {
+	if got := worldTimeSyncTicks(time.Minute, 100*time.Millisecond); got != 600 {
+		t.Fatalf("expected 600 ticks, got %d", got)
+	}
+	if got := worldTimeSyncTicks(0, 100*time.Millisecond); got != 1 {
+		t.Fatalf("expected at least one tick, got %d", got)
+	}
+}
+
+func TestWorldTimeProto(t *testing.T) {
+	calendar := timeutil.NewCalendar(time.Hour, 2)
+	msg := worldTimeProto(calendar.At(6*3600 + 900))
+
+	if msg.Day != 6 || msg.SecondOfDay != 900+1200 || msg.DayLengthSeconds != 3600 {
+		t.Fatalf("unexpected day fields: %+v", msg)
+	}
+	if msg.Season != netproto.Season_SEASON_WINTER || msg.DayOfSeason != 0 || msg.SeasonDays != 2 || msg.Year != 0 {
+		t.Fatalf("unexpected season fields: %+v", msg)
+	}
+}
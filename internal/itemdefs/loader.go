@@ -105,6 +105,15 @@ func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
 		)
 	}
 
+	for _, item := range allItems {
+		if item.Spoil == nil || item.Spoil.SpoiledItemKey == "" {
+			continue
+		}
+		if err := validateSpoiledItem(&item, allItems, seenKeys[item.Key]); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Info("Item definitions loaded",
 		zap.Int("files", len(files)),
 		zap.Int("items", len(allItems)),
@@ -237,9 +246,111 @@ func validateItem(item *ItemDef, filePath string) error {
 		}
 	}
 
+	if item.Food != nil {
+		if item.Food.Energy < 0 {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message:  "food.energy must be >= 0",
+			}
+		}
+		switch item.Food.QualityScaling {
+		case "", FoodQualityScalingNone, FoodQualityScalingLinear, FoodQualityScalingSqrt:
+			// ok
+		default:
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message: fmt.Sprintf("invalid food.qualityScaling '%s', expected 'none', 'linear' or 'sqrt'",
+					item.Food.QualityScaling),
+			}
+		}
+		if exp := item.Food.Exp; exp != nil && (exp.LP < 0 || exp.Nature < 0 || exp.Industry < 0 || exp.Combat < 0) {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message:  "food.exp values must be >= 0",
+			}
+		}
+	}
+
+	if item.Durability > 0 && item.Stack != nil && item.Stack.Mode == StackModeStack {
+		return &LoadError{
+			FilePath: filePath,
+			DefID:    item.DefID,
+			Key:      item.Key,
+			Message:  "durability requires stack.mode 'none' (wear is per item)",
+		}
+	}
+
+	if item.Spoil != nil {
+		if item.Spoil.Ticks < 1 {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message:  "spoil.ticks must be >= 1",
+			}
+		}
+		if item.Stack != nil && item.Stack.Mode == StackModeStack {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message:  "spoil requires stack.mode 'none' (freshness is per item)",
+			}
+		}
+	}
+
+	if item.Weapon != nil && item.Weapon.Damage < 0 {
+		return &LoadError{
+			FilePath: filePath,
+			DefID:    item.DefID,
+			Key:      item.Key,
+			Message:  "weapon.damage must be >= 0",
+		}
+	}
+
+	if item.Container != nil && item.Container.SpoilRate != nil && *item.Container.SpoilRate < 0 {
+		return &LoadError{
+			FilePath: filePath,
+			DefID:    item.DefID,
+			Key:      item.Key,
+			Message:  "container.spoilRate must be >= 0",
+		}
+	}
+
 	return nil
 }
 
+// validateSpoiledItem checks that the item a spoiled item turns into exists and fits in its place.
+func validateSpoiledItem(item *ItemDef, allItems []ItemDef, filePath string) error {
+	for i := range allItems {
+		spoiled := &allItems[i]
+		if spoiled.Key != item.Spoil.SpoiledItemKey {
+			continue
+		}
+		if spoiled.Size != item.Size {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    item.DefID,
+				Key:      item.Key,
+				Message:  fmt.Sprintf("spoil.spoiledItemKey '%s' must have the same size", spoiled.Key),
+			}
+		}
+		return nil
+	}
+	return &LoadError{
+		FilePath: filePath,
+		DefID:    item.DefID,
+		Key:      item.Key,
+		Message:  fmt.Sprintf("spoil.spoiledItemKey '%s' is not defined", item.Spoil.SpoiledItemKey),
+	}
+}
+
 func applyDefaults(item *ItemDef) {
 	trueVal := true
 	emptySlice := []string{}
@@ -259,4 +370,12 @@ func applyDefaults(item *ItemDef) {
 	if item.DiscoveryLP == 0 {
 		item.DiscoveryLP = 50
 	}
+	if item.Food != nil {
+		if item.Food.EatTicks == 0 {
+			item.Food.EatTicks = DefaultFoodEatTicks
+		}
+		if item.Food.QualityScaling == "" {
+			item.Food.QualityScaling = FoodQualityScalingSqrt
+		}
+	}
 }
@@ -469,3 +469,214 @@ func TestLoadFromDirectory_InvalidContainerHeight(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "container.size.h must be >= 1")
 }
+
+func TestLoadFromDirectory_FoodDefaults(t *testing.T) {
+	dir := t.TempDir()
+
+	json := `{
+		"v": 1,
+		"source": "test",
+		"items": [
+			{
+				"defId": 2001,
+				"key": "apple",
+				"name": "Apple",
+				"tags": ["food"],
+				"size": { "w": 1, "h": 1 },
+				"food": { "energy": 60, "exp": { "nature": 2 } }
+			}
+		]
+	}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+	registry, err := LoadFromDirectory(dir, testLogger())
+	require.NoError(t, err)
+
+	item, ok := registry.GetByKey("apple")
+	require.True(t, ok)
+	require.NotNil(t, item.Food)
+	assert.Equal(t, 60.0, item.Food.Energy)
+	assert.Equal(t, DefaultFoodEatTicks, item.Food.EatTicks)
+	assert.Equal(t, FoodQualityScalingSqrt, item.Food.QualityScaling)
+	require.NotNil(t, item.Food.Exp)
+	assert.Equal(t, int64(2), item.Food.Exp.Nature)
+}
+
+func TestLoadFromDirectory_InvalidFood(t *testing.T) {
+	tests := []struct {
+		name    string
+		food    string
+		wantErr string
+	}{
+		{name: "negative energy", food: `{ "energy": -1 }`, wantErr: "food.energy must be >= 0"},
+		{name: "unknown scaling", food: `{ "energy": 10, "qualityScaling": "cubic" }`, wantErr: "invalid food.qualityScaling"},
+		{name: "negative exp", food: `{ "energy": 10, "exp": { "lp": -5 } }`, wantErr: "food.exp values must be >= 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			json := `{
+				"v": 1,
+				"source": "test",
+				"items": [{ "defId": 2001, "key": "apple", "name": "Apple", "tags": [], "size": { "w": 1, "h": 1 }, "food": ` + tt.food + ` }]
+			}`
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+			_, err := LoadFromDirectory(dir, testLogger())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFoodDef_QualityMultiplier(t *testing.T) {
+	tests := []struct {
+		scaling string
+		quality uint32
+		want    float64
+	}{
+		{scaling: FoodQualityScalingSqrt, quality: 10, want: 1},
+		{scaling: FoodQualityScalingSqrt, quality: 40, want: 2},
+		{scaling: FoodQualityScalingLinear, quality: 25, want: 2.5},
+		{scaling: FoodQualityScalingLinear, quality: 0, want: 0.1},
+		{scaling: FoodQualityScalingNone, quality: 90, want: 1},
+	}
+
+	for _, tt := range tests {
+		food := &FoodDef{Energy: 10, QualityScaling: tt.scaling}
+		assert.InDelta(t, tt.want, food.QualityMultiplier(tt.quality), 1e-9, "%s q%d", tt.scaling, tt.quality)
+	}
+}
+
+func TestLoadFromDirectory_DurabilityRequiresUnstackable(t *testing.T) {
+	dir := t.TempDir()
+	json := `{
+		"v": 1,
+		"source": "test",
+		"items": [{
+			"defId": 1002, "key": "stone_axe", "name": "Stone Axe", "tags": ["axe"], "size": { "w": 1, "h": 1 },
+			"stack": { "mode": "stack", "max": 10 }, "durability": 100
+		}]
+	}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+	_, err := LoadFromDirectory(dir, testLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "durability requires stack.mode 'none'")
+}
+
+func TestLoadFromDirectory_WeaponDamageMustNotBeNegative(t *testing.T) {
+	dir := t.TempDir()
+	json := `{
+		"v": 1,
+		"source": "test",
+		"items": [{
+			"defId": 1002, "key": "stone_axe", "name": "Stone Axe", "tags": ["axe"], "size": { "w": 1, "h": 1 },
+			"weapon": { "damage": -1 }
+		}]
+	}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+	_, err := LoadFromDirectory(dir, testLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "weapon.damage must be >= 0")
+}
+
+func TestItemDef_MaxDurability(t *testing.T) {
+	tests := []struct {
+		durability uint32
+		quality    uint32
+		want       uint32
+	}{
+		{durability: 100, quality: 10, want: 100},
+		{durability: 100, quality: 40, want: 200},
+		{durability: 100, quality: 0, want: 32},
+		{durability: 1, quality: 1, want: 1},
+		{durability: 0, quality: 50, want: 0},
+	}
+
+	for _, tt := range tests {
+		item := &ItemDef{Durability: tt.durability}
+		assert.Equal(t, tt.want, item.MaxDurability(tt.quality), "durability %d q%d", tt.durability, tt.quality)
+	}
+}
+
+func TestLoadFromDirectory_SpoilValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   string
+		wantErr string
+	}{
+		{
+			name:    "zero ticks",
+			items:   `{"defId": 2001, "key": "apple", "name": "Apple", "tags": [], "size": {"w": 1, "h": 1}, "spoil": {"ticks": 0}}`,
+			wantErr: "spoil.ticks must be >= 1",
+		},
+		{
+			name:    "stackable",
+			items:   `{"defId": 2001, "key": "apple", "name": "Apple", "tags": [], "size": {"w": 1, "h": 1}, "stack": {"mode": "stack", "max": 10}, "spoil": {"ticks": 100}}`,
+			wantErr: "spoil requires stack.mode 'none'",
+		},
+		{
+			name:    "unknown spoiled item",
+			items:   `{"defId": 2001, "key": "apple", "name": "Apple", "tags": [], "size": {"w": 1, "h": 1}, "spoil": {"ticks": 100, "spoiledItemKey": "spoiled_apple"}}`,
+			wantErr: "spoil.spoiledItemKey 'spoiled_apple' is not defined",
+		},
+		{
+			name: "spoiled item of another size",
+			items: `{"defId": 2001, "key": "apple", "name": "Apple", "tags": [], "size": {"w": 1, "h": 1}, "spoil": {"ticks": 100, "spoiledItemKey": "spoiled_apple"}},
+				{"defId": 2101, "key": "spoiled_apple", "name": "Spoiled Apple", "tags": [], "size": {"w": 2, "h": 1}}`,
+			wantErr: "spoil.spoiledItemKey 'spoiled_apple' must have the same size",
+		},
+		{
+			name:    "negative container spoil rate",
+			items:   `{"defId": 4001, "key": "basket", "name": "Basket", "tags": [], "size": {"w": 1, "h": 1}, "container": {"size": {"w": 2, "h": 2}, "spoilRate": -1}}`,
+			wantErr: "container.spoilRate must be >= 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			json := `{"v": 1, "source": "test", "items": [` + tt.items + `]}`
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+			_, err := LoadFromDirectory(dir, testLogger())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadFromDirectory_SpoilWithSpoiledItem(t *testing.T) {
+	dir := t.TempDir()
+	json := `{
+		"v": 1,
+		"source": "test",
+		"items": [
+			{"defId": 2001, "key": "apple", "name": "Apple", "tags": ["food"], "size": {"w": 1, "h": 1}, "spoil": {"ticks": 100, "spoiledItemKey": "spoiled_apple"}},
+			{"defId": 2101, "key": "spoiled_apple", "name": "Spoiled Apple", "tags": [], "size": {"w": 1, "h": 1}}
+		]
+	}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(json), 0644))
+
+	registry, err := LoadFromDirectory(dir, testLogger())
+	require.NoError(t, err)
+	apple, ok := registry.GetByKey("apple")
+	require.True(t, ok)
+	require.NotNil(t, apple.Spoil)
+	assert.Equal(t, uint64(100), apple.Spoil.Ticks)
+	assert.Equal(t, "spoiled_apple", apple.Spoil.SpoiledItemKey)
+}
+
+func TestSpoilDef_Freshness(t *testing.T) {
+	spoil := &SpoilDef{Ticks: 200}
+	assert.Equal(t, 1.0, spoil.Freshness(0))
+	assert.Equal(t, 0.75, spoil.Freshness(50))
+	assert.Equal(t, 0.0, spoil.Freshness(200))
+	assert.Equal(t, 0.0, spoil.Freshness(500))
+
+	var none *SpoilDef
+	assert.Equal(t, 1.0, none.Freshness(500))
+}
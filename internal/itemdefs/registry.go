@@ -2,6 +2,7 @@ package itemdefs
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 // Registry holds all loaded item definitions.
@@ -12,7 +13,7 @@ type Registry struct {
 }
 
 var (
-	globalRegistry *Registry
+	globalRegistry atomic.Pointer[Registry]
 	registryOnce   sync.Once
 )
 
@@ -59,17 +60,23 @@ func (r *Registry) All() []*ItemDef {
 // SetGlobal sets the global registry (should be called once at startup).
 func SetGlobal(r *Registry) {
 	registryOnce.Do(func() {
-		globalRegistry = r
+		globalRegistry.Store(r)
 	})
 }
 
 // SetGlobalForTesting replaces the global registry without sync.Once protection.
 // Must only be used in tests.
 func SetGlobalForTesting(r *Registry) {
-	globalRegistry = r
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
 }
 
 // Global returns the global registry.
 func Global() *Registry {
-	return globalRegistry
+	return globalRegistry.Load()
 }
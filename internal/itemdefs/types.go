@@ -1,5 +1,7 @@
 package itemdefs
 
+import "math"
+
 // ItemDef represents a single item definition.
 type ItemDef struct {
 	DefID       int      `json:"defId"`
@@ -16,6 +18,39 @@ type ItemDef struct {
 	// Container describes nested inventory capabilities for this item (e.g. seed bag).
 	// If nil, the item is not a container.
 	Container *ContainerDef `json:"container,omitempty"`
+
+	// Food makes the item edible through the inventory "eat" action.
+	// If nil, the item cannot be eaten.
+	Food *FoodDef `json:"food,omitempty"`
+
+	// Durability is how many cycles of work a tool lasts at DurabilityBaseQuality.
+	// If 0, the item never wears out.
+	Durability uint32 `json:"durability,omitempty"`
+
+	// Spoil makes the item decay over server time.
+	// If nil, the item never spoils.
+	Spoil *SpoilDef `json:"spoil,omitempty"`
+
+	// Weapon adds melee damage to combat moves while the item is equipped.
+	// If nil, the item is not a weapon.
+	Weapon *WeaponDef `json:"weapon,omitempty"`
+}
+
+// DurabilityBaseQuality is the item quality at which a tool has exactly its defined durability.
+const DurabilityBaseQuality = 10
+
+// MaxDurability returns the durability of an item instance of the given quality:
+// Durability * sqrt(quality / DurabilityBaseQuality), at least 1. Quality below 1 is treated as 1.
+// Returns 0 for items that never wear out.
+func (d *ItemDef) MaxDurability(quality uint32) uint32 {
+	if d == nil || d.Durability == 0 {
+		return 0
+	}
+	if quality < 1 {
+		quality = 1
+	}
+	scaled := math.Round(float64(d.Durability) * math.Sqrt(float64(quality)/DurabilityBaseQuality))
+	return uint32(max(scaled, 1))
 }
 
 // Size represents item dimensions in inventory grid.
@@ -47,6 +82,16 @@ type ContainerDef struct {
 	Size Size `json:"size"`
 	// ContentRules limit what items can be placed into this container.
 	Rules ContentRules `json:"rules"`
+	// SpoilRate scales how fast items inside spoil. If nil, DefaultSpoilRate is used.
+	SpoilRate *float64 `json:"spoilRate,omitempty"`
+}
+
+// ItemSpoilRate returns the spoil rate of items inside the container.
+func (c *ContainerDef) ItemSpoilRate() float64 {
+	if c == nil || c.SpoilRate == nil {
+		return DefaultSpoilRate
+	}
+	return *c.SpoilRate
 }
 
 // ContentRules defines allow/deny constraints for items placed inside the container.
@@ -64,6 +109,90 @@ type ContentRules struct {
 	DenyItemKeys []string `json:"denyItemKeys,omitempty"`
 }
 
+// FoodDef describes what eating one unit of the item gives.
+type FoodDef struct {
+	// Energy restored per unit at FoodBaseQuality.
+	Energy float64 `json:"energy"`
+	// EatTicks is the duration of eating one unit (one cycle of the eat action).
+	EatTicks uint32 `json:"eatTicks,omitempty"`
+	// QualityScaling selects how item quality scales energy and experience.
+	QualityScaling string `json:"qualityScaling,omitempty"`
+	// Exp is experience granted per unit, scaled by quality like energy.
+	Exp *FoodExp `json:"exp,omitempty"`
+}
+
+// FoodExp lists experience gains by kind (see components.CharacterExperience).
+type FoodExp struct {
+	LP       int64 `json:"lp,omitempty"`
+	Nature   int64 `json:"nature,omitempty"`
+	Industry int64 `json:"industry,omitempty"`
+	Combat   int64 `json:"combat,omitempty"`
+}
+
+// FoodBaseQuality is the item quality at which food gives exactly its defined values.
+const FoodBaseQuality = 10
+
+// DefaultFoodEatTicks is used when FoodDef.EatTicks is not set.
+const DefaultFoodEatTicks uint32 = 20
+
+// Food quality scaling modes.
+const (
+	// FoodQualityScalingNone ignores quality.
+	FoodQualityScalingNone = "none"
+	// FoodQualityScalingLinear multiplies by quality / FoodBaseQuality.
+	FoodQualityScalingLinear = "linear"
+	// FoodQualityScalingSqrt multiplies by sqrt(quality / FoodBaseQuality). Default.
+	FoodQualityScalingSqrt = "sqrt"
+)
+
+// QualityMultiplier returns the factor applied to energy and experience for an item of the given quality.
+// Quality below 1 is treated as 1.
+func (f *FoodDef) QualityMultiplier(quality uint32) float64 {
+	if f == nil {
+		return 0
+	}
+	if quality < 1 {
+		quality = 1
+	}
+	ratio := float64(quality) / FoodBaseQuality
+	switch f.QualityScaling {
+	case FoodQualityScalingNone:
+		return 1
+	case FoodQualityScalingLinear:
+		return ratio
+	default:
+		return math.Sqrt(ratio)
+	}
+}
+
+// SpoilDef describes how an item decays over server time.
+type SpoilDef struct {
+	// Ticks is how long the item takes to spoil fully at DefaultSpoilRate.
+	Ticks uint64 `json:"ticks"`
+	// SpoiledItemKey is the item a fully spoiled item turns into. If empty, the item vanishes.
+	SpoiledItemKey string `json:"spoiledItemKey,omitempty"`
+}
+
+// WeaponDef describes an item used as a melee weapon.
+type WeaponDef struct {
+	// Damage is added to the base power of every combat move.
+	Damage float64 `json:"damage"`
+}
+
+// DefaultSpoilRate is the spoil rate outside of containers that set their own (player inventory, ground).
+const DefaultSpoilRate = 1.0
+
+// Freshness returns how fresh an item with the given accumulated spoilage is, from 1 (fresh) to 0 (spoiled).
+func (s *SpoilDef) Freshness(spoil uint64) float64 {
+	if s == nil || s.Ticks == 0 {
+		return 1
+	}
+	if spoil >= s.Ticks {
+		return 0
+	}
+	return 1 - float64(spoil)/float64(s.Ticks)
+}
+
 // Visual defines rules for computing resource path dynamically.
 type Visual struct {
 	NestedInventory *NestedInventoryVisual `json:"nestedInventory,omitempty"`
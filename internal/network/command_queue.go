@@ -30,6 +30,14 @@ const (
 	CmdLiftPutDown
 	CmdOpenWindow
 	CmdCloseWindow
+	CmdItemContextMenu
+	CmdItemAction
+	CmdSkillList
+	CmdLearnSkill
+	CmdTrainAttribute
+	CmdAttack
+	CmdCombatMove
+	CmdFollow
 )
 
 // PlayerCommand represents an intent from a client to be processed by ECS
@@ -47,6 +55,8 @@ type PlayerCommand struct {
 type ChatCommandPayload struct {
 	Channel netproto.ChatChannel
 	Text    string
+	// TargetID is the recipient character for CHAT_CHANNEL_PRIVATE, zero otherwise
+	TargetID types.EntityID
 }
 
 // Server job type constants
@@ -57,6 +67,8 @@ const (
 	JobSendMovementModeSnapshot
 	JobSendCraftListSnapshot
 	JobSendBuildListSnapshot
+	JobAdminGiveItem
+	JobSaveAllCharacters
 )
 
 // ServerJob represents an internal job to be processed by ECS
@@ -99,6 +111,27 @@ type BuildListSnapshotJobPayload struct {
 	Handle types.Handle
 }
 
+// ServerJobResult reports the outcome of a server job to a waiting caller.
+type ServerJobResult struct {
+	Message string
+	Err     error
+}
+
+// AdminGiveItemJobPayload is the payload for JobAdminGiveItem.
+// Result must be buffered so the shard never blocks on a caller that gave up waiting.
+type AdminGiveItemJobPayload struct {
+	ItemKey string
+	Count   uint32
+	Quality uint32
+	Result  chan<- ServerJobResult
+}
+
+// SaveAllCharactersJobPayload is the payload for JobSaveAllCharacters.
+// Result must be buffered, see AdminGiveItemJobPayload.
+type SaveAllCharactersJobPayload struct {
+	Result chan<- ServerJobResult
+}
+
 // CommandQueueConfig holds configuration for command queues
 type CommandQueueConfig struct {
 	MaxQueueSize                int // Maximum commands in queue before overflow (default: 500)
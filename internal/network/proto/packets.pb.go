@@ -328,6 +328,8 @@ const (
 	ErrorCode_ERROR_CODE_RECIPE_UNKNOWN                 ErrorCode = 14
 	ErrorCode_ERROR_PACKET_PER_SECOND_LIMIT_THRESHOLDED ErrorCode = 15
 	ErrorCode_ERROR_CODE_INTERNAL_ERROR                 ErrorCode = 16
+	ErrorCode_ERROR_CODE_KICKED                         ErrorCode = 17 // сервер закрывает соединение (kick/ban)
+	ErrorCode_ERROR_CODE_MUTED                          ErrorCode = 18 // чат заблокирован модератором
 )
 
 // Enum value maps for ErrorCode.
@@ -350,6 +352,8 @@ var (
 		14: "ERROR_CODE_RECIPE_UNKNOWN",
 		15: "ERROR_PACKET_PER_SECOND_LIMIT_THRESHOLDED",
 		16: "ERROR_CODE_INTERNAL_ERROR",
+		17: "ERROR_CODE_KICKED",
+		18: "ERROR_CODE_MUTED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_NONE":                           0,
@@ -369,6 +373,8 @@ var (
 		"ERROR_CODE_RECIPE_UNKNOWN":                 14,
 		"ERROR_PACKET_PER_SECOND_LIMIT_THRESHOLDED": 15,
 		"ERROR_CODE_INTERNAL_ERROR":                 16,
+		"ERROR_CODE_KICKED":                         17,
+		"ERROR_CODE_MUTED":                          18,
 	}
 )
 
@@ -613,6 +619,116 @@ func (ChatChannel) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_packets_proto_rawDescGZIP(), []int{9}
 }
 
+type PartyAction int32
+
+const (
+	PartyAction_PARTY_ACTION_UNSPECIFIED PartyAction = 0
+	PartyAction_PARTY_ACTION_INVITE      PartyAction = 1 // target = приглашаемый
+	PartyAction_PARTY_ACTION_ACCEPT      PartyAction = 2 // target = пригласивший
+	PartyAction_PARTY_ACTION_DECLINE     PartyAction = 3 // target = пригласивший
+	PartyAction_PARTY_ACTION_LEAVE       PartyAction = 4
+	PartyAction_PARTY_ACTION_KICK        PartyAction = 5 // target = исключаемый (только лидер)
+)
+
+// Enum value maps for PartyAction.
+var (
+	PartyAction_name = map[int32]string{
+		0: "PARTY_ACTION_UNSPECIFIED",
+		1: "PARTY_ACTION_INVITE",
+		2: "PARTY_ACTION_ACCEPT",
+		3: "PARTY_ACTION_DECLINE",
+		4: "PARTY_ACTION_LEAVE",
+		5: "PARTY_ACTION_KICK",
+	}
+	PartyAction_value = map[string]int32{
+		"PARTY_ACTION_UNSPECIFIED": 0,
+		"PARTY_ACTION_INVITE":      1,
+		"PARTY_ACTION_ACCEPT":      2,
+		"PARTY_ACTION_DECLINE":     3,
+		"PARTY_ACTION_LEAVE":       4,
+		"PARTY_ACTION_KICK":        5,
+	}
+)
+
+func (x PartyAction) Enum() *PartyAction {
+	p := new(PartyAction)
+	*p = x
+	return p
+}
+
+func (x PartyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_packets_proto_enumTypes[10].Descriptor()
+}
+
+func (PartyAction) Type() protoreflect.EnumType {
+	return &file_api_proto_packets_proto_enumTypes[10]
+}
+
+func (x PartyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartyAction.Descriptor instead.
+func (PartyAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{10}
+}
+
+type Season int32
+
+const (
+	Season_SEASON_SPRING Season = 0
+	Season_SEASON_SUMMER Season = 1
+	Season_SEASON_AUTUMN Season = 2
+	Season_SEASON_WINTER Season = 3
+)
+
+// Enum value maps for Season.
+var (
+	Season_name = map[int32]string{
+		0: "SEASON_SPRING",
+		1: "SEASON_SUMMER",
+		2: "SEASON_AUTUMN",
+		3: "SEASON_WINTER",
+	}
+	Season_value = map[string]int32{
+		"SEASON_SPRING": 0,
+		"SEASON_SUMMER": 1,
+		"SEASON_AUTUMN": 2,
+		"SEASON_WINTER": 3,
+	}
+)
+
+func (x Season) Enum() *Season {
+	p := new(Season)
+	*p = x
+	return p
+}
+
+func (x Season) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Season) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_packets_proto_enumTypes[11].Descriptor()
+}
+
+func (Season) Type() protoreflect.EnumType {
+	return &file_api_proto_packets_proto_enumTypes[11]
+}
+
+func (x Season) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Season.Descriptor instead.
+func (Season) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{11}
+}
+
 type AlertSeverity int32
 
 const (
@@ -646,11 +762,11 @@ func (x AlertSeverity) String() string {
 }
 
 func (AlertSeverity) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_packets_proto_enumTypes[10].Descriptor()
+	return file_api_proto_packets_proto_enumTypes[12].Descriptor()
 }
 
 func (AlertSeverity) Type() protoreflect.EnumType {
-	return &file_api_proto_packets_proto_enumTypes[10]
+	return &file_api_proto_packets_proto_enumTypes[12]
 }
 
 func (x AlertSeverity) Number() protoreflect.EnumNumber {
@@ -659,7 +775,7 @@ func (x AlertSeverity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertSeverity.Descriptor instead.
 func (AlertSeverity) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{12}
 }
 
 type CyclicActionFinishResult int32
@@ -695,11 +811,11 @@ func (x CyclicActionFinishResult) String() string {
 }
 
 func (CyclicActionFinishResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_packets_proto_enumTypes[11].Descriptor()
+	return file_api_proto_packets_proto_enumTypes[13].Descriptor()
 }
 
 func (CyclicActionFinishResult) Type() protoreflect.EnumType {
-	return &file_api_proto_packets_proto_enumTypes[11]
+	return &file_api_proto_packets_proto_enumTypes[13]
 }
 
 func (x CyclicActionFinishResult) Number() protoreflect.EnumNumber {
@@ -708,7 +824,62 @@ func (x CyclicActionFinishResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CyclicActionFinishResult.Descriptor instead.
 func (CyclicActionFinishResult) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{13}
+}
+
+type FollowStopReason int32
+
+const (
+	FollowStopReason_FOLLOW_STOP_REASON_NONE         FollowStopReason = 0
+	FollowStopReason_FOLLOW_STOP_REASON_STOPPED      FollowStopReason = 1 // остановлено командой или другим движением
+	FollowStopReason_FOLLOW_STOP_REASON_TARGET_LOST  FollowStopReason = 2 // цель исчезла или пропала из видимости
+	FollowStopReason_FOLLOW_STOP_REASON_LINKED       FollowStopReason = 3 // установлена связь с объектом
+	FollowStopReason_FOLLOW_STOP_REASON_PATH_BLOCKED FollowStopReason = 4 // до цели нет пути
+)
+
+// Enum value maps for FollowStopReason.
+var (
+	FollowStopReason_name = map[int32]string{
+		0: "FOLLOW_STOP_REASON_NONE",
+		1: "FOLLOW_STOP_REASON_STOPPED",
+		2: "FOLLOW_STOP_REASON_TARGET_LOST",
+		3: "FOLLOW_STOP_REASON_LINKED",
+		4: "FOLLOW_STOP_REASON_PATH_BLOCKED",
+	}
+	FollowStopReason_value = map[string]int32{
+		"FOLLOW_STOP_REASON_NONE":         0,
+		"FOLLOW_STOP_REASON_STOPPED":      1,
+		"FOLLOW_STOP_REASON_TARGET_LOST":  2,
+		"FOLLOW_STOP_REASON_LINKED":       3,
+		"FOLLOW_STOP_REASON_PATH_BLOCKED": 4,
+	}
+)
+
+func (x FollowStopReason) Enum() *FollowStopReason {
+	p := new(FollowStopReason)
+	*p = x
+	return p
+}
+
+func (x FollowStopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowStopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_packets_proto_enumTypes[14].Descriptor()
+}
+
+func (FollowStopReason) Type() protoreflect.EnumType {
+	return &file_api_proto_packets_proto_enumTypes[14]
+}
+
+func (x FollowStopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowStopReason.Descriptor instead.
+func (FollowStopReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{14}
 }
 
 // Позиция в мире
@@ -1013,7 +1184,11 @@ type ItemInstance struct {
 	H        uint32                 `protobuf:"varint,11,opt,name=h,proto3" json:"h,omitempty"`
 	// Если предмет является контейнером (seed_bag, backpack, etc.),
 	// nested_ref указывает на вложенный инвентарь (состояние приходит отдельно по запросу)
-	NestedRef     *InventoryRef `protobuf:"bytes,12,opt,name=nested_ref,json=nestedRef,proto3,oneof" json:"nested_ref,omitempty"`
+	NestedRef *InventoryRef `protobuf:"bytes,12,opt,name=nested_ref,json=nestedRef,proto3,oneof" json:"nested_ref,omitempty"`
+	// Износ инструмента: wear растёт с работой, предмет ломается при wear >= max_durability.
+	// max_durability = 0 — предмет не изнашивается.
+	Wear          uint32 `protobuf:"varint,13,opt,name=wear,proto3" json:"wear,omitempty"`
+	MaxDurability uint32 `protobuf:"varint,14,opt,name=max_durability,json=maxDurability,proto3" json:"max_durability,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1118,6 +1293,20 @@ func (x *ItemInstance) GetNestedRef() *InventoryRef {
 	return nil
 }
 
+func (x *ItemInstance) GetWear() uint32 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *ItemInstance) GetMaxDurability() uint32 {
+	if x != nil {
+		return x.MaxDurability
+	}
+	return 0
+}
+
 type GridItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             uint32                 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // top-left
@@ -2009,6 +2198,104 @@ func (x *C2S_CloseContainer) GetRef() *InventoryRef {
 	return nil
 }
 
+// Действия с предметом в собственном инвентаре игрока (например, "eat").
+// Ответ на C2S_ItemContextMenu — S2C_ContextMenu с заполненным item_id.
+type C2S_ItemContextMenu struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        uint64                 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_ItemContextMenu) Reset() {
+	*x = C2S_ItemContextMenu{}
+	mi := &file_api_proto_packets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_ItemContextMenu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_ItemContextMenu) ProtoMessage() {}
+
+func (x *C2S_ItemContextMenu) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_ItemContextMenu.ProtoReflect.Descriptor instead.
+func (*C2S_ItemContextMenu) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *C2S_ItemContextMenu) GetItemId() uint64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type C2S_ItemAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        uint64                 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ActionId      string                 `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_ItemAction) Reset() {
+	*x = C2S_ItemAction{}
+	mi := &file_api_proto_packets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_ItemAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_ItemAction) ProtoMessage() {}
+
+func (x *C2S_ItemAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_ItemAction.ProtoReflect.Descriptor instead.
+func (*C2S_ItemAction) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *C2S_ItemAction) GetItemId() uint64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *C2S_ItemAction) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
 type EntityMovement struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Position       *Position              `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
@@ -2022,7 +2309,7 @@ type EntityMovement struct {
 
 func (x *EntityMovement) Reset() {
 	*x = EntityMovement{}
-	mi := &file_api_proto_packets_proto_msgTypes[20]
+	mi := &file_api_proto_packets_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2034,7 +2321,7 @@ func (x *EntityMovement) String() string {
 func (*EntityMovement) ProtoMessage() {}
 
 func (x *EntityMovement) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[20]
+	mi := &file_api_proto_packets_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2047,7 +2334,7 @@ func (x *EntityMovement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityMovement.ProtoReflect.Descriptor instead.
 func (*EntityMovement) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *EntityMovement) GetPosition() *Position {
@@ -2095,7 +2382,7 @@ type EntityPosition struct {
 
 func (x *EntityPosition) Reset() {
 	*x = EntityPosition{}
-	mi := &file_api_proto_packets_proto_msgTypes[21]
+	mi := &file_api_proto_packets_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2107,7 +2394,7 @@ func (x *EntityPosition) String() string {
 func (*EntityPosition) ProtoMessage() {}
 
 func (x *EntityPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[21]
+	mi := &file_api_proto_packets_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2407,7 @@ func (x *EntityPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityPosition.ProtoReflect.Descriptor instead.
 func (*EntityPosition) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *EntityPosition) GetPosition() *Position {
@@ -2147,7 +2434,7 @@ type EntityAppearance struct {
 
 func (x *EntityAppearance) Reset() {
 	*x = EntityAppearance{}
-	mi := &file_api_proto_packets_proto_msgTypes[22]
+	mi := &file_api_proto_packets_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +2446,7 @@ func (x *EntityAppearance) String() string {
 func (*EntityAppearance) ProtoMessage() {}
 
 func (x *EntityAppearance) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[22]
+	mi := &file_api_proto_packets_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2459,7 @@ func (x *EntityAppearance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityAppearance.ProtoReflect.Descriptor instead.
 func (*EntityAppearance) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EntityAppearance) GetResource() string {
@@ -2199,7 +2486,7 @@ type ChunkCoord struct {
 
 func (x *ChunkCoord) Reset() {
 	*x = ChunkCoord{}
-	mi := &file_api_proto_packets_proto_msgTypes[23]
+	mi := &file_api_proto_packets_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2498,7 @@ func (x *ChunkCoord) String() string {
 func (*ChunkCoord) ProtoMessage() {}
 
 func (x *ChunkCoord) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[23]
+	mi := &file_api_proto_packets_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2511,7 @@ func (x *ChunkCoord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChunkCoord.ProtoReflect.Descriptor instead.
 func (*ChunkCoord) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ChunkCoord) GetX() int32 {
@@ -2252,7 +2539,7 @@ type ChunkData struct {
 
 func (x *ChunkData) Reset() {
 	*x = ChunkData{}
-	mi := &file_api_proto_packets_proto_msgTypes[24]
+	mi := &file_api_proto_packets_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2264,7 +2551,7 @@ func (x *ChunkData) String() string {
 func (*ChunkData) ProtoMessage() {}
 
 func (x *ChunkData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[24]
+	mi := &file_api_proto_packets_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,7 +2564,7 @@ func (x *ChunkData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChunkData.ProtoReflect.Descriptor instead.
 func (*ChunkData) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ChunkData) GetCoord() *ChunkCoord {
@@ -2311,7 +2598,7 @@ type MoveTo struct {
 
 func (x *MoveTo) Reset() {
 	*x = MoveTo{}
-	mi := &file_api_proto_packets_proto_msgTypes[25]
+	mi := &file_api_proto_packets_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2610,7 @@ func (x *MoveTo) String() string {
 func (*MoveTo) ProtoMessage() {}
 
 func (x *MoveTo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[25]
+	mi := &file_api_proto_packets_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2623,7 @@ func (x *MoveTo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveTo.ProtoReflect.Descriptor instead.
 func (*MoveTo) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MoveTo) GetX() int32 {
@@ -2363,7 +2650,7 @@ type MoveToEntity struct {
 
 func (x *MoveToEntity) Reset() {
 	*x = MoveToEntity{}
-	mi := &file_api_proto_packets_proto_msgTypes[26]
+	mi := &file_api_proto_packets_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2375,7 +2662,7 @@ func (x *MoveToEntity) String() string {
 func (*MoveToEntity) ProtoMessage() {}
 
 func (x *MoveToEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[26]
+	mi := &file_api_proto_packets_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2388,7 +2675,7 @@ func (x *MoveToEntity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToEntity.ProtoReflect.Descriptor instead.
 func (*MoveToEntity) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MoveToEntity) GetEntityId() uint64 {
@@ -2415,7 +2702,7 @@ type Interact struct {
 
 func (x *Interact) Reset() {
 	*x = Interact{}
-	mi := &file_api_proto_packets_proto_msgTypes[27]
+	mi := &file_api_proto_packets_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2427,7 +2714,7 @@ func (x *Interact) String() string {
 func (*Interact) ProtoMessage() {}
 
 func (x *Interact) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[27]
+	mi := &file_api_proto_packets_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2440,7 +2727,7 @@ func (x *Interact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interact.ProtoReflect.Descriptor instead.
 func (*Interact) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Interact) GetEntityId() uint64 {
@@ -2467,7 +2754,7 @@ type SelectContextAction struct {
 
 func (x *SelectContextAction) Reset() {
 	*x = SelectContextAction{}
-	mi := &file_api_proto_packets_proto_msgTypes[28]
+	mi := &file_api_proto_packets_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2479,7 +2766,7 @@ func (x *SelectContextAction) String() string {
 func (*SelectContextAction) ProtoMessage() {}
 
 func (x *SelectContextAction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[28]
+	mi := &file_api_proto_packets_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,7 +2779,7 @@ func (x *SelectContextAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectContextAction.ProtoReflect.Descriptor instead.
 func (*SelectContextAction) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SelectContextAction) GetEntityId() uint64 {
@@ -2509,35 +2796,29 @@ func (x *SelectContextAction) GetActionId() string {
 	return ""
 }
 
-type C2S_PlayerAction struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Action:
-	//
-	//	*C2S_PlayerAction_MoveTo
-	//	*C2S_PlayerAction_MoveToEntity
-	//	*C2S_PlayerAction_Interact
-	//	*C2S_PlayerAction_SelectContextAction
-	Action        isC2S_PlayerAction_Action `protobuf_oneof:"action"`
-	Modifiers     uint32                    `protobuf:"varint,10,opt,name=modifiers,proto3" json:"modifiers,omitempty"` // bitflags: SHIFT=1, CTRL=2, ALT=4
+// Вступить в бой с сущностью: создаёт боевую связь у обеих сторон.
+type Attack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *C2S_PlayerAction) Reset() {
-	*x = C2S_PlayerAction{}
-	mi := &file_api_proto_packets_proto_msgTypes[29]
+func (x *Attack) Reset() {
+	*x = Attack{}
+	mi := &file_api_proto_packets_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *C2S_PlayerAction) String() string {
+func (x *Attack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_PlayerAction) ProtoMessage() {}
+func (*Attack) ProtoMessage() {}
 
-func (x *C2S_PlayerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[29]
+func (x *Attack) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2548,16 +2829,123 @@ func (x *C2S_PlayerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_PlayerAction.ProtoReflect.Descriptor instead.
-func (*C2S_PlayerAction) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Attack.ProtoReflect.Descriptor instead.
+func (*Attack) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *C2S_PlayerAction) GetAction() isC2S_PlayerAction_Action {
+func (x *Attack) GetEntityId() uint64 {
 	if x != nil {
-		return x.Action
+		return x.EntityId
 	}
-	return nil
+	return 0
+}
+
+// Следовать за сущностью, держась на дистанции, пока она видна.
+// Любая другая команда движения прекращает следование; entity_id = 0 — просто остановиться.
+type Follow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Distance      uint32                 `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"` // 0 — дистанция по умолчанию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	mi := &file_api_proto_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Follow) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Follow) GetDistance() uint32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type C2S_PlayerAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*C2S_PlayerAction_MoveTo
+	//	*C2S_PlayerAction_MoveToEntity
+	//	*C2S_PlayerAction_Interact
+	//	*C2S_PlayerAction_SelectContextAction
+	//	*C2S_PlayerAction_Attack
+	//	*C2S_PlayerAction_Follow
+	Action        isC2S_PlayerAction_Action `protobuf_oneof:"action"`
+	Modifiers     uint32                    `protobuf:"varint,10,opt,name=modifiers,proto3" json:"modifiers,omitempty"` // bitflags: SHIFT=1, CTRL=2, ALT=4
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_PlayerAction) Reset() {
+	*x = C2S_PlayerAction{}
+	mi := &file_api_proto_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_PlayerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_PlayerAction) ProtoMessage() {}
+
+func (x *C2S_PlayerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_PlayerAction.ProtoReflect.Descriptor instead.
+func (*C2S_PlayerAction) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *C2S_PlayerAction) GetAction() isC2S_PlayerAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
 }
 
 func (x *C2S_PlayerAction) GetMoveTo() *MoveTo {
@@ -2596,6 +2984,24 @@ func (x *C2S_PlayerAction) GetSelectContextAction() *SelectContextAction {
 	return nil
 }
 
+func (x *C2S_PlayerAction) GetAttack() *Attack {
+	if x != nil {
+		if x, ok := x.Action.(*C2S_PlayerAction_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *C2S_PlayerAction) GetFollow() *Follow {
+	if x != nil {
+		if x, ok := x.Action.(*C2S_PlayerAction_Follow); ok {
+			return x.Follow
+		}
+	}
+	return nil
+}
+
 func (x *C2S_PlayerAction) GetModifiers() uint32 {
 	if x != nil {
 		return x.Modifiers
@@ -2623,6 +3029,15 @@ type C2S_PlayerAction_SelectContextAction struct {
 	SelectContextAction *SelectContextAction `protobuf:"bytes,4,opt,name=select_context_action,json=selectContextAction,proto3,oneof"`
 }
 
+type C2S_PlayerAction_Attack struct {
+	Attack *Attack `protobuf:"bytes,5,opt,name=attack,proto3,oneof"`
+}
+
+type C2S_PlayerAction_Follow struct {
+	// StopAction stop = 6;
+	Follow *Follow `protobuf:"bytes,7,opt,name=follow,proto3,oneof"`
+}
+
 func (*C2S_PlayerAction_MoveTo) isC2S_PlayerAction_Action() {}
 
 func (*C2S_PlayerAction_MoveToEntity) isC2S_PlayerAction_Action() {}
@@ -2631,6 +3046,10 @@ func (*C2S_PlayerAction_Interact) isC2S_PlayerAction_Action() {}
 
 func (*C2S_PlayerAction_SelectContextAction) isC2S_PlayerAction_Action() {}
 
+func (*C2S_PlayerAction_Attack) isC2S_PlayerAction_Action() {}
+
+func (*C2S_PlayerAction_Follow) isC2S_PlayerAction_Action() {}
+
 // Движение - отдельный поток для responsive controls
 type C2S_MovementMode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2641,7 +3060,7 @@ type C2S_MovementMode struct {
 
 func (x *C2S_MovementMode) Reset() {
 	*x = C2S_MovementMode{}
-	mi := &file_api_proto_packets_proto_msgTypes[30]
+	mi := &file_api_proto_packets_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2653,7 +3072,7 @@ func (x *C2S_MovementMode) String() string {
 func (*C2S_MovementMode) ProtoMessage() {}
 
 func (x *C2S_MovementMode) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[30]
+	mi := &file_api_proto_packets_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2666,7 +3085,7 @@ func (x *C2S_MovementMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_MovementMode.ProtoReflect.Descriptor instead.
 func (*C2S_MovementMode) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *C2S_MovementMode) GetMode() MovementMode {
@@ -2690,7 +3109,7 @@ type C2S_ChatMessage struct {
 
 func (x *C2S_ChatMessage) Reset() {
 	*x = C2S_ChatMessage{}
-	mi := &file_api_proto_packets_proto_msgTypes[31]
+	mi := &file_api_proto_packets_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2702,7 +3121,7 @@ func (x *C2S_ChatMessage) String() string {
 func (*C2S_ChatMessage) ProtoMessage() {}
 
 func (x *C2S_ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[31]
+	mi := &file_api_proto_packets_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,7 +3134,7 @@ func (x *C2S_ChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_ChatMessage.ProtoReflect.Descriptor instead.
 func (*C2S_ChatMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *C2S_ChatMessage) GetText() string {
@@ -2758,6 +3177,111 @@ type C2S_ChatMessage_PrivateEntityId struct {
 
 func (*C2S_ChatMessage_PrivateEntityId) isC2S_ChatMessage_Target() {}
 
+type C2S_PartyCommand struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Action         PartyAction            `protobuf:"varint,1,opt,name=action,proto3,enum=proto.PartyAction" json:"action,omitempty"`
+	TargetEntityId uint64                 `protobuf:"varint,2,opt,name=target_entity_id,json=targetEntityId,proto3" json:"target_entity_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *C2S_PartyCommand) Reset() {
+	*x = C2S_PartyCommand{}
+	mi := &file_api_proto_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_PartyCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_PartyCommand) ProtoMessage() {}
+
+func (x *C2S_PartyCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_PartyCommand.ProtoReflect.Descriptor instead.
+func (*C2S_PartyCommand) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *C2S_PartyCommand) GetAction() PartyAction {
+	if x != nil {
+		return x.Action
+	}
+	return PartyAction_PARTY_ACTION_UNSPECIFIED
+}
+
+func (x *C2S_PartyCommand) GetTargetEntityId() uint64 {
+	if x != nil {
+		return x.TargetEntityId
+	}
+	return 0
+}
+
+// Запрос истории чата (шлётся клиентом после S2C_PlayerEnterWorld)
+type C2S_ChatHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrivateLimit  uint32                 `protobuf:"varint,1,opt,name=private_limit,json=privateLimit,proto3" json:"private_limit,omitempty"` // последние N приватных сообщений (0 = значение сервера по умолчанию)
+	LocalLimit    uint32                 `protobuf:"varint,2,opt,name=local_limit,json=localLimit,proto3" json:"local_limit,omitempty"`       // последние N локальных сообщений рядом с позицией игрока (0 = по умолчанию)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_ChatHistoryRequest) Reset() {
+	*x = C2S_ChatHistoryRequest{}
+	mi := &file_api_proto_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_ChatHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_ChatHistoryRequest) ProtoMessage() {}
+
+func (x *C2S_ChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_ChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*C2S_ChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *C2S_ChatHistoryRequest) GetPrivateLimit() uint32 {
+	if x != nil {
+		return x.PrivateLimit
+	}
+	return 0
+}
+
+func (x *C2S_ChatHistoryRequest) GetLocalLimit() uint32 {
+	if x != nil {
+		return x.LocalLimit
+	}
+	return 0
+}
+
 type C2S_Auth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -2768,7 +3292,7 @@ type C2S_Auth struct {
 
 func (x *C2S_Auth) Reset() {
 	*x = C2S_Auth{}
-	mi := &file_api_proto_packets_proto_msgTypes[32]
+	mi := &file_api_proto_packets_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2780,7 +3304,7 @@ func (x *C2S_Auth) String() string {
 func (*C2S_Auth) ProtoMessage() {}
 
 func (x *C2S_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[32]
+	mi := &file_api_proto_packets_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2793,7 +3317,7 @@ func (x *C2S_Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_Auth.ProtoReflect.Descriptor instead.
 func (*C2S_Auth) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *C2S_Auth) GetToken() string {
@@ -2819,7 +3343,7 @@ type C2S_Ping struct {
 
 func (x *C2S_Ping) Reset() {
 	*x = C2S_Ping{}
-	mi := &file_api_proto_packets_proto_msgTypes[33]
+	mi := &file_api_proto_packets_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2831,7 +3355,7 @@ func (x *C2S_Ping) String() string {
 func (*C2S_Ping) ProtoMessage() {}
 
 func (x *C2S_Ping) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[33]
+	mi := &file_api_proto_packets_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,7 +3368,7 @@ func (x *C2S_Ping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_Ping.ProtoReflect.Descriptor instead.
 func (*C2S_Ping) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{33}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *C2S_Ping) GetClientTimeMs() int64 {
@@ -2863,7 +3387,7 @@ type C2S_StartCraftOne struct {
 
 func (x *C2S_StartCraftOne) Reset() {
 	*x = C2S_StartCraftOne{}
-	mi := &file_api_proto_packets_proto_msgTypes[34]
+	mi := &file_api_proto_packets_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2875,7 +3399,7 @@ func (x *C2S_StartCraftOne) String() string {
 func (*C2S_StartCraftOne) ProtoMessage() {}
 
 func (x *C2S_StartCraftOne) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[34]
+	mi := &file_api_proto_packets_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,7 +3412,7 @@ func (x *C2S_StartCraftOne) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_StartCraftOne.ProtoReflect.Descriptor instead.
 func (*C2S_StartCraftOne) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{34}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *C2S_StartCraftOne) GetCraftKey() string {
@@ -2908,7 +3432,7 @@ type C2S_StartCraftMany struct {
 
 func (x *C2S_StartCraftMany) Reset() {
 	*x = C2S_StartCraftMany{}
-	mi := &file_api_proto_packets_proto_msgTypes[35]
+	mi := &file_api_proto_packets_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2920,7 +3444,7 @@ func (x *C2S_StartCraftMany) String() string {
 func (*C2S_StartCraftMany) ProtoMessage() {}
 
 func (x *C2S_StartCraftMany) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[35]
+	mi := &file_api_proto_packets_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,7 +3457,7 @@ func (x *C2S_StartCraftMany) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_StartCraftMany.ProtoReflect.Descriptor instead.
 func (*C2S_StartCraftMany) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{35}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *C2S_StartCraftMany) GetCraftKey() string {
@@ -2960,7 +3484,7 @@ type C2S_BuildStart struct {
 
 func (x *C2S_BuildStart) Reset() {
 	*x = C2S_BuildStart{}
-	mi := &file_api_proto_packets_proto_msgTypes[36]
+	mi := &file_api_proto_packets_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2972,7 +3496,7 @@ func (x *C2S_BuildStart) String() string {
 func (*C2S_BuildStart) ProtoMessage() {}
 
 func (x *C2S_BuildStart) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[36]
+	mi := &file_api_proto_packets_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2985,7 +3509,7 @@ func (x *C2S_BuildStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_BuildStart.ProtoReflect.Descriptor instead.
 func (*C2S_BuildStart) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{36}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *C2S_BuildStart) GetBuildKey() string {
@@ -3011,7 +3535,7 @@ type C2S_BuildProgress struct {
 
 func (x *C2S_BuildProgress) Reset() {
 	*x = C2S_BuildProgress{}
-	mi := &file_api_proto_packets_proto_msgTypes[37]
+	mi := &file_api_proto_packets_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3023,7 +3547,7 @@ func (x *C2S_BuildProgress) String() string {
 func (*C2S_BuildProgress) ProtoMessage() {}
 
 func (x *C2S_BuildProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[37]
+	mi := &file_api_proto_packets_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,7 +3560,7 @@ func (x *C2S_BuildProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_BuildProgress.ProtoReflect.Descriptor instead.
 func (*C2S_BuildProgress) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{37}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *C2S_BuildProgress) GetEntityId() uint64 {
@@ -3056,7 +3580,7 @@ type C2S_BuildTakeBack struct {
 
 func (x *C2S_BuildTakeBack) Reset() {
 	*x = C2S_BuildTakeBack{}
-	mi := &file_api_proto_packets_proto_msgTypes[38]
+	mi := &file_api_proto_packets_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3068,7 +3592,7 @@ func (x *C2S_BuildTakeBack) String() string {
 func (*C2S_BuildTakeBack) ProtoMessage() {}
 
 func (x *C2S_BuildTakeBack) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[38]
+	mi := &file_api_proto_packets_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3081,7 +3605,7 @@ func (x *C2S_BuildTakeBack) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_BuildTakeBack.ProtoReflect.Descriptor instead.
 func (*C2S_BuildTakeBack) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{38}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *C2S_BuildTakeBack) GetEntityId() uint64 {
@@ -3108,7 +3632,7 @@ type C2S_LiftPutDown struct {
 
 func (x *C2S_LiftPutDown) Reset() {
 	*x = C2S_LiftPutDown{}
-	mi := &file_api_proto_packets_proto_msgTypes[39]
+	mi := &file_api_proto_packets_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3644,7 @@ func (x *C2S_LiftPutDown) String() string {
 func (*C2S_LiftPutDown) ProtoMessage() {}
 
 func (x *C2S_LiftPutDown) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[39]
+	mi := &file_api_proto_packets_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3657,7 @@ func (x *C2S_LiftPutDown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_LiftPutDown.ProtoReflect.Descriptor instead.
 func (*C2S_LiftPutDown) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{39}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *C2S_LiftPutDown) GetEntityId() uint64 {
@@ -3159,7 +3683,7 @@ type C2S_OpenWindow struct {
 
 func (x *C2S_OpenWindow) Reset() {
 	*x = C2S_OpenWindow{}
-	mi := &file_api_proto_packets_proto_msgTypes[40]
+	mi := &file_api_proto_packets_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3171,7 +3695,7 @@ func (x *C2S_OpenWindow) String() string {
 func (*C2S_OpenWindow) ProtoMessage() {}
 
 func (x *C2S_OpenWindow) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[40]
+	mi := &file_api_proto_packets_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,7 +3708,7 @@ func (x *C2S_OpenWindow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_OpenWindow.ProtoReflect.Descriptor instead.
 func (*C2S_OpenWindow) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{40}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *C2S_OpenWindow) GetName() string {
@@ -3203,7 +3727,7 @@ type C2S_CloseWindow struct {
 
 func (x *C2S_CloseWindow) Reset() {
 	*x = C2S_CloseWindow{}
-	mi := &file_api_proto_packets_proto_msgTypes[41]
+	mi := &file_api_proto_packets_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3215,7 +3739,7 @@ func (x *C2S_CloseWindow) String() string {
 func (*C2S_CloseWindow) ProtoMessage() {}
 
 func (x *C2S_CloseWindow) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[41]
+	mi := &file_api_proto_packets_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3228,7 +3752,7 @@ func (x *C2S_CloseWindow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_CloseWindow.ProtoReflect.Descriptor instead.
 func (*C2S_CloseWindow) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{41}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *C2S_CloseWindow) GetName() string {
@@ -3238,6 +3762,190 @@ func (x *C2S_CloseWindow) GetName() string {
 	return ""
 }
 
+// Запрос списка навыков. Ответ — S2C_SkillList.
+type C2S_SkillList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_SkillList) Reset() {
+	*x = C2S_SkillList{}
+	mi := &file_api_proto_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_SkillList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_SkillList) ProtoMessage() {}
+
+func (x *C2S_SkillList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_SkillList.ProtoReflect.Descriptor instead.
+func (*C2S_SkillList) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{48}
+}
+
+// Изучение навыка за LP. При успехе сервер шлёт S2C_SkillList и S2C_CharacterProfile,
+// при отказе — S2C_MiniAlert с причиной.
+type C2S_LearnSkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillKey      string                 `protobuf:"bytes,1,opt,name=skill_key,json=skillKey,proto3" json:"skill_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_LearnSkill) Reset() {
+	*x = C2S_LearnSkill{}
+	mi := &file_api_proto_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_LearnSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_LearnSkill) ProtoMessage() {}
+
+func (x *C2S_LearnSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_LearnSkill.ProtoReflect.Descriptor instead.
+func (*C2S_LearnSkill) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *C2S_LearnSkill) GetSkillKey() string {
+	if x != nil {
+		return x.SkillKey
+	}
+	return ""
+}
+
+// Повышение атрибута на 1 за LP. Стоимость растёт с текущим значением атрибута.
+// При успехе сервер шлёт S2C_CharacterProfile и S2C_PlayerStats,
+// при отказе — S2C_MiniAlert с причиной.
+type C2S_TrainAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           CharacterAttributeKey  `protobuf:"varint,1,opt,name=key,proto3,enum=proto.CharacterAttributeKey" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_TrainAttribute) Reset() {
+	*x = C2S_TrainAttribute{}
+	mi := &file_api_proto_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_TrainAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_TrainAttribute) ProtoMessage() {}
+
+func (x *C2S_TrainAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_TrainAttribute.ProtoReflect.Descriptor instead.
+func (*C2S_TrainAttribute) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *C2S_TrainAttribute) GetKey() CharacterAttributeKey {
+	if x != nil {
+		return x.Key
+	}
+	return CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_UNSPECIFIED
+}
+
+// Боевой приём против сущности, с которой идёт бой. Удар наносится через windup тиков,
+// приём снова доступен после перезарядки. При отказе — S2C_MiniAlert с причиной.
+type C2S_CombatMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MoveKey       string                 `protobuf:"bytes,1,opt,name=move_key,json=moveKey,proto3" json:"move_key,omitempty"`
+	TargetId      uint64                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *C2S_CombatMove) Reset() {
+	*x = C2S_CombatMove{}
+	mi := &file_api_proto_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *C2S_CombatMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_CombatMove) ProtoMessage() {}
+
+func (x *C2S_CombatMove) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_CombatMove.ProtoReflect.Descriptor instead.
+func (*C2S_CombatMove) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *C2S_CombatMove) GetMoveKey() string {
+	if x != nil {
+		return x.MoveKey
+	}
+	return ""
+}
+
+func (x *C2S_CombatMove) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
 // Обёртка для всех клиентских сообщений
 type ClientMessage struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
@@ -3260,6 +3968,14 @@ type ClientMessage struct {
 	//	*ClientMessage_BuildProgress
 	//	*ClientMessage_BuildTakeBack
 	//	*ClientMessage_LiftPutDown
+	//	*ClientMessage_ChatHistory
+	//	*ClientMessage_PartyCommand
+	//	*ClientMessage_ItemContextMenu
+	//	*ClientMessage_ItemAction
+	//	*ClientMessage_SkillList
+	//	*ClientMessage_LearnSkill
+	//	*ClientMessage_TrainAttribute
+	//	*ClientMessage_CombatMove
 	Payload       isClientMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3267,7 +3983,7 @@ type ClientMessage struct {
 
 func (x *ClientMessage) Reset() {
 	*x = ClientMessage{}
-	mi := &file_api_proto_packets_proto_msgTypes[42]
+	mi := &file_api_proto_packets_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3279,7 +3995,7 @@ func (x *ClientMessage) String() string {
 func (*ClientMessage) ProtoMessage() {}
 
 func (x *ClientMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[42]
+	mi := &file_api_proto_packets_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3292,7 +4008,7 @@ func (x *ClientMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
 func (*ClientMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{42}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ClientMessage) GetSequence() uint32 {
@@ -3453,6 +4169,78 @@ func (x *ClientMessage) GetLiftPutDown() *C2S_LiftPutDown {
 	return nil
 }
 
+func (x *ClientMessage) GetChatHistory() *C2S_ChatHistoryRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ChatHistory); ok {
+			return x.ChatHistory
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetPartyCommand() *C2S_PartyCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_PartyCommand); ok {
+			return x.PartyCommand
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetItemContextMenu() *C2S_ItemContextMenu {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ItemContextMenu); ok {
+			return x.ItemContextMenu
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetItemAction() *C2S_ItemAction {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ItemAction); ok {
+			return x.ItemAction
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSkillList() *C2S_SkillList {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_SkillList); ok {
+			return x.SkillList
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetLearnSkill() *C2S_LearnSkill {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_LearnSkill); ok {
+			return x.LearnSkill
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetTrainAttribute() *C2S_TrainAttribute {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_TrainAttribute); ok {
+			return x.TrainAttribute
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetCombatMove() *C2S_CombatMove {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_CombatMove); ok {
+			return x.CombatMove
+		}
+	}
+	return nil
+}
+
 type isClientMessage_Payload interface {
 	isClientMessage_Payload()
 }
@@ -3521,6 +4309,38 @@ type ClientMessage_LiftPutDown struct {
 	LiftPutDown *C2S_LiftPutDown `protobuf:"bytes,25,opt,name=lift_put_down,json=liftPutDown,proto3,oneof"`
 }
 
+type ClientMessage_ChatHistory struct {
+	ChatHistory *C2S_ChatHistoryRequest `protobuf:"bytes,26,opt,name=chat_history,json=chatHistory,proto3,oneof"`
+}
+
+type ClientMessage_PartyCommand struct {
+	PartyCommand *C2S_PartyCommand `protobuf:"bytes,27,opt,name=party_command,json=partyCommand,proto3,oneof"`
+}
+
+type ClientMessage_ItemContextMenu struct {
+	ItemContextMenu *C2S_ItemContextMenu `protobuf:"bytes,28,opt,name=item_context_menu,json=itemContextMenu,proto3,oneof"`
+}
+
+type ClientMessage_ItemAction struct {
+	ItemAction *C2S_ItemAction `protobuf:"bytes,29,opt,name=item_action,json=itemAction,proto3,oneof"`
+}
+
+type ClientMessage_SkillList struct {
+	SkillList *C2S_SkillList `protobuf:"bytes,30,opt,name=skill_list,json=skillList,proto3,oneof"`
+}
+
+type ClientMessage_LearnSkill struct {
+	LearnSkill *C2S_LearnSkill `protobuf:"bytes,31,opt,name=learn_skill,json=learnSkill,proto3,oneof"`
+}
+
+type ClientMessage_TrainAttribute struct {
+	TrainAttribute *C2S_TrainAttribute `protobuf:"bytes,32,opt,name=train_attribute,json=trainAttribute,proto3,oneof"`
+}
+
+type ClientMessage_CombatMove struct {
+	CombatMove *C2S_CombatMove `protobuf:"bytes,33,opt,name=combat_move,json=combatMove,proto3,oneof"`
+}
+
 func (*ClientMessage_Auth) isClientMessage_Payload() {}
 
 func (*ClientMessage_Ping) isClientMessage_Payload() {}
@@ -3553,6 +4373,22 @@ func (*ClientMessage_BuildTakeBack) isClientMessage_Payload() {}
 
 func (*ClientMessage_LiftPutDown) isClientMessage_Payload() {}
 
+func (*ClientMessage_ChatHistory) isClientMessage_Payload() {}
+
+func (*ClientMessage_PartyCommand) isClientMessage_Payload() {}
+
+func (*ClientMessage_ItemContextMenu) isClientMessage_Payload() {}
+
+func (*ClientMessage_ItemAction) isClientMessage_Payload() {}
+
+func (*ClientMessage_SkillList) isClientMessage_Payload() {}
+
+func (*ClientMessage_LearnSkill) isClientMessage_Payload() {}
+
+func (*ClientMessage_TrainAttribute) isClientMessage_Payload() {}
+
+func (*ClientMessage_CombatMove) isClientMessage_Payload() {}
+
 type S2C_AuthResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -3563,7 +4399,7 @@ type S2C_AuthResult struct {
 
 func (x *S2C_AuthResult) Reset() {
 	*x = S2C_AuthResult{}
-	mi := &file_api_proto_packets_proto_msgTypes[43]
+	mi := &file_api_proto_packets_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3575,7 +4411,7 @@ func (x *S2C_AuthResult) String() string {
 func (*S2C_AuthResult) ProtoMessage() {}
 
 func (x *S2C_AuthResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[43]
+	mi := &file_api_proto_packets_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +4424,7 @@ func (x *S2C_AuthResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_AuthResult.ProtoReflect.Descriptor instead.
 func (*S2C_AuthResult) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{43}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *S2C_AuthResult) GetSuccess() bool {
@@ -3615,7 +4451,7 @@ type S2C_Pong struct {
 
 func (x *S2C_Pong) Reset() {
 	*x = S2C_Pong{}
-	mi := &file_api_proto_packets_proto_msgTypes[44]
+	mi := &file_api_proto_packets_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3627,7 +4463,7 @@ func (x *S2C_Pong) String() string {
 func (*S2C_Pong) ProtoMessage() {}
 
 func (x *S2C_Pong) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[44]
+	mi := &file_api_proto_packets_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3640,7 +4476,7 @@ func (x *S2C_Pong) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_Pong.ProtoReflect.Descriptor instead.
 func (*S2C_Pong) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{44}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *S2C_Pong) GetClientTimeMs() int64 {
@@ -3670,14 +4506,15 @@ type S2C_PlayerEnterWorld struct {
 	// Inventory inventory = 6;
 	// Paperdoll paperdoll = 7;
 	// optional uint64 dragging_entity_id = 8; // если тащим что-то
-	StreamEpoch   uint32 `protobuf:"varint,9,opt,name=stream_epoch,json=streamEpoch,proto3" json:"stream_epoch,omitempty"` // для защиты от гонок состояний
+	StreamEpoch   uint32         `protobuf:"varint,9,opt,name=stream_epoch,json=streamEpoch,proto3" json:"stream_epoch,omitempty"` // для защиты от гонок состояний
+	WorldTime     *S2C_WorldTime `protobuf:"bytes,10,opt,name=world_time,json=worldTime,proto3" json:"world_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *S2C_PlayerEnterWorld) Reset() {
 	*x = S2C_PlayerEnterWorld{}
-	mi := &file_api_proto_packets_proto_msgTypes[45]
+	mi := &file_api_proto_packets_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3689,7 +4526,7 @@ func (x *S2C_PlayerEnterWorld) String() string {
 func (*S2C_PlayerEnterWorld) ProtoMessage() {}
 
 func (x *S2C_PlayerEnterWorld) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[45]
+	mi := &file_api_proto_packets_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3702,7 +4539,7 @@ func (x *S2C_PlayerEnterWorld) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_PlayerEnterWorld.ProtoReflect.Descriptor instead.
 func (*S2C_PlayerEnterWorld) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{45}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *S2C_PlayerEnterWorld) GetEntityId() uint64 {
@@ -3747,29 +4584,130 @@ func (x *S2C_PlayerEnterWorld) GetStreamEpoch() uint32 {
 	return 0
 }
 
-type CharacterAttributeEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           CharacterAttributeKey  `protobuf:"varint,1,opt,name=key,proto3,enum=proto.CharacterAttributeKey" json:"key,omitempty"`
-	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *S2C_PlayerEnterWorld) GetWorldTime() *S2C_WorldTime {
+	if x != nil {
+		return x.WorldTime
+	}
+	return nil
 }
 
-func (x *CharacterAttributeEntry) Reset() {
-	*x = CharacterAttributeEntry{}
-	mi := &file_api_proto_packets_proto_msgTypes[46]
+// Игровое время и календарь. Шлётся при входе в мир и периодически;
+// между обновлениями клиент продвигает second_of_day сам по реальному времени.
+type S2C_WorldTime struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Day              uint64                 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`                                                     // игровой день с начала мира, с 0
+	SecondOfDay      uint32                 `protobuf:"varint,2,opt,name=second_of_day,json=secondOfDay,proto3" json:"second_of_day,omitempty"`                // реальных секунд с игровой полуночи
+	DayLengthSeconds uint32                 `protobuf:"varint,3,opt,name=day_length_seconds,json=dayLengthSeconds,proto3" json:"day_length_seconds,omitempty"` // длина игровых суток в реальных секундах
+	Season           Season                 `protobuf:"varint,4,opt,name=season,proto3,enum=proto.Season" json:"season,omitempty"`
+	DayOfSeason      uint32                 `protobuf:"varint,5,opt,name=day_of_season,json=dayOfSeason,proto3" json:"day_of_season,omitempty"` // с 0
+	SeasonDays       uint32                 `protobuf:"varint,6,opt,name=season_days,json=seasonDays,proto3" json:"season_days,omitempty"`
+	Year             uint64                 `protobuf:"varint,7,opt,name=year,proto3" json:"year,omitempty"` // с 0
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *S2C_WorldTime) Reset() {
+	*x = S2C_WorldTime{}
+	mi := &file_api_proto_packets_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CharacterAttributeEntry) String() string {
+func (x *S2C_WorldTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CharacterAttributeEntry) ProtoMessage() {}
+func (*S2C_WorldTime) ProtoMessage() {}
 
-func (x *CharacterAttributeEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[46]
+func (x *S2C_WorldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_WorldTime.ProtoReflect.Descriptor instead.
+func (*S2C_WorldTime) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *S2C_WorldTime) GetDay() uint64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *S2C_WorldTime) GetSecondOfDay() uint32 {
+	if x != nil {
+		return x.SecondOfDay
+	}
+	return 0
+}
+
+func (x *S2C_WorldTime) GetDayLengthSeconds() uint32 {
+	if x != nil {
+		return x.DayLengthSeconds
+	}
+	return 0
+}
+
+func (x *S2C_WorldTime) GetSeason() Season {
+	if x != nil {
+		return x.Season
+	}
+	return Season_SEASON_SPRING
+}
+
+func (x *S2C_WorldTime) GetDayOfSeason() uint32 {
+	if x != nil {
+		return x.DayOfSeason
+	}
+	return 0
+}
+
+func (x *S2C_WorldTime) GetSeasonDays() uint32 {
+	if x != nil {
+		return x.SeasonDays
+	}
+	return 0
+}
+
+func (x *S2C_WorldTime) GetYear() uint64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type CharacterAttributeEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           CharacterAttributeKey  `protobuf:"varint,1,opt,name=key,proto3,enum=proto.CharacterAttributeKey" json:"key,omitempty"`
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterAttributeEntry) Reset() {
+	*x = CharacterAttributeEntry{}
+	mi := &file_api_proto_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterAttributeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterAttributeEntry) ProtoMessage() {}
+
+func (x *CharacterAttributeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3782,7 +4720,7 @@ func (x *CharacterAttributeEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterAttributeEntry.ProtoReflect.Descriptor instead.
 func (*CharacterAttributeEntry) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{46}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *CharacterAttributeEntry) GetKey() CharacterAttributeKey {
@@ -3811,7 +4749,7 @@ type CharacterExperience struct {
 
 func (x *CharacterExperience) Reset() {
 	*x = CharacterExperience{}
-	mi := &file_api_proto_packets_proto_msgTypes[47]
+	mi := &file_api_proto_packets_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3823,7 +4761,7 @@ func (x *CharacterExperience) String() string {
 func (*CharacterExperience) ProtoMessage() {}
 
 func (x *CharacterExperience) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[47]
+	mi := &file_api_proto_packets_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3836,7 +4774,7 @@ func (x *CharacterExperience) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterExperience.ProtoReflect.Descriptor instead.
 func (*CharacterExperience) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{47}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *CharacterExperience) GetLp() int64 {
@@ -3867,17 +4805,71 @@ func (x *CharacterExperience) GetCombat() int64 {
 	return 0
 }
 
+// Стоимость повышения атрибута на 1 в LP.
+type CharacterAttributeTrainCost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           CharacterAttributeKey  `protobuf:"varint,1,opt,name=key,proto3,enum=proto.CharacterAttributeKey" json:"key,omitempty"`
+	LpCost        int64                  `protobuf:"varint,2,opt,name=lp_cost,json=lpCost,proto3" json:"lp_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterAttributeTrainCost) Reset() {
+	*x = CharacterAttributeTrainCost{}
+	mi := &file_api_proto_packets_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterAttributeTrainCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterAttributeTrainCost) ProtoMessage() {}
+
+func (x *CharacterAttributeTrainCost) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterAttributeTrainCost.ProtoReflect.Descriptor instead.
+func (*CharacterAttributeTrainCost) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CharacterAttributeTrainCost) GetKey() CharacterAttributeKey {
+	if x != nil {
+		return x.Key
+	}
+	return CharacterAttributeKey_CHARACTER_ATTRIBUTE_KEY_UNSPECIFIED
+}
+
+func (x *CharacterAttributeTrainCost) GetLpCost() int64 {
+	if x != nil {
+		return x.LpCost
+	}
+	return 0
+}
+
 type S2C_CharacterProfile struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Attributes    []*CharacterAttributeEntry `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	Exp           *CharacterExperience       `protobuf:"bytes,2,opt,name=exp,proto3" json:"exp,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Attributes    []*CharacterAttributeEntry     `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Exp           *CharacterExperience           `protobuf:"bytes,2,opt,name=exp,proto3" json:"exp,omitempty"`
+	TrainCosts    []*CharacterAttributeTrainCost `protobuf:"bytes,3,rep,name=train_costs,json=trainCosts,proto3" json:"train_costs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *S2C_CharacterProfile) Reset() {
 	*x = S2C_CharacterProfile{}
-	mi := &file_api_proto_packets_proto_msgTypes[48]
+	mi := &file_api_proto_packets_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3889,7 +4881,7 @@ func (x *S2C_CharacterProfile) String() string {
 func (*S2C_CharacterProfile) ProtoMessage() {}
 
 func (x *S2C_CharacterProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[48]
+	mi := &file_api_proto_packets_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3902,7 +4894,7 @@ func (x *S2C_CharacterProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_CharacterProfile.ProtoReflect.Descriptor instead.
 func (*S2C_CharacterProfile) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{48}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *S2C_CharacterProfile) GetAttributes() []*CharacterAttributeEntry {
@@ -3919,6 +4911,13 @@ func (x *S2C_CharacterProfile) GetExp() *CharacterExperience {
 	return nil
 }
 
+func (x *S2C_CharacterProfile) GetTrainCosts() []*CharacterAttributeTrainCost {
+	if x != nil {
+		return x.TrainCosts
+	}
+	return nil
+}
+
 type S2C_PlayerStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stamina       uint32                 `protobuf:"varint,1,opt,name=stamina,proto3" json:"stamina,omitempty"`
@@ -3935,7 +4934,7 @@ type S2C_PlayerStats struct {
 
 func (x *S2C_PlayerStats) Reset() {
 	*x = S2C_PlayerStats{}
-	mi := &file_api_proto_packets_proto_msgTypes[49]
+	mi := &file_api_proto_packets_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3947,7 +4946,7 @@ func (x *S2C_PlayerStats) String() string {
 func (*S2C_PlayerStats) ProtoMessage() {}
 
 func (x *S2C_PlayerStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[49]
+	mi := &file_api_proto_packets_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,7 +4959,7 @@ func (x *S2C_PlayerStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_PlayerStats.ProtoReflect.Descriptor instead.
 func (*S2C_PlayerStats) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{49}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *S2C_PlayerStats) GetStamina() uint32 {
@@ -4029,7 +5028,7 @@ type S2C_DeathDialog struct {
 
 func (x *S2C_DeathDialog) Reset() {
 	*x = S2C_DeathDialog{}
-	mi := &file_api_proto_packets_proto_msgTypes[50]
+	mi := &file_api_proto_packets_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4041,7 +5040,7 @@ func (x *S2C_DeathDialog) String() string {
 func (*S2C_DeathDialog) ProtoMessage() {}
 
 func (x *S2C_DeathDialog) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[50]
+	mi := &file_api_proto_packets_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4054,7 +5053,7 @@ func (x *S2C_DeathDialog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_DeathDialog.ProtoReflect.Descriptor instead.
 func (*S2C_DeathDialog) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{50}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *S2C_DeathDialog) GetTitle() string {
@@ -4081,7 +5080,7 @@ type S2C_PlayerLeaveWorld struct {
 
 func (x *S2C_PlayerLeaveWorld) Reset() {
 	*x = S2C_PlayerLeaveWorld{}
-	mi := &file_api_proto_packets_proto_msgTypes[51]
+	mi := &file_api_proto_packets_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4093,7 +5092,7 @@ func (x *S2C_PlayerLeaveWorld) String() string {
 func (*S2C_PlayerLeaveWorld) ProtoMessage() {}
 
 func (x *S2C_PlayerLeaveWorld) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[51]
+	mi := &file_api_proto_packets_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,7 +5105,7 @@ func (x *S2C_PlayerLeaveWorld) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_PlayerLeaveWorld.ProtoReflect.Descriptor instead.
 func (*S2C_PlayerLeaveWorld) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{51}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *S2C_PlayerLeaveWorld) GetEntityId() uint64 {
@@ -4119,13 +5118,14 @@ func (x *S2C_PlayerLeaveWorld) GetEntityId() uint64 {
 type S2C_ChunkLoad struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chunk         *ChunkData             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Weather       WeatherType            `protobuf:"varint,2,opt,name=weather,proto3,enum=proto.WeatherType" json:"weather,omitempty"` // погода над чанком на момент загрузки
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *S2C_ChunkLoad) Reset() {
 	*x = S2C_ChunkLoad{}
-	mi := &file_api_proto_packets_proto_msgTypes[52]
+	mi := &file_api_proto_packets_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4137,7 +5137,7 @@ func (x *S2C_ChunkLoad) String() string {
 func (*S2C_ChunkLoad) ProtoMessage() {}
 
 func (x *S2C_ChunkLoad) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[52]
+	mi := &file_api_proto_packets_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4150,7 +5150,7 @@ func (x *S2C_ChunkLoad) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ChunkLoad.ProtoReflect.Descriptor instead.
 func (*S2C_ChunkLoad) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{52}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *S2C_ChunkLoad) GetChunk() *ChunkData {
@@ -4160,6 +5160,13 @@ func (x *S2C_ChunkLoad) GetChunk() *ChunkData {
 	return nil
 }
 
+func (x *S2C_ChunkLoad) GetWeather() WeatherType {
+	if x != nil {
+		return x.Weather
+	}
+	return WeatherType_WEATHER_TYPE_CLEAR
+}
+
 type S2C_ChunkUnload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coord         *ChunkCoord            `protobuf:"bytes,1,opt,name=coord,proto3" json:"coord,omitempty"`
@@ -4169,7 +5176,7 @@ type S2C_ChunkUnload struct {
 
 func (x *S2C_ChunkUnload) Reset() {
 	*x = S2C_ChunkUnload{}
-	mi := &file_api_proto_packets_proto_msgTypes[53]
+	mi := &file_api_proto_packets_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4181,7 +5188,7 @@ func (x *S2C_ChunkUnload) String() string {
 func (*S2C_ChunkUnload) ProtoMessage() {}
 
 func (x *S2C_ChunkUnload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[53]
+	mi := &file_api_proto_packets_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4194,7 +5201,7 @@ func (x *S2C_ChunkUnload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ChunkUnload.ProtoReflect.Descriptor instead.
 func (*S2C_ChunkUnload) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{53}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *S2C_ChunkUnload) GetCoord() *ChunkCoord {
@@ -4204,32 +5211,31 @@ func (x *S2C_ChunkUnload) GetCoord() *ChunkCoord {
 	return nil
 }
 
-type S2C_ObjectSpawn struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	EntityId          uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	TypeId            uint32                 `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"` // defId from object definitions
-	ResourcePath      string                 `protobuf:"bytes,3,opt,name=resource_path,json=resourcePath,proto3" json:"resource_path,omitempty"`
-	Position          *EntityPosition        `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
-	CarriedByEntityId uint64                 `protobuf:"varint,5,opt,name=carried_by_entity_id,json=carriedByEntityId,proto3" json:"carried_by_entity_id,omitempty"` // 0 when not carried
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Изменение отдельных тайлов загруженного чанка (копание, мощение, засыпка воды)
+type TileChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             uint32                 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // локальная координата тайла в чанке
+	Y             uint32                 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Tile          uint32                 `protobuf:"varint,3,opt,name=tile,proto3" json:"tile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_ObjectSpawn) Reset() {
-	*x = S2C_ObjectSpawn{}
-	mi := &file_api_proto_packets_proto_msgTypes[54]
+func (x *TileChange) Reset() {
+	*x = TileChange{}
+	mi := &file_api_proto_packets_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_ObjectSpawn) String() string {
+func (x *TileChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_ObjectSpawn) ProtoMessage() {}
+func (*TileChange) ProtoMessage() {}
 
-func (x *S2C_ObjectSpawn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[54]
+func (x *TileChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4240,68 +5246,116 @@ func (x *S2C_ObjectSpawn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_ObjectSpawn.ProtoReflect.Descriptor instead.
-func (*S2C_ObjectSpawn) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use TileChange.ProtoReflect.Descriptor instead.
+func (*TileChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *S2C_ObjectSpawn) GetEntityId() uint64 {
+func (x *TileChange) GetX() uint32 {
 	if x != nil {
-		return x.EntityId
+		return x.X
 	}
 	return 0
 }
 
-func (x *S2C_ObjectSpawn) GetTypeId() uint32 {
+func (x *TileChange) GetY() uint32 {
 	if x != nil {
-		return x.TypeId
+		return x.Y
 	}
 	return 0
 }
 
-func (x *S2C_ObjectSpawn) GetResourcePath() string {
+func (x *TileChange) GetTile() uint32 {
 	if x != nil {
-		return x.ResourcePath
+		return x.Tile
 	}
-	return ""
+	return 0
 }
 
-func (x *S2C_ObjectSpawn) GetPosition() *EntityPosition {
+type S2C_TileUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coord         *ChunkCoord            `protobuf:"bytes,1,opt,name=coord,proto3" json:"coord,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // версия чанка после изменения
+	Tiles         []*TileChange          `protobuf:"bytes,3,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_TileUpdate) Reset() {
+	*x = S2C_TileUpdate{}
+	mi := &file_api_proto_packets_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_TileUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_TileUpdate) ProtoMessage() {}
+
+func (x *S2C_TileUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[67]
 	if x != nil {
-		return x.Position
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_TileUpdate.ProtoReflect.Descriptor instead.
+func (*S2C_TileUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *S2C_TileUpdate) GetCoord() *ChunkCoord {
+	if x != nil {
+		return x.Coord
 	}
 	return nil
 }
 
-func (x *S2C_ObjectSpawn) GetCarriedByEntityId() uint64 {
+func (x *S2C_TileUpdate) GetVersion() uint32 {
 	if x != nil {
-		return x.CarriedByEntityId
+		return x.Version
 	}
 	return 0
 }
 
-type S2C_ObjectDespawn struct {
+func (x *S2C_TileUpdate) GetTiles() []*TileChange {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+// Смена погоды над загруженным чанком
+type S2C_Weather struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Coord         *ChunkCoord            `protobuf:"bytes,1,opt,name=coord,proto3" json:"coord,omitempty"`
+	Weather       WeatherType            `protobuf:"varint,2,opt,name=weather,proto3,enum=proto.WeatherType" json:"weather,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_ObjectDespawn) Reset() {
-	*x = S2C_ObjectDespawn{}
-	mi := &file_api_proto_packets_proto_msgTypes[55]
+func (x *S2C_Weather) Reset() {
+	*x = S2C_Weather{}
+	mi := &file_api_proto_packets_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_ObjectDespawn) String() string {
+func (x *S2C_Weather) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_ObjectDespawn) ProtoMessage() {}
+func (*S2C_Weather) ProtoMessage() {}
 
-func (x *S2C_ObjectDespawn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[55]
+func (x *S2C_Weather) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4312,9 +5366,136 @@ func (x *S2C_ObjectDespawn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_ObjectDespawn.ProtoReflect.Descriptor instead.
-func (*S2C_ObjectDespawn) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use S2C_Weather.ProtoReflect.Descriptor instead.
+func (*S2C_Weather) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *S2C_Weather) GetCoord() *ChunkCoord {
+	if x != nil {
+		return x.Coord
+	}
+	return nil
+}
+
+func (x *S2C_Weather) GetWeather() WeatherType {
+	if x != nil {
+		return x.Weather
+	}
+	return WeatherType_WEATHER_TYPE_CLEAR
+}
+
+type S2C_ObjectSpawn struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EntityId          uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	TypeId            uint32                 `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"` // defId from object definitions
+	ResourcePath      string                 `protobuf:"bytes,3,opt,name=resource_path,json=resourcePath,proto3" json:"resource_path,omitempty"`
+	Position          *EntityPosition        `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	CarriedByEntityId uint64                 `protobuf:"varint,5,opt,name=carried_by_entity_id,json=carriedByEntityId,proto3" json:"carried_by_entity_id,omitempty"` // 0 when not carried
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *S2C_ObjectSpawn) Reset() {
+	*x = S2C_ObjectSpawn{}
+	mi := &file_api_proto_packets_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_ObjectSpawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ObjectSpawn) ProtoMessage() {}
+
+func (x *S2C_ObjectSpawn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ObjectSpawn.ProtoReflect.Descriptor instead.
+func (*S2C_ObjectSpawn) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *S2C_ObjectSpawn) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *S2C_ObjectSpawn) GetTypeId() uint32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *S2C_ObjectSpawn) GetResourcePath() string {
+	if x != nil {
+		return x.ResourcePath
+	}
+	return ""
+}
+
+func (x *S2C_ObjectSpawn) GetPosition() *EntityPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *S2C_ObjectSpawn) GetCarriedByEntityId() uint64 {
+	if x != nil {
+		return x.CarriedByEntityId
+	}
+	return 0
+}
+
+type S2C_ObjectDespawn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_ObjectDespawn) Reset() {
+	*x = S2C_ObjectDespawn{}
+	mi := &file_api_proto_packets_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_ObjectDespawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ObjectDespawn) ProtoMessage() {}
+
+func (x *S2C_ObjectDespawn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ObjectDespawn.ProtoReflect.Descriptor instead.
+func (*S2C_ObjectDespawn) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *S2C_ObjectDespawn) GetEntityId() uint64 {
@@ -4341,7 +5522,7 @@ type S2C_ObjectMove struct {
 
 func (x *S2C_ObjectMove) Reset() {
 	*x = S2C_ObjectMove{}
-	mi := &file_api_proto_packets_proto_msgTypes[56]
+	mi := &file_api_proto_packets_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4353,7 +5534,7 @@ func (x *S2C_ObjectMove) String() string {
 func (*S2C_ObjectMove) ProtoMessage() {}
 
 func (x *S2C_ObjectMove) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[56]
+	mi := &file_api_proto_packets_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4366,7 +5547,7 @@ func (x *S2C_ObjectMove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ObjectMove.ProtoReflect.Descriptor instead.
 func (*S2C_ObjectMove) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{56}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *S2C_ObjectMove) GetEntityId() uint64 {
@@ -4421,7 +5602,7 @@ type S2C_MovementMode struct {
 
 func (x *S2C_MovementMode) Reset() {
 	*x = S2C_MovementMode{}
-	mi := &file_api_proto_packets_proto_msgTypes[57]
+	mi := &file_api_proto_packets_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4433,7 +5614,7 @@ func (x *S2C_MovementMode) String() string {
 func (*S2C_MovementMode) ProtoMessage() {}
 
 func (x *S2C_MovementMode) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[57]
+	mi := &file_api_proto_packets_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4446,7 +5627,7 @@ func (x *S2C_MovementMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_MovementMode.ProtoReflect.Descriptor instead.
 func (*S2C_MovementMode) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{57}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *S2C_MovementMode) GetEntityId() uint64 {
@@ -4480,7 +5661,7 @@ type S2C_InventoryOpResult struct {
 
 func (x *S2C_InventoryOpResult) Reset() {
 	*x = S2C_InventoryOpResult{}
-	mi := &file_api_proto_packets_proto_msgTypes[58]
+	mi := &file_api_proto_packets_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4492,7 +5673,7 @@ func (x *S2C_InventoryOpResult) String() string {
 func (*S2C_InventoryOpResult) ProtoMessage() {}
 
 func (x *S2C_InventoryOpResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[58]
+	mi := &file_api_proto_packets_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4505,7 +5686,7 @@ func (x *S2C_InventoryOpResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_InventoryOpResult.ProtoReflect.Descriptor instead.
 func (*S2C_InventoryOpResult) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{58}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *S2C_InventoryOpResult) GetOpId() uint64 {
@@ -4566,7 +5747,7 @@ type S2C_InventoryUpdate struct {
 
 func (x *S2C_InventoryUpdate) Reset() {
 	*x = S2C_InventoryUpdate{}
-	mi := &file_api_proto_packets_proto_msgTypes[59]
+	mi := &file_api_proto_packets_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4578,7 +5759,7 @@ func (x *S2C_InventoryUpdate) String() string {
 func (*S2C_InventoryUpdate) ProtoMessage() {}
 
 func (x *S2C_InventoryUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[59]
+	mi := &file_api_proto_packets_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4591,7 +5772,7 @@ func (x *S2C_InventoryUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_InventoryUpdate.ProtoReflect.Descriptor instead.
 func (*S2C_InventoryUpdate) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{59}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *S2C_InventoryUpdate) GetUpdated() []*InventoryState {
@@ -4610,7 +5791,7 @@ type S2C_ContainerOpened struct {
 
 func (x *S2C_ContainerOpened) Reset() {
 	*x = S2C_ContainerOpened{}
-	mi := &file_api_proto_packets_proto_msgTypes[60]
+	mi := &file_api_proto_packets_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4622,7 +5803,7 @@ func (x *S2C_ContainerOpened) String() string {
 func (*S2C_ContainerOpened) ProtoMessage() {}
 
 func (x *S2C_ContainerOpened) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[60]
+	mi := &file_api_proto_packets_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4635,7 +5816,7 @@ func (x *S2C_ContainerOpened) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ContainerOpened.ProtoReflect.Descriptor instead.
 func (*S2C_ContainerOpened) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{60}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *S2C_ContainerOpened) GetState() *InventoryState {
@@ -4654,7 +5835,7 @@ type S2C_ContainerClosed struct {
 
 func (x *S2C_ContainerClosed) Reset() {
 	*x = S2C_ContainerClosed{}
-	mi := &file_api_proto_packets_proto_msgTypes[61]
+	mi := &file_api_proto_packets_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4666,7 +5847,7 @@ func (x *S2C_ContainerClosed) String() string {
 func (*S2C_ContainerClosed) ProtoMessage() {}
 
 func (x *S2C_ContainerClosed) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[61]
+	mi := &file_api_proto_packets_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4679,7 +5860,7 @@ func (x *S2C_ContainerClosed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ContainerClosed.ProtoReflect.Descriptor instead.
 func (*S2C_ContainerClosed) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{61}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *S2C_ContainerClosed) GetRef() *InventoryRef {
@@ -4699,7 +5880,7 @@ type ContextMenuAction struct {
 
 func (x *ContextMenuAction) Reset() {
 	*x = ContextMenuAction{}
-	mi := &file_api_proto_packets_proto_msgTypes[62]
+	mi := &file_api_proto_packets_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4711,7 +5892,7 @@ func (x *ContextMenuAction) String() string {
 func (*ContextMenuAction) ProtoMessage() {}
 
 func (x *ContextMenuAction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[62]
+	mi := &file_api_proto_packets_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4724,7 +5905,7 @@ func (x *ContextMenuAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContextMenuAction.ProtoReflect.Descriptor instead.
 func (*ContextMenuAction) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{62}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ContextMenuAction) GetActionId() string {
@@ -4745,13 +5926,14 @@ type S2C_ContextMenu struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	Actions       []*ContextMenuAction   `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	ItemId        uint64                 `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // меню предмета инвентаря (entity_id = 0)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *S2C_ContextMenu) Reset() {
 	*x = S2C_ContextMenu{}
-	mi := &file_api_proto_packets_proto_msgTypes[63]
+	mi := &file_api_proto_packets_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4763,7 +5945,7 @@ func (x *S2C_ContextMenu) String() string {
 func (*S2C_ContextMenu) ProtoMessage() {}
 
 func (x *S2C_ContextMenu) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[63]
+	mi := &file_api_proto_packets_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4776,7 +5958,7 @@ func (x *S2C_ContextMenu) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_ContextMenu.ProtoReflect.Descriptor instead.
 func (*S2C_ContextMenu) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{63}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *S2C_ContextMenu) GetEntityId() uint64 {
@@ -4793,6 +5975,13 @@ func (x *S2C_ContextMenu) GetActions() []*ContextMenuAction {
 	return nil
 }
 
+func (x *S2C_ContextMenu) GetItemId() uint64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
 type S2C_MiniAlert struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Severity      AlertSeverity          `protobuf:"varint,1,opt,name=severity,proto3,enum=proto.AlertSeverity" json:"severity,omitempty"`
@@ -4804,7 +5993,7 @@ type S2C_MiniAlert struct {
 
 func (x *S2C_MiniAlert) Reset() {
 	*x = S2C_MiniAlert{}
-	mi := &file_api_proto_packets_proto_msgTypes[64]
+	mi := &file_api_proto_packets_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4816,7 +6005,7 @@ func (x *S2C_MiniAlert) String() string {
 func (*S2C_MiniAlert) ProtoMessage() {}
 
 func (x *S2C_MiniAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[64]
+	mi := &file_api_proto_packets_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4829,7 +6018,7 @@ func (x *S2C_MiniAlert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_MiniAlert.ProtoReflect.Descriptor instead.
 func (*S2C_MiniAlert) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{64}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *S2C_MiniAlert) GetSeverity() AlertSeverity {
@@ -4866,7 +6055,7 @@ type S2C_CyclicActionProgress struct {
 
 func (x *S2C_CyclicActionProgress) Reset() {
 	*x = S2C_CyclicActionProgress{}
-	mi := &file_api_proto_packets_proto_msgTypes[65]
+	mi := &file_api_proto_packets_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4878,7 +6067,7 @@ func (x *S2C_CyclicActionProgress) String() string {
 func (*S2C_CyclicActionProgress) ProtoMessage() {}
 
 func (x *S2C_CyclicActionProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[65]
+	mi := &file_api_proto_packets_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4891,7 +6080,7 @@ func (x *S2C_CyclicActionProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_CyclicActionProgress.ProtoReflect.Descriptor instead.
 func (*S2C_CyclicActionProgress) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{65}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *S2C_CyclicActionProgress) GetActionId() string {
@@ -4942,7 +6131,7 @@ type S2C_CyclicActionFinished struct {
 
 func (x *S2C_CyclicActionFinished) Reset() {
 	*x = S2C_CyclicActionFinished{}
-	mi := &file_api_proto_packets_proto_msgTypes[66]
+	mi := &file_api_proto_packets_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4954,7 +6143,7 @@ func (x *S2C_CyclicActionFinished) String() string {
 func (*S2C_CyclicActionFinished) ProtoMessage() {}
 
 func (x *S2C_CyclicActionFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[66]
+	mi := &file_api_proto_packets_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4967,7 +6156,7 @@ func (x *S2C_CyclicActionFinished) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_CyclicActionFinished.ProtoReflect.Descriptor instead.
 func (*S2C_CyclicActionFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{66}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *S2C_CyclicActionFinished) GetActionId() string {
@@ -5017,7 +6206,7 @@ type CraftInputDef struct {
 
 func (x *CraftInputDef) Reset() {
 	*x = CraftInputDef{}
-	mi := &file_api_proto_packets_proto_msgTypes[67]
+	mi := &file_api_proto_packets_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5029,7 +6218,7 @@ func (x *CraftInputDef) String() string {
 func (*CraftInputDef) ProtoMessage() {}
 
 func (x *CraftInputDef) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[67]
+	mi := &file_api_proto_packets_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5042,7 +6231,7 @@ func (x *CraftInputDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftInputDef.ProtoReflect.Descriptor instead.
 func (*CraftInputDef) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{67}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *CraftInputDef) GetItemKey() string {
@@ -5083,7 +6272,7 @@ type CraftOutputDef struct {
 
 func (x *CraftOutputDef) Reset() {
 	*x = CraftOutputDef{}
-	mi := &file_api_proto_packets_proto_msgTypes[68]
+	mi := &file_api_proto_packets_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5095,7 +6284,7 @@ func (x *CraftOutputDef) String() string {
 func (*CraftOutputDef) ProtoMessage() {}
 
 func (x *CraftOutputDef) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[68]
+	mi := &file_api_proto_packets_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5108,7 +6297,7 @@ func (x *CraftOutputDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftOutputDef.ProtoReflect.Descriptor instead.
 func (*CraftOutputDef) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{68}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *CraftOutputDef) GetItemKey() string {
@@ -5138,7 +6327,7 @@ type CraftRequirementFlags struct {
 
 func (x *CraftRequirementFlags) Reset() {
 	*x = CraftRequirementFlags{}
-	mi := &file_api_proto_packets_proto_msgTypes[69]
+	mi := &file_api_proto_packets_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5150,7 +6339,7 @@ func (x *CraftRequirementFlags) String() string {
 func (*CraftRequirementFlags) ProtoMessage() {}
 
 func (x *CraftRequirementFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[69]
+	mi := &file_api_proto_packets_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5163,7 +6352,7 @@ func (x *CraftRequirementFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftRequirementFlags.ProtoReflect.Descriptor instead.
 func (*CraftRequirementFlags) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{69}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *CraftRequirementFlags) GetHasRequiredLinkedObject() bool {
@@ -5220,7 +6409,7 @@ type CraftRecipeEntry struct {
 
 func (x *CraftRecipeEntry) Reset() {
 	*x = CraftRecipeEntry{}
-	mi := &file_api_proto_packets_proto_msgTypes[70]
+	mi := &file_api_proto_packets_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5232,7 +6421,7 @@ func (x *CraftRecipeEntry) String() string {
 func (*CraftRecipeEntry) ProtoMessage() {}
 
 func (x *CraftRecipeEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[70]
+	mi := &file_api_proto_packets_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5245,7 +6434,7 @@ func (x *CraftRecipeEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftRecipeEntry.ProtoReflect.Descriptor instead.
 func (*CraftRecipeEntry) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{70}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *CraftRecipeEntry) GetCraftKey() string {
@@ -5334,7 +6523,7 @@ type S2C_CraftList struct {
 
 func (x *S2C_CraftList) Reset() {
 	*x = S2C_CraftList{}
-	mi := &file_api_proto_packets_proto_msgTypes[71]
+	mi := &file_api_proto_packets_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5346,7 +6535,7 @@ func (x *S2C_CraftList) String() string {
 func (*S2C_CraftList) ProtoMessage() {}
 
 func (x *S2C_CraftList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[71]
+	mi := &file_api_proto_packets_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5359,7 +6548,7 @@ func (x *S2C_CraftList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_CraftList.ProtoReflect.Descriptor instead.
 func (*S2C_CraftList) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{71}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *S2C_CraftList) GetRecipes() []*CraftRecipeEntry {
@@ -5382,7 +6571,7 @@ type BuildInputDef struct {
 
 func (x *BuildInputDef) Reset() {
 	*x = BuildInputDef{}
-	mi := &file_api_proto_packets_proto_msgTypes[72]
+	mi := &file_api_proto_packets_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5394,7 +6583,7 @@ func (x *BuildInputDef) String() string {
 func (*BuildInputDef) ProtoMessage() {}
 
 func (x *BuildInputDef) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[72]
+	mi := &file_api_proto_packets_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5407,7 +6596,7 @@ func (x *BuildInputDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildInputDef.ProtoReflect.Descriptor instead.
 func (*BuildInputDef) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{72}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *BuildInputDef) GetItemKey() string {
@@ -5459,7 +6648,7 @@ type BuildStateItem struct {
 
 func (x *BuildStateItem) Reset() {
 	*x = BuildStateItem{}
-	mi := &file_api_proto_packets_proto_msgTypes[73]
+	mi := &file_api_proto_packets_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5471,7 +6660,7 @@ func (x *BuildStateItem) String() string {
 func (*BuildStateItem) ProtoMessage() {}
 
 func (x *BuildStateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[73]
+	mi := &file_api_proto_packets_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5484,7 +6673,7 @@ func (x *BuildStateItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildStateItem.ProtoReflect.Descriptor instead.
 func (*BuildStateItem) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{73}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *BuildStateItem) GetResource() string {
@@ -5548,7 +6737,7 @@ type BuildRecipeEntry struct {
 
 func (x *BuildRecipeEntry) Reset() {
 	*x = BuildRecipeEntry{}
-	mi := &file_api_proto_packets_proto_msgTypes[74]
+	mi := &file_api_proto_packets_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5560,7 +6749,7 @@ func (x *BuildRecipeEntry) String() string {
 func (*BuildRecipeEntry) ProtoMessage() {}
 
 func (x *BuildRecipeEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[74]
+	mi := &file_api_proto_packets_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5573,7 +6762,7 @@ func (x *BuildRecipeEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildRecipeEntry.ProtoReflect.Descriptor instead.
 func (*BuildRecipeEntry) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{74}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *BuildRecipeEntry) GetBuildKey() string {
@@ -5662,7 +6851,7 @@ type S2C_BuildList struct {
 
 func (x *S2C_BuildList) Reset() {
 	*x = S2C_BuildList{}
-	mi := &file_api_proto_packets_proto_msgTypes[75]
+	mi := &file_api_proto_packets_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5674,7 +6863,7 @@ func (x *S2C_BuildList) String() string {
 func (*S2C_BuildList) ProtoMessage() {}
 
 func (x *S2C_BuildList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[75]
+	mi := &file_api_proto_packets_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5687,7 +6876,7 @@ func (x *S2C_BuildList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_BuildList.ProtoReflect.Descriptor instead.
 func (*S2C_BuildList) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{75}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *S2C_BuildList) GetBuilds() []*BuildRecipeEntry {
@@ -5708,7 +6897,7 @@ type S2C_BuildState struct {
 
 func (x *S2C_BuildState) Reset() {
 	*x = S2C_BuildState{}
-	mi := &file_api_proto_packets_proto_msgTypes[76]
+	mi := &file_api_proto_packets_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5720,7 +6909,7 @@ func (x *S2C_BuildState) String() string {
 func (*S2C_BuildState) ProtoMessage() {}
 
 func (x *S2C_BuildState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[76]
+	mi := &file_api_proto_packets_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5733,7 +6922,7 @@ func (x *S2C_BuildState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_BuildState.ProtoReflect.Descriptor instead.
 func (*S2C_BuildState) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{76}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *S2C_BuildState) GetEntityId() uint64 {
@@ -5757,28 +6946,35 @@ func (x *S2C_BuildState) GetBuildName() string {
 	return ""
 }
 
-type S2C_BuildStateClosed struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+type SkillEntry struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	SkillKey           string                     `protobuf:"bytes,1,opt,name=skill_key,json=skillKey,proto3" json:"skill_key,omitempty"`
+	Name               string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LpCost             int64                      `protobuf:"varint,3,opt,name=lp_cost,json=lpCost,proto3" json:"lp_cost,omitempty"`
+	RequiredSkills     []string                   `protobuf:"bytes,4,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`
+	RequiredAttributes []*CharacterAttributeEntry `protobuf:"bytes,5,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+	Learned            bool                       `protobuf:"varint,6,opt,name=learned,proto3" json:"learned,omitempty"`
+	// Все требования выполнены и LP хватает.
+	CanLearn      bool `protobuf:"varint,7,opt,name=can_learn,json=canLearn,proto3" json:"can_learn,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_BuildStateClosed) Reset() {
-	*x = S2C_BuildStateClosed{}
-	mi := &file_api_proto_packets_proto_msgTypes[77]
+func (x *SkillEntry) Reset() {
+	*x = SkillEntry{}
+	mi := &file_api_proto_packets_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_BuildStateClosed) String() string {
+func (x *SkillEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_BuildStateClosed) ProtoMessage() {}
+func (*SkillEntry) ProtoMessage() {}
 
-func (x *S2C_BuildStateClosed) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[77]
+func (x *SkillEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5789,20 +6985,358 @@ func (x *S2C_BuildStateClosed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_BuildStateClosed.ProtoReflect.Descriptor instead.
-func (*S2C_BuildStateClosed) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use SkillEntry.ProtoReflect.Descriptor instead.
+func (*SkillEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *S2C_BuildStateClosed) GetEntityId() uint64 {
+func (x *SkillEntry) GetSkillKey() string {
 	if x != nil {
-		return x.EntityId
+		return x.SkillKey
 	}
-	return 0
+	return ""
 }
 
-type S2C_LiftCarryState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SkillEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkillEntry) GetLpCost() int64 {
+	if x != nil {
+		return x.LpCost
+	}
+	return 0
+}
+
+func (x *SkillEntry) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *SkillEntry) GetRequiredAttributes() []*CharacterAttributeEntry {
+	if x != nil {
+		return x.RequiredAttributes
+	}
+	return nil
+}
+
+func (x *SkillEntry) GetLearned() bool {
+	if x != nil {
+		return x.Learned
+	}
+	return false
+}
+
+func (x *SkillEntry) GetCanLearn() bool {
+	if x != nil {
+		return x.CanLearn
+	}
+	return false
+}
+
+type CombatCooldown struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MoveKey        string                 `protobuf:"bytes,1,opt,name=move_key,json=moveKey,proto3" json:"move_key,omitempty"`
+	RemainingTicks uint32                 `protobuf:"varint,2,opt,name=remaining_ticks,json=remainingTicks,proto3" json:"remaining_ticks,omitempty"` // 0 — приём доступен
+	TotalTicks     uint32                 `protobuf:"varint,3,opt,name=total_ticks,json=totalTicks,proto3" json:"total_ticks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CombatCooldown) Reset() {
+	*x = CombatCooldown{}
+	mi := &file_api_proto_packets_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombatCooldown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombatCooldown) ProtoMessage() {}
+
+func (x *CombatCooldown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombatCooldown.ProtoReflect.Descriptor instead.
+func (*CombatCooldown) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CombatCooldown) GetMoveKey() string {
+	if x != nil {
+		return x.MoveKey
+	}
+	return ""
+}
+
+func (x *CombatCooldown) GetRemainingTicks() uint32 {
+	if x != nil {
+		return x.RemainingTicks
+	}
+	return 0
+}
+
+func (x *CombatCooldown) GetTotalTicks() uint32 {
+	if x != nil {
+		return x.TotalTicks
+	}
+	return 0
+}
+
+// Состояние боя игрока. Шлётся при изменении связей, приёмов и перезарядок.
+// Пустой opponent_ids — бой окончен.
+type S2C_CombatState struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OpponentIds           []uint64               `protobuf:"varint,1,rep,packed,name=opponent_ids,json=opponentIds,proto3" json:"opponent_ids,omitempty"`
+	Cooldowns             []*CombatCooldown      `protobuf:"bytes,2,rep,name=cooldowns,proto3" json:"cooldowns,omitempty"`
+	PendingMove           string                 `protobuf:"bytes,3,opt,name=pending_move,json=pendingMove,proto3" json:"pending_move,omitempty"`
+	PendingTargetId       uint64                 `protobuf:"varint,4,opt,name=pending_target_id,json=pendingTargetId,proto3" json:"pending_target_id,omitempty"`
+	PendingRemainingTicks uint32                 `protobuf:"varint,5,opt,name=pending_remaining_ticks,json=pendingRemainingTicks,proto3" json:"pending_remaining_ticks,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *S2C_CombatState) Reset() {
+	*x = S2C_CombatState{}
+	mi := &file_api_proto_packets_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_CombatState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_CombatState) ProtoMessage() {}
+
+func (x *S2C_CombatState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_CombatState.ProtoReflect.Descriptor instead.
+func (*S2C_CombatState) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *S2C_CombatState) GetOpponentIds() []uint64 {
+	if x != nil {
+		return x.OpponentIds
+	}
+	return nil
+}
+
+func (x *S2C_CombatState) GetCooldowns() []*CombatCooldown {
+	if x != nil {
+		return x.Cooldowns
+	}
+	return nil
+}
+
+func (x *S2C_CombatState) GetPendingMove() string {
+	if x != nil {
+		return x.PendingMove
+	}
+	return ""
+}
+
+func (x *S2C_CombatState) GetPendingTargetId() uint64 {
+	if x != nil {
+		return x.PendingTargetId
+	}
+	return 0
+}
+
+func (x *S2C_CombatState) GetPendingRemainingTicks() uint32 {
+	if x != nil {
+		return x.PendingRemainingTicks
+	}
+	return 0
+}
+
+// Состояние следования игрока. Шлётся при начале и окончании следования.
+// leader_id = 0 — следование прекращено по причине reason.
+type S2C_FollowState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaderId      uint64                 `protobuf:"varint,1,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Distance      uint32                 `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Reason        FollowStopReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=proto.FollowStopReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_FollowState) Reset() {
+	*x = S2C_FollowState{}
+	mi := &file_api_proto_packets_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_FollowState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_FollowState) ProtoMessage() {}
+
+func (x *S2C_FollowState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_FollowState.ProtoReflect.Descriptor instead.
+func (*S2C_FollowState) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *S2C_FollowState) GetLeaderId() uint64 {
+	if x != nil {
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *S2C_FollowState) GetDistance() uint32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *S2C_FollowState) GetReason() FollowStopReason {
+	if x != nil {
+		return x.Reason
+	}
+	return FollowStopReason_FOLLOW_STOP_REASON_NONE
+}
+
+type S2C_SkillList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*SkillEntry          `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Lp            int64                  `protobuf:"varint,2,opt,name=lp,proto3" json:"lp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_SkillList) Reset() {
+	*x = S2C_SkillList{}
+	mi := &file_api_proto_packets_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_SkillList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_SkillList) ProtoMessage() {}
+
+func (x *S2C_SkillList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_SkillList.ProtoReflect.Descriptor instead.
+func (*S2C_SkillList) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *S2C_SkillList) GetSkills() []*SkillEntry {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *S2C_SkillList) GetLp() int64 {
+	if x != nil {
+		return x.Lp
+	}
+	return 0
+}
+
+type S2C_BuildStateClosed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_BuildStateClosed) Reset() {
+	*x = S2C_BuildStateClosed{}
+	mi := &file_api_proto_packets_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_BuildStateClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_BuildStateClosed) ProtoMessage() {}
+
+func (x *S2C_BuildStateClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_BuildStateClosed.ProtoReflect.Descriptor instead.
+func (*S2C_BuildStateClosed) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *S2C_BuildStateClosed) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+type S2C_LiftCarryState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
 	EntityId      uint64                 `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -5811,7 +7345,7 @@ type S2C_LiftCarryState struct {
 
 func (x *S2C_LiftCarryState) Reset() {
 	*x = S2C_LiftCarryState{}
-	mi := &file_api_proto_packets_proto_msgTypes[78]
+	mi := &file_api_proto_packets_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5823,7 +7357,7 @@ func (x *S2C_LiftCarryState) String() string {
 func (*S2C_LiftCarryState) ProtoMessage() {}
 
 func (x *S2C_LiftCarryState) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[78]
+	mi := &file_api_proto_packets_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5836,7 +7370,7 @@ func (x *S2C_LiftCarryState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_LiftCarryState.ProtoReflect.Descriptor instead.
 func (*S2C_LiftCarryState) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{78}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *S2C_LiftCarryState) GetActive() bool {
@@ -5865,7 +7399,7 @@ type S2C_Sound struct {
 
 func (x *S2C_Sound) Reset() {
 	*x = S2C_Sound{}
-	mi := &file_api_proto_packets_proto_msgTypes[79]
+	mi := &file_api_proto_packets_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5877,7 +7411,7 @@ func (x *S2C_Sound) String() string {
 func (*S2C_Sound) ProtoMessage() {}
 
 func (x *S2C_Sound) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[79]
+	mi := &file_api_proto_packets_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5890,7 +7424,7 @@ func (x *S2C_Sound) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_Sound.ProtoReflect.Descriptor instead.
 func (*S2C_Sound) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{79}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *S2C_Sound) GetSoundKey() string {
@@ -5900,53 +7434,345 @@ func (x *S2C_Sound) GetSoundKey() string {
 	return ""
 }
 
-func (x *S2C_Sound) GetX() float64 {
+func (x *S2C_Sound) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *S2C_Sound) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *S2C_Sound) GetMaxHearDistance() float64 {
+	if x != nil {
+		return x.MaxHearDistance
+	}
+	return 0
+}
+
+type S2C_ExpGained struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Lp            *int64                 `protobuf:"varint,2,opt,name=lp,proto3,oneof" json:"lp,omitempty"`
+	Nature        *int64                 `protobuf:"varint,3,opt,name=nature,proto3,oneof" json:"nature,omitempty"`
+	Industry      *int64                 `protobuf:"varint,4,opt,name=industry,proto3,oneof" json:"industry,omitempty"`
+	Combat        *int64                 `protobuf:"varint,5,opt,name=combat,proto3,oneof" json:"combat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_ExpGained) Reset() {
+	*x = S2C_ExpGained{}
+	mi := &file_api_proto_packets_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_ExpGained) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ExpGained) ProtoMessage() {}
+
+func (x *S2C_ExpGained) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ExpGained.ProtoReflect.Descriptor instead.
+func (*S2C_ExpGained) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *S2C_ExpGained) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *S2C_ExpGained) GetLp() int64 {
+	if x != nil && x.Lp != nil {
+		return *x.Lp
+	}
+	return 0
+}
+
+func (x *S2C_ExpGained) GetNature() int64 {
+	if x != nil && x.Nature != nil {
+		return *x.Nature
+	}
+	return 0
+}
+
+func (x *S2C_ExpGained) GetIndustry() int64 {
+	if x != nil && x.Industry != nil {
+		return *x.Industry
+	}
+	return 0
+}
+
+func (x *S2C_ExpGained) GetCombat() int64 {
+	if x != nil && x.Combat != nil {
+		return *x.Combat
+	}
+	return 0
+}
+
+type S2C_Fx struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FxKey         string                 `protobuf:"bytes,1,opt,name=fx_key,json=fxKey,proto3" json:"fx_key,omitempty"`
+	Position      *Vector2               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_Fx) Reset() {
+	*x = S2C_Fx{}
+	mi := &file_api_proto_packets_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_Fx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_Fx) ProtoMessage() {}
+
+func (x *S2C_Fx) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_Fx.ProtoReflect.Descriptor instead.
+func (*S2C_Fx) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *S2C_Fx) GetFxKey() string {
+	if x != nil {
+		return x.FxKey
+	}
+	return ""
+}
+
+func (x *S2C_Fx) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type S2C_ChatMessage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Channel      ChatChannel            `protobuf:"varint,1,opt,name=channel,proto3,enum=proto.ChatChannel" json:"channel,omitempty"`
+	FromEntityId uint64                 `protobuf:"varint,2,opt,name=from_entity_id,json=fromEntityId,proto3" json:"from_entity_id,omitempty"`
+	FromName     string                 `protobuf:"bytes,3,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	Text         string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// для приватного чата: кому адресовано (полезно клиенту для UI/логов)
+	ToEntityId    *uint64 `protobuf:"varint,5,opt,name=to_entity_id,json=toEntityId,proto3,oneof" json:"to_entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_ChatMessage) Reset() {
+	*x = S2C_ChatMessage{}
+	mi := &file_api_proto_packets_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ChatMessage) ProtoMessage() {}
+
+func (x *S2C_ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ChatMessage.ProtoReflect.Descriptor instead.
+func (*S2C_ChatMessage) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *S2C_ChatMessage) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_LOCAL
+}
+
+func (x *S2C_ChatMessage) GetFromEntityId() uint64 {
+	if x != nil {
+		return x.FromEntityId
+	}
+	return 0
+}
+
+func (x *S2C_ChatMessage) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *S2C_ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *S2C_ChatMessage) GetToEntityId() uint64 {
+	if x != nil && x.ToEntityId != nil {
+		return *x.ToEntityId
+	}
+	return 0
+}
+
+type ChatHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       ChatChannel            `protobuf:"varint,1,opt,name=channel,proto3,enum=proto.ChatChannel" json:"channel,omitempty"`
+	FromEntityId  uint64                 `protobuf:"varint,2,opt,name=from_entity_id,json=fromEntityId,proto3" json:"from_entity_id,omitempty"`
+	FromName      string                 `protobuf:"bytes,3,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	ToEntityId    *uint64                `protobuf:"varint,5,opt,name=to_entity_id,json=toEntityId,proto3,oneof" json:"to_entity_id,omitempty"`
+	SentAtUnixMs  int64                  `protobuf:"varint,6,opt,name=sent_at_unix_ms,json=sentAtUnixMs,proto3" json:"sent_at_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryEntry) Reset() {
+	*x = ChatHistoryEntry{}
+	mi := &file_api_proto_packets_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryEntry) ProtoMessage() {}
+
+func (x *ChatHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryEntry.ProtoReflect.Descriptor instead.
+func (*ChatHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ChatHistoryEntry) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_LOCAL
+}
+
+func (x *ChatHistoryEntry) GetFromEntityId() uint64 {
+	if x != nil {
+		return x.FromEntityId
+	}
+	return 0
+}
+
+func (x *ChatHistoryEntry) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *ChatHistoryEntry) GetText() string {
 	if x != nil {
-		return x.X
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *S2C_Sound) GetY() float64 {
-	if x != nil {
-		return x.Y
+func (x *ChatHistoryEntry) GetToEntityId() uint64 {
+	if x != nil && x.ToEntityId != nil {
+		return *x.ToEntityId
 	}
 	return 0
 }
 
-func (x *S2C_Sound) GetMaxHearDistance() float64 {
+func (x *ChatHistoryEntry) GetSentAtUnixMs() int64 {
 	if x != nil {
-		return x.MaxHearDistance
+		return x.SentAtUnixMs
 	}
 	return 0
 }
 
-type S2C_ExpGained struct {
+type PartyMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EntityId      uint64                 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	Lp            *int64                 `protobuf:"varint,2,opt,name=lp,proto3,oneof" json:"lp,omitempty"`
-	Nature        *int64                 `protobuf:"varint,3,opt,name=nature,proto3,oneof" json:"nature,omitempty"`
-	Industry      *int64                 `protobuf:"varint,4,opt,name=industry,proto3,oneof" json:"industry,omitempty"`
-	Combat        *int64                 `protobuf:"varint,5,opt,name=combat,proto3,oneof" json:"combat,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online        bool                   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	Layer         int32                  `protobuf:"varint,4,opt,name=layer,proto3" json:"layer,omitempty"`
+	Position      *Vector2               `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"` // для маркеров на карте
+	Shp           uint32                 `protobuf:"varint,6,opt,name=shp,proto3" json:"shp,omitempty"`
+	Hhp           uint32                 `protobuf:"varint,7,opt,name=hhp,proto3" json:"hhp,omitempty"`
+	Mhp           uint32                 `protobuf:"varint,8,opt,name=mhp,proto3" json:"mhp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_ExpGained) Reset() {
-	*x = S2C_ExpGained{}
-	mi := &file_api_proto_packets_proto_msgTypes[80]
+func (x *PartyMember) Reset() {
+	*x = PartyMember{}
+	mi := &file_api_proto_packets_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_ExpGained) String() string {
+func (x *PartyMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_ExpGained) ProtoMessage() {}
+func (*PartyMember) ProtoMessage() {}
 
-func (x *S2C_ExpGained) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[80]
+func (x *PartyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5957,69 +7783,92 @@ func (x *S2C_ExpGained) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_ExpGained.ProtoReflect.Descriptor instead.
-func (*S2C_ExpGained) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use PartyMember.ProtoReflect.Descriptor instead.
+func (*PartyMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *S2C_ExpGained) GetEntityId() uint64 {
+func (x *PartyMember) GetEntityId() uint64 {
 	if x != nil {
 		return x.EntityId
 	}
 	return 0
 }
 
-func (x *S2C_ExpGained) GetLp() int64 {
-	if x != nil && x.Lp != nil {
-		return *x.Lp
+func (x *PartyMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartyMember) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *PartyMember) GetLayer() int32 {
+	if x != nil {
+		return x.Layer
 	}
 	return 0
 }
 
-func (x *S2C_ExpGained) GetNature() int64 {
-	if x != nil && x.Nature != nil {
-		return *x.Nature
+func (x *PartyMember) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PartyMember) GetShp() uint32 {
+	if x != nil {
+		return x.Shp
 	}
 	return 0
 }
 
-func (x *S2C_ExpGained) GetIndustry() int64 {
-	if x != nil && x.Industry != nil {
-		return *x.Industry
+func (x *PartyMember) GetHhp() uint32 {
+	if x != nil {
+		return x.Hhp
 	}
 	return 0
 }
 
-func (x *S2C_ExpGained) GetCombat() int64 {
-	if x != nil && x.Combat != nil {
-		return *x.Combat
+func (x *PartyMember) GetMhp() uint32 {
+	if x != nil {
+		return x.Mhp
 	}
 	return 0
 }
 
-type S2C_Fx struct {
+// Состав группы. Пустой members (party_id = 0) = игрок не в группе
+type S2C_PartyState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FxKey         string                 `protobuf:"bytes,1,opt,name=fx_key,json=fxKey,proto3" json:"fx_key,omitempty"`
-	Position      *Vector2               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	PartyId       uint64                 `protobuf:"varint,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	LeaderId      uint64                 `protobuf:"varint,2,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Members       []*PartyMember         `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_Fx) Reset() {
-	*x = S2C_Fx{}
-	mi := &file_api_proto_packets_proto_msgTypes[81]
+func (x *S2C_PartyState) Reset() {
+	*x = S2C_PartyState{}
+	mi := &file_api_proto_packets_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_Fx) String() string {
+func (x *S2C_PartyState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_Fx) ProtoMessage() {}
+func (*S2C_PartyState) ProtoMessage() {}
 
-func (x *S2C_Fx) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[81]
+func (x *S2C_PartyState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6030,52 +7879,56 @@ func (x *S2C_Fx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_Fx.ProtoReflect.Descriptor instead.
-func (*S2C_Fx) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use S2C_PartyState.ProtoReflect.Descriptor instead.
+func (*S2C_PartyState) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *S2C_Fx) GetFxKey() string {
+func (x *S2C_PartyState) GetPartyId() uint64 {
 	if x != nil {
-		return x.FxKey
+		return x.PartyId
 	}
-	return ""
+	return 0
 }
 
-func (x *S2C_Fx) GetPosition() *Vector2 {
+func (x *S2C_PartyState) GetLeaderId() uint64 {
 	if x != nil {
-		return x.Position
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *S2C_PartyState) GetMembers() []*PartyMember {
+	if x != nil {
+		return x.Members
 	}
 	return nil
 }
 
-type S2C_ChatMessage struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Channel      ChatChannel            `protobuf:"varint,1,opt,name=channel,proto3,enum=proto.ChatChannel" json:"channel,omitempty"`
-	FromEntityId uint64                 `protobuf:"varint,2,opt,name=from_entity_id,json=fromEntityId,proto3" json:"from_entity_id,omitempty"`
-	FromName     string                 `protobuf:"bytes,3,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
-	Text         string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
-	// для приватного чата: кому адресовано (полезно клиенту для UI/логов)
-	ToEntityId    *uint64 `protobuf:"varint,5,opt,name=to_entity_id,json=toEntityId,proto3,oneof" json:"to_entity_id,omitempty"`
+type S2C_PartyInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromEntityId  uint64                 `protobuf:"varint,1,opt,name=from_entity_id,json=fromEntityId,proto3" json:"from_entity_id,omitempty"`
+	FromName      string                 `protobuf:"bytes,2,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	ExpiresInMs   uint32                 `protobuf:"varint,3,opt,name=expires_in_ms,json=expiresInMs,proto3" json:"expires_in_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *S2C_ChatMessage) Reset() {
-	*x = S2C_ChatMessage{}
-	mi := &file_api_proto_packets_proto_msgTypes[82]
+func (x *S2C_PartyInvite) Reset() {
+	*x = S2C_PartyInvite{}
+	mi := &file_api_proto_packets_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *S2C_ChatMessage) String() string {
+func (x *S2C_PartyInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_ChatMessage) ProtoMessage() {}
+func (*S2C_PartyInvite) ProtoMessage() {}
 
-func (x *S2C_ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[82]
+func (x *S2C_PartyInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6086,44 +7939,75 @@ func (x *S2C_ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_ChatMessage.ProtoReflect.Descriptor instead.
-func (*S2C_ChatMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *S2C_ChatMessage) GetChannel() ChatChannel {
-	if x != nil {
-		return x.Channel
-	}
-	return ChatChannel_CHAT_CHANNEL_LOCAL
+// Deprecated: Use S2C_PartyInvite.ProtoReflect.Descriptor instead.
+func (*S2C_PartyInvite) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *S2C_ChatMessage) GetFromEntityId() uint64 {
+func (x *S2C_PartyInvite) GetFromEntityId() uint64 {
 	if x != nil {
 		return x.FromEntityId
 	}
 	return 0
 }
 
-func (x *S2C_ChatMessage) GetFromName() string {
+func (x *S2C_PartyInvite) GetFromName() string {
 	if x != nil {
 		return x.FromName
 	}
 	return ""
 }
 
-func (x *S2C_ChatMessage) GetText() string {
+func (x *S2C_PartyInvite) GetExpiresInMs() uint32 {
 	if x != nil {
-		return x.Text
+		return x.ExpiresInMs
 	}
-	return ""
+	return 0
 }
 
-func (x *S2C_ChatMessage) GetToEntityId() uint64 {
-	if x != nil && x.ToEntityId != nil {
-		return *x.ToEntityId
+// История чата, отсортирована по времени (старые -> новые)
+type S2C_ChatHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatHistoryEntry    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S2C_ChatHistory) Reset() {
+	*x = S2C_ChatHistory{}
+	mi := &file_api_proto_packets_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S2C_ChatHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ChatHistory) ProtoMessage() {}
+
+func (x *S2C_ChatHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_packets_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ChatHistory.ProtoReflect.Descriptor instead.
+func (*S2C_ChatHistory) Descriptor() ([]byte, []int) {
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *S2C_ChatHistory) GetMessages() []*ChatHistoryEntry {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
 }
 
 type S2C_Error struct {
@@ -6136,7 +8020,7 @@ type S2C_Error struct {
 
 func (x *S2C_Error) Reset() {
 	*x = S2C_Error{}
-	mi := &file_api_proto_packets_proto_msgTypes[83]
+	mi := &file_api_proto_packets_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6148,7 +8032,7 @@ func (x *S2C_Error) String() string {
 func (*S2C_Error) ProtoMessage() {}
 
 func (x *S2C_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[83]
+	mi := &file_api_proto_packets_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6161,7 +8045,7 @@ func (x *S2C_Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_Error.ProtoReflect.Descriptor instead.
 func (*S2C_Error) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{83}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *S2C_Error) GetCode() ErrorCode {
@@ -6188,7 +8072,7 @@ type S2C_Warning struct {
 
 func (x *S2C_Warning) Reset() {
 	*x = S2C_Warning{}
-	mi := &file_api_proto_packets_proto_msgTypes[84]
+	mi := &file_api_proto_packets_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6200,7 +8084,7 @@ func (x *S2C_Warning) String() string {
 func (*S2C_Warning) ProtoMessage() {}
 
 func (x *S2C_Warning) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[84]
+	mi := &file_api_proto_packets_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6213,7 +8097,7 @@ func (x *S2C_Warning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S2C_Warning.ProtoReflect.Descriptor instead.
 func (*S2C_Warning) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{84}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *S2C_Warning) GetCode() WarningCode {
@@ -6268,6 +8152,15 @@ type ServerMessage struct {
 	//	*ServerMessage_DeathDialog
 	//	*ServerMessage_Error
 	//	*ServerMessage_Warning
+	//	*ServerMessage_ChatHistory
+	//	*ServerMessage_PartyState
+	//	*ServerMessage_PartyInvite
+	//	*ServerMessage_SkillList
+	//	*ServerMessage_CombatState
+	//	*ServerMessage_FollowState
+	//	*ServerMessage_TileUpdate
+	//	*ServerMessage_WorldTime
+	//	*ServerMessage_Weather
 	Payload       isServerMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -6275,7 +8168,7 @@ type ServerMessage struct {
 
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
-	mi := &file_api_proto_packets_proto_msgTypes[85]
+	mi := &file_api_proto_packets_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6287,7 +8180,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_packets_proto_msgTypes[85]
+	mi := &file_api_proto_packets_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6300,7 +8193,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_packets_proto_rawDescGZIP(), []int{85}
+	return file_api_proto_packets_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ServerMessage) GetSequence() uint32 {
@@ -6605,6 +8498,87 @@ func (x *ServerMessage) GetWarning() *S2C_Warning {
 	return nil
 }
 
+func (x *ServerMessage) GetChatHistory() *S2C_ChatHistory {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_ChatHistory); ok {
+			return x.ChatHistory
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetPartyState() *S2C_PartyState {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_PartyState); ok {
+			return x.PartyState
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetPartyInvite() *S2C_PartyInvite {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_PartyInvite); ok {
+			return x.PartyInvite
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetSkillList() *S2C_SkillList {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_SkillList); ok {
+			return x.SkillList
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetCombatState() *S2C_CombatState {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_CombatState); ok {
+			return x.CombatState
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetFollowState() *S2C_FollowState {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_FollowState); ok {
+			return x.FollowState
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetTileUpdate() *S2C_TileUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_TileUpdate); ok {
+			return x.TileUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetWorldTime() *S2C_WorldTime {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_WorldTime); ok {
+			return x.WorldTime
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetWeather() *S2C_Weather {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_Weather); ok {
+			return x.Weather
+		}
+	}
+	return nil
+}
+
 type isServerMessage_Payload interface {
 	isServerMessage_Payload()
 }
@@ -6763,6 +8737,44 @@ type ServerMessage_Warning struct {
 	Warning *S2C_Warning `protobuf:"bytes,43,opt,name=warning,proto3,oneof"`
 }
 
+type ServerMessage_ChatHistory struct {
+	// S2C_Disconnect disconnect = 44;
+	// S2C_EventBatch event_batch = 45;
+	ChatHistory *S2C_ChatHistory `protobuf:"bytes,46,opt,name=chat_history,json=chatHistory,proto3,oneof"`
+}
+
+type ServerMessage_PartyState struct {
+	PartyState *S2C_PartyState `protobuf:"bytes,47,opt,name=party_state,json=partyState,proto3,oneof"`
+}
+
+type ServerMessage_PartyInvite struct {
+	PartyInvite *S2C_PartyInvite `protobuf:"bytes,48,opt,name=party_invite,json=partyInvite,proto3,oneof"`
+}
+
+type ServerMessage_SkillList struct {
+	SkillList *S2C_SkillList `protobuf:"bytes,49,opt,name=skill_list,json=skillList,proto3,oneof"`
+}
+
+type ServerMessage_CombatState struct {
+	CombatState *S2C_CombatState `protobuf:"bytes,50,opt,name=combat_state,json=combatState,proto3,oneof"`
+}
+
+type ServerMessage_FollowState struct {
+	FollowState *S2C_FollowState `protobuf:"bytes,51,opt,name=follow_state,json=followState,proto3,oneof"`
+}
+
+type ServerMessage_TileUpdate struct {
+	TileUpdate *S2C_TileUpdate `protobuf:"bytes,52,opt,name=tile_update,json=tileUpdate,proto3,oneof"`
+}
+
+type ServerMessage_WorldTime struct {
+	WorldTime *S2C_WorldTime `protobuf:"bytes,53,opt,name=world_time,json=worldTime,proto3,oneof"`
+}
+
+type ServerMessage_Weather struct {
+	Weather *S2C_Weather `protobuf:"bytes,54,opt,name=weather,proto3,oneof"`
+}
+
 func (*ServerMessage_AuthResult) isServerMessage_Payload() {}
 
 func (*ServerMessage_Pong) isServerMessage_Payload() {}
@@ -6827,6 +8839,24 @@ func (*ServerMessage_Error) isServerMessage_Payload() {}
 
 func (*ServerMessage_Warning) isServerMessage_Payload() {}
 
+func (*ServerMessage_ChatHistory) isServerMessage_Payload() {}
+
+func (*ServerMessage_PartyState) isServerMessage_Payload() {}
+
+func (*ServerMessage_PartyInvite) isServerMessage_Payload() {}
+
+func (*ServerMessage_SkillList) isServerMessage_Payload() {}
+
+func (*ServerMessage_CombatState) isServerMessage_Payload() {}
+
+func (*ServerMessage_FollowState) isServerMessage_Payload() {}
+
+func (*ServerMessage_TileUpdate) isServerMessage_Payload() {}
+
+func (*ServerMessage_WorldTime) isServerMessage_Payload() {}
+
+func (*ServerMessage_Weather) isServerMessage_Payload() {}
+
 var File_api_proto_packets_proto protoreflect.FileDescriptor
 
 const file_api_proto_packets_proto_rawDesc = "" +
@@ -6849,7 +8879,7 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\fInventoryRef\x12(\n" +
 	"\x04kind\x18\x01 \x01(\x0e2\x14.proto.InventoryKindR\x04kind\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\x04R\aownerId\x12#\n" +
-	"\rinventory_key\x18\x03 \x01(\rR\finventoryKey\"\xb7\x02\n" +
+	"\rinventory_key\x18\x03 \x01(\rR\finventoryKey\"\xf2\x02\n" +
 	"\fItemInstance\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\x04R\x06itemId\x12\x17\n" +
 	"\atype_id\x18\x02 \x01(\rR\x06typeId\x12\x1a\n" +
@@ -6862,7 +8892,9 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	" \x01(\rR\x01w\x12\f\n" +
 	"\x01h\x18\v \x01(\rR\x01h\x127\n" +
 	"\n" +
-	"nested_ref\x18\f \x01(\v2\x13.proto.InventoryRefH\x01R\tnestedRef\x88\x01\x01B\v\n" +
+	"nested_ref\x18\f \x01(\v2\x13.proto.InventoryRefH\x01R\tnestedRef\x88\x01\x01\x12\x12\n" +
+	"\x04wear\x18\r \x01(\rR\x04wear\x12%\n" +
+	"\x0emax_durability\x18\x0e \x01(\rR\rmaxDurabilityB\v\n" +
 	"\t_hint_extB\r\n" +
 	"\v_nested_ref\"O\n" +
 	"\bGridItem\x12\f\n" +
@@ -6925,7 +8957,12 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x11C2S_OpenContainer\x12%\n" +
 	"\x03ref\x18\x01 \x01(\v2\x13.proto.InventoryRefR\x03ref\";\n" +
 	"\x12C2S_CloseContainer\x12%\n" +
-	"\x03ref\x18\x01 \x01(\v2\x13.proto.InventoryRefR\x03ref\"\x8a\x02\n" +
+	"\x03ref\x18\x01 \x01(\v2\x13.proto.InventoryRefR\x03ref\".\n" +
+	"\x13C2S_ItemContextMenu\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x04R\x06itemId\"F\n" +
+	"\x0eC2S_ItemAction\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x04R\x06itemId\x12\x1b\n" +
+	"\taction_id\x18\x02 \x01(\tR\bactionId\"\x8a\x02\n" +
 	"\x0eEntityMovement\x12+\n" +
 	"\bposition\x18\x01 \x01(\v2\x0f.proto.PositionR\bposition\x12*\n" +
 	"\bvelocity\x18\x02 \x01(\v2\x0e.proto.Vector2R\bvelocity\x120\n" +
@@ -6958,12 +8995,19 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x04type\x18\x02 \x01(\x0e2\x16.proto.InteractionTypeR\x04type\"O\n" +
 	"\x13SelectContextAction\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12\x1b\n" +
-	"\taction_id\x18\x02 \x01(\tR\bactionId\"\xa2\x02\n" +
+	"\taction_id\x18\x02 \x01(\tR\bactionId\"%\n" +
+	"\x06Attack\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\x04R\bentityId\"A\n" +
+	"\x06Follow\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\rR\bdistance\"\xf4\x02\n" +
 	"\x10C2S_PlayerAction\x12(\n" +
 	"\amove_to\x18\x01 \x01(\v2\r.proto.MoveToH\x00R\x06moveTo\x12;\n" +
 	"\x0emove_to_entity\x18\x02 \x01(\v2\x13.proto.MoveToEntityH\x00R\fmoveToEntity\x12-\n" +
 	"\binteract\x18\x03 \x01(\v2\x0f.proto.InteractH\x00R\binteract\x12P\n" +
-	"\x15select_context_action\x18\x04 \x01(\v2\x1a.proto.SelectContextActionH\x00R\x13selectContextAction\x12\x1c\n" +
+	"\x15select_context_action\x18\x04 \x01(\v2\x1a.proto.SelectContextActionH\x00R\x13selectContextAction\x12'\n" +
+	"\x06attack\x18\x05 \x01(\v2\r.proto.AttackH\x00R\x06attack\x12'\n" +
+	"\x06follow\x18\a \x01(\v2\r.proto.FollowH\x00R\x06follow\x12\x1c\n" +
 	"\tmodifiers\x18\n" +
 	" \x01(\rR\tmodifiersB\b\n" +
 	"\x06action\";\n" +
@@ -6973,7 +9017,14 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12,\n" +
 	"\achannel\x18\x02 \x01(\x0e2\x12.proto.ChatChannelR\achannel\x12,\n" +
 	"\x11private_entity_id\x18\x03 \x01(\x04H\x00R\x0fprivateEntityIdB\b\n" +
-	"\x06target\"G\n" +
+	"\x06target\"h\n" +
+	"\x10C2S_PartyCommand\x12*\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x12.proto.PartyActionR\x06action\x12(\n" +
+	"\x10target_entity_id\x18\x02 \x01(\x04R\x0etargetEntityId\"^\n" +
+	"\x16C2S_ChatHistoryRequest\x12#\n" +
+	"\rprivate_limit\x18\x01 \x01(\rR\fprivateLimit\x12\x1f\n" +
+	"\vlocal_limit\x18\x02 \x01(\rR\n" +
+	"localLimit\"G\n" +
 	"\bC2S_Auth\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
 	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\"0\n" +
@@ -6998,7 +9049,15 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x0eC2S_OpenWindow\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"%\n" +
 	"\x0fC2S_CloseWindow\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xf9\a\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x0f\n" +
+	"\rC2S_SkillList\"-\n" +
+	"\x0eC2S_LearnSkill\x12\x1b\n" +
+	"\tskill_key\x18\x01 \x01(\tR\bskillKey\"D\n" +
+	"\x12C2S_TrainAttribute\x12.\n" +
+	"\x03key\x18\x01 \x01(\x0e2\x1c.proto.CharacterAttributeKeyR\x03key\"H\n" +
+	"\x0eC2S_CombatMove\x12\x19\n" +
+	"\bmove_key\x18\x01 \x01(\tR\amoveKey\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x04R\btargetId\"\xf2\v\n" +
 	"\rClientMessage\x12\x1a\n" +
 	"\bsequence\x18\x01 \x01(\rR\bsequence\x12%\n" +
 	"\x04auth\x18\n" +
@@ -7019,14 +9078,26 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"buildStart\x12A\n" +
 	"\x0ebuild_progress\x18\x17 \x01(\v2\x18.proto.C2S_BuildProgressH\x00R\rbuildProgress\x12B\n" +
 	"\x0fbuild_take_back\x18\x18 \x01(\v2\x18.proto.C2S_BuildTakeBackH\x00R\rbuildTakeBack\x12<\n" +
-	"\rlift_put_down\x18\x19 \x01(\v2\x16.proto.C2S_LiftPutDownH\x00R\vliftPutDownB\t\n" +
+	"\rlift_put_down\x18\x19 \x01(\v2\x16.proto.C2S_LiftPutDownH\x00R\vliftPutDown\x12B\n" +
+	"\fchat_history\x18\x1a \x01(\v2\x1d.proto.C2S_ChatHistoryRequestH\x00R\vchatHistory\x12>\n" +
+	"\rparty_command\x18\x1b \x01(\v2\x17.proto.C2S_PartyCommandH\x00R\fpartyCommand\x12H\n" +
+	"\x11item_context_menu\x18\x1c \x01(\v2\x1a.proto.C2S_ItemContextMenuH\x00R\x0fitemContextMenu\x128\n" +
+	"\vitem_action\x18\x1d \x01(\v2\x15.proto.C2S_ItemActionH\x00R\n" +
+	"itemAction\x125\n" +
+	"\n" +
+	"skill_list\x18\x1e \x01(\v2\x14.proto.C2S_SkillListH\x00R\tskillList\x128\n" +
+	"\vlearn_skill\x18\x1f \x01(\v2\x15.proto.C2S_LearnSkillH\x00R\n" +
+	"learnSkill\x12D\n" +
+	"\x0ftrain_attribute\x18  \x01(\v2\x19.proto.C2S_TrainAttributeH\x00R\x0etrainAttribute\x128\n" +
+	"\vcombat_move\x18! \x01(\v2\x15.proto.C2S_CombatMoveH\x00R\n" +
+	"combatMoveB\t\n" +
 	"\apayload\"O\n" +
 	"\x0eS2C_AuthResult\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"V\n" +
 	"\bS2C_Pong\x12$\n" +
 	"\x0eclient_time_ms\x18\x01 \x01(\x03R\fclientTimeMs\x12$\n" +
-	"\x0eserver_time_ms\x18\x02 \x01(\x03R\fserverTimeMs\"\xcc\x01\n" +
+	"\x0eserver_time_ms\x18\x02 \x01(\x03R\fserverTimeMs\"\x81\x02\n" +
 	"\x14S2C_PlayerEnterWorld\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
@@ -7034,7 +9105,19 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\n" +
 	"chunk_size\x18\x04 \x01(\rR\tchunkSize\x12\x1b\n" +
 	"\ttick_rate\x18\x05 \x01(\rR\btickRate\x12!\n" +
-	"\fstream_epoch\x18\t \x01(\rR\vstreamEpoch\"_\n" +
+	"\fstream_epoch\x18\t \x01(\rR\vstreamEpoch\x123\n" +
+	"\n" +
+	"world_time\x18\n" +
+	" \x01(\v2\x14.proto.S2C_WorldTimeR\tworldTime\"\xf3\x01\n" +
+	"\rS2C_WorldTime\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x04R\x03day\x12\"\n" +
+	"\rsecond_of_day\x18\x02 \x01(\rR\vsecondOfDay\x12,\n" +
+	"\x12day_length_seconds\x18\x03 \x01(\rR\x10dayLengthSeconds\x12%\n" +
+	"\x06season\x18\x04 \x01(\x0e2\r.proto.SeasonR\x06season\x12\"\n" +
+	"\rday_of_season\x18\x05 \x01(\rR\vdayOfSeason\x12\x1f\n" +
+	"\vseason_days\x18\x06 \x01(\rR\n" +
+	"seasonDays\x12\x12\n" +
+	"\x04year\x18\a \x01(\x04R\x04year\"_\n" +
 	"\x17CharacterAttributeEntry\x12.\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1c.proto.CharacterAttributeKeyR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value\"q\n" +
@@ -7042,12 +9125,17 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x02lp\x18\x02 \x01(\x03R\x02lp\x12\x16\n" +
 	"\x06nature\x18\x03 \x01(\x03R\x06nature\x12\x1a\n" +
 	"\bindustry\x18\x04 \x01(\x03R\bindustry\x12\x16\n" +
-	"\x06combat\x18\x05 \x01(\x03R\x06combat\"\x84\x01\n" +
+	"\x06combat\x18\x05 \x01(\x03R\x06combat\"f\n" +
+	"\x1bCharacterAttributeTrainCost\x12.\n" +
+	"\x03key\x18\x01 \x01(\x0e2\x1c.proto.CharacterAttributeKeyR\x03key\x12\x17\n" +
+	"\alp_cost\x18\x02 \x01(\x03R\x06lpCost\"\xc9\x01\n" +
 	"\x14S2C_CharacterProfile\x12>\n" +
 	"\n" +
 	"attributes\x18\x01 \x03(\v2\x1e.proto.CharacterAttributeEntryR\n" +
 	"attributes\x12,\n" +
-	"\x03exp\x18\x02 \x01(\v2\x1a.proto.CharacterExperienceR\x03exp\"\xdf\x01\n" +
+	"\x03exp\x18\x02 \x01(\v2\x1a.proto.CharacterExperienceR\x03exp\x12C\n" +
+	"\vtrain_costs\x18\x03 \x03(\v2\".proto.CharacterAttributeTrainCostR\n" +
+	"trainCosts\"\xdf\x01\n" +
 	"\x0fS2C_PlayerStats\x12\x18\n" +
 	"\astamina\x18\x01 \x01(\rR\astamina\x12\x16\n" +
 	"\x06energy\x18\x02 \x01(\rR\x06energy\x12\x1f\n" +
@@ -7063,11 +9151,24 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
 	"\x14S2C_PlayerLeaveWorld\x12\x1b\n" +
-	"\tentity_id\x18\x01 \x01(\x04R\bentityId\"7\n" +
+	"\tentity_id\x18\x01 \x01(\x04R\bentityId\"e\n" +
 	"\rS2C_ChunkLoad\x12&\n" +
-	"\x05chunk\x18\x01 \x01(\v2\x10.proto.ChunkDataR\x05chunk\":\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x10.proto.ChunkDataR\x05chunk\x12,\n" +
+	"\aweather\x18\x02 \x01(\x0e2\x12.proto.WeatherTypeR\aweather\":\n" +
 	"\x0fS2C_ChunkUnload\x12'\n" +
-	"\x05coord\x18\x01 \x01(\v2\x11.proto.ChunkCoordR\x05coord\"\xd0\x01\n" +
+	"\x05coord\x18\x01 \x01(\v2\x11.proto.ChunkCoordR\x05coord\"<\n" +
+	"\n" +
+	"TileChange\x12\f\n" +
+	"\x01x\x18\x01 \x01(\rR\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\rR\x01y\x12\x12\n" +
+	"\x04tile\x18\x03 \x01(\rR\x04tile\"|\n" +
+	"\x0eS2C_TileUpdate\x12'\n" +
+	"\x05coord\x18\x01 \x01(\v2\x11.proto.ChunkCoordR\x05coord\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x12'\n" +
+	"\x05tiles\x18\x03 \x03(\v2\x11.proto.TileChangeR\x05tiles\"d\n" +
+	"\vS2C_Weather\x12'\n" +
+	"\x05coord\x18\x01 \x01(\v2\x11.proto.ChunkCoordR\x05coord\x12,\n" +
+	"\aweather\x18\x02 \x01(\x0e2\x12.proto.WeatherTypeR\aweather\"\xd0\x01\n" +
 	"\x0fS2C_ObjectSpawn\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12\x17\n" +
 	"\atype_id\x18\x02 \x01(\rR\x06typeId\x12#\n" +
@@ -7106,10 +9207,11 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x03ref\x18\x01 \x01(\v2\x13.proto.InventoryRefR\x03ref\"F\n" +
 	"\x11ContextMenuAction\x12\x1b\n" +
 	"\taction_id\x18\x01 \x01(\tR\bactionId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"b\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"{\n" +
 	"\x0fS2C_ContextMenu\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x122\n" +
-	"\aactions\x18\x02 \x03(\v2\x18.proto.ContextMenuActionR\aactions\"y\n" +
+	"\aactions\x18\x02 \x03(\v2\x18.proto.ContextMenuActionR\aactions\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\x04R\x06itemId\"y\n" +
 	"\rS2C_MiniAlert\x120\n" +
 	"\bseverity\x18\x01 \x01(\x0e2\x14.proto.AlertSeverityR\bseverity\x12\x1f\n" +
 	"\vreason_code\x18\x02 \x01(\tR\n" +
@@ -7204,7 +9306,34 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12)\n" +
 	"\x04list\x18\x02 \x03(\v2\x15.proto.BuildStateItemR\x04list\x12\x1d\n" +
 	"\n" +
-	"build_name\x18\x03 \x01(\tR\tbuildName\"3\n" +
+	"build_name\x18\x03 \x01(\tR\tbuildName\"\x87\x02\n" +
+	"\n" +
+	"SkillEntry\x12\x1b\n" +
+	"\tskill_key\x18\x01 \x01(\tR\bskillKey\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\alp_cost\x18\x03 \x01(\x03R\x06lpCost\x12'\n" +
+	"\x0frequired_skills\x18\x04 \x03(\tR\x0erequiredSkills\x12O\n" +
+	"\x13required_attributes\x18\x05 \x03(\v2\x1e.proto.CharacterAttributeEntryR\x12requiredAttributes\x12\x18\n" +
+	"\alearned\x18\x06 \x01(\bR\alearned\x12\x1b\n" +
+	"\tcan_learn\x18\a \x01(\bR\bcanLearn\"u\n" +
+	"\x0eCombatCooldown\x12\x19\n" +
+	"\bmove_key\x18\x01 \x01(\tR\amoveKey\x12'\n" +
+	"\x0fremaining_ticks\x18\x02 \x01(\rR\x0eremainingTicks\x12\x1f\n" +
+	"\vtotal_ticks\x18\x03 \x01(\rR\n" +
+	"totalTicks\"\xf0\x01\n" +
+	"\x0fS2C_CombatState\x12!\n" +
+	"\fopponent_ids\x18\x01 \x03(\x04R\vopponentIds\x123\n" +
+	"\tcooldowns\x18\x02 \x03(\v2\x15.proto.CombatCooldownR\tcooldowns\x12!\n" +
+	"\fpending_move\x18\x03 \x01(\tR\vpendingMove\x12*\n" +
+	"\x11pending_target_id\x18\x04 \x01(\x04R\x0fpendingTargetId\x126\n" +
+	"\x17pending_remaining_ticks\x18\x05 \x01(\rR\x15pendingRemainingTicks\"{\n" +
+	"\x0fS2C_FollowState\x12\x1b\n" +
+	"\tleader_id\x18\x01 \x01(\x04R\bleaderId\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\rR\bdistance\x12/\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x17.proto.FollowStopReasonR\x06reason\"J\n" +
+	"\rS2C_SkillList\x12)\n" +
+	"\x06skills\x18\x01 \x03(\v2\x11.proto.SkillEntryR\x06skills\x12\x0e\n" +
+	"\x02lp\x18\x02 \x01(\x03R\x02lp\"3\n" +
 	"\x14S2C_BuildStateClosed\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\x04R\bentityId\"I\n" +
 	"\x12S2C_LiftCarryState\x12\x16\n" +
@@ -7235,13 +9364,41 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x04text\x18\x04 \x01(\tR\x04text\x12%\n" +
 	"\fto_entity_id\x18\x05 \x01(\x04H\x00R\n" +
 	"toEntityId\x88\x01\x01B\x0f\n" +
-	"\r_to_entity_id\"K\n" +
+	"\r_to_entity_id\"\xf6\x01\n" +
+	"\x10ChatHistoryEntry\x12,\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x12.proto.ChatChannelR\achannel\x12$\n" +
+	"\x0efrom_entity_id\x18\x02 \x01(\x04R\ffromEntityId\x12\x1b\n" +
+	"\tfrom_name\x18\x03 \x01(\tR\bfromName\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12%\n" +
+	"\fto_entity_id\x18\x05 \x01(\x04H\x00R\n" +
+	"toEntityId\x88\x01\x01\x12%\n" +
+	"\x0fsent_at_unix_ms\x18\x06 \x01(\x03R\fsentAtUnixMsB\x0f\n" +
+	"\r_to_entity_id\"\xce\x01\n" +
+	"\vPartyMember\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\x04R\bentityId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06online\x18\x03 \x01(\bR\x06online\x12\x14\n" +
+	"\x05layer\x18\x04 \x01(\x05R\x05layer\x12*\n" +
+	"\bposition\x18\x05 \x01(\v2\x0e.proto.Vector2R\bposition\x12\x10\n" +
+	"\x03shp\x18\x06 \x01(\rR\x03shp\x12\x10\n" +
+	"\x03hhp\x18\a \x01(\rR\x03hhp\x12\x10\n" +
+	"\x03mhp\x18\b \x01(\rR\x03mhp\"v\n" +
+	"\x0eS2C_PartyState\x12\x19\n" +
+	"\bparty_id\x18\x01 \x01(\x04R\apartyId\x12\x1b\n" +
+	"\tleader_id\x18\x02 \x01(\x04R\bleaderId\x12,\n" +
+	"\amembers\x18\x03 \x03(\v2\x12.proto.PartyMemberR\amembers\"x\n" +
+	"\x0fS2C_PartyInvite\x12$\n" +
+	"\x0efrom_entity_id\x18\x01 \x01(\x04R\ffromEntityId\x12\x1b\n" +
+	"\tfrom_name\x18\x02 \x01(\tR\bfromName\x12\"\n" +
+	"\rexpires_in_ms\x18\x03 \x01(\rR\vexpiresInMs\"F\n" +
+	"\x0fS2C_ChatHistory\x123\n" +
+	"\bmessages\x18\x01 \x03(\v2\x17.proto.ChatHistoryEntryR\bmessages\"K\n" +
 	"\tS2C_Error\x12$\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x10.proto.ErrorCodeR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"O\n" +
 	"\vS2C_Warning\x12&\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x12.proto.WarningCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xfc\x0f\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x14\n" +
 	"\rServerMessage\x12\x1a\n" +
 	"\bsequence\x18\x01 \x01(\rR\bsequence\x128\n" +
 	"\vauth_result\x18\n" +
@@ -7284,7 +9441,20 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x10lift_carry_state\x18& \x01(\v2\x19.proto.S2C_LiftCarryStateH\x00R\x0eliftCarryState\x12;\n" +
 	"\fdeath_dialog\x18' \x01(\v2\x16.proto.S2C_DeathDialogH\x00R\vdeathDialog\x12(\n" +
 	"\x05error\x18* \x01(\v2\x10.proto.S2C_ErrorH\x00R\x05error\x12.\n" +
-	"\awarning\x18+ \x01(\v2\x12.proto.S2C_WarningH\x00R\awarningB\t\n" +
+	"\awarning\x18+ \x01(\v2\x12.proto.S2C_WarningH\x00R\awarning\x12;\n" +
+	"\fchat_history\x18. \x01(\v2\x16.proto.S2C_ChatHistoryH\x00R\vchatHistory\x128\n" +
+	"\vparty_state\x18/ \x01(\v2\x15.proto.S2C_PartyStateH\x00R\n" +
+	"partyState\x12;\n" +
+	"\fparty_invite\x180 \x01(\v2\x16.proto.S2C_PartyInviteH\x00R\vpartyInvite\x125\n" +
+	"\n" +
+	"skill_list\x181 \x01(\v2\x14.proto.S2C_SkillListH\x00R\tskillList\x12;\n" +
+	"\fcombat_state\x182 \x01(\v2\x16.proto.S2C_CombatStateH\x00R\vcombatState\x12;\n" +
+	"\ffollow_state\x183 \x01(\v2\x16.proto.S2C_FollowStateH\x00R\vfollowState\x128\n" +
+	"\vtile_update\x184 \x01(\v2\x15.proto.S2C_TileUpdateH\x00R\n" +
+	"tileUpdate\x125\n" +
+	"\n" +
+	"world_time\x185 \x01(\v2\x14.proto.S2C_WorldTimeH\x00R\tworldTime\x12.\n" +
+	"\aweather\x186 \x01(\v2\x12.proto.S2C_WeatherH\x00R\aweatherB\t\n" +
 	"\apayload*v\n" +
 	"\fMovementMode\x12\x13\n" +
 	"\x0fMOVE_MODE_CRAWL\x10\x00\x12\x12\n" +
@@ -7320,7 +9490,7 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x13INVENTORY_KIND_HAND\x10\x01\x12\x1c\n" +
 	"\x18INVENTORY_KIND_EQUIPMENT\x10\x02\x12\x1f\n" +
 	"\x1bINVENTORY_KIND_DROPPED_ITEM\x10\x03\x12\x18\n" +
-	"\x14INVENTORY_KIND_BUILD\x10\x04*\xb9\x04\n" +
+	"\x14INVENTORY_KIND_BUILD\x10\x04*\xe6\x04\n" +
 	"\tErrorCode\x12\x13\n" +
 	"\x0fERROR_CODE_NONE\x10\x00\x12\x1e\n" +
 	"\x1aERROR_CODE_INVALID_REQUEST\x10\x01\x12 \n" +
@@ -7339,7 +9509,9 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x1eERROR_CODE_BUILDING_INCOMPLETE\x10\r\x12\x1d\n" +
 	"\x19ERROR_CODE_RECIPE_UNKNOWN\x10\x0e\x12-\n" +
 	")ERROR_PACKET_PER_SECOND_LIMIT_THRESHOLDED\x10\x0f\x12\x1d\n" +
-	"\x19ERROR_CODE_INTERNAL_ERROR\x10\x10*,\n" +
+	"\x19ERROR_CODE_INTERNAL_ERROR\x10\x10\x12\x15\n" +
+	"\x11ERROR_CODE_KICKED\x10\x11\x12\x14\n" +
+	"\x10ERROR_CODE_MUTED\x10\x12*,\n" +
 	"\vWarningCode\x12\x1d\n" +
 	"\x19WARN_INPUT_QUEUE_OVERFLOW\x10\x00*\xe9\x02\n" +
 	"\x15CharacterAttributeKey\x12'\n" +
@@ -7362,7 +9534,19 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x12CHAT_CHANNEL_LOCAL\x10\x00\x12\x17\n" +
 	"\x13CHAT_CHANNEL_GLOBAL\x10\x01\x12\x18\n" +
 	"\x14CHAT_CHANNEL_PRIVATE\x10\x02\x12\x16\n" +
-	"\x12CHAT_CHANNEL_PARTY\x10\x03*^\n" +
+	"\x12CHAT_CHANNEL_PARTY\x10\x03*\xa6\x01\n" +
+	"\vPartyAction\x12\x1c\n" +
+	"\x18PARTY_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PARTY_ACTION_INVITE\x10\x01\x12\x17\n" +
+	"\x13PARTY_ACTION_ACCEPT\x10\x02\x12\x18\n" +
+	"\x14PARTY_ACTION_DECLINE\x10\x03\x12\x16\n" +
+	"\x12PARTY_ACTION_LEAVE\x10\x04\x12\x15\n" +
+	"\x11PARTY_ACTION_KICK\x10\x05*T\n" +
+	"\x06Season\x12\x11\n" +
+	"\rSEASON_SPRING\x10\x00\x12\x11\n" +
+	"\rSEASON_SUMMER\x10\x01\x12\x11\n" +
+	"\rSEASON_AUTUMN\x10\x02\x12\x11\n" +
+	"\rSEASON_WINTER\x10\x03*^\n" +
 	"\rAlertSeverity\x12\x17\n" +
 	"\x13ALERT_SEVERITY_INFO\x10\x00\x12\x1a\n" +
 	"\x16ALERT_SEVERITY_WARNING\x10\x01\x12\x18\n" +
@@ -7370,7 +9554,13 @@ const file_api_proto_packets_proto_rawDesc = "" +
 	"\x18CyclicActionFinishResult\x12+\n" +
 	"'CYCLIC_ACTION_FINISH_RESULT_UNSPECIFIED\x10\x00\x12)\n" +
 	"%CYCLIC_ACTION_FINISH_RESULT_COMPLETED\x10\x01\x12(\n" +
-	"$CYCLIC_ACTION_FINISH_RESULT_CANCELED\x10\x02B\x1fZ\x1dorigin/internal/network/protob\x06proto3"
+	"$CYCLIC_ACTION_FINISH_RESULT_CANCELED\x10\x02*\xb7\x01\n" +
+	"\x10FollowStopReason\x12\x1b\n" +
+	"\x17FOLLOW_STOP_REASON_NONE\x10\x00\x12\x1e\n" +
+	"\x1aFOLLOW_STOP_REASON_STOPPED\x10\x01\x12\"\n" +
+	"\x1eFOLLOW_STOP_REASON_TARGET_LOST\x10\x02\x12\x1d\n" +
+	"\x19FOLLOW_STOP_REASON_LINKED\x10\x03\x12#\n" +
+	"\x1fFOLLOW_STOP_REASON_PATH_BLOCKED\x10\x04B\x1fZ\x1dorigin/internal/network/protob\x06proto3"
 
 var (
 	file_api_proto_packets_proto_rawDescOnce sync.Once
@@ -7384,230 +9574,296 @@ func file_api_proto_packets_proto_rawDescGZIP() []byte {
 	return file_api_proto_packets_proto_rawDescData
 }
 
-var file_api_proto_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_api_proto_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_api_proto_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_api_proto_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_api_proto_packets_proto_goTypes = []any{
-	(MovementMode)(0),                // 0: proto.MovementMode
-	(EquipSlot)(0),                   // 1: proto.EquipSlot
-	(ExpType)(0),                     // 2: proto.ExpType
-	(WeatherType)(0),                 // 3: proto.WeatherType
-	(InventoryKind)(0),               // 4: proto.InventoryKind
-	(ErrorCode)(0),                   // 5: proto.ErrorCode
-	(WarningCode)(0),                 // 6: proto.WarningCode
-	(CharacterAttributeKey)(0),       // 7: proto.CharacterAttributeKey
-	(InteractionType)(0),             // 8: proto.InteractionType
-	(ChatChannel)(0),                 // 9: proto.ChatChannel
-	(AlertSeverity)(0),               // 10: proto.AlertSeverity
-	(CyclicActionFinishResult)(0),    // 11: proto.CyclicActionFinishResult
-	(*Position)(nil),                 // 12: proto.Position
-	(*Vector2)(nil),                  // 13: proto.Vector2
-	(*AABB)(nil),                     // 14: proto.AABB
-	(*Timestamp)(nil),                // 15: proto.Timestamp
-	(*InventoryRef)(nil),             // 16: proto.InventoryRef
-	(*ItemInstance)(nil),             // 17: proto.ItemInstance
-	(*GridItem)(nil),                 // 18: proto.GridItem
-	(*InventoryGridState)(nil),       // 19: proto.InventoryGridState
-	(*EquipmentItem)(nil),            // 20: proto.EquipmentItem
-	(*InventoryEquipmentState)(nil),  // 21: proto.InventoryEquipmentState
-	(*InventoryHandState)(nil),       // 22: proto.InventoryHandState
-	(*InventoryState)(nil),           // 23: proto.InventoryState
-	(*InventoryExpected)(nil),        // 24: proto.InventoryExpected
-	(*GridPos)(nil),                  // 25: proto.GridPos
-	(*HandPos)(nil),                  // 26: proto.HandPos
-	(*InventoryMoveSpec)(nil),        // 27: proto.InventoryMoveSpec
-	(*InventoryOp)(nil),              // 28: proto.InventoryOp
-	(*C2S_InventoryOp)(nil),          // 29: proto.C2S_InventoryOp
-	(*C2S_OpenContainer)(nil),        // 30: proto.C2S_OpenContainer
-	(*C2S_CloseContainer)(nil),       // 31: proto.C2S_CloseContainer
-	(*EntityMovement)(nil),           // 32: proto.EntityMovement
-	(*EntityPosition)(nil),           // 33: proto.EntityPosition
-	(*EntityAppearance)(nil),         // 34: proto.EntityAppearance
-	(*ChunkCoord)(nil),               // 35: proto.ChunkCoord
-	(*ChunkData)(nil),                // 36: proto.ChunkData
-	(*MoveTo)(nil),                   // 37: proto.MoveTo
-	(*MoveToEntity)(nil),             // 38: proto.MoveToEntity
-	(*Interact)(nil),                 // 39: proto.Interact
-	(*SelectContextAction)(nil),      // 40: proto.SelectContextAction
-	(*C2S_PlayerAction)(nil),         // 41: proto.C2S_PlayerAction
-	(*C2S_MovementMode)(nil),         // 42: proto.C2S_MovementMode
-	(*C2S_ChatMessage)(nil),          // 43: proto.C2S_ChatMessage
-	(*C2S_Auth)(nil),                 // 44: proto.C2S_Auth
-	(*C2S_Ping)(nil),                 // 45: proto.C2S_Ping
-	(*C2S_StartCraftOne)(nil),        // 46: proto.C2S_StartCraftOne
-	(*C2S_StartCraftMany)(nil),       // 47: proto.C2S_StartCraftMany
-	(*C2S_BuildStart)(nil),           // 48: proto.C2S_BuildStart
-	(*C2S_BuildProgress)(nil),        // 49: proto.C2S_BuildProgress
-	(*C2S_BuildTakeBack)(nil),        // 50: proto.C2S_BuildTakeBack
-	(*C2S_LiftPutDown)(nil),          // 51: proto.C2S_LiftPutDown
-	(*C2S_OpenWindow)(nil),           // 52: proto.C2S_OpenWindow
-	(*C2S_CloseWindow)(nil),          // 53: proto.C2S_CloseWindow
-	(*ClientMessage)(nil),            // 54: proto.ClientMessage
-	(*S2C_AuthResult)(nil),           // 55: proto.S2C_AuthResult
-	(*S2C_Pong)(nil),                 // 56: proto.S2C_Pong
-	(*S2C_PlayerEnterWorld)(nil),     // 57: proto.S2C_PlayerEnterWorld
-	(*CharacterAttributeEntry)(nil),  // 58: proto.CharacterAttributeEntry
-	(*CharacterExperience)(nil),      // 59: proto.CharacterExperience
-	(*S2C_CharacterProfile)(nil),     // 60: proto.S2C_CharacterProfile
-	(*S2C_PlayerStats)(nil),          // 61: proto.S2C_PlayerStats
-	(*S2C_DeathDialog)(nil),          // 62: proto.S2C_DeathDialog
-	(*S2C_PlayerLeaveWorld)(nil),     // 63: proto.S2C_PlayerLeaveWorld
-	(*S2C_ChunkLoad)(nil),            // 64: proto.S2C_ChunkLoad
-	(*S2C_ChunkUnload)(nil),          // 65: proto.S2C_ChunkUnload
-	(*S2C_ObjectSpawn)(nil),          // 66: proto.S2C_ObjectSpawn
-	(*S2C_ObjectDespawn)(nil),        // 67: proto.S2C_ObjectDespawn
-	(*S2C_ObjectMove)(nil),           // 68: proto.S2C_ObjectMove
-	(*S2C_MovementMode)(nil),         // 69: proto.S2C_MovementMode
-	(*S2C_InventoryOpResult)(nil),    // 70: proto.S2C_InventoryOpResult
-	(*S2C_InventoryUpdate)(nil),      // 71: proto.S2C_InventoryUpdate
-	(*S2C_ContainerOpened)(nil),      // 72: proto.S2C_ContainerOpened
-	(*S2C_ContainerClosed)(nil),      // 73: proto.S2C_ContainerClosed
-	(*ContextMenuAction)(nil),        // 74: proto.ContextMenuAction
-	(*S2C_ContextMenu)(nil),          // 75: proto.S2C_ContextMenu
-	(*S2C_MiniAlert)(nil),            // 76: proto.S2C_MiniAlert
-	(*S2C_CyclicActionProgress)(nil), // 77: proto.S2C_CyclicActionProgress
-	(*S2C_CyclicActionFinished)(nil), // 78: proto.S2C_CyclicActionFinished
-	(*CraftInputDef)(nil),            // 79: proto.CraftInputDef
-	(*CraftOutputDef)(nil),           // 80: proto.CraftOutputDef
-	(*CraftRequirementFlags)(nil),    // 81: proto.CraftRequirementFlags
-	(*CraftRecipeEntry)(nil),         // 82: proto.CraftRecipeEntry
-	(*S2C_CraftList)(nil),            // 83: proto.S2C_CraftList
-	(*BuildInputDef)(nil),            // 84: proto.BuildInputDef
-	(*BuildStateItem)(nil),           // 85: proto.BuildStateItem
-	(*BuildRecipeEntry)(nil),         // 86: proto.BuildRecipeEntry
-	(*S2C_BuildList)(nil),            // 87: proto.S2C_BuildList
-	(*S2C_BuildState)(nil),           // 88: proto.S2C_BuildState
-	(*S2C_BuildStateClosed)(nil),     // 89: proto.S2C_BuildStateClosed
-	(*S2C_LiftCarryState)(nil),       // 90: proto.S2C_LiftCarryState
-	(*S2C_Sound)(nil),                // 91: proto.S2C_Sound
-	(*S2C_ExpGained)(nil),            // 92: proto.S2C_ExpGained
-	(*S2C_Fx)(nil),                   // 93: proto.S2C_Fx
-	(*S2C_ChatMessage)(nil),          // 94: proto.S2C_ChatMessage
-	(*S2C_Error)(nil),                // 95: proto.S2C_Error
-	(*S2C_Warning)(nil),              // 96: proto.S2C_Warning
-	(*ServerMessage)(nil),            // 97: proto.ServerMessage
+	(MovementMode)(0),                   // 0: proto.MovementMode
+	(EquipSlot)(0),                      // 1: proto.EquipSlot
+	(ExpType)(0),                        // 2: proto.ExpType
+	(WeatherType)(0),                    // 3: proto.WeatherType
+	(InventoryKind)(0),                  // 4: proto.InventoryKind
+	(ErrorCode)(0),                      // 5: proto.ErrorCode
+	(WarningCode)(0),                    // 6: proto.WarningCode
+	(CharacterAttributeKey)(0),          // 7: proto.CharacterAttributeKey
+	(InteractionType)(0),                // 8: proto.InteractionType
+	(ChatChannel)(0),                    // 9: proto.ChatChannel
+	(PartyAction)(0),                    // 10: proto.PartyAction
+	(Season)(0),                         // 11: proto.Season
+	(AlertSeverity)(0),                  // 12: proto.AlertSeverity
+	(CyclicActionFinishResult)(0),       // 13: proto.CyclicActionFinishResult
+	(FollowStopReason)(0),               // 14: proto.FollowStopReason
+	(*Position)(nil),                    // 15: proto.Position
+	(*Vector2)(nil),                     // 16: proto.Vector2
+	(*AABB)(nil),                        // 17: proto.AABB
+	(*Timestamp)(nil),                   // 18: proto.Timestamp
+	(*InventoryRef)(nil),                // 19: proto.InventoryRef
+	(*ItemInstance)(nil),                // 20: proto.ItemInstance
+	(*GridItem)(nil),                    // 21: proto.GridItem
+	(*InventoryGridState)(nil),          // 22: proto.InventoryGridState
+	(*EquipmentItem)(nil),               // 23: proto.EquipmentItem
+	(*InventoryEquipmentState)(nil),     // 24: proto.InventoryEquipmentState
+	(*InventoryHandState)(nil),          // 25: proto.InventoryHandState
+	(*InventoryState)(nil),              // 26: proto.InventoryState
+	(*InventoryExpected)(nil),           // 27: proto.InventoryExpected
+	(*GridPos)(nil),                     // 28: proto.GridPos
+	(*HandPos)(nil),                     // 29: proto.HandPos
+	(*InventoryMoveSpec)(nil),           // 30: proto.InventoryMoveSpec
+	(*InventoryOp)(nil),                 // 31: proto.InventoryOp
+	(*C2S_InventoryOp)(nil),             // 32: proto.C2S_InventoryOp
+	(*C2S_OpenContainer)(nil),           // 33: proto.C2S_OpenContainer
+	(*C2S_CloseContainer)(nil),          // 34: proto.C2S_CloseContainer
+	(*C2S_ItemContextMenu)(nil),         // 35: proto.C2S_ItemContextMenu
+	(*C2S_ItemAction)(nil),              // 36: proto.C2S_ItemAction
+	(*EntityMovement)(nil),              // 37: proto.EntityMovement
+	(*EntityPosition)(nil),              // 38: proto.EntityPosition
+	(*EntityAppearance)(nil),            // 39: proto.EntityAppearance
+	(*ChunkCoord)(nil),                  // 40: proto.ChunkCoord
+	(*ChunkData)(nil),                   // 41: proto.ChunkData
+	(*MoveTo)(nil),                      // 42: proto.MoveTo
+	(*MoveToEntity)(nil),                // 43: proto.MoveToEntity
+	(*Interact)(nil),                    // 44: proto.Interact
+	(*SelectContextAction)(nil),         // 45: proto.SelectContextAction
+	(*Attack)(nil),                      // 46: proto.Attack
+	(*Follow)(nil),                      // 47: proto.Follow
+	(*C2S_PlayerAction)(nil),            // 48: proto.C2S_PlayerAction
+	(*C2S_MovementMode)(nil),            // 49: proto.C2S_MovementMode
+	(*C2S_ChatMessage)(nil),             // 50: proto.C2S_ChatMessage
+	(*C2S_PartyCommand)(nil),            // 51: proto.C2S_PartyCommand
+	(*C2S_ChatHistoryRequest)(nil),      // 52: proto.C2S_ChatHistoryRequest
+	(*C2S_Auth)(nil),                    // 53: proto.C2S_Auth
+	(*C2S_Ping)(nil),                    // 54: proto.C2S_Ping
+	(*C2S_StartCraftOne)(nil),           // 55: proto.C2S_StartCraftOne
+	(*C2S_StartCraftMany)(nil),          // 56: proto.C2S_StartCraftMany
+	(*C2S_BuildStart)(nil),              // 57: proto.C2S_BuildStart
+	(*C2S_BuildProgress)(nil),           // 58: proto.C2S_BuildProgress
+	(*C2S_BuildTakeBack)(nil),           // 59: proto.C2S_BuildTakeBack
+	(*C2S_LiftPutDown)(nil),             // 60: proto.C2S_LiftPutDown
+	(*C2S_OpenWindow)(nil),              // 61: proto.C2S_OpenWindow
+	(*C2S_CloseWindow)(nil),             // 62: proto.C2S_CloseWindow
+	(*C2S_SkillList)(nil),               // 63: proto.C2S_SkillList
+	(*C2S_LearnSkill)(nil),              // 64: proto.C2S_LearnSkill
+	(*C2S_TrainAttribute)(nil),          // 65: proto.C2S_TrainAttribute
+	(*C2S_CombatMove)(nil),              // 66: proto.C2S_CombatMove
+	(*ClientMessage)(nil),               // 67: proto.ClientMessage
+	(*S2C_AuthResult)(nil),              // 68: proto.S2C_AuthResult
+	(*S2C_Pong)(nil),                    // 69: proto.S2C_Pong
+	(*S2C_PlayerEnterWorld)(nil),        // 70: proto.S2C_PlayerEnterWorld
+	(*S2C_WorldTime)(nil),               // 71: proto.S2C_WorldTime
+	(*CharacterAttributeEntry)(nil),     // 72: proto.CharacterAttributeEntry
+	(*CharacterExperience)(nil),         // 73: proto.CharacterExperience
+	(*CharacterAttributeTrainCost)(nil), // 74: proto.CharacterAttributeTrainCost
+	(*S2C_CharacterProfile)(nil),        // 75: proto.S2C_CharacterProfile
+	(*S2C_PlayerStats)(nil),             // 76: proto.S2C_PlayerStats
+	(*S2C_DeathDialog)(nil),             // 77: proto.S2C_DeathDialog
+	(*S2C_PlayerLeaveWorld)(nil),        // 78: proto.S2C_PlayerLeaveWorld
+	(*S2C_ChunkLoad)(nil),               // 79: proto.S2C_ChunkLoad
+	(*S2C_ChunkUnload)(nil),             // 80: proto.S2C_ChunkUnload
+	(*TileChange)(nil),                  // 81: proto.TileChange
+	(*S2C_TileUpdate)(nil),              // 82: proto.S2C_TileUpdate
+	(*S2C_Weather)(nil),                 // 83: proto.S2C_Weather
+	(*S2C_ObjectSpawn)(nil),             // 84: proto.S2C_ObjectSpawn
+	(*S2C_ObjectDespawn)(nil),           // 85: proto.S2C_ObjectDespawn
+	(*S2C_ObjectMove)(nil),              // 86: proto.S2C_ObjectMove
+	(*S2C_MovementMode)(nil),            // 87: proto.S2C_MovementMode
+	(*S2C_InventoryOpResult)(nil),       // 88: proto.S2C_InventoryOpResult
+	(*S2C_InventoryUpdate)(nil),         // 89: proto.S2C_InventoryUpdate
+	(*S2C_ContainerOpened)(nil),         // 90: proto.S2C_ContainerOpened
+	(*S2C_ContainerClosed)(nil),         // 91: proto.S2C_ContainerClosed
+	(*ContextMenuAction)(nil),           // 92: proto.ContextMenuAction
+	(*S2C_ContextMenu)(nil),             // 93: proto.S2C_ContextMenu
+	(*S2C_MiniAlert)(nil),               // 94: proto.S2C_MiniAlert
+	(*S2C_CyclicActionProgress)(nil),    // 95: proto.S2C_CyclicActionProgress
+	(*S2C_CyclicActionFinished)(nil),    // 96: proto.S2C_CyclicActionFinished
+	(*CraftInputDef)(nil),               // 97: proto.CraftInputDef
+	(*CraftOutputDef)(nil),              // 98: proto.CraftOutputDef
+	(*CraftRequirementFlags)(nil),       // 99: proto.CraftRequirementFlags
+	(*CraftRecipeEntry)(nil),            // 100: proto.CraftRecipeEntry
+	(*S2C_CraftList)(nil),               // 101: proto.S2C_CraftList
+	(*BuildInputDef)(nil),               // 102: proto.BuildInputDef
+	(*BuildStateItem)(nil),              // 103: proto.BuildStateItem
+	(*BuildRecipeEntry)(nil),            // 104: proto.BuildRecipeEntry
+	(*S2C_BuildList)(nil),               // 105: proto.S2C_BuildList
+	(*S2C_BuildState)(nil),              // 106: proto.S2C_BuildState
+	(*SkillEntry)(nil),                  // 107: proto.SkillEntry
+	(*CombatCooldown)(nil),              // 108: proto.CombatCooldown
+	(*S2C_CombatState)(nil),             // 109: proto.S2C_CombatState
+	(*S2C_FollowState)(nil),             // 110: proto.S2C_FollowState
+	(*S2C_SkillList)(nil),               // 111: proto.S2C_SkillList
+	(*S2C_BuildStateClosed)(nil),        // 112: proto.S2C_BuildStateClosed
+	(*S2C_LiftCarryState)(nil),          // 113: proto.S2C_LiftCarryState
+	(*S2C_Sound)(nil),                   // 114: proto.S2C_Sound
+	(*S2C_ExpGained)(nil),               // 115: proto.S2C_ExpGained
+	(*S2C_Fx)(nil),                      // 116: proto.S2C_Fx
+	(*S2C_ChatMessage)(nil),             // 117: proto.S2C_ChatMessage
+	(*ChatHistoryEntry)(nil),            // 118: proto.ChatHistoryEntry
+	(*PartyMember)(nil),                 // 119: proto.PartyMember
+	(*S2C_PartyState)(nil),              // 120: proto.S2C_PartyState
+	(*S2C_PartyInvite)(nil),             // 121: proto.S2C_PartyInvite
+	(*S2C_ChatHistory)(nil),             // 122: proto.S2C_ChatHistory
+	(*S2C_Error)(nil),                   // 123: proto.S2C_Error
+	(*S2C_Warning)(nil),                 // 124: proto.S2C_Warning
+	(*ServerMessage)(nil),               // 125: proto.ServerMessage
 }
 var file_api_proto_packets_proto_depIdxs = []int32{
 	4,   // 0: proto.InventoryRef.kind:type_name -> proto.InventoryKind
-	16,  // 1: proto.ItemInstance.nested_ref:type_name -> proto.InventoryRef
-	17,  // 2: proto.GridItem.item:type_name -> proto.ItemInstance
-	18,  // 3: proto.InventoryGridState.items:type_name -> proto.GridItem
+	19,  // 1: proto.ItemInstance.nested_ref:type_name -> proto.InventoryRef
+	20,  // 2: proto.GridItem.item:type_name -> proto.ItemInstance
+	21,  // 3: proto.InventoryGridState.items:type_name -> proto.GridItem
 	1,   // 4: proto.EquipmentItem.slot:type_name -> proto.EquipSlot
-	17,  // 5: proto.EquipmentItem.item:type_name -> proto.ItemInstance
-	20,  // 6: proto.InventoryEquipmentState.items:type_name -> proto.EquipmentItem
-	17,  // 7: proto.InventoryHandState.item:type_name -> proto.ItemInstance
-	26,  // 8: proto.InventoryHandState.hand_pos:type_name -> proto.HandPos
-	16,  // 9: proto.InventoryState.ref:type_name -> proto.InventoryRef
-	19,  // 10: proto.InventoryState.grid:type_name -> proto.InventoryGridState
-	21,  // 11: proto.InventoryState.equipment:type_name -> proto.InventoryEquipmentState
-	22,  // 12: proto.InventoryState.hand:type_name -> proto.InventoryHandState
-	16,  // 13: proto.InventoryExpected.ref:type_name -> proto.InventoryRef
-	16,  // 14: proto.InventoryMoveSpec.src:type_name -> proto.InventoryRef
-	16,  // 15: proto.InventoryMoveSpec.dst:type_name -> proto.InventoryRef
-	25,  // 16: proto.InventoryMoveSpec.dst_pos:type_name -> proto.GridPos
+	20,  // 5: proto.EquipmentItem.item:type_name -> proto.ItemInstance
+	23,  // 6: proto.InventoryEquipmentState.items:type_name -> proto.EquipmentItem
+	20,  // 7: proto.InventoryHandState.item:type_name -> proto.ItemInstance
+	29,  // 8: proto.InventoryHandState.hand_pos:type_name -> proto.HandPos
+	19,  // 9: proto.InventoryState.ref:type_name -> proto.InventoryRef
+	22,  // 10: proto.InventoryState.grid:type_name -> proto.InventoryGridState
+	24,  // 11: proto.InventoryState.equipment:type_name -> proto.InventoryEquipmentState
+	25,  // 12: proto.InventoryState.hand:type_name -> proto.InventoryHandState
+	19,  // 13: proto.InventoryExpected.ref:type_name -> proto.InventoryRef
+	19,  // 14: proto.InventoryMoveSpec.src:type_name -> proto.InventoryRef
+	19,  // 15: proto.InventoryMoveSpec.dst:type_name -> proto.InventoryRef
+	28,  // 16: proto.InventoryMoveSpec.dst_pos:type_name -> proto.GridPos
 	1,   // 17: proto.InventoryMoveSpec.dst_equip_slot:type_name -> proto.EquipSlot
-	26,  // 18: proto.InventoryMoveSpec.hand_pos:type_name -> proto.HandPos
-	24,  // 19: proto.InventoryOp.expected:type_name -> proto.InventoryExpected
-	27,  // 20: proto.InventoryOp.move:type_name -> proto.InventoryMoveSpec
-	27,  // 21: proto.InventoryOp.drop_to_world:type_name -> proto.InventoryMoveSpec
-	28,  // 22: proto.C2S_InventoryOp.op:type_name -> proto.InventoryOp
-	16,  // 23: proto.C2S_OpenContainer.ref:type_name -> proto.InventoryRef
-	16,  // 24: proto.C2S_CloseContainer.ref:type_name -> proto.InventoryRef
-	12,  // 25: proto.EntityMovement.position:type_name -> proto.Position
-	13,  // 26: proto.EntityMovement.velocity:type_name -> proto.Vector2
+	29,  // 18: proto.InventoryMoveSpec.hand_pos:type_name -> proto.HandPos
+	27,  // 19: proto.InventoryOp.expected:type_name -> proto.InventoryExpected
+	30,  // 20: proto.InventoryOp.move:type_name -> proto.InventoryMoveSpec
+	30,  // 21: proto.InventoryOp.drop_to_world:type_name -> proto.InventoryMoveSpec
+	31,  // 22: proto.C2S_InventoryOp.op:type_name -> proto.InventoryOp
+	19,  // 23: proto.C2S_OpenContainer.ref:type_name -> proto.InventoryRef
+	19,  // 24: proto.C2S_CloseContainer.ref:type_name -> proto.InventoryRef
+	15,  // 25: proto.EntityMovement.position:type_name -> proto.Position
+	16,  // 26: proto.EntityMovement.velocity:type_name -> proto.Vector2
 	0,   // 27: proto.EntityMovement.move_mode:type_name -> proto.MovementMode
-	13,  // 28: proto.EntityMovement.target_position:type_name -> proto.Vector2
-	12,  // 29: proto.EntityPosition.position:type_name -> proto.Position
-	13,  // 30: proto.EntityPosition.size:type_name -> proto.Vector2
-	35,  // 31: proto.ChunkData.coord:type_name -> proto.ChunkCoord
+	16,  // 28: proto.EntityMovement.target_position:type_name -> proto.Vector2
+	15,  // 29: proto.EntityPosition.position:type_name -> proto.Position
+	16,  // 30: proto.EntityPosition.size:type_name -> proto.Vector2
+	40,  // 31: proto.ChunkData.coord:type_name -> proto.ChunkCoord
 	8,   // 32: proto.Interact.type:type_name -> proto.InteractionType
-	37,  // 33: proto.C2S_PlayerAction.move_to:type_name -> proto.MoveTo
-	38,  // 34: proto.C2S_PlayerAction.move_to_entity:type_name -> proto.MoveToEntity
-	39,  // 35: proto.C2S_PlayerAction.interact:type_name -> proto.Interact
-	40,  // 36: proto.C2S_PlayerAction.select_context_action:type_name -> proto.SelectContextAction
-	0,   // 37: proto.C2S_MovementMode.mode:type_name -> proto.MovementMode
-	9,   // 38: proto.C2S_ChatMessage.channel:type_name -> proto.ChatChannel
-	13,  // 39: proto.C2S_BuildStart.pos:type_name -> proto.Vector2
-	13,  // 40: proto.C2S_LiftPutDown.pos:type_name -> proto.Vector2
-	44,  // 41: proto.ClientMessage.auth:type_name -> proto.C2S_Auth
-	45,  // 42: proto.ClientMessage.ping:type_name -> proto.C2S_Ping
-	41,  // 43: proto.ClientMessage.player_action:type_name -> proto.C2S_PlayerAction
-	42,  // 44: proto.ClientMessage.movement_mode:type_name -> proto.C2S_MovementMode
-	29,  // 45: proto.ClientMessage.inventory_op:type_name -> proto.C2S_InventoryOp
-	43,  // 46: proto.ClientMessage.chat:type_name -> proto.C2S_ChatMessage
-	30,  // 47: proto.ClientMessage.open_container:type_name -> proto.C2S_OpenContainer
-	31,  // 48: proto.ClientMessage.close_container:type_name -> proto.C2S_CloseContainer
-	46,  // 49: proto.ClientMessage.start_craft_one:type_name -> proto.C2S_StartCraftOne
-	47,  // 50: proto.ClientMessage.start_craft_many:type_name -> proto.C2S_StartCraftMany
-	52,  // 51: proto.ClientMessage.open_window:type_name -> proto.C2S_OpenWindow
-	53,  // 52: proto.ClientMessage.close_window:type_name -> proto.C2S_CloseWindow
-	48,  // 53: proto.ClientMessage.build_start:type_name -> proto.C2S_BuildStart
-	49,  // 54: proto.ClientMessage.build_progress:type_name -> proto.C2S_BuildProgress
-	50,  // 55: proto.ClientMessage.build_take_back:type_name -> proto.C2S_BuildTakeBack
-	51,  // 56: proto.ClientMessage.lift_put_down:type_name -> proto.C2S_LiftPutDown
-	7,   // 57: proto.CharacterAttributeEntry.key:type_name -> proto.CharacterAttributeKey
-	58,  // 58: proto.S2C_CharacterProfile.attributes:type_name -> proto.CharacterAttributeEntry
-	59,  // 59: proto.S2C_CharacterProfile.exp:type_name -> proto.CharacterExperience
-	36,  // 60: proto.S2C_ChunkLoad.chunk:type_name -> proto.ChunkData
-	35,  // 61: proto.S2C_ChunkUnload.coord:type_name -> proto.ChunkCoord
-	33,  // 62: proto.S2C_ObjectSpawn.position:type_name -> proto.EntityPosition
-	32,  // 63: proto.S2C_ObjectMove.movement:type_name -> proto.EntityMovement
-	0,   // 64: proto.S2C_MovementMode.movement_mode:type_name -> proto.MovementMode
-	5,   // 65: proto.S2C_InventoryOpResult.error:type_name -> proto.ErrorCode
-	23,  // 66: proto.S2C_InventoryOpResult.updated:type_name -> proto.InventoryState
-	23,  // 67: proto.S2C_InventoryUpdate.updated:type_name -> proto.InventoryState
-	23,  // 68: proto.S2C_ContainerOpened.state:type_name -> proto.InventoryState
-	16,  // 69: proto.S2C_ContainerClosed.ref:type_name -> proto.InventoryRef
-	74,  // 70: proto.S2C_ContextMenu.actions:type_name -> proto.ContextMenuAction
-	10,  // 71: proto.S2C_MiniAlert.severity:type_name -> proto.AlertSeverity
-	11,  // 72: proto.S2C_CyclicActionFinished.result:type_name -> proto.CyclicActionFinishResult
-	79,  // 73: proto.CraftRecipeEntry.inputs:type_name -> proto.CraftInputDef
-	80,  // 74: proto.CraftRecipeEntry.outputs:type_name -> proto.CraftOutputDef
-	81,  // 75: proto.CraftRecipeEntry.flags:type_name -> proto.CraftRequirementFlags
-	82,  // 76: proto.S2C_CraftList.recipes:type_name -> proto.CraftRecipeEntry
-	84,  // 77: proto.BuildRecipeEntry.inputs:type_name -> proto.BuildInputDef
-	86,  // 78: proto.S2C_BuildList.builds:type_name -> proto.BuildRecipeEntry
-	85,  // 79: proto.S2C_BuildState.list:type_name -> proto.BuildStateItem
-	13,  // 80: proto.S2C_Fx.position:type_name -> proto.Vector2
-	9,   // 81: proto.S2C_ChatMessage.channel:type_name -> proto.ChatChannel
-	5,   // 82: proto.S2C_Error.code:type_name -> proto.ErrorCode
-	6,   // 83: proto.S2C_Warning.code:type_name -> proto.WarningCode
-	55,  // 84: proto.ServerMessage.auth_result:type_name -> proto.S2C_AuthResult
-	56,  // 85: proto.ServerMessage.pong:type_name -> proto.S2C_Pong
-	64,  // 86: proto.ServerMessage.chunk_load:type_name -> proto.S2C_ChunkLoad
-	65,  // 87: proto.ServerMessage.chunk_unload:type_name -> proto.S2C_ChunkUnload
-	57,  // 88: proto.ServerMessage.player_enter_world:type_name -> proto.S2C_PlayerEnterWorld
-	63,  // 89: proto.ServerMessage.player_leave_world:type_name -> proto.S2C_PlayerLeaveWorld
-	66,  // 90: proto.ServerMessage.object_spawn:type_name -> proto.S2C_ObjectSpawn
-	67,  // 91: proto.ServerMessage.object_despawn:type_name -> proto.S2C_ObjectDespawn
-	68,  // 92: proto.ServerMessage.object_move:type_name -> proto.S2C_ObjectMove
-	69,  // 93: proto.ServerMessage.movement_mode:type_name -> proto.S2C_MovementMode
-	70,  // 94: proto.ServerMessage.inventory_op_result:type_name -> proto.S2C_InventoryOpResult
-	71,  // 95: proto.ServerMessage.inventory_update:type_name -> proto.S2C_InventoryUpdate
-	72,  // 96: proto.ServerMessage.container_opened:type_name -> proto.S2C_ContainerOpened
-	73,  // 97: proto.ServerMessage.container_closed:type_name -> proto.S2C_ContainerClosed
-	94,  // 98: proto.ServerMessage.chat:type_name -> proto.S2C_ChatMessage
-	75,  // 99: proto.ServerMessage.context_menu:type_name -> proto.S2C_ContextMenu
-	76,  // 100: proto.ServerMessage.mini_alert:type_name -> proto.S2C_MiniAlert
-	77,  // 101: proto.ServerMessage.cyclic_action_progress:type_name -> proto.S2C_CyclicActionProgress
-	78,  // 102: proto.ServerMessage.cyclic_action_finished:type_name -> proto.S2C_CyclicActionFinished
-	91,  // 103: proto.ServerMessage.sound:type_name -> proto.S2C_Sound
-	60,  // 104: proto.ServerMessage.character_profile:type_name -> proto.S2C_CharacterProfile
-	61,  // 105: proto.ServerMessage.player_stats:type_name -> proto.S2C_PlayerStats
-	92,  // 106: proto.ServerMessage.exp_gained:type_name -> proto.S2C_ExpGained
-	93,  // 107: proto.ServerMessage.fx:type_name -> proto.S2C_Fx
-	83,  // 108: proto.ServerMessage.craft_list:type_name -> proto.S2C_CraftList
-	87,  // 109: proto.ServerMessage.build_list:type_name -> proto.S2C_BuildList
-	88,  // 110: proto.ServerMessage.build_state:type_name -> proto.S2C_BuildState
-	89,  // 111: proto.ServerMessage.build_state_closed:type_name -> proto.S2C_BuildStateClosed
-	90,  // 112: proto.ServerMessage.lift_carry_state:type_name -> proto.S2C_LiftCarryState
-	62,  // 113: proto.ServerMessage.death_dialog:type_name -> proto.S2C_DeathDialog
-	95,  // 114: proto.ServerMessage.error:type_name -> proto.S2C_Error
-	96,  // 115: proto.ServerMessage.warning:type_name -> proto.S2C_Warning
-	116, // [116:116] is the sub-list for method output_type
-	116, // [116:116] is the sub-list for method input_type
-	116, // [116:116] is the sub-list for extension type_name
-	116, // [116:116] is the sub-list for extension extendee
-	0,   // [0:116] is the sub-list for field type_name
+	42,  // 33: proto.C2S_PlayerAction.move_to:type_name -> proto.MoveTo
+	43,  // 34: proto.C2S_PlayerAction.move_to_entity:type_name -> proto.MoveToEntity
+	44,  // 35: proto.C2S_PlayerAction.interact:type_name -> proto.Interact
+	45,  // 36: proto.C2S_PlayerAction.select_context_action:type_name -> proto.SelectContextAction
+	46,  // 37: proto.C2S_PlayerAction.attack:type_name -> proto.Attack
+	47,  // 38: proto.C2S_PlayerAction.follow:type_name -> proto.Follow
+	0,   // 39: proto.C2S_MovementMode.mode:type_name -> proto.MovementMode
+	9,   // 40: proto.C2S_ChatMessage.channel:type_name -> proto.ChatChannel
+	10,  // 41: proto.C2S_PartyCommand.action:type_name -> proto.PartyAction
+	16,  // 42: proto.C2S_BuildStart.pos:type_name -> proto.Vector2
+	16,  // 43: proto.C2S_LiftPutDown.pos:type_name -> proto.Vector2
+	7,   // 44: proto.C2S_TrainAttribute.key:type_name -> proto.CharacterAttributeKey
+	53,  // 45: proto.ClientMessage.auth:type_name -> proto.C2S_Auth
+	54,  // 46: proto.ClientMessage.ping:type_name -> proto.C2S_Ping
+	48,  // 47: proto.ClientMessage.player_action:type_name -> proto.C2S_PlayerAction
+	49,  // 48: proto.ClientMessage.movement_mode:type_name -> proto.C2S_MovementMode
+	32,  // 49: proto.ClientMessage.inventory_op:type_name -> proto.C2S_InventoryOp
+	50,  // 50: proto.ClientMessage.chat:type_name -> proto.C2S_ChatMessage
+	33,  // 51: proto.ClientMessage.open_container:type_name -> proto.C2S_OpenContainer
+	34,  // 52: proto.ClientMessage.close_container:type_name -> proto.C2S_CloseContainer
+	55,  // 53: proto.ClientMessage.start_craft_one:type_name -> proto.C2S_StartCraftOne
+	56,  // 54: proto.ClientMessage.start_craft_many:type_name -> proto.C2S_StartCraftMany
+	61,  // 55: proto.ClientMessage.open_window:type_name -> proto.C2S_OpenWindow
+	62,  // 56: proto.ClientMessage.close_window:type_name -> proto.C2S_CloseWindow
+	57,  // 57: proto.ClientMessage.build_start:type_name -> proto.C2S_BuildStart
+	58,  // 58: proto.ClientMessage.build_progress:type_name -> proto.C2S_BuildProgress
+	59,  // 59: proto.ClientMessage.build_take_back:type_name -> proto.C2S_BuildTakeBack
+	60,  // 60: proto.ClientMessage.lift_put_down:type_name -> proto.C2S_LiftPutDown
+	52,  // 61: proto.ClientMessage.chat_history:type_name -> proto.C2S_ChatHistoryRequest
+	51,  // 62: proto.ClientMessage.party_command:type_name -> proto.C2S_PartyCommand
+	35,  // 63: proto.ClientMessage.item_context_menu:type_name -> proto.C2S_ItemContextMenu
+	36,  // 64: proto.ClientMessage.item_action:type_name -> proto.C2S_ItemAction
+	63,  // 65: proto.ClientMessage.skill_list:type_name -> proto.C2S_SkillList
+	64,  // 66: proto.ClientMessage.learn_skill:type_name -> proto.C2S_LearnSkill
+	65,  // 67: proto.ClientMessage.train_attribute:type_name -> proto.C2S_TrainAttribute
+	66,  // 68: proto.ClientMessage.combat_move:type_name -> proto.C2S_CombatMove
+	71,  // 69: proto.S2C_PlayerEnterWorld.world_time:type_name -> proto.S2C_WorldTime
+	11,  // 70: proto.S2C_WorldTime.season:type_name -> proto.Season
+	7,   // 71: proto.CharacterAttributeEntry.key:type_name -> proto.CharacterAttributeKey
+	7,   // 72: proto.CharacterAttributeTrainCost.key:type_name -> proto.CharacterAttributeKey
+	72,  // 73: proto.S2C_CharacterProfile.attributes:type_name -> proto.CharacterAttributeEntry
+	73,  // 74: proto.S2C_CharacterProfile.exp:type_name -> proto.CharacterExperience
+	74,  // 75: proto.S2C_CharacterProfile.train_costs:type_name -> proto.CharacterAttributeTrainCost
+	41,  // 76: proto.S2C_ChunkLoad.chunk:type_name -> proto.ChunkData
+	3,   // 77: proto.S2C_ChunkLoad.weather:type_name -> proto.WeatherType
+	40,  // 78: proto.S2C_ChunkUnload.coord:type_name -> proto.ChunkCoord
+	40,  // 79: proto.S2C_TileUpdate.coord:type_name -> proto.ChunkCoord
+	81,  // 80: proto.S2C_TileUpdate.tiles:type_name -> proto.TileChange
+	40,  // 81: proto.S2C_Weather.coord:type_name -> proto.ChunkCoord
+	3,   // 82: proto.S2C_Weather.weather:type_name -> proto.WeatherType
+	38,  // 83: proto.S2C_ObjectSpawn.position:type_name -> proto.EntityPosition
+	37,  // 84: proto.S2C_ObjectMove.movement:type_name -> proto.EntityMovement
+	0,   // 85: proto.S2C_MovementMode.movement_mode:type_name -> proto.MovementMode
+	5,   // 86: proto.S2C_InventoryOpResult.error:type_name -> proto.ErrorCode
+	26,  // 87: proto.S2C_InventoryOpResult.updated:type_name -> proto.InventoryState
+	26,  // 88: proto.S2C_InventoryUpdate.updated:type_name -> proto.InventoryState
+	26,  // 89: proto.S2C_ContainerOpened.state:type_name -> proto.InventoryState
+	19,  // 90: proto.S2C_ContainerClosed.ref:type_name -> proto.InventoryRef
+	92,  // 91: proto.S2C_ContextMenu.actions:type_name -> proto.ContextMenuAction
+	12,  // 92: proto.S2C_MiniAlert.severity:type_name -> proto.AlertSeverity
+	13,  // 93: proto.S2C_CyclicActionFinished.result:type_name -> proto.CyclicActionFinishResult
+	97,  // 94: proto.CraftRecipeEntry.inputs:type_name -> proto.CraftInputDef
+	98,  // 95: proto.CraftRecipeEntry.outputs:type_name -> proto.CraftOutputDef
+	99,  // 96: proto.CraftRecipeEntry.flags:type_name -> proto.CraftRequirementFlags
+	100, // 97: proto.S2C_CraftList.recipes:type_name -> proto.CraftRecipeEntry
+	102, // 98: proto.BuildRecipeEntry.inputs:type_name -> proto.BuildInputDef
+	104, // 99: proto.S2C_BuildList.builds:type_name -> proto.BuildRecipeEntry
+	103, // 100: proto.S2C_BuildState.list:type_name -> proto.BuildStateItem
+	72,  // 101: proto.SkillEntry.required_attributes:type_name -> proto.CharacterAttributeEntry
+	108, // 102: proto.S2C_CombatState.cooldowns:type_name -> proto.CombatCooldown
+	14,  // 103: proto.S2C_FollowState.reason:type_name -> proto.FollowStopReason
+	107, // 104: proto.S2C_SkillList.skills:type_name -> proto.SkillEntry
+	16,  // 105: proto.S2C_Fx.position:type_name -> proto.Vector2
+	9,   // 106: proto.S2C_ChatMessage.channel:type_name -> proto.ChatChannel
+	9,   // 107: proto.ChatHistoryEntry.channel:type_name -> proto.ChatChannel
+	16,  // 108: proto.PartyMember.position:type_name -> proto.Vector2
+	119, // 109: proto.S2C_PartyState.members:type_name -> proto.PartyMember
+	118, // 110: proto.S2C_ChatHistory.messages:type_name -> proto.ChatHistoryEntry
+	5,   // 111: proto.S2C_Error.code:type_name -> proto.ErrorCode
+	6,   // 112: proto.S2C_Warning.code:type_name -> proto.WarningCode
+	68,  // 113: proto.ServerMessage.auth_result:type_name -> proto.S2C_AuthResult
+	69,  // 114: proto.ServerMessage.pong:type_name -> proto.S2C_Pong
+	79,  // 115: proto.ServerMessage.chunk_load:type_name -> proto.S2C_ChunkLoad
+	80,  // 116: proto.ServerMessage.chunk_unload:type_name -> proto.S2C_ChunkUnload
+	70,  // 117: proto.ServerMessage.player_enter_world:type_name -> proto.S2C_PlayerEnterWorld
+	78,  // 118: proto.ServerMessage.player_leave_world:type_name -> proto.S2C_PlayerLeaveWorld
+	84,  // 119: proto.ServerMessage.object_spawn:type_name -> proto.S2C_ObjectSpawn
+	85,  // 120: proto.ServerMessage.object_despawn:type_name -> proto.S2C_ObjectDespawn
+	86,  // 121: proto.ServerMessage.object_move:type_name -> proto.S2C_ObjectMove
+	87,  // 122: proto.ServerMessage.movement_mode:type_name -> proto.S2C_MovementMode
+	88,  // 123: proto.ServerMessage.inventory_op_result:type_name -> proto.S2C_InventoryOpResult
+	89,  // 124: proto.ServerMessage.inventory_update:type_name -> proto.S2C_InventoryUpdate
+	90,  // 125: proto.ServerMessage.container_opened:type_name -> proto.S2C_ContainerOpened
+	91,  // 126: proto.ServerMessage.container_closed:type_name -> proto.S2C_ContainerClosed
+	117, // 127: proto.ServerMessage.chat:type_name -> proto.S2C_ChatMessage
+	93,  // 128: proto.ServerMessage.context_menu:type_name -> proto.S2C_ContextMenu
+	94,  // 129: proto.ServerMessage.mini_alert:type_name -> proto.S2C_MiniAlert
+	95,  // 130: proto.ServerMessage.cyclic_action_progress:type_name -> proto.S2C_CyclicActionProgress
+	96,  // 131: proto.ServerMessage.cyclic_action_finished:type_name -> proto.S2C_CyclicActionFinished
+	114, // 132: proto.ServerMessage.sound:type_name -> proto.S2C_Sound
+	75,  // 133: proto.ServerMessage.character_profile:type_name -> proto.S2C_CharacterProfile
+	76,  // 134: proto.ServerMessage.player_stats:type_name -> proto.S2C_PlayerStats
+	115, // 135: proto.ServerMessage.exp_gained:type_name -> proto.S2C_ExpGained
+	116, // 136: proto.ServerMessage.fx:type_name -> proto.S2C_Fx
+	101, // 137: proto.ServerMessage.craft_list:type_name -> proto.S2C_CraftList
+	105, // 138: proto.ServerMessage.build_list:type_name -> proto.S2C_BuildList
+	106, // 139: proto.ServerMessage.build_state:type_name -> proto.S2C_BuildState
+	112, // 140: proto.ServerMessage.build_state_closed:type_name -> proto.S2C_BuildStateClosed
+	113, // 141: proto.ServerMessage.lift_carry_state:type_name -> proto.S2C_LiftCarryState
+	77,  // 142: proto.ServerMessage.death_dialog:type_name -> proto.S2C_DeathDialog
+	123, // 143: proto.ServerMessage.error:type_name -> proto.S2C_Error
+	124, // 144: proto.ServerMessage.warning:type_name -> proto.S2C_Warning
+	122, // 145: proto.ServerMessage.chat_history:type_name -> proto.S2C_ChatHistory
+	120, // 146: proto.ServerMessage.party_state:type_name -> proto.S2C_PartyState
+	121, // 147: proto.ServerMessage.party_invite:type_name -> proto.S2C_PartyInvite
+	111, // 148: proto.ServerMessage.skill_list:type_name -> proto.S2C_SkillList
+	109, // 149: proto.ServerMessage.combat_state:type_name -> proto.S2C_CombatState
+	110, // 150: proto.ServerMessage.follow_state:type_name -> proto.S2C_FollowState
+	82,  // 151: proto.ServerMessage.tile_update:type_name -> proto.S2C_TileUpdate
+	71,  // 152: proto.ServerMessage.world_time:type_name -> proto.S2C_WorldTime
+	83,  // 153: proto.ServerMessage.weather:type_name -> proto.S2C_Weather
+	154, // [154:154] is the sub-list for method output_type
+	154, // [154:154] is the sub-list for method input_type
+	154, // [154:154] is the sub-list for extension type_name
+	154, // [154:154] is the sub-list for extension extendee
+	0,   // [0:154] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_packets_proto_init() }
@@ -7626,17 +9882,19 @@ func file_api_proto_packets_proto_init() {
 		(*InventoryOp_Move)(nil),
 		(*InventoryOp_DropToWorld)(nil),
 	}
-	file_api_proto_packets_proto_msgTypes[20].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[29].OneofWrappers = []any{
+	file_api_proto_packets_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[33].OneofWrappers = []any{
 		(*C2S_PlayerAction_MoveTo)(nil),
 		(*C2S_PlayerAction_MoveToEntity)(nil),
 		(*C2S_PlayerAction_Interact)(nil),
 		(*C2S_PlayerAction_SelectContextAction)(nil),
+		(*C2S_PlayerAction_Attack)(nil),
+		(*C2S_PlayerAction_Follow)(nil),
 	}
-	file_api_proto_packets_proto_msgTypes[31].OneofWrappers = []any{
+	file_api_proto_packets_proto_msgTypes[35].OneofWrappers = []any{
 		(*C2S_ChatMessage_PrivateEntityId)(nil),
 	}
-	file_api_proto_packets_proto_msgTypes[42].OneofWrappers = []any{
+	file_api_proto_packets_proto_msgTypes[52].OneofWrappers = []any{
 		(*ClientMessage_Auth)(nil),
 		(*ClientMessage_Ping)(nil),
 		(*ClientMessage_PlayerAction)(nil),
@@ -7653,16 +9911,25 @@ func file_api_proto_packets_proto_init() {
 		(*ClientMessage_BuildProgress)(nil),
 		(*ClientMessage_BuildTakeBack)(nil),
 		(*ClientMessage_LiftPutDown)(nil),
+		(*ClientMessage_ChatHistory)(nil),
+		(*ClientMessage_PartyCommand)(nil),
+		(*ClientMessage_ItemContextMenu)(nil),
+		(*ClientMessage_ItemAction)(nil),
+		(*ClientMessage_SkillList)(nil),
+		(*ClientMessage_LearnSkill)(nil),
+		(*ClientMessage_TrainAttribute)(nil),
+		(*ClientMessage_CombatMove)(nil),
 	}
-	file_api_proto_packets_proto_msgTypes[58].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[66].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[67].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[70].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[72].OneofWrappers = []any{}
 	file_api_proto_packets_proto_msgTypes[73].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[80].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[81].OneofWrappers = []any{}
 	file_api_proto_packets_proto_msgTypes[82].OneofWrappers = []any{}
-	file_api_proto_packets_proto_msgTypes[85].OneofWrappers = []any{
+	file_api_proto_packets_proto_msgTypes[85].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[87].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[100].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[102].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[103].OneofWrappers = []any{}
+	file_api_proto_packets_proto_msgTypes[110].OneofWrappers = []any{
 		(*ServerMessage_AuthResult)(nil),
 		(*ServerMessage_Pong)(nil),
 		(*ServerMessage_ChunkLoad)(nil),
@@ -7695,14 +9962,23 @@ func file_api_proto_packets_proto_init() {
 		(*ServerMessage_DeathDialog)(nil),
 		(*ServerMessage_Error)(nil),
 		(*ServerMessage_Warning)(nil),
+		(*ServerMessage_ChatHistory)(nil),
+		(*ServerMessage_PartyState)(nil),
+		(*ServerMessage_PartyInvite)(nil),
+		(*ServerMessage_SkillList)(nil),
+		(*ServerMessage_CombatState)(nil),
+		(*ServerMessage_FollowState)(nil),
+		(*ServerMessage_TileUpdate)(nil),
+		(*ServerMessage_WorldTime)(nil),
+		(*ServerMessage_Weather)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_packets_proto_rawDesc), len(file_api_proto_packets_proto_rawDesc)),
-			NumEnums:      12,
-			NumMessages:   86,
+			NumEnums:      15,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -53,6 +53,7 @@ type Client struct {
 	closeOnce   sync.Once
 	writeBuf    *bufio.Writer
 	CharacterID types.EntityID
+	AccountID   int64
 	Layer       int
 	StreamEpoch atomic.Uint32
 	InWorld     atomic.Bool
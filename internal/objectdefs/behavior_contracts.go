@@ -16,6 +16,7 @@ func (d *ObjectDef) SetTreeBehaviorConfig(cfg contracts.TreeBehaviorConfig) {
 				Name:       entry.Name,
 				ItemDefKey: entry.ItemDefKey,
 				Count:      entry.Count,
+				ToolTag:    entry.ToolTag,
 			})
 		}
 		stages = append(stages, TreeStageConfig{
@@ -46,6 +47,7 @@ func (d *ObjectDef) SetTakeBehaviorConfig(cfg contracts.TakeBehaviorConfig) {
 			Name:       item.Name,
 			ItemDefKey: item.ItemDefKey,
 			Count:      item.Count,
+			ToolTag:    item.ToolTag,
 		})
 	}
 	d.TakeConfig = &TakeBehaviorConfig{
@@ -53,3 +55,67 @@ func (d *ObjectDef) SetTakeBehaviorConfig(cfg contracts.TakeBehaviorConfig) {
 		Items:    items,
 	}
 }
+
+// SetCropBehaviorConfig applies validated crop behavior config onto object def.
+func (d *ObjectDef) SetCropBehaviorConfig(cfg contracts.CropBehaviorConfig) {
+	if d == nil {
+		return
+	}
+	stages := make([]CropStageConfig, 0, len(cfg.Stages))
+	for _, stage := range cfg.Stages {
+		take := make([]TakeConfig, 0, len(stage.Take))
+		for _, entry := range stage.Take {
+			take = append(take, TakeConfig{
+				ID:         entry.ID,
+				Name:       entry.Name,
+				ItemDefKey: entry.ItemDefKey,
+				Count:      entry.Count,
+				ToolTag:    entry.ToolTag,
+			})
+		}
+		stages = append(stages, CropStageConfig{
+			StageDuration: stage.StageDuration,
+			Take:          take,
+			Harvest:       append([]string(nil), stage.Harvest...),
+		})
+	}
+	d.CropConfig = &CropBehaviorConfig{
+		Priority:    cfg.Priority,
+		SeedItemKey: cfg.SeedItemKey,
+		Stages:      stages,
+	}
+}
+
+// SetProcessorBehaviorConfig applies validated processor behavior config onto object def.
+func (d *ObjectDef) SetProcessorBehaviorConfig(cfg contracts.ProcessorBehaviorConfig) {
+	if d == nil {
+		return
+	}
+	fuel := make([]ProcessorFuelConfig, 0, len(cfg.Fuel))
+	for _, entry := range cfg.Fuel {
+		fuel = append(fuel, ProcessorFuelConfig{
+			ItemKey: entry.ItemKey,
+			Ticks:   entry.Ticks,
+		})
+	}
+	d.ProcessorConfig = &ProcessorBehaviorConfig{
+		Priority: cfg.Priority,
+		Machine:  cfg.Machine,
+		Fuel:     fuel,
+	}
+}
+
+// SetAnimalBehaviorConfig applies validated animal behavior config onto object def.
+func (d *ObjectDef) SetAnimalBehaviorConfig(cfg contracts.AnimalBehaviorConfig) {
+	if d == nil {
+		return
+	}
+	d.AnimalConfig = &AnimalBehaviorConfig{
+		Priority:         cfg.Priority,
+		Aggressive:       cfg.Aggressive,
+		AlertRadius:      cfg.AlertRadius,
+		CalmRadius:       cfg.CalmRadius,
+		WanderRadius:     cfg.WanderRadius,
+		WanderPauseTicks: cfg.WanderPauseTicks,
+	}
+}
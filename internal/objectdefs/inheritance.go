@@ -0,0 +1,259 @@
+package objectdefs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// Object definitions can inherit from another definition of the same directory (any file):
+//
+//	"extends": "<key>"  start from the resolved parent and merge this definition over it
+//	"abstract": true    template only: has no defId, is not validated on its own and not registered
+//
+// Merge rules, child over parent:
+//   - objects merge key by key, recursively; null removes the key
+//   - arrays of objects where every element has a unique "id" merge by id - appearance, take lists.
+//     Parent order is kept; a new id goes right after the child element listed before it
+//     if that one exists in the parent, otherwise to the end
+//   - other arrays of objects with the same length merge element by element - tree stages
+//   - anything else, including arrays of different length, is replaced
+//
+// defId, key, extends and abstract are never inherited. Behavior configs are validated on the
+// merged result only.
+
+// nonInheritedFields are dropped from the parent before merging.
+var nonInheritedFields = []string{"defId", "key", "extends", "abstract"}
+
+// rawObjectDef is one object entry as written in a file, before inheritance is resolved.
+type rawObjectDef struct {
+	filePath string
+	key      string
+	extends  string
+	abstract bool
+	defID    int
+	fields   map[string]any
+}
+
+// decodeRawObject checks the entry shape against ObjectDef and keeps its fields for merging.
+func decodeRawObject(data json.RawMessage, filePath string) (*rawObjectDef, error) {
+	var shape ObjectDef
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&shape); err != nil {
+		return nil, &LoadError{
+			FilePath: filePath,
+			Message:  fmt.Sprintf("failed to parse JSON: %v", err),
+		}
+	}
+
+	var fields map[string]any
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, &LoadError{
+			FilePath: filePath,
+			Key:      shape.Key,
+			Message:  fmt.Sprintf("failed to parse JSON: %v", err),
+		}
+	}
+
+	return &rawObjectDef{
+		filePath: filePath,
+		key:      shape.Key,
+		extends:  shape.Extends,
+		abstract: shape.Abstract,
+		defID:    shape.DefID,
+		fields:   fields,
+	}, nil
+}
+
+// inheritanceResolver merges definitions over their parents, memoizing resolved parents.
+type inheritanceResolver struct {
+	byKey     map[string]*rawObjectDef
+	resolved  map[string]map[string]any
+	resolving map[string]bool
+}
+
+func newInheritanceResolver(objects []*rawObjectDef) *inheritanceResolver {
+	r := &inheritanceResolver{
+		byKey:     make(map[string]*rawObjectDef, len(objects)),
+		resolved:  make(map[string]map[string]any),
+		resolving: make(map[string]bool),
+	}
+	for _, obj := range objects {
+		r.byKey[obj.key] = obj
+	}
+	return r
+}
+
+// resolve returns the effective fields of obj with extends applied.
+func (r *inheritanceResolver) resolve(obj *rawObjectDef) (map[string]any, error) {
+	if obj.extends == "" {
+		return obj.fields, nil
+	}
+	if fields, ok := r.resolved[obj.key]; ok {
+		return fields, nil
+	}
+	if r.resolving[obj.key] {
+		return nil, &LoadError{
+			FilePath: obj.filePath,
+			Key:      obj.key,
+			Message:  fmt.Sprintf("extends cycle through %q", obj.extends),
+		}
+	}
+
+	parent, ok := r.byKey[obj.extends]
+	if !ok {
+		return nil, &LoadError{
+			FilePath: obj.filePath,
+			Key:      obj.key,
+			Message:  fmt.Sprintf("extends unknown object key %q", obj.extends),
+		}
+	}
+
+	r.resolving[obj.key] = true
+	parentFields, err := r.resolve(parent)
+	delete(r.resolving, obj.key)
+	if err != nil {
+		return nil, err
+	}
+
+	base := make(map[string]any, len(parentFields))
+	for name, value := range parentFields {
+		base[name] = value
+	}
+	for _, name := range nonInheritedFields {
+		delete(base, name)
+	}
+	fields := mergeJSONObjects(base, obj.fields)
+	r.resolved[obj.key] = fields
+	return fields, nil
+}
+
+// decodeResolvedObject decodes merged fields into an ObjectDef.
+func decodeResolvedObject(obj *rawObjectDef, fields map[string]any) (ObjectDef, error) {
+	var def ObjectDef
+	data, err := json.Marshal(fields)
+	if err == nil {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&def)
+	}
+	if err != nil {
+		return ObjectDef{}, &LoadError{
+			FilePath: obj.filePath,
+			Key:      obj.key,
+			Message:  fmt.Sprintf("failed to decode resolved definition: %v", err),
+		}
+	}
+	def.Extends = ""
+	def.Abstract = false
+	return def, nil
+}
+
+func mergeJSONObjects(parent, child map[string]any) map[string]any {
+	out := make(map[string]any, len(parent)+len(child))
+	for name, value := range parent {
+		out[name] = value
+	}
+	for name, value := range child {
+		if value == nil {
+			delete(out, name)
+			continue
+		}
+		out[name] = mergeJSON(out[name], value)
+	}
+	return out
+}
+
+func mergeJSON(parent, child any) any {
+	switch childValue := child.(type) {
+	case map[string]any:
+		if parentValue, ok := parent.(map[string]any); ok {
+			return mergeJSONObjects(parentValue, childValue)
+		}
+	case []any:
+		if parentValue, ok := parent.([]any); ok {
+			return mergeJSONArrays(parentValue, childValue)
+		}
+	}
+	return child
+}
+
+func mergeJSONArrays(parent, child []any) []any {
+	parentIDs, parentKeyed := arrayElementIDs(parent)
+	childIDs, childKeyed := arrayElementIDs(child)
+	if parentKeyed && childKeyed && len(child) > 0 {
+		out := make([]any, len(parent), len(parent)+len(child))
+		copy(out, parent)
+		ids := append(make([]string, 0, len(parentIDs)+len(childIDs)), parentIDs...)
+		anchor := -1
+		for i, id := range childIDs {
+			if at := indexOfID(ids, id); at >= 0 {
+				out[at] = mergeJSON(out[at], child[i])
+				anchor = at
+				continue
+			}
+			if anchor < 0 {
+				out = append(out, child[i])
+				ids = append(ids, id)
+				continue
+			}
+			anchor++
+			out = slices.Insert(out, anchor, child[i])
+			ids = slices.Insert(ids, anchor, id)
+		}
+		return out
+	}
+
+	if len(parent) != len(child) || !allJSONObjects(parent) || !allJSONObjects(child) {
+		return child
+	}
+	out := make([]any, len(child))
+	for i := range child {
+		out[i] = mergeJSON(parent[i], child[i])
+	}
+	return out
+}
+
+// arrayElementIDs returns element ids if every element is an object with a unique string "id".
+func arrayElementIDs(values []any) ([]string, bool) {
+	ids := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		id, ok := obj["id"].(string)
+		if !ok || id == "" {
+			return nil, false
+		}
+		if _, dup := seen[id]; dup {
+			return nil, false
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+func indexOfID(ids []string, id string) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func allJSONObjects(values []any) bool {
+	for _, value := range values {
+		if _, ok := value.(map[string]any); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+package objectdefs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromDirectory_ExtendsAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	writeJSONC(t, dir, "a_templates.jsonc", `{
+		"v": 1, "source": "templates",
+		"objects": [
+			{
+				"key": "tree_base",
+				"abstract": true,
+				"hp": 100,
+				"components": { "collider": { "w": 10, "h": 10 } },
+				"appearance": [
+					{ "id": "stage1", "when": { "flags": ["tree.stage1"] } },
+					{ "id": "stage2", "when": { "flags": ["tree.stage2"] } }
+				],
+				"behaviors": {
+					"container": {},
+					"tree": {
+						"priority": 20,
+						"stages": [
+							{ "chopPointsTotal": 1, "stageDurationTicks": 60, "spawnChopItem": ["branch"] },
+							{ "chopPointsTotal": 2, "spawnChopObject": ["log"] }
+						]
+					}
+				}
+			}
+		]
+	}`)
+	writeJSONC(t, dir, "b_trees.jsonc", `{
+		"v": 1, "source": "trees",
+		"objects": [
+			{
+				"defId": 1,
+				"key": "tree_oak",
+				"name": "Oak",
+				"extends": "tree_base",
+				"resource": "trees/oak/1",
+				"components": { "collider": { "h": 12 } },
+				"appearance": [
+					{ "id": "stage1", "resource": "trees/oak/1" },
+					{ "id": "stage2", "resource": "trees/oak/2" }
+				],
+				"behaviors": {
+					"container": null,
+					"tree": { "stages": [{}, { "transformToDefKey": "stump_oak" }] }
+				}
+			}
+		]
+	}`)
+
+	registry, err := LoadFromDirectory(dir, testBehaviors(t), testLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.Count())
+	_, ok := registry.GetByKey("tree_base")
+	assert.False(t, ok, "abstract template must not be registered")
+
+	def, ok := registry.GetByKey("tree_oak")
+	require.True(t, ok)
+	assert.Equal(t, 100, def.HP)
+	assert.Empty(t, def.Extends)
+	require.NotNil(t, def.Components.Collider)
+	assert.Equal(t, 10.0, def.Components.Collider.W)
+	assert.Equal(t, 12.0, def.Components.Collider.H)
+
+	require.Len(t, def.Appearance, 2)
+	assert.Equal(t, "trees/oak/2", def.Appearance[1].Resource)
+	assert.Equal(t, []string{"tree.stage2"}, def.Appearance[1].When.Flags)
+
+	assert.Equal(t, []string{"tree"}, def.BehaviorOrder, "null removes inherited behavior")
+	require.NotNil(t, def.TreeConfig)
+	require.Len(t, def.TreeConfig.Stages, 2)
+	assert.Equal(t, []string{"branch"}, def.TreeConfig.Stages[0].SpawnChopItem)
+	assert.Equal(t, 2, def.TreeConfig.Stages[1].ChopPointsTotal)
+	assert.Equal(t, "stump_oak", def.TreeConfig.Stages[1].TransformToDefKey)
+	assert.Equal(t, 20, def.BehaviorPriorities["tree"])
+}
+
+func TestLoadFromDirectory_ExtendsValidatesMergedBehaviorConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	writeJSONC(t, dir, "test.jsonc", `{
+		"v": 1, "source": "test",
+		"objects": [
+			{
+				"key": "tree_base",
+				"abstract": true,
+				"behaviors": { "tree": { "stages": [{ "chopPointsTotal": 1 }] } }
+			},
+			{
+				"defId": 1, "key": "tree_bad", "name": "Bad", "resource": "x.png",
+				"extends": "tree_base",
+				"behaviors": { "tree": { "stages": [{ "chopPointsTotal": 0 }] } }
+			}
+		]
+	}`)
+
+	_, err := LoadFromDirectory(dir, testBehaviors(t), testLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "defId=1")
+	assert.Contains(t, err.Error(), "tree.stages[0].chopPointsTotal must be > 0")
+}
+
+func TestLoadFromDirectory_ExtendsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects string
+		wantErr string
+	}{
+		{
+			name:    "unknown parent",
+			objects: `{ "defId": 1, "key": "a", "name": "A", "resource": "a.png", "extends": "missing" }`,
+			wantErr: `extends unknown object key "missing"`,
+		},
+		{
+			name: "cycle",
+			objects: `{ "key": "a", "abstract": true, "extends": "b" },
+				{ "defId": 1, "key": "b", "name": "B", "resource": "b.png", "extends": "a" }`,
+			wantErr: "extends cycle",
+		},
+		{
+			name:    "abstract with defId",
+			objects: `{ "defId": 1, "key": "a", "abstract": true }`,
+			wantErr: "abstract object must not have defId",
+		},
+		{
+			name:    "defId is not inherited",
+			objects: `{ "defId": 1, "key": "a", "name": "A", "resource": "a.png" }, { "key": "b", "extends": "a" }`,
+			wantErr: "defId must be > 0",
+		},
+		{
+			name:    "unknown field in template",
+			objects: `{ "key": "a", "abstract": true, "colour": "red" }`,
+			wantErr: "failed to parse JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeJSONC(t, dir, "test.jsonc", `{ "v": 1, "source": "test", "objects": [`+tt.objects+`] }`)
+
+			_, err := LoadFromDirectory(dir, testBehaviors(t), testLogger())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestMergeJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent string
+		child  string
+		want   string
+	}{
+		{
+			name:   "keyed arrays merge by id and keep anchored order",
+			parent: `[{"id":"branch","count":7},{"id":"bark","item":"bark","count":1}]`,
+			child:  `[{"id":"branch"},{"id":"catkin","count":3},{"id":"bark","item":"birch_bark"},{"id":"resin"}]`,
+			want:   `[{"count":7,"id":"branch"},{"count":3,"id":"catkin"},{"count":1,"id":"bark","item":"birch_bark"},{"id":"resin"}]`,
+		},
+		{
+			name:   "unanchored new id is appended",
+			parent: `[{"id":"a"},{"id":"b"}]`,
+			child:  `[{"id":"c"}]`,
+			want:   `[{"id":"a"},{"id":"b"},{"id":"c"}]`,
+		},
+		{
+			name:   "empty child array clears keyed parent",
+			parent: `[{"id":"a"}]`,
+			child:  `[]`,
+			want:   `[]`,
+		},
+		{
+			name:   "object arrays of equal length merge by index",
+			parent: `[{"a":1,"b":1},{"a":2}]`,
+			child:  `[{},{"b":3}]`,
+			want:   `[{"a":1,"b":1},{"a":2,"b":3}]`,
+		},
+		{
+			name:   "object arrays of different length are replaced",
+			parent: `[{"a":1},{"a":2}]`,
+			child:  `[{"b":3}]`,
+			want:   `[{"b":3}]`,
+		},
+		{
+			name:   "scalar arrays are replaced",
+			parent: `{"flags":["x","y"]}`,
+			child:  `{"flags":["z"]}`,
+			want:   `{"flags":["z"]}`,
+		},
+		{
+			name:   "null removes key",
+			parent: `{"a":{"b":1,"c":2}}`,
+			child:  `{"a":{"c":null}}`,
+			want:   `{"a":{"b":1}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var parent, child any
+			require.NoError(t, json.Unmarshal([]byte(tt.parent), &parent))
+			require.NoError(t, json.Unmarshal([]byte(tt.child), &child))
+			parentBefore, err := json.Marshal(parent)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(mergeJSON(parent, child))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+
+			parentAfter, err := json.Marshal(parent)
+			require.NoError(t, err)
+			assert.Equal(t, string(parentBefore), string(parentAfter), "parent must not be modified")
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"origin/internal/game/behaviors/contracts"
+	"origin/internal/itemdefs"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -45,6 +46,12 @@ func stripJSONCComments(data []byte) []byte {
 
 // LoadFromDirectory loads all object definitions from JSONC files in the specified directory.
 func LoadFromDirectory(dir string, behaviors contracts.BehaviorRegistry, logger *zap.Logger) (*Registry, error) {
+	return LoadFromDirectoryWithItems(dir, behaviors, nil, logger)
+}
+
+// LoadFromDirectoryWithItems is LoadFromDirectory validating item references of behavior configs
+// against the given item registry instead of the global one (nil = itemdefs.Global()).
+func LoadFromDirectoryWithItems(dir string, behaviors contracts.BehaviorRegistry, items *itemdefs.Registry, logger *zap.Logger) (*Registry, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
@@ -68,41 +75,41 @@ func LoadFromDirectory(dir string, behaviors contracts.BehaviorRegistry, logger
 		return NewRegistry(nil), nil
 	}
 
-	var allObjects []ObjectDef
-	seenDefIDs := make(map[int]string)
+	var rawObjects []*rawObjectDef
 	seenKeys := make(map[string]string)
+	fileCounts := make(map[string]int, len(files))
 
 	for _, filePath := range files {
-		objects, err := loadFile(filePath, behaviors)
+		objects, err := loadFile(filePath)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, obj := range objects {
-			if existingFile, exists := seenDefIDs[obj.DefID]; exists {
-				return nil, &LoadError{
-					FilePath: filePath,
-					DefID:    obj.DefID,
-					Message:  fmt.Sprintf("duplicate defId, already defined in %s", existingFile),
-				}
-			}
-			seenDefIDs[obj.DefID] = filePath
-
-			if existingFile, exists := seenKeys[obj.Key]; exists {
+			if existingFile, exists := seenKeys[obj.key]; exists && obj.key != "" {
 				return nil, &LoadError{
 					FilePath: filePath,
-					Key:      obj.Key,
+					Key:      obj.key,
 					Message:  fmt.Sprintf("duplicate key, already defined in %s", existingFile),
 				}
 			}
-			seenKeys[obj.Key] = filePath
-
-			allObjects = append(allObjects, obj)
+			seenKeys[obj.key] = filePath
+			if !obj.abstract {
+				fileCounts[filePath]++
+			}
 		}
+		rawObjects = append(rawObjects, objects...)
+	}
 
+	allObjects, err := resolveObjects(rawObjects, behaviors, items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filePath := range files {
 		logger.Debug("Loaded object definitions file",
 			zap.String("file", filepath.Base(filePath)),
-			zap.Int("count", len(objects)),
+			zap.Int("count", fileCounts[filePath]),
 		)
 	}
 
@@ -114,7 +121,65 @@ func LoadFromDirectory(dir string, behaviors contracts.BehaviorRegistry, logger
 	return NewRegistry(allObjects), nil
 }
 
-func loadFile(filePath string, behaviors contracts.BehaviorRegistry) ([]ObjectDef, error) {
+// resolveObjects applies inheritance and validates every non-abstract definition in file order.
+func resolveObjects(rawObjects []*rawObjectDef, behaviors contracts.BehaviorRegistry, items *itemdefs.Registry) ([]ObjectDef, error) {
+	resolver := newInheritanceResolver(rawObjects)
+	allObjects := make([]ObjectDef, 0, len(rawObjects))
+	seenDefIDs := make(map[int]string)
+
+	for _, raw := range rawObjects {
+		fields, err := resolver.resolve(raw)
+		if err != nil {
+			return nil, err
+		}
+		if raw.abstract {
+			if raw.key == "" {
+				return nil, &LoadError{
+					FilePath: raw.filePath,
+					Message:  "key is required for abstract object",
+				}
+			}
+			if raw.defID != 0 {
+				return nil, &LoadError{
+					FilePath: raw.filePath,
+					Key:      raw.key,
+					Message:  "abstract object must not have defId",
+				}
+			}
+			continue
+		}
+
+		obj, err := decodeResolvedObject(raw, fields)
+		if err != nil {
+			return nil, err
+		}
+		applyDefaults(&obj)
+		if err := validateObject(&obj, raw.filePath, behaviors, items); err != nil {
+			return nil, err
+		}
+
+		if existingFile, exists := seenDefIDs[obj.DefID]; exists {
+			return nil, &LoadError{
+				FilePath: raw.filePath,
+				DefID:    obj.DefID,
+				Message:  fmt.Sprintf("duplicate defId, already defined in %s", existingFile),
+			}
+		}
+		seenDefIDs[obj.DefID] = raw.filePath
+
+		allObjects = append(allObjects, obj)
+	}
+	return allObjects, nil
+}
+
+// rawObjectsFile is ObjectsFile with entries kept raw until inheritance is resolved.
+type rawObjectsFile struct {
+	Version int               `json:"v"`
+	Source  string            `json:"source"`
+	Objects []json.RawMessage `json:"objects"`
+}
+
+func loadFile(filePath string) ([]*rawObjectDef, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, &LoadError{
@@ -128,7 +193,7 @@ func loadFile(filePath string, behaviors contracts.BehaviorRegistry) ([]ObjectDe
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	decoder.DisallowUnknownFields()
 
-	var file ObjectsFile
+	var file rawObjectsFile
 	if err := decoder.Decode(&file); err != nil {
 		return nil, &LoadError{
 			FilePath: filePath,
@@ -143,14 +208,16 @@ func loadFile(filePath string, behaviors contracts.BehaviorRegistry) ([]ObjectDe
 		}
 	}
 
-	for i := range file.Objects {
-		applyDefaults(&file.Objects[i])
-		if err := validateObject(&file.Objects[i], filePath, behaviors); err != nil {
+	objects := make([]*rawObjectDef, 0, len(file.Objects))
+	for _, entry := range file.Objects {
+		obj, err := decodeRawObject(entry, filePath)
+		if err != nil {
 			return nil, err
 		}
+		objects = append(objects, obj)
 	}
 
-	return file.Objects, nil
+	return objects, nil
 }
 
 func applyDefaults(obj *ObjectDef) {
@@ -186,10 +253,13 @@ func applyDefaults(obj *ObjectDef) {
 		obj.BehaviorPriorities = nil
 		obj.TreeConfig = nil
 		obj.TakeConfig = nil
+		obj.CropConfig = nil
+		obj.ProcessorConfig = nil
+		obj.AnimalConfig = nil
 	}
 }
 
-func validateObject(obj *ObjectDef, filePath string, behaviors contracts.BehaviorRegistry) error {
+func validateObject(obj *ObjectDef, filePath string, behaviors contracts.BehaviorRegistry, items *itemdefs.Registry) error {
 	if obj.DefID <= 0 {
 		return &LoadError{
 			FilePath: filePath,
@@ -235,6 +305,26 @@ func validateObject(obj *ObjectDef, filePath string, behaviors contracts.Behavio
 		}
 	}
 
+	// Validate movement
+	if obj.Components != nil && obj.Components.Movement != nil {
+		if obj.Components.Movement.Speed <= 0 {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    obj.DefID,
+				Key:      obj.Key,
+				Message:  "components.movement.speed must be > 0",
+			}
+		}
+		if obj.IsStatic {
+			return &LoadError{
+				FilePath: filePath,
+				DefID:    obj.DefID,
+				Key:      obj.Key,
+				Message:  "components.movement requires static: false",
+			}
+		}
+	}
+
 	// Validate inventory
 	if obj.Components != nil {
 		for idx, inv := range obj.Components.Inventory {
@@ -254,6 +344,14 @@ func validateObject(obj *ObjectDef, filePath string, behaviors contracts.Behavio
 					Message:  fmt.Sprintf("components.inventory[%d].h must be > 0", idx),
 				}
 			}
+			if inv.SpoilRate != nil && *inv.SpoilRate < 0 {
+				return &LoadError{
+					FilePath: filePath,
+					DefID:    obj.DefID,
+					Key:      obj.Key,
+					Message:  fmt.Sprintf("components.inventory[%d].spoilRate must be >= 0", idx),
+				}
+			}
 		}
 	}
 
@@ -316,6 +414,7 @@ func validateObject(obj *ObjectDef, filePath string, behaviors contracts.Behavio
 				BehaviorKey: behaviorKey,
 				RawConfig:   raw,
 				Def:         obj,
+				Items:       items,
 			})
 			if err != nil {
 				return &LoadError{
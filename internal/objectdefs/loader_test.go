@@ -129,7 +129,7 @@ func (testTreeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConf
 		}
 		seenTakeIDs := make(map[string]int, len(stage.Take))
 		for takeIdx, take := range stage.Take {
-			if err := validateTakeConfigForTest(idx, takeIdx, take); err != nil {
+			if err := validateTakeConfigForTest(idx, takeIdx, take, ctx.ItemRegistry()); err != nil {
 				return 0, err
 			}
 			takeID := strings.TrimSpace(take.ID)
@@ -169,7 +169,7 @@ func (testTakeBehavior) ValidateAndApplyDefConfig(ctx *contracts.BehaviorDefConf
 
 	seenIDs := make(map[string]int, len(cfg.Items))
 	for itemIndex, item := range cfg.Items {
-		if err := validateTakeBehaviorItemConfigForTest(itemIndex, item); err != nil {
+		if err := validateTakeBehaviorItemConfigForTest(itemIndex, item, ctx.ItemRegistry()); err != nil {
 			return 0, err
 		}
 		itemID := strings.TrimSpace(item.ID)
@@ -195,7 +195,7 @@ func decodeStrictJSONForTest(raw []byte, dst any) error {
 	return nil
 }
 
-func validateTakeConfigForTest(stageIndex int, takeIndex int, cfg contracts.TakeConfig) error {
+func validateTakeConfigForTest(stageIndex int, takeIndex int, cfg contracts.TakeConfig, registry *itemdefs.Registry) error {
 	takeID := strings.TrimSpace(cfg.ID)
 	if takeID == "" {
 		return fmt.Errorf("tree.stages[%d].take[%d].id must not be empty", stageIndex, takeIndex)
@@ -210,7 +210,6 @@ func validateTakeConfigForTest(stageIndex int, takeIndex int, cfg contracts.Take
 	if cfg.Count <= 0 {
 		return fmt.Errorf("tree.stages[%d].take[%d].count must be > 0", stageIndex, takeIndex)
 	}
-	registry := itemdefs.Global()
 	if registry == nil {
 		return fmt.Errorf("tree.stages[%d].take[%d].itemDefKey validation requires loaded item defs", stageIndex, takeIndex)
 	}
@@ -220,7 +219,7 @@ func validateTakeConfigForTest(stageIndex int, takeIndex int, cfg contracts.Take
 	return nil
 }
 
-func validateTakeBehaviorItemConfigForTest(itemIndex int, item contracts.TakeConfig) error {
+func validateTakeBehaviorItemConfigForTest(itemIndex int, item contracts.TakeConfig, registry *itemdefs.Registry) error {
 	itemID := strings.TrimSpace(item.ID)
 	if itemID == "" {
 		return fmt.Errorf("take.items[%d].id must not be empty", itemIndex)
@@ -235,7 +234,6 @@ func validateTakeBehaviorItemConfigForTest(itemIndex int, item contracts.TakeCon
 	if item.Count <= 0 {
 		return fmt.Errorf("take.items[%d].count must be > 0", itemIndex)
 	}
-	registry := itemdefs.Global()
 	if registry == nil {
 		return fmt.Errorf("take.items[%d].itemDefKey validation requires loaded item defs", itemIndex)
 	}
@@ -518,6 +516,33 @@ func TestLoadFromDirectory_InvalidInventory(t *testing.T) {
 	assert.Contains(t, err.Error(), "components.inventory[0].w must be > 0")
 }
 
+func TestLoadFromDirectory_Movement(t *testing.T) {
+	dir := t.TempDir()
+
+	writeJSONC(t, dir, "test.jsonc", `{
+		"v": 1, "source": "test",
+		"objects": [{ "defId": 1, "key": "deer", "name": "Deer", "static": false, "components": { "movement": { "speed": 28 } }, "resource": "deer" }]
+	}`)
+
+	registry, err := LoadFromDirectory(dir, testBehaviors(t), testLogger())
+	require.NoError(t, err)
+	def, ok := registry.GetByKey("deer")
+	require.True(t, ok)
+	require.NotNil(t, def.Components.Movement)
+	assert.Equal(t, 28.0, def.Components.Movement.Speed)
+
+	for name, objectJSON := range map[string]string{
+		"components.movement.speed must be > 0":      `{ "defId": 1, "key": "bad", "name": "Bad", "static": false, "components": { "movement": { "speed": 0 } }, "resource": "x.png" }`,
+		"components.movement requires static: false": `{ "defId": 1, "key": "bad", "name": "Bad", "components": { "movement": { "speed": 20 } }, "resource": "x.png" }`,
+	} {
+		badDir := t.TempDir()
+		writeJSONC(t, badDir, "test.jsonc", `{ "v": 1, "source": "test", "objects": [`+objectJSON+`] }`)
+		_, err := LoadFromDirectory(badDir, testBehaviors(t), testLogger())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), name)
+	}
+}
+
 func TestLoadFromDirectory_InvalidTreeBehaviorConfig(t *testing.T) {
 	dir := t.TempDir()
 
@@ -1117,3 +1142,43 @@ func TestLoadFromDirectory_BehaviorOrderByPriority(t *testing.T) {
 	assert.Equal(t, 50, def.PriorityForBehavior("container"))
 	assert.Equal(t, 200, def.PriorityForBehavior("player"))
 }
+
+func TestLoadFromDirectoryWithItems_UsesGivenItemRegistry(t *testing.T) {
+	dir := t.TempDir()
+	previousRegistry := itemdefs.Global()
+	itemdefs.SetGlobalForTesting(itemdefs.NewRegistry(nil))
+	defer itemdefs.SetGlobalForTesting(previousRegistry)
+
+	writeJSONC(t, dir, "test.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"objects": [{
+			"defId": 1,
+			"key": "boulder",
+			"name": "Boulder",
+			"resource": "x.png",
+			"behaviors": {
+				"take": {
+					"items": [
+						{
+							"id": "chip_stone",
+							"name": "Chip Stone",
+							"itemDefKey": "stone",
+							"count": 1
+						}
+					]
+				}
+			}
+		}]
+	}`)
+
+	_, err := LoadFromDirectory(dir, testBehaviors(t), testLogger())
+	require.Error(t, err, "global registry has no stone")
+
+	items := itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1, Key: "stone", Name: "Stone", Size: itemdefs.Size{W: 1, H: 1}},
+	})
+	registry, err := LoadFromDirectoryWithItems(dir, testBehaviors(t), items, testLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.Count())
+}
@@ -2,6 +2,7 @@ package objectdefs
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 // Registry holds all loaded object definitions.
@@ -9,10 +10,12 @@ import (
 type Registry struct {
 	byID  map[int]*ObjectDef
 	byKey map[string]*ObjectDef
+	// cropsBySeed maps a seed item key to the crop planted from it (lowest defId wins).
+	cropsBySeed map[string]*ObjectDef
 }
 
 var (
-	globalRegistry *Registry
+	globalRegistry atomic.Pointer[Registry]
 	registryOnce   sync.Once
 )
 
@@ -21,11 +24,18 @@ func NewRegistry(objects []ObjectDef) *Registry {
 	r := &Registry{
 		byID:  make(map[int]*ObjectDef, len(objects)),
 		byKey: make(map[string]*ObjectDef, len(objects)),
+
+		cropsBySeed: make(map[string]*ObjectDef),
 	}
 	for i := range objects {
 		obj := &objects[i]
 		r.byID[obj.DefID] = obj
 		r.byKey[obj.Key] = obj
+		if obj.CropConfig != nil && obj.CropConfig.SeedItemKey != "" {
+			if existing, ok := r.cropsBySeed[obj.CropConfig.SeedItemKey]; !ok || obj.DefID < existing.DefID {
+				r.cropsBySeed[obj.CropConfig.SeedItemKey] = obj
+			}
+		}
 	}
 	return r
 }
@@ -42,6 +52,15 @@ func (r *Registry) GetByKey(key string) (*ObjectDef, bool) {
 	return obj, ok
 }
 
+// GetCropBySeed returns the crop object definition planted from the given seed item key.
+func (r *Registry) GetCropBySeed(seedItemKey string) (*ObjectDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	obj, ok := r.cropsBySeed[seedItemKey]
+	return obj, ok
+}
+
 // Count returns the total number of object definitions.
 func (r *Registry) Count() int {
 	return len(r.byID)
@@ -59,17 +78,23 @@ func (r *Registry) All() []*ObjectDef {
 // SetGlobal sets the global registry (should be called once at startup).
 func SetGlobal(r *Registry) {
 	registryOnce.Do(func() {
-		globalRegistry = r
+		globalRegistry.Store(r)
 	})
 }
 
 // SetGlobalForTesting replaces the global registry without sync.Once protection.
 // Must only be used in tests.
 func SetGlobalForTesting(r *Registry) {
-	globalRegistry = r
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
 }
 
 // Global returns the global registry.
 func Global() *Registry {
-	return globalRegistry
+	return globalRegistry.Load()
 }
@@ -14,20 +14,28 @@ type ObjectDef struct {
 	Resource                  string                     `json:"resource,omitempty"`
 	Appearance                []Appearance               `json:"appearance,omitempty"`
 	Behaviors                 map[string]json.RawMessage `json:"behaviors,omitempty"`
+	// Extends is the key of the definition this one is merged over (see inheritance.go).
+	Extends string `json:"extends,omitempty"`
+	// Abstract marks a template usable only through extends: it is not registered and has no defId.
+	Abstract bool `json:"abstract,omitempty"`
 
 	// resolved at load time
-	IsStatic                       bool                `json:"-"`
-	ContextMenuEvenForOneItemValue bool                `json:"-"`
-	BehaviorOrder                  []string            `json:"-"`
-	BehaviorPriorities             map[string]int      `json:"-"`
-	TreeConfig                     *TreeBehaviorConfig `json:"-"`
-	TakeConfig                     *TakeBehaviorConfig `json:"-"`
+	IsStatic                       bool                     `json:"-"`
+	ContextMenuEvenForOneItemValue bool                     `json:"-"`
+	BehaviorOrder                  []string                 `json:"-"`
+	BehaviorPriorities             map[string]int           `json:"-"`
+	TreeConfig                     *TreeBehaviorConfig      `json:"-"`
+	TakeConfig                     *TakeBehaviorConfig      `json:"-"`
+	CropConfig                     *CropBehaviorConfig      `json:"-"`
+	ProcessorConfig                *ProcessorBehaviorConfig `json:"-"`
+	AnimalConfig                   *AnimalBehaviorConfig    `json:"-"`
 }
 
 // Components describes ECS components to attach when loading the object.
 type Components struct {
 	Collider  *ColliderDef   `json:"collider,omitempty"`
 	Inventory []InventoryDef `json:"inventory,omitempty"`
+	Movement  *MovementDef   `json:"movement,omitempty"`
 }
 
 // ColliderDef describes collision box dimensions in game coordinates.
@@ -44,6 +52,14 @@ type InventoryDef struct {
 	H    int    `json:"h"`
 	Kind string `json:"kind,omitempty"` // default "grid"
 	Key  uint32 `json:"key,omitempty"`  // default 0
+	// SpoilRate scales how fast items inside spoil (e.g. 0.25 for a barrel). Default itemdefs.DefaultSpoilRate.
+	SpoilRate *float64 `json:"spoilRate,omitempty"`
+}
+
+// MovementDef makes the object mobile: it is spawned with a Movement component.
+type MovementDef struct {
+	// Speed is the walk speed in world units per second (players walk at 32).
+	Speed float64 `json:"speed"`
 }
 
 // Appearance describes a conditional visual override.
@@ -80,6 +96,7 @@ type TakeConfig struct {
 	Name       string `json:"name"`
 	ItemDefKey string `json:"itemDefKey"`
 	Count      int    `json:"count"`
+	ToolTag    string `json:"toolTag,omitempty"`
 }
 
 type TakeBehaviorConfig struct {
@@ -87,6 +104,54 @@ type TakeBehaviorConfig struct {
 	Items    []TakeConfig `json:"items"`
 }
 
+// CropBehaviorConfig contains numeric/crop-specific config only.
+type CropBehaviorConfig struct {
+	Priority    int               `json:"priority,omitempty"`
+	SeedItemKey string            `json:"seedItemKey"`
+	Stages      []CropStageConfig `json:"stages"`
+}
+
+type CropStageConfig struct {
+	StageDuration int          `json:"stageDurationTicks"`
+	Take          []TakeConfig `json:"take,omitempty"`
+	Harvest       []string     `json:"harvest,omitempty"`
+}
+
+// ProcessorBehaviorConfig contains fuel and recipe-machine config only.
+type ProcessorBehaviorConfig struct {
+	Priority int                   `json:"priority,omitempty"`
+	Machine  string                `json:"machine"`
+	Fuel     []ProcessorFuelConfig `json:"fuel"`
+}
+
+type ProcessorFuelConfig struct {
+	ItemKey string `json:"itemKey"`
+	Ticks   uint64 `json:"ticks"`
+}
+
+// FuelTicks returns burn ticks of one fuel item, or 0 when the item is not fuel.
+func (c *ProcessorBehaviorConfig) FuelTicks(itemKey string) uint64 {
+	if c == nil {
+		return 0
+	}
+	for _, entry := range c.Fuel {
+		if entry.ItemKey == itemKey {
+			return entry.Ticks
+		}
+	}
+	return 0
+}
+
+// AnimalBehaviorConfig contains wild animal AI config only.
+type AnimalBehaviorConfig struct {
+	Priority         int     `json:"priority,omitempty"`
+	Aggressive       bool    `json:"aggressive,omitempty"`
+	AlertRadius      float64 `json:"alertRadius"`
+	CalmRadius       float64 `json:"calmRadius,omitempty"`
+	WanderRadius     float64 `json:"wanderRadius"`
+	WanderPauseTicks uint64  `json:"wanderPauseTicks"`
+}
+
 // ObjectsFile represents a JSONC file containing object definitions.
 type ObjectsFile struct {
 	Version int         `json:"v"`
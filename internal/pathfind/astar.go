@@ -0,0 +1,214 @@
+package pathfind
+
+import "container/heap"
+
+// Cell is a grid coordinate.
+type Cell struct {
+	X, Y int
+}
+
+// Grid reports whether a mover can stand in a cell.
+type Grid interface {
+	Walkable(x, y int) bool
+}
+
+// Status is the state of an incremental search.
+type Status uint8
+
+const (
+	StatusSearching Status = iota
+	StatusFound
+	StatusNoPath
+)
+
+const (
+	straightCost = 10
+	diagonalCost = 14
+)
+
+var neighborOffsets = [8]Cell{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{1, 1}, {1, -1}, {-1, 1}, {-1, -1},
+}
+
+type node struct {
+	cell      Cell
+	parent    int32
+	g         int
+	f         int
+	heapIndex int
+	closed    bool
+}
+
+// Search is an 8-directional A* that can be spread over several ticks:
+// Step expands at most the given number of nodes and resumes where it stopped on the next call.
+// Diagonal moves are only allowed when both adjacent straight cells are walkable, so paths never cut corners.
+// The start cell is always treated as walkable: the mover already stands there.
+type Search struct {
+	start      Cell
+	goal       Cell
+	goalRadius int
+	maxNodes   int
+	expanded   int
+	status     Status
+
+	nodes []node
+	index map[Cell]int32
+	open  openHeap
+	end   int32
+}
+
+// NewSearch prepares a search from start to any cell within goalRadius (Chebyshev distance) of goal.
+// The search gives up with StatusNoPath after maxNodes expansions.
+func NewSearch(start, goal Cell, goalRadius, maxNodes int) *Search {
+	s := &Search{
+		start:      start,
+		goal:       goal,
+		goalRadius: max(goalRadius, 0),
+		maxNodes:   maxNodes,
+		index:      make(map[Cell]int32, 256),
+		end:        -1,
+	}
+	s.open.search = s
+	startIndex := s.addNode(start, -1, 0)
+	heap.Push(&s.open, startIndex)
+	return s
+}
+
+func (s *Search) Status() Status { return s.status }
+
+// Expanded is the total number of nodes expanded so far.
+func (s *Search) Expanded() int { return s.expanded }
+
+// Step expands up to budget nodes and returns how many were expanded.
+func (s *Search) Step(grid Grid, budget int) int {
+	used := 0
+	for s.status == StatusSearching && used < budget {
+		if s.open.Len() == 0 || s.expanded >= s.maxNodes {
+			s.status = StatusNoPath
+			break
+		}
+		current := heap.Pop(&s.open).(int32)
+		s.nodes[current].closed = true
+		s.expanded++
+		used++
+
+		cell := s.nodes[current].cell
+		if s.isGoal(cell) {
+			s.end = current
+			s.status = StatusFound
+			break
+		}
+		s.expandNeighbors(grid, current, cell)
+	}
+	return used
+}
+
+// Path returns the cells from the cell after start up to the reached goal cell.
+// It is empty when the start already satisfies the goal or the search has not found a path.
+func (s *Search) Path() []Cell {
+	if s.status != StatusFound {
+		return nil
+	}
+	length := 0
+	for i := s.end; s.nodes[i].parent >= 0; i = s.nodes[i].parent {
+		length++
+	}
+	path := make([]Cell, length)
+	for i := s.end; s.nodes[i].parent >= 0; i = s.nodes[i].parent {
+		length--
+		path[length] = s.nodes[i].cell
+	}
+	return path
+}
+
+func (s *Search) expandNeighbors(grid Grid, current int32, cell Cell) {
+	for dir, offset := range neighborOffsets {
+		next := Cell{X: cell.X + offset.X, Y: cell.Y + offset.Y}
+		cost := straightCost
+		if dir >= 4 {
+			if !grid.Walkable(cell.X+offset.X, cell.Y) || !grid.Walkable(cell.X, cell.Y+offset.Y) {
+				continue
+			}
+			cost = diagonalCost
+		}
+
+		g := s.nodes[current].g + cost
+		if existing, seen := s.index[next]; seen {
+			n := &s.nodes[existing]
+			if n.closed || g >= n.g {
+				continue
+			}
+			n.g = g
+			n.f = g + s.heuristic(next)
+			n.parent = current
+			heap.Fix(&s.open, n.heapIndex)
+			continue
+		}
+		if !grid.Walkable(next.X, next.Y) {
+			continue
+		}
+		heap.Push(&s.open, s.addNode(next, current, g))
+	}
+}
+
+func (s *Search) addNode(cell Cell, parent int32, g int) int32 {
+	index := int32(len(s.nodes))
+	s.nodes = append(s.nodes, node{cell: cell, parent: parent, g: g, f: g + s.heuristic(cell)})
+	s.index[cell] = index
+	return index
+}
+
+func (s *Search) isGoal(cell Cell) bool {
+	return abs(cell.X-s.goal.X) <= s.goalRadius && abs(cell.Y-s.goal.Y) <= s.goalRadius
+}
+
+// heuristic is the octile distance to the goal area; it never overestimates.
+func (s *Search) heuristic(cell Cell) int {
+	dx := max(abs(cell.X-s.goal.X)-s.goalRadius, 0)
+	dy := max(abs(cell.Y-s.goal.Y)-s.goalRadius, 0)
+	return straightCost*(dx+dy) + (diagonalCost-2*straightCost)*min(dx, dy)
+}
+
+// openHeap orders node indices by f, preferring the node closer to the goal on ties.
+type openHeap struct {
+	search  *Search
+	indices []int32
+}
+
+func (h *openHeap) Len() int { return len(h.indices) }
+
+func (h *openHeap) Less(i, j int) bool {
+	a := &h.search.nodes[h.indices[i]]
+	b := &h.search.nodes[h.indices[j]]
+	if a.f != b.f {
+		return a.f < b.f
+	}
+	return a.g > b.g
+}
+
+func (h *openHeap) Swap(i, j int) {
+	h.indices[i], h.indices[j] = h.indices[j], h.indices[i]
+	h.search.nodes[h.indices[i]].heapIndex = i
+	h.search.nodes[h.indices[j]].heapIndex = j
+}
+
+func (h *openHeap) Push(x any) {
+	index := x.(int32)
+	h.search.nodes[index].heapIndex = len(h.indices)
+	h.indices = append(h.indices, index)
+}
+
+func (h *openHeap) Pop() any {
+	last := len(h.indices) - 1
+	index := h.indices[last]
+	h.indices = h.indices[:last]
+	return index
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
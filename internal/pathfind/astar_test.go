@@ -0,0 +1,169 @@
+package pathfind
+
+import (
+	"strings"
+	"testing"
+)
+
+// mapGrid is a test grid drawn with '#' for blocked cells; everything outside the drawing is blocked.
+type mapGrid struct {
+	rows    []string
+	queries int
+}
+
+func newMapGrid(drawing string) *mapGrid {
+	return &mapGrid{rows: strings.Split(strings.TrimSpace(drawing), "\n")}
+}
+
+func (g *mapGrid) Walkable(x, y int) bool {
+	g.queries++
+	if y < 0 || y >= len(g.rows) || x < 0 || x >= len(g.rows[y]) {
+		return false
+	}
+	return g.rows[y][x] != '#'
+}
+
+func runSearch(t *testing.T, grid Grid, search *Search) {
+	t.Helper()
+	for i := 0; search.Status() == StatusSearching; i++ {
+		if i > 1000 {
+			t.Fatalf("search did not finish")
+		}
+		search.Step(grid, 4)
+	}
+}
+
+func TestSearch_FindsPathAroundWall(t *testing.T) {
+	grid := newMapGrid(`
+.......
+...#...
+...#...
+...#...
+.......`)
+	search := NewSearch(Cell{X: 1, Y: 2}, Cell{X: 5, Y: 2}, 0, 1000)
+	runSearch(t, grid, search)
+
+	if search.Status() != StatusFound {
+		t.Fatalf("expected path around the wall, got status %v", search.Status())
+	}
+	path := search.Path()
+	if len(path) == 0 || path[len(path)-1] != (Cell{X: 5, Y: 2}) {
+		t.Fatalf("expected path ending at goal, got %v", path)
+	}
+	previous := Cell{X: 1, Y: 2}
+	for _, cell := range path {
+		if !grid.Walkable(cell.X, cell.Y) {
+			t.Fatalf("path goes through blocked cell %v: %v", cell, path)
+		}
+		if abs(cell.X-previous.X) > 1 || abs(cell.Y-previous.Y) > 1 {
+			t.Fatalf("path jumps from %v to %v", previous, cell)
+		}
+		previous = cell
+	}
+	// 4 diagonal + 2 straight steps is the shortest way around a 3-cell wall.
+	if len(path) != 6 {
+		t.Fatalf("expected shortest path of 6 steps, got %d: %v", len(path), path)
+	}
+}
+
+func TestSearch_DoesNotCutCorners(t *testing.T) {
+	grid := newMapGrid(`
+..
+#.`)
+	search := NewSearch(Cell{X: 0, Y: 0}, Cell{X: 1, Y: 1}, 0, 100)
+	runSearch(t, grid, search)
+
+	path := search.Path()
+	if len(path) != 2 || path[0] != (Cell{X: 1, Y: 0}) {
+		t.Fatalf("expected path around the corner, got %v", path)
+	}
+
+	grid = newMapGrid(`
+.#
+#.`)
+	search = NewSearch(Cell{X: 0, Y: 0}, Cell{X: 1, Y: 1}, 0, 100)
+	runSearch(t, grid, search)
+	if search.Status() != StatusNoPath {
+		t.Fatalf("expected diagonal squeeze to be blocked, got %v", search.Path())
+	}
+}
+
+func TestSearch_GoalRadiusStopsNextToBlockedGoal(t *testing.T) {
+	grid := newMapGrid(`
+.....
+..#..
+.....`)
+	search := NewSearch(Cell{X: 0, Y: 1}, Cell{X: 2, Y: 1}, 1, 100)
+	runSearch(t, grid, search)
+
+	path := search.Path()
+	if search.Status() != StatusFound || len(path) != 1 || path[0] != (Cell{X: 1, Y: 1}) {
+		t.Fatalf("expected to stop next to the goal, got %v %v", search.Status(), path)
+	}
+}
+
+func TestSearch_UnreachableAndNodeLimit(t *testing.T) {
+	grid := newMapGrid(`
+..#..
+..#..
+..#..`)
+	search := NewSearch(Cell{X: 0, Y: 1}, Cell{X: 4, Y: 1}, 0, 1000)
+	runSearch(t, grid, search)
+	if search.Status() != StatusNoPath || search.Expanded() != 6 {
+		t.Fatalf("expected no path after exhausting the 6 reachable cells, got %v after %d", search.Status(), search.Expanded())
+	}
+
+	open := newMapGrid(strings.Repeat(strings.Repeat(".", 40)+"\n", 40))
+	search = NewSearch(Cell{X: 0, Y: 0}, Cell{X: 39, Y: 39}, 0, 10)
+	runSearch(t, open, search)
+	if search.Status() != StatusNoPath || search.Expanded() != 10 {
+		t.Fatalf("expected search to give up at the node limit, got %v after %d", search.Status(), search.Expanded())
+	}
+}
+
+func TestSearch_StepRespectsBudget(t *testing.T) {
+	grid := newMapGrid(`
+.......
+...#...
+...#...
+...#...
+.......`)
+	search := NewSearch(Cell{X: 1, Y: 2}, Cell{X: 5, Y: 2}, 0, 1000)
+	if used := search.Step(grid, 2); used != 2 || search.Status() != StatusSearching {
+		t.Fatalf("expected 2 expansions and a running search, got %d %v", used, search.Status())
+	}
+	runSearch(t, grid, search)
+	if search.Status() != StatusFound {
+		t.Fatalf("expected search to resume and finish, got %v", search.Status())
+	}
+}
+
+func TestSmooth_KeepsOnlyTurningPoints(t *testing.T) {
+	grid := newMapGrid(`
+.......
+...#...
+...#...
+...#...
+.......`)
+	start := Cell{X: 1, Y: 2}
+	search := NewSearch(start, Cell{X: 5, Y: 2}, 0, 1000)
+	runSearch(t, grid, search)
+
+	smoothed := Smooth(grid, start, search.Path())
+	if len(smoothed) == 0 || len(smoothed) >= len(search.Path()) {
+		t.Fatalf("expected smoothing to drop cells, got %v from %v", smoothed, search.Path())
+	}
+	anchor := start
+	for _, cell := range smoothed {
+		if !LineWalkable(grid, anchor, cell) {
+			t.Fatalf("smoothed leg %v -> %v crosses a blocked cell", anchor, cell)
+		}
+		anchor = cell
+	}
+	if anchor != (Cell{X: 5, Y: 2}) {
+		t.Fatalf("expected smoothed path to end at goal, got %v", smoothed)
+	}
+	if LineWalkable(grid, start, Cell{X: 5, Y: 2}) {
+		t.Fatalf("expected the wall to block line of sight")
+	}
+}
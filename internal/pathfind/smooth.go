@@ -0,0 +1,65 @@
+package pathfind
+
+// LineWalkable reports whether every cell crossed by the segment between the centers of a and b is walkable.
+// The cell a itself is not checked. When the segment passes exactly through a cell corner,
+// both cells touching that corner must be walkable, matching the no-corner-cutting rule of Search.
+func LineWalkable(grid Grid, a, b Cell) bool {
+	dx := b.X - a.X
+	dy := b.Y - a.Y
+	nx, ny := abs(dx), abs(dy)
+	signX, signY := sign(dx), sign(dy)
+
+	x, y := a.X, a.Y
+	for ix, iy := 0, 0; ix < nx || iy < ny; {
+		decision := (1+2*ix)*ny - (1+2*iy)*nx
+		switch {
+		case decision == 0:
+			if !grid.Walkable(x+signX, y) || !grid.Walkable(x, y+signY) {
+				return false
+			}
+			x += signX
+			y += signY
+			ix++
+			iy++
+		case decision < 0:
+			x += signX
+			ix++
+		default:
+			y += signY
+			iy++
+		}
+		if !grid.Walkable(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// Smooth drops path cells that can be skipped in a straight line.
+// It walks forward from start and keeps only the cells where the line of sight breaks, plus the last cell.
+func Smooth(grid Grid, start Cell, path []Cell) []Cell {
+	if len(path) == 0 {
+		return nil
+	}
+	out := make([]Cell, 0, len(path))
+	anchor := start
+	for i := 0; i < len(path); i++ {
+		for i+1 < len(path) && LineWalkable(grid, anchor, path[i+1]) {
+			i++
+		}
+		out = append(out, path[i])
+		anchor = path[i]
+	}
+	return out
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Chat history is range-partitioned by created_at into monthly tables named chat_yYYYYmMM.
+// Rows outside any monthly partition land in chat_default.
+const chatPartitionNameLayout = "chat_y2006m01"
+
+func chatPartitionName(month time.Time) string {
+	return monthStart(month).Format(chatPartitionNameLayout)
+}
+
+// parseChatPartitionMonth returns the first instant of the month covered by a monthly partition.
+// Returns false for chat_default or any table that does not follow the naming scheme.
+func parseChatPartitionMonth(name string) (time.Time, bool) {
+	month, err := time.ParseInLocation(chatPartitionNameLayout, name, time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// chatRetentionCutoff returns the start of the oldest month that must be kept.
+func chatRetentionCutoff(now time.Time, retentionMonths int) time.Time {
+	return monthStart(now).AddDate(0, -retentionMonths, 0)
+}
+
+// EnsureChatPartitions creates monthly partitions for the current month and monthsAhead following months.
+// Missing partitions are attached with chatPartitionAttachStatements so rows that already landed in
+// chat_default for that month are moved over instead of blocking the attach.
+func (p *Postgres) EnsureChatPartitions(ctx context.Context, now time.Time, monthsAhead int) error {
+	partitions, err := p.queries.ListChatPartitions(ctx)
+	if err != nil {
+		return fmt.Errorf("list chat partitions: %w", err)
+	}
+	existing := make(map[string]struct{}, len(partitions))
+	for _, name := range partitions {
+		existing[name] = struct{}{}
+	}
+
+	start := monthStart(now)
+	for i := 0; i <= monthsAhead; i++ {
+		from := start.AddDate(0, i, 0)
+		name := chatPartitionName(from)
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		if err := p.attachChatPartition(ctx, from); err != nil {
+			return fmt.Errorf("create chat partition %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (p *Postgres) attachChatPartition(ctx context.Context, month time.Time) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range chatPartitionAttachStatements(month) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// chatPartitionAttachStatements builds the monthly partition as a standalone table, moves that month's
+// rows out of chat_default into it and only then attaches it: Postgres refuses to attach a range
+// while chat_default still holds rows inside it.
+func chatPartitionAttachStatements(month time.Time) []string {
+	from := monthStart(month)
+	to := from.AddDate(0, 1, 0)
+	name := chatPartitionName(from)
+	fromLit, toLit := from.Format(time.RFC3339), to.Format(time.RFC3339)
+	return []string{
+		fmt.Sprintf("CREATE TABLE %s (LIKE chat INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", name),
+		fmt.Sprintf(
+			"WITH moved AS (DELETE FROM chat_default WHERE created_at >= '%s' AND created_at < '%s' RETURNING *) "+
+				"INSERT INTO %s SELECT * FROM moved",
+			fromLit, toLit, name,
+		),
+		fmt.Sprintf("ALTER TABLE chat ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')", name, fromLit, toLit),
+	}
+}
+
+// DropExpiredChatPartitions drops monthly partitions that ended before the retention cutoff
+// and purges expired rows from chat_default. Returns names of dropped partitions.
+func (p *Postgres) DropExpiredChatPartitions(ctx context.Context, now time.Time, retentionMonths int) ([]string, error) {
+	cutoff := chatRetentionCutoff(now, retentionMonths)
+
+	partitions, err := p.queries.ListChatPartitions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list chat partitions: %w", err)
+	}
+
+	dropped := make([]string, 0)
+	for _, name := range partitions {
+		month, ok := parseChatPartitionMonth(name)
+		if !ok || !month.Before(cutoff) {
+			continue
+		}
+		if _, err := p.db.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s", name)); err != nil {
+			return dropped, fmt.Errorf("drop chat partition %s: %w", name, err)
+		}
+		dropped = append(dropped, name)
+	}
+
+	purged, err := p.queries.DeleteDefaultChatOlderThan(ctx, cutoff)
+	if err != nil {
+		return dropped, fmt.Errorf("purge chat_default: %w", err)
+	}
+	if len(dropped) > 0 || purged > 0 {
+		p.logger.Info("Chat retention applied",
+			zap.Time("cutoff", cutoff),
+			zap.Strings("dropped_partitions", dropped),
+			zap.Int64("purged_default_rows", purged))
+	}
+	return dropped, nil
+}
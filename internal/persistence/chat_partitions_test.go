@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChatPartitionNameRoundTrip(t *testing.T) {
+	month := time.Date(2026, time.March, 17, 13, 0, 0, 0, time.UTC)
+	name := chatPartitionName(month)
+	if name != "chat_y2026m03" {
+		t.Fatalf("unexpected partition name %q", name)
+	}
+
+	parsed, ok := parseChatPartitionMonth(name)
+	if !ok {
+		t.Fatalf("expected %q to parse", name)
+	}
+	if !parsed.Equal(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected parsed month %v", parsed)
+	}
+}
+
+func TestParseChatPartitionMonth_RejectsForeignTables(t *testing.T) {
+	for _, name := range []string{"chat_default", "chat", "chat_y2026", "object_region_1"} {
+		if _, ok := parseChatPartitionMonth(name); ok {
+			t.Fatalf("expected %q to be rejected", name)
+		}
+	}
+}
+
+func TestChatRetentionCutoff(t *testing.T) {
+	now := time.Date(2026, time.February, 10, 8, 0, 0, 0, time.UTC)
+	cutoff := chatRetentionCutoff(now, 3)
+	want := time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC)
+	if !cutoff.Equal(want) {
+		t.Fatalf("expected cutoff %v, got %v", want, cutoff)
+	}
+}
+
+func TestChatPartitionAttachStatements_MoveDefaultRowsBeforeAttach(t *testing.T) {
+	stmts := chatPartitionAttachStatements(time.Date(2026, time.March, 17, 13, 0, 0, 0, time.UTC))
+	if len(stmts) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(stmts))
+	}
+	if !strings.HasPrefix(stmts[0], "CREATE TABLE chat_y2026m03 (LIKE chat") {
+		t.Fatalf("expected standalone table first, got %q", stmts[0])
+	}
+	if !strings.Contains(stmts[1], "DELETE FROM chat_default WHERE created_at >= '2026-03-01T00:00:00Z' AND created_at < '2026-04-01T00:00:00Z'") ||
+		!strings.Contains(stmts[1], "INSERT INTO chat_y2026m03") {
+		t.Fatalf("expected month rows moved out of chat_default, got %q", stmts[1])
+	}
+	if stmts[2] != "ALTER TABLE chat ATTACH PARTITION chat_y2026m03 FOR VALUES FROM ('2026-03-01T00:00:00Z') TO ('2026-04-01T00:00:00Z')" {
+		t.Fatalf("unexpected attach statement %q", stmts[2])
+	}
+}
@@ -1,3 +1,8 @@
+-- name: GetAccountByID :one
+SELECT *
+FROM account
+WHERE id = $1;
+
 -- name: GetAccountByLogin :one
 SELECT *
 FROM account
@@ -27,3 +32,24 @@ WHERE account_id = $1
 -- name: GetAllAccounts :many
 SELECT *
 FROM account;
+
+-- name: BanAccount :exec
+UPDATE account
+SET status = 1, banned_until = sqlc.narg('banned_until'), ban_reason = @ban_reason, token = NULL, updated_at = now()
+WHERE id = @id;
+
+-- name: UnbanAccount :execrows
+UPDATE account
+SET status = 0, banned_until = NULL, ban_reason = NULL, updated_at = now()
+WHERE id = $1
+  AND status != 0;
+
+-- name: SetAccountRole :execrows
+UPDATE account
+SET role = @role, updated_at = now()
+WHERE id = @id;
+
+-- name: SetAccountMutedUntil :exec
+UPDATE account
+SET muted_until = sqlc.narg('muted_until'), updated_at = now()
+WHERE id = @id;
@@ -0,0 +1,10 @@
+-- name: InsertAdminAudit :exec
+INSERT INTO admin_audit (actor_id, actor_role, target_id, command, args, outcome, result, created_at)
+VALUES (@actor_id, @actor_role, sqlc.narg('target_id'), @command, @args, @outcome, @result, @created_at);
+
+-- name: ListAdminAuditByActor :many
+SELECT *
+FROM admin_audit
+WHERE actor_id = @actor_id
+ORDER BY created_at DESC
+LIMIT @row_limit;
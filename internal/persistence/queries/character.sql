@@ -84,6 +84,14 @@ SET attributes = sqlc.arg(attributes)::jsonb,
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: UpdateCharacterAttributesAndExp :exec
+UPDATE character
+SET attributes = sqlc.arg(attributes)::jsonb,
+    exp = sqlc.arg(exp)::jsonb,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: ResetOnlinePlayers :exec
 UPDATE character
 SET is_online = false
@@ -124,3 +132,11 @@ FROM (
      ) AS v
 WHERE character.id = v.id
   AND character.deleted_at IS NULL;
+
+-- name: FindCharacterByName :one
+SELECT id, account_id, name
+FROM character
+WHERE lower(name) = lower(@name)
+  AND deleted_at IS NULL
+ORDER BY is_online DESC, updated_at DESC
+LIMIT 1;
@@ -0,0 +1,59 @@
+-- name: InsertChatMessages :exec
+INSERT INTO chat (channel, sender_id, sender_name, receiver_id, region, x, y, layer, message, created_at)
+SELECT v.channel, NULLIF(v.sender_id, 0), NULLIF(v.sender_name, ''), NULLIF(v.receiver_id, 0), v.region, v.x, v.y, v.layer, v.message, v.created_at
+FROM (
+         SELECT
+             unnest(sqlc.arg(channels)::int[])::smallint as channel,
+             unnest(sqlc.arg(sender_ids)::bigint[]) as sender_id,
+             unnest(sqlc.arg(sender_names)::text[]) as sender_name,
+             unnest(sqlc.arg(receiver_ids)::bigint[]) as receiver_id,
+             unnest(sqlc.arg(regions)::int[]) as region,
+             unnest(sqlc.arg(xs)::int[]) as x,
+             unnest(sqlc.arg(ys)::int[]) as y,
+             unnest(sqlc.arg(layers)::int[])::smallint as layer,
+             unnest(sqlc.arg(messages)::text[]) as message,
+             unnest(sqlc.arg(created_ats)::timestamptz[]) as created_at
+     ) AS v;
+
+-- name: GetRecentPrivateChat :many
+SELECT c.id, c.channel, c.sender_id, c.receiver_id, c.message, c.created_at, s.name AS sender_name
+FROM chat c
+         JOIN character s ON s.id = c.sender_id
+WHERE c.channel = sqlc.arg(channel)
+  AND (c.sender_id = sqlc.arg(character_id) OR c.receiver_id = sqlc.arg(character_id))
+ORDER BY c.created_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetRecentLocalChatNear :many
+SELECT c.id, c.channel, c.sender_id, c.receiver_id, c.message, c.created_at, s.name AS sender_name
+FROM chat c
+         JOIN character s ON s.id = c.sender_id
+WHERE c.channel = sqlc.arg(channel)
+  AND c.region = sqlc.arg(region)
+  AND c.layer = sqlc.arg(layer)
+  AND c.x BETWEEN sqlc.arg(min_x) AND sqlc.arg(max_x)
+  AND c.y BETWEEN sqlc.arg(min_y) AND sqlc.arg(max_y)
+  AND c.created_at >= sqlc.arg(since)
+ORDER BY c.created_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: SearchChatByCharacter :many
+SELECT *
+FROM chat
+WHERE (sender_id = sqlc.arg(character_id) OR receiver_id = sqlc.arg(character_id))
+  AND created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time)
+ORDER BY created_at
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListChatPartitions :many
+SELECT c.relname::text AS partition_name
+FROM pg_inherits i
+         JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = 'chat'::regclass
+ORDER BY c.relname;
+
+-- name: DeleteDefaultChatOlderThan :execrows
+DELETE
+FROM chat_default
+WHERE created_at < sqlc.arg(cutoff);
@@ -56,3 +56,8 @@ WHERE owner_id = $1 AND kind = $4 AND inventory_key = $5 AND version = $6;
 UPDATE inventory
 SET deleted_at = NOW()
 WHERE owner_id = $1 AND kind = $2 AND inventory_key = $3 AND deleted_at IS NULL;
+
+-- name: ListInventoryItemTypeIDsInUse :many
+SELECT DISTINCT (jsonb_path_query(data, 'lax $.**.type_id'))::int AS type_id
+FROM inventory
+WHERE deleted_at IS NULL;
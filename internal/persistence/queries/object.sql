@@ -64,3 +64,8 @@ WHERE region = $1
 
 -- name: HardDeleteObjectsByRegion :exec
 DELETE FROM object WHERE region = $1;
+
+-- name: ListObjectTypeIDsInUse :many
+SELECT DISTINCT type_id
+FROM object
+WHERE deleted_at IS NULL;
@@ -0,0 +1,13 @@
+-- name: GetSkillsByCharacter :many
+SELECT *
+FROM skill
+WHERE character_id = $1
+ORDER BY skill_id;
+
+-- name: InsertSkills :exec
+INSERT INTO skill (character_id, skill_id, level)
+SELECT
+    unnest(sqlc.arg(character_ids)::bigint[]),
+    unnest(sqlc.arg(skill_ids)::int[]),
+    1
+ON CONFLICT (character_id, skill_id) DO NOTHING;
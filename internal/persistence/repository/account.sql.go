@@ -10,6 +10,23 @@ import (
 	"database/sql"
 )
 
+const banAccount = `-- name: BanAccount :exec
+UPDATE account
+SET status = 1, banned_until = $1, ban_reason = $2, token = NULL, updated_at = now()
+WHERE id = $3
+`
+
+type BanAccountParams struct {
+	BannedUntil sql.NullTime   `json:"banned_until"`
+	BanReason   sql.NullString `json:"ban_reason"`
+	ID          int64          `json:"id"`
+}
+
+func (q *Queries) BanAccount(ctx context.Context, arg BanAccountParams) error {
+	_, err := q.db.ExecContext(ctx, banAccount, arg.BannedUntil, arg.BanReason, arg.ID)
+	return err
+}
+
 const countCharactersByAccountID = `-- name: CountCharactersByAccountID :one
 SELECT COUNT(*)
 FROM character
@@ -27,7 +44,7 @@ func (q *Queries) CountCharactersByAccountID(ctx context.Context, accountID int6
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO account (login, password_hash)
 VALUES ($1, $2)
-RETURNING id, login, password_hash, status, token, last_logged_at, created_at, updated_at
+RETURNING id, login, password_hash, status, role, banned_until, ban_reason, muted_until, token, last_logged_at, created_at, updated_at
 `
 
 type CreateAccountParams struct {
@@ -43,6 +60,36 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Login,
 		&i.PasswordHash,
 		&i.Status,
+		&i.Role,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.MutedUntil,
+		&i.Token,
+		&i.LastLoggedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAccountByID = `-- name: GetAccountByID :one
+SELECT id, login, password_hash, status, role, banned_until, ban_reason, muted_until, token, last_logged_at, created_at, updated_at
+FROM account
+WHERE id = $1
+`
+
+func (q *Queries) GetAccountByID(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountByID, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Login,
+		&i.PasswordHash,
+		&i.Status,
+		&i.Role,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.MutedUntil,
 		&i.Token,
 		&i.LastLoggedAt,
 		&i.CreatedAt,
@@ -52,7 +99,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 }
 
 const getAccountByLogin = `-- name: GetAccountByLogin :one
-SELECT id, login, password_hash, status, token, last_logged_at, created_at, updated_at
+SELECT id, login, password_hash, status, role, banned_until, ban_reason, muted_until, token, last_logged_at, created_at, updated_at
 FROM account
 WHERE login = $1
 `
@@ -65,6 +112,10 @@ func (q *Queries) GetAccountByLogin(ctx context.Context, login string) (Account,
 		&i.Login,
 		&i.PasswordHash,
 		&i.Status,
+		&i.Role,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.MutedUntil,
 		&i.Token,
 		&i.LastLoggedAt,
 		&i.CreatedAt,
@@ -74,7 +125,7 @@ func (q *Queries) GetAccountByLogin(ctx context.Context, login string) (Account,
 }
 
 const getAccountByToken = `-- name: GetAccountByToken :one
-SELECT id, login, password_hash, status, token, last_logged_at, created_at, updated_at
+SELECT id, login, password_hash, status, role, banned_until, ban_reason, muted_until, token, last_logged_at, created_at, updated_at
 FROM account
 WHERE token = $1
 `
@@ -87,6 +138,10 @@ func (q *Queries) GetAccountByToken(ctx context.Context, token sql.NullString) (
 		&i.Login,
 		&i.PasswordHash,
 		&i.Status,
+		&i.Role,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.MutedUntil,
 		&i.Token,
 		&i.LastLoggedAt,
 		&i.CreatedAt,
@@ -96,7 +151,7 @@ func (q *Queries) GetAccountByToken(ctx context.Context, token sql.NullString) (
 }
 
 const getAllAccounts = `-- name: GetAllAccounts :many
-SELECT id, login, password_hash, status, token, last_logged_at, created_at, updated_at
+SELECT id, login, password_hash, status, role, banned_until, ban_reason, muted_until, token, last_logged_at, created_at, updated_at
 FROM account
 `
 
@@ -114,6 +169,10 @@ func (q *Queries) GetAllAccounts(ctx context.Context) ([]Account, error) {
 			&i.Login,
 			&i.PasswordHash,
 			&i.Status,
+			&i.Role,
+			&i.BannedUntil,
+			&i.BanReason,
+			&i.MutedUntil,
 			&i.Token,
 			&i.LastLoggedAt,
 			&i.CreatedAt,
@@ -132,6 +191,56 @@ func (q *Queries) GetAllAccounts(ctx context.Context) ([]Account, error) {
 	return items, nil
 }
 
+const setAccountMutedUntil = `-- name: SetAccountMutedUntil :exec
+UPDATE account
+SET muted_until = $1, updated_at = now()
+WHERE id = $2
+`
+
+type SetAccountMutedUntilParams struct {
+	MutedUntil sql.NullTime `json:"muted_until"`
+	ID         int64        `json:"id"`
+}
+
+func (q *Queries) SetAccountMutedUntil(ctx context.Context, arg SetAccountMutedUntilParams) error {
+	_, err := q.db.ExecContext(ctx, setAccountMutedUntil, arg.MutedUntil, arg.ID)
+	return err
+}
+
+const setAccountRole = `-- name: SetAccountRole :execrows
+UPDATE account
+SET role = $1, updated_at = now()
+WHERE id = $2
+`
+
+type SetAccountRoleParams struct {
+	Role int16 `json:"role"`
+	ID   int64 `json:"id"`
+}
+
+func (q *Queries) SetAccountRole(ctx context.Context, arg SetAccountRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setAccountRole, arg.Role, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const unbanAccount = `-- name: UnbanAccount :execrows
+UPDATE account
+SET status = 0, banned_until = NULL, ban_reason = NULL, updated_at = now()
+WHERE id = $1
+  AND status != 0
+`
+
+func (q *Queries) UnbanAccount(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, unbanAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateAccountToken = `-- name: UpdateAccountToken :exec
 UPDATE account
 SET token = $1, last_logged_at = now(), updated_at = now()
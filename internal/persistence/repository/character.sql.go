@@ -126,6 +126,28 @@ func (q *Queries) DeleteCharacterByID(ctx context.Context, id int64) error {
 	return err
 }
 
+const findCharacterByName = `-- name: FindCharacterByName :one
+SELECT id, account_id, name
+FROM character
+WHERE lower(name) = lower($1)
+  AND deleted_at IS NULL
+ORDER BY is_online DESC, updated_at DESC
+LIMIT 1
+`
+
+type FindCharacterByNameRow struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Name      string `json:"name"`
+}
+
+func (q *Queries) FindCharacterByName(ctx context.Context, name string) (FindCharacterByNameRow, error) {
+	row := q.db.QueryRowContext(ctx, findCharacterByName, name)
+	var i FindCharacterByNameRow
+	err := row.Scan(&i.ID, &i.AccountID, &i.Name)
+	return i, err
+}
+
 const getCharacter = `-- name: GetCharacter :one
 SELECT id, account_id, name, region, x, y, layer, heading, stamina, energy, shp, hhp, attributes, exp, skills, discovery, online_time, auth_token, token_expires_at, is_online, disconnect_at, is_ghost, last_save_at, deleted_at, created_at, updated_at
 FROM character
@@ -349,6 +371,26 @@ func (q *Queries) UpdateCharacterAttributes(ctx context.Context, arg UpdateChara
 	return err
 }
 
+const updateCharacterAttributesAndExp = `-- name: UpdateCharacterAttributesAndExp :exec
+UPDATE character
+SET attributes = $1::jsonb,
+    exp = $2::jsonb,
+    updated_at = now()
+WHERE id = $3
+  AND deleted_at IS NULL
+`
+
+type UpdateCharacterAttributesAndExpParams struct {
+	Attributes json.RawMessage `json:"attributes"`
+	Exp        json.RawMessage `json:"exp"`
+	ID         int64           `json:"id"`
+}
+
+func (q *Queries) UpdateCharacterAttributesAndExp(ctx context.Context, arg UpdateCharacterAttributesAndExpParams) error {
+	_, err := q.db.ExecContext(ctx, updateCharacterAttributesAndExp, arg.Attributes, arg.Exp, arg.ID)
+	return err
+}
+
 const updateCharacterPosition = `-- name: UpdateCharacterPosition :exec
 UPDATE character
 SET x = $2, y = $3
@@ -151,6 +151,35 @@ func (q *Queries) GetInventoriesByOwners(ctx context.Context, ownerIds []int64)
 	return items, nil
 }
 
+const listInventoryItemTypeIDsInUse = `-- name: ListInventoryItemTypeIDsInUse :many
+SELECT DISTINCT (jsonb_path_query(data, 'lax $.**.type_id'))::int AS type_id
+FROM inventory
+WHERE deleted_at IS NULL
+`
+
+func (q *Queries) ListInventoryItemTypeIDsInUse(ctx context.Context) ([]int, error) {
+	rows, err := q.db.QueryContext(ctx, listInventoryItemTypeIDsInUse)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int
+	for rows.Next() {
+		var type_id int
+		if err := rows.Scan(&type_id); err != nil {
+			return nil, err
+		}
+		items = append(items, type_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateInventory = `-- name: UpdateInventory :exec
 UPDATE inventory
 SET data = $2, version = $3, updated_at = now()
@@ -17,12 +17,28 @@ type Account struct {
 	Login        string         `json:"login"`
 	PasswordHash string         `json:"password_hash"`
 	Status       sql.NullInt16  `json:"status"`
+	Role         int16          `json:"role"`
+	BannedUntil  sql.NullTime   `json:"banned_until"`
+	BanReason    sql.NullString `json:"ban_reason"`
+	MutedUntil   sql.NullTime   `json:"muted_until"`
 	Token        sql.NullString `json:"token"`
 	LastLoggedAt sql.NullTime   `json:"last_logged_at"`
 	CreatedAt    sql.NullTime   `json:"created_at"`
 	UpdatedAt    sql.NullTime   `json:"updated_at"`
 }
 
+type AdminAudit struct {
+	ID        int64         `json:"id"`
+	ActorID   int64         `json:"actor_id"`
+	ActorRole int16         `json:"actor_role"`
+	TargetID  sql.NullInt64 `json:"target_id"`
+	Command   string        `json:"command"`
+	Args      string        `json:"args"`
+	Outcome   int16         `json:"outcome"`
+	Result    string        `json:"result"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
 type BuildSite struct {
 	ID                  int64           `json:"id"`
 	ObjectID            int64           `json:"object_id"`
@@ -67,29 +83,31 @@ type Character struct {
 }
 
 type Chat struct {
-	ID         int64         `json:"id"`
-	Channel    int16         `json:"channel"`
-	SenderID   int64         `json:"sender_id"`
-	ReceiverID sql.NullInt64 `json:"receiver_id"`
-	Region     int           `json:"region"`
-	X          int           `json:"x"`
-	Y          int           `json:"y"`
-	Layer      int16         `json:"layer"`
-	Message    string        `json:"message"`
-	CreatedAt  time.Time     `json:"created_at"`
+	ID         int64          `json:"id"`
+	Channel    int16          `json:"channel"`
+	SenderID   sql.NullInt64  `json:"sender_id"`
+	SenderName sql.NullString `json:"sender_name"`
+	ReceiverID sql.NullInt64  `json:"receiver_id"`
+	Region     int            `json:"region"`
+	X          int            `json:"x"`
+	Y          int            `json:"y"`
+	Layer      int16          `json:"layer"`
+	Message    string         `json:"message"`
+	CreatedAt  time.Time      `json:"created_at"`
 }
 
 type ChatDefault struct {
-	ID         int64         `json:"id"`
-	Channel    int16         `json:"channel"`
-	SenderID   int64         `json:"sender_id"`
-	ReceiverID sql.NullInt64 `json:"receiver_id"`
-	Region     int           `json:"region"`
-	X          int           `json:"x"`
-	Y          int           `json:"y"`
-	Layer      int16         `json:"layer"`
-	Message    string        `json:"message"`
-	CreatedAt  time.Time     `json:"created_at"`
+	ID         int64          `json:"id"`
+	Channel    int16          `json:"channel"`
+	SenderID   sql.NullInt64  `json:"sender_id"`
+	SenderName sql.NullString `json:"sender_name"`
+	ReceiverID sql.NullInt64  `json:"receiver_id"`
+	Region     int            `json:"region"`
+	X          int            `json:"x"`
+	Y          int            `json:"y"`
+	Layer      int16          `json:"layer"`
+	Message    string         `json:"message"`
+	CreatedAt  time.Time      `json:"created_at"`
 }
 
 type Chunk struct {
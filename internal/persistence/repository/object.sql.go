@@ -156,6 +156,35 @@ func (q *Queries) HardDeleteObjectsByRegion(ctx context.Context, region int) err
 	return err
 }
 
+const listObjectTypeIDsInUse = `-- name: ListObjectTypeIDsInUse :many
+SELECT DISTINCT type_id
+FROM object
+WHERE deleted_at IS NULL
+`
+
+func (q *Queries) ListObjectTypeIDsInUse(ctx context.Context) ([]int, error) {
+	rows, err := q.db.QueryContext(ctx, listObjectTypeIDsInUse)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int
+	for rows.Next() {
+		var type_id int
+		if err := rows.Scan(&type_id); err != nil {
+			return nil, err
+		}
+		items = append(items, type_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteObject = `-- name: SoftDeleteObject :exec
 UPDATE object
 SET deleted_at = NOW()
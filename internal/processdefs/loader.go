@@ -0,0 +1,210 @@
+package processdefs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"origin/internal/itemdefs"
+
+	"go.uber.org/zap"
+)
+
+type LoadError struct {
+	FilePath string
+	DefID    int
+	Key      string
+	Message  string
+}
+
+func (e *LoadError) Error() string {
+	if e.DefID != 0 && e.Key != "" {
+		return fmt.Sprintf("%s: defId=%d key=%s: %s", e.FilePath, e.DefID, e.Key, e.Message)
+	}
+	if e.DefID != 0 {
+		return fmt.Sprintf("%s: defId=%d: %s", e.FilePath, e.DefID, e.Message)
+	}
+	if e.Key != "" {
+		return fmt.Sprintf("%s: key=%s: %s", e.FilePath, e.Key, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Message)
+}
+
+var reLineComment = regexp.MustCompile(`(?m)//.*$`)
+var reBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+func stripJSONCComments(data []byte) []byte {
+	data = reBlockComment.ReplaceAll(data, nil)
+	data = reLineComment.ReplaceAll(data, nil)
+	return data
+}
+
+func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
+	return LoadFromDirectoryWithRefs(dir, itemdefs.Global(), logger)
+}
+
+// LoadFromDirectoryWithRefs validates item references against the given registry.
+// Used by hot reload, where the referenced registry is not global yet.
+func LoadFromDirectoryWithRefs(dir string, items *itemdefs.Registry, logger *zap.Logger) (*Registry, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			logger.Warn("Process definitions directory not found, using empty registry", zap.String("dir", dir))
+			return NewRegistry(nil), nil
+		}
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext == ".json" || ext == ".jsonc" {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		logger.Info("No process definitions found", zap.String("dir", dir))
+		return NewRegistry(nil), nil
+	}
+
+	all := make([]ProcessDef, 0, 32)
+	seenIDs := make(map[int]string)
+	seenKeys := make(map[string]string)
+	seenInputs := make(map[string]string)
+	for _, filePath := range files {
+		recipes, err := loadFile(filePath, items)
+		if err != nil {
+			return nil, err
+		}
+		for _, recipe := range recipes {
+			if prev, exists := seenIDs[recipe.DefID]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					DefID:    recipe.DefID,
+					Key:      recipe.Key,
+					Message:  fmt.Sprintf("duplicate defId, already defined in %s", prev),
+				}
+			}
+			if prev, exists := seenKeys[recipe.Key]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					DefID:    recipe.DefID,
+					Key:      recipe.Key,
+					Message:  fmt.Sprintf("duplicate key, already defined in %s", prev),
+				}
+			}
+			inputKey := recipe.Machine + "/" + recipe.Input.ItemKey
+			if prev, exists := seenInputs[inputKey]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					DefID:    recipe.DefID,
+					Key:      recipe.Key,
+					Message:  fmt.Sprintf("machine %q already has a recipe for input %q in %s", recipe.Machine, recipe.Input.ItemKey, prev),
+				}
+			}
+			seenIDs[recipe.DefID] = filePath
+			seenKeys[recipe.Key] = filePath
+			seenInputs[inputKey] = filePath
+			all = append(all, recipe)
+		}
+		logger.Debug("Loaded process definitions file", zap.String("file", filepath.Base(filePath)), zap.Int("count", len(recipes)))
+	}
+
+	logger.Info("Process definitions loaded", zap.Int("files", len(files)), zap.Int("recipes", len(all)))
+	return NewRegistry(all), nil
+}
+
+func loadFile(filePath string, items *itemdefs.Registry) ([]ProcessDef, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to read file: %v", err)}
+	}
+
+	data = stripJSONCComments(data)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var file ProcessesFile
+	if err := dec.Decode(&file); err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to parse JSON: %v", err)}
+	}
+	if file.Version != 1 {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("unsupported version %d, expected 1", file.Version)}
+	}
+
+	for i := range file.Recipes {
+		applyDefaults(&file.Recipes[i])
+		if err := validateRecipe(&file.Recipes[i], filePath, items); err != nil {
+			return nil, err
+		}
+	}
+
+	return file.Recipes, nil
+}
+
+func applyDefaults(p *ProcessDef) {
+	p.Machine = strings.TrimSpace(p.Machine)
+	p.Input.ItemKey = strings.TrimSpace(p.Input.ItemKey)
+	p.Output.ItemKey = strings.TrimSpace(p.Output.ItemKey)
+	p.WasteItemKey = strings.TrimSpace(p.WasteItemKey)
+	if p.Output.Count == 0 {
+		p.Output.Count = 1
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		p.Name = p.Key
+	}
+}
+
+func validateRecipe(p *ProcessDef, filePath string, items *itemdefs.Registry) error {
+	if p.DefID <= 0 {
+		return &LoadError{FilePath: filePath, Key: p.Key, Message: "defId must be > 0"}
+	}
+	if strings.TrimSpace(p.Key) == "" {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Message: "key is required"}
+	}
+	if p.Machine == "" {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "machine is required"}
+	}
+	if p.TicksRequired == 0 {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "ticksRequired must be > 0"}
+	}
+	if p.WasteItemKey != "" && p.BurnAfterTicks == 0 {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "wasteItemKey requires burnAfterTicks > 0"}
+	}
+
+	if items == nil {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "item defs registry not loaded"}
+	}
+	if p.Input.ItemKey == "" {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "input.itemKey is required"}
+	}
+	if _, ok := items.GetByKey(p.Input.ItemKey); !ok {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: fmt.Sprintf("input.itemKey unknown: %s", p.Input.ItemKey)}
+	}
+	if p.Output.ItemKey == "" {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: "output.itemKey is required"}
+	}
+	if _, ok := items.GetByKey(p.Output.ItemKey); !ok {
+		return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: fmt.Sprintf("output.itemKey unknown: %s", p.Output.ItemKey)}
+	}
+	if p.WasteItemKey != "" {
+		if _, ok := items.GetByKey(p.WasteItemKey); !ok {
+			return &LoadError{FilePath: filePath, DefID: p.DefID, Key: p.Key, Message: fmt.Sprintf("wasteItemKey unknown: %s", p.WasteItemKey)}
+		}
+	}
+	return nil
+}
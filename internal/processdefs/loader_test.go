@@ -0,0 +1,146 @@
+package processdefs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"origin/internal/itemdefs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func processDefsTestItems() *itemdefs.Registry {
+	return itemdefs.NewRegistry([]itemdefs.ItemDef{
+		{DefID: 1001, Key: "block_of_wood", Name: "Block of Wood"},
+		{DefID: 1002, Key: "charcoal", Name: "Charcoal"},
+		{DefID: 1003, Key: "ash", Name: "Ash"},
+		{DefID: 1004, Key: "clay", Name: "Clay"},
+		{DefID: 1005, Key: "brick", Name: "Brick"},
+	})
+}
+
+func writeProcessDefsTestFile(t *testing.T, dir string, name string, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0644))
+}
+
+func TestLoadFromDirectoryWithRefs_Success(t *testing.T) {
+	dir := t.TempDir()
+	writeProcessDefsTestFile(t, dir, "kiln.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"recipes": [
+			{
+				"defId": 1,
+				"key": "kiln_charcoal",
+				"name": "Charcoal",
+				"machine": "kiln",
+				"input": { "itemKey": "block_of_wood" },
+				"output": { "itemKey": "charcoal", "count": 2 },
+				"ticksRequired": 100,
+				"burnAfterTicks": 50,
+				"wasteItemKey": "ash"
+			},
+			{
+				// output count defaults to 1
+				"defId": 2,
+				"key": "kiln_brick",
+				"machine": "kiln",
+				"input": { "itemKey": "clay" },
+				"output": { "itemKey": "brick" },
+				"ticksRequired": 60
+			}
+		]
+	}`)
+
+	reg, err := LoadFromDirectoryWithRefs(dir, processDefsTestItems(), zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 2, reg.Count())
+
+	charcoal, ok := reg.GetForInput("kiln", "block_of_wood")
+	require.True(t, ok)
+	assert.Equal(t, "kiln_charcoal", charcoal.Key)
+	assert.Equal(t, uint32(2), charcoal.Output.Count)
+
+	byOutput, ok := reg.GetForOutput("kiln", "charcoal")
+	require.True(t, ok)
+	assert.Equal(t, uint64(50), byOutput.BurnAfterTicks)
+
+	brick, ok := reg.GetByKey("kiln_brick")
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), brick.Output.Count)
+	assert.Equal(t, "kiln_brick", brick.Name)
+
+	_, ok = reg.GetForInput("oven", "clay")
+	assert.False(t, ok)
+}
+
+func TestLoadFromDirectoryWithRefs_DuplicateMachineInputRejected(t *testing.T) {
+	dir := t.TempDir()
+	writeProcessDefsTestFile(t, dir, "kiln.json", `{
+		"v": 1,
+		"source": "test",
+		"recipes": [
+			{ "defId": 1, "key": "a", "machine": "kiln", "input": { "itemKey": "clay" }, "output": { "itemKey": "brick" }, "ticksRequired": 1 },
+			{ "defId": 2, "key": "b", "machine": "kiln", "input": { "itemKey": "clay" }, "output": { "itemKey": "ash" }, "ticksRequired": 1 }
+		]
+	}`)
+
+	_, err := LoadFromDirectoryWithRefs(dir, processDefsTestItems(), zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `already has a recipe for input "clay"`)
+}
+
+func TestLoadFromDirectoryWithRefs_ValidationErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		recipe  string
+		wantErr string
+	}{
+		{
+			name:    "missing machine",
+			recipe:  `{ "defId": 1, "key": "a", "input": { "itemKey": "clay" }, "output": { "itemKey": "brick" }, "ticksRequired": 1 }`,
+			wantErr: "machine is required",
+		},
+		{
+			name:    "zero ticks",
+			recipe:  `{ "defId": 1, "key": "a", "machine": "kiln", "input": { "itemKey": "clay" }, "output": { "itemKey": "brick" } }`,
+			wantErr: "ticksRequired must be > 0",
+		},
+		{
+			name:    "unknown input",
+			recipe:  `{ "defId": 1, "key": "a", "machine": "kiln", "input": { "itemKey": "iron_ore" }, "output": { "itemKey": "brick" }, "ticksRequired": 1 }`,
+			wantErr: "input.itemKey unknown: iron_ore",
+		},
+		{
+			name:    "unknown waste",
+			recipe:  `{ "defId": 1, "key": "a", "machine": "kiln", "input": { "itemKey": "clay" }, "output": { "itemKey": "brick" }, "ticksRequired": 1, "burnAfterTicks": 1, "wasteItemKey": "slag" }`,
+			wantErr: "wasteItemKey unknown: slag",
+		},
+		{
+			name:    "waste without burn",
+			recipe:  `{ "defId": 1, "key": "a", "machine": "kiln", "input": { "itemKey": "clay" }, "output": { "itemKey": "brick" }, "ticksRequired": 1, "wasteItemKey": "ash" }`,
+			wantErr: "wasteItemKey requires burnAfterTicks > 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeProcessDefsTestFile(t, dir, "bad.json", `{"v": 1, "source": "test", "recipes": [`+testCase.recipe+`]}`)
+
+			_, err := LoadFromDirectoryWithRefs(dir, processDefsTestItems(), zap.NewNop())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.wantErr)
+		})
+	}
+}
+
+func TestLoadFromDirectory_MissingDirectoryReturnsEmptyRegistry(t *testing.T) {
+	reg, err := LoadFromDirectory(filepath.Join(t.TempDir(), "missing"), zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 0, reg.Count())
+}
@@ -0,0 +1,121 @@
+package processdefs
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Registry struct {
+	byID     map[int]*ProcessDef
+	byKey    map[string]*ProcessDef
+	byInput  map[string]map[string]*ProcessDef
+	byOutput map[string]map[string]*ProcessDef
+	all      []*ProcessDef
+}
+
+var (
+	globalRegistry atomic.Pointer[Registry]
+	registryOnce   sync.Once
+)
+
+func NewRegistry(recipes []ProcessDef) *Registry {
+	r := &Registry{
+		byID:     make(map[int]*ProcessDef, len(recipes)),
+		byKey:    make(map[string]*ProcessDef, len(recipes)),
+		byInput:  make(map[string]map[string]*ProcessDef),
+		byOutput: make(map[string]map[string]*ProcessDef),
+		all:      make([]*ProcessDef, 0, len(recipes)),
+	}
+	for i := range recipes {
+		recipe := &recipes[i]
+		r.byID[recipe.DefID] = recipe
+		r.byKey[recipe.Key] = recipe
+		indexByMachine(r.byInput, recipe.Machine, recipe.Input.ItemKey, recipe)
+		indexByMachine(r.byOutput, recipe.Machine, recipe.Output.ItemKey, recipe)
+		r.all = append(r.all, recipe)
+	}
+	return r
+}
+
+func indexByMachine(index map[string]map[string]*ProcessDef, machine string, itemKey string, recipe *ProcessDef) {
+	byItem, ok := index[machine]
+	if !ok {
+		byItem = make(map[string]*ProcessDef)
+		index[machine] = byItem
+	}
+	if _, exists := byItem[itemKey]; !exists {
+		byItem[itemKey] = recipe
+	}
+}
+
+func (r *Registry) GetByID(defID int) (*ProcessDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byID[defID]
+	return v, ok
+}
+
+func (r *Registry) GetByKey(key string) (*ProcessDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byKey[key]
+	return v, ok
+}
+
+// GetForInput returns the recipe that the machine applies to the given input item.
+func (r *Registry) GetForInput(machine string, itemKey string) (*ProcessDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byInput[machine][itemKey]
+	return v, ok
+}
+
+// GetForOutput returns the first recipe of the machine producing the given item.
+// Used to find overcook settings of output items.
+func (r *Registry) GetForOutput(machine string, itemKey string) (*ProcessDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byOutput[machine][itemKey]
+	return v, ok
+}
+
+func (r *Registry) All() []*ProcessDef {
+	if r == nil {
+		return nil
+	}
+	out := make([]*ProcessDef, len(r.all))
+	copy(out, r.all)
+	return out
+}
+
+func (r *Registry) Count() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.byID)
+}
+
+func SetGlobal(r *Registry) {
+	registryOnce.Do(func() {
+		globalRegistry.Store(r)
+	})
+}
+
+func SetGlobalForTesting(r *Registry) {
+	registryOnce = sync.Once{}
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
+}
+
+func Global() *Registry {
+	return globalRegistry.Load()
+}
@@ -0,0 +1,37 @@
+package processdefs
+
+type ProcessInput struct {
+	ItemKey string `json:"itemKey"`
+}
+
+type ProcessOutput struct {
+	ItemKey string `json:"itemKey"`
+	Count   uint32 `json:"count"`
+}
+
+// ProcessDef is a timed recipe of a fuel-burning machine (kiln, oven, ...).
+// Every input unit inside a lit machine is converted after TicksRequired ticks.
+type ProcessDef struct {
+	DefID int    `json:"defId"`
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+
+	// Machine matches processor.machine of the object definition.
+	Machine string `json:"machine"`
+
+	Input  ProcessInput  `json:"input"`
+	Output ProcessOutput `json:"output"`
+
+	TicksRequired uint64 `json:"ticksRequired"`
+
+	// BurnAfterTicks > 0 makes the output overcook when left in the lit machine that long.
+	// Overcooked output turns into WasteItemKey, or burns away when no waste item is set.
+	BurnAfterTicks uint64 `json:"burnAfterTicks,omitempty"`
+	WasteItemKey   string `json:"wasteItemKey,omitempty"`
+}
+
+type ProcessesFile struct {
+	Version int          `json:"v"`
+	Source  string       `json:"source"`
+	Recipes []ProcessDef `json:"recipes"`
+}
@@ -0,0 +1,404 @@
+package restapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/game"
+	"origin/internal/types"
+)
+
+const (
+	adminRequestTimeout = 10 * time.Second
+	// adminChatSearchWindow is the search range when the request gives no "from".
+	adminChatSearchWindow       = 24 * time.Hour
+	adminChatSearchDefaultLimit = 200
+)
+
+// AdminService is the live server API used by /admin/* routes. Implemented by game.Game.
+type AdminService interface {
+	AdminOnlinePlayers() []game.AdminOnlinePlayer
+	AdminModerate(ctx context.Context, req game.ModerationRequest) (game.ModerationResult, error)
+	AdminTeleport(ctx context.Context, playerID types.EntityID, x, y int, targetLayer *int) error
+	AdminGiveItem(ctx context.Context, playerID types.EntityID, itemKey string, count, quality uint32) (string, error)
+	AdminSaveAll(ctx context.Context) (map[int]string, error)
+	AdminSetAccountRole(ctx context.Context, accountID int64, role game.AccountRole) error
+	AdminStats() game.AdminStats
+	AdminSearchChat(ctx context.Context, characterID types.EntityID, from, to time.Time, limit int) ([]game.AdminChatMessage, error)
+}
+
+// SetAdminService enables /admin/* routes. Must be called before RegisterRoutes.
+func (h *Handler) SetAdminService(service AdminService) {
+	h.admin = service
+}
+
+func (h *Handler) registerAdminRoutes(mux *http.ServeMux) {
+	if h.admin == nil {
+		return
+	}
+	mux.HandleFunc("GET /admin/players", h.withAdminAuth(h.handleAdminListPlayers))
+	mux.HandleFunc("POST /admin/players/{player}/kick", h.withAdminAuth(h.handleAdminKick))
+	mux.HandleFunc("POST /admin/players/{player}/mute", h.withAdminAuth(h.handleAdminMute))
+	mux.HandleFunc("POST /admin/players/{player}/ban", h.withAdminAuth(h.handleAdminBan))
+	mux.HandleFunc("POST /admin/players/{player}/unban", h.withAdminAuth(h.handleAdminUnban))
+	mux.HandleFunc("POST /admin/players/{id}/teleport", h.withAdminAuth(h.handleAdminTeleport))
+	mux.HandleFunc("POST /admin/players/{id}/give", h.withAdminAuth(h.handleAdminGive))
+	mux.HandleFunc("GET /admin/players/{id}/chat", h.withAdminAuth(h.handleAdminSearchChat))
+	mux.HandleFunc("POST /admin/accounts/{account}/role", h.withAdminAuth(h.handleAdminSetRole))
+	mux.HandleFunc("POST /admin/save", h.withAdminAuth(h.handleAdminSaveAll))
+	mux.HandleFunc("GET /admin/stats", h.withAdminAuth(h.handleAdminStats))
+}
+
+// withAdminAuth accepts account bearer tokens of accounts with the admin role.
+// No character has to be online, so ops tooling can use a dedicated account.
+func (h *Handler) withAdminAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			h.jsonError(w, "invalid authorization header", http.StatusUnauthorized)
+			return
+		}
+
+		account, err := h.db.Queries().GetAccountByToken(r.Context(), sql.NullString{String: parts[1], Valid: true})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				h.jsonError(w, "invalid token", http.StatusUnauthorized)
+				return
+			}
+			h.logger.Error("failed to get account by token", zap.Error(err))
+			h.jsonError(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		if game.AccountRole(account.Role) < game.RoleAdmin {
+			h.jsonError(w, "admin role required", http.StatusForbidden)
+			return
+		}
+
+		h.logger.Info("Admin API request",
+			zap.Int64("account_id", account.ID),
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path))
+		ctx := context.WithValue(r.Context(), accountIDKey, account.ID)
+		ctx = game.WithAdminActor(ctx, account.ID)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func (h *Handler) handleAdminListPlayers(w http.ResponseWriter, r *http.Request) {
+	players := h.admin.AdminOnlinePlayers()
+	list := make([]AdminPlayerItem, 0, len(players))
+	for _, p := range players {
+		list = append(list, AdminPlayerItem{
+			ID:        int64(p.EntityID),
+			Name:      p.Name,
+			Layer:     p.Layer,
+			X:         p.X,
+			Y:         p.Y,
+			Connected: p.Connected,
+		})
+	}
+	h.jsonResponse(w, AdminPlayersResponse{List: list}, http.StatusOK)
+}
+
+func (h *Handler) handleAdminKick(w http.ResponseWriter, r *http.Request) {
+	var req AdminKickRequest
+	if !h.decodeOptionalBody(w, r, &req) {
+		return
+	}
+	h.moderate(w, r, game.ModerationRequest{
+		Action: game.ModerationKick,
+		Target: r.PathValue("player"),
+		Reason: req.Reason,
+	})
+}
+
+func (h *Handler) handleAdminMute(w http.ResponseWriter, r *http.Request) {
+	var req AdminMuteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	duration, err := game.ParseModerationDuration(req.Duration)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.moderate(w, r, game.ModerationRequest{
+		Action:   game.ModerationMute,
+		Target:   r.PathValue("player"),
+		Duration: duration,
+	})
+}
+
+func (h *Handler) handleAdminBan(w http.ResponseWriter, r *http.Request) {
+	var req AdminBanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Reason == "" {
+		h.jsonError(w, "reason is required", http.StatusBadRequest)
+		return
+	}
+	var duration time.Duration
+	if req.Duration != "" && req.Duration != "perm" {
+		d, err := game.ParseModerationDuration(req.Duration)
+		if err != nil || d == 0 {
+			h.jsonError(w, "invalid duration: "+req.Duration, http.StatusBadRequest)
+			return
+		}
+		duration = d
+	}
+	h.moderate(w, r, game.ModerationRequest{
+		Action:   game.ModerationBan,
+		Target:   r.PathValue("player"),
+		Duration: duration,
+		Reason:   req.Reason,
+	})
+}
+
+func (h *Handler) handleAdminUnban(w http.ResponseWriter, r *http.Request) {
+	h.moderate(w, r, game.ModerationRequest{
+		Action: game.ModerationUnban,
+		Target: r.PathValue("player"),
+	})
+}
+
+func (h *Handler) moderate(w http.ResponseWriter, r *http.Request, req game.ModerationRequest) {
+	ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+	defer cancel()
+
+	result, err := h.admin.AdminModerate(ctx, req)
+	if err != nil {
+		h.adminError(w, err)
+		return
+	}
+	h.jsonResponse(w, AdminResultResponse{TargetID: int64(result.TargetID), Message: result.Message}, http.StatusOK)
+}
+
+func (h *Handler) handleAdminTeleport(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.adminPlayerID(w, r)
+	if !ok {
+		return
+	}
+	var req AdminTeleportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := h.admin.AdminTeleport(r.Context(), playerID, req.X, req.Y, req.Layer); err != nil {
+		h.adminError(w, err)
+		return
+	}
+	h.jsonResponse(w, AdminResultResponse{TargetID: int64(playerID), Message: "teleport requested"}, http.StatusAccepted)
+}
+
+func (h *Handler) handleAdminGive(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.adminPlayerID(w, r)
+	if !ok {
+		return
+	}
+	var req AdminGiveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.ItemKey == "" {
+		h.jsonError(w, "item_key is required", http.StatusBadRequest)
+		return
+	}
+	if req.Count == 0 {
+		req.Count = 1
+	}
+	if req.Quality == 0 {
+		req.Quality = 10
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+	defer cancel()
+	message, err := h.admin.AdminGiveItem(ctx, playerID, req.ItemKey, req.Count, req.Quality)
+	if err != nil {
+		h.adminError(w, err)
+		return
+	}
+	h.jsonResponse(w, AdminResultResponse{TargetID: int64(playerID), Message: message}, http.StatusOK)
+}
+
+// handleAdminSearchChat lists messages a character sent or received.
+// Query: from, to (RFC 3339, default the last 24h) and limit.
+func (h *Handler) handleAdminSearchChat(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.adminPlayerID(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	to := time.Now()
+	if raw := query.Get("to"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			h.jsonError(w, "invalid to: "+raw, http.StatusBadRequest)
+			return
+		}
+		to = parsed
+	}
+	from := to.Add(-adminChatSearchWindow)
+	if raw := query.Get("from"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			h.jsonError(w, "invalid from: "+raw, http.StatusBadRequest)
+			return
+		}
+		from = parsed
+	}
+	if !from.Before(to) {
+		h.jsonError(w, "from must be before to", http.StatusBadRequest)
+		return
+	}
+	limit := adminChatSearchDefaultLimit
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > game.AdminChatSearchMaxLimit {
+			h.jsonError(w, "invalid limit: "+raw, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+	defer cancel()
+	messages, err := h.admin.AdminSearchChat(ctx, playerID, from, to, limit)
+	if err != nil {
+		h.adminError(w, err)
+		return
+	}
+	list := make([]AdminChatMessageItem, 0, len(messages))
+	for _, m := range messages {
+		list = append(list, AdminChatMessageItem{
+			ID:         m.ID,
+			Channel:    m.Channel.String(),
+			SenderID:   int64(m.SenderID),
+			SenderName: m.SenderName,
+			ReceiverID: int64(m.ReceiverID),
+			Layer:      m.Layer,
+			X:          m.X,
+			Y:          m.Y,
+			Text:       m.Text,
+			SentAt:     m.SentAt,
+		})
+	}
+	h.jsonResponse(w, AdminChatSearchResponse{List: list}, http.StatusOK)
+}
+
+func (h *Handler) handleAdminSetRole(w http.ResponseWriter, r *http.Request) {
+	accountID, err := strconv.ParseInt(r.PathValue("account"), 10, 64)
+	if err != nil || accountID <= 0 {
+		h.jsonError(w, "invalid account id", http.StatusBadRequest)
+		return
+	}
+	var req AdminSetRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	role, ok := game.ParseAccountRole(req.Role)
+	if !ok {
+		h.jsonError(w, "unknown role", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+	defer cancel()
+	if err := h.admin.AdminSetAccountRole(ctx, accountID, role); err != nil {
+		h.adminError(w, err)
+		return
+	}
+	h.jsonResponse(w, AdminResultResponse{Message: "role set to " + role.String()}, http.StatusOK)
+}
+
+func (h *Handler) handleAdminSaveAll(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+	defer cancel()
+	saved, err := h.admin.AdminSaveAll(ctx)
+	if err != nil {
+		h.adminError(w, err)
+		return
+	}
+	h.jsonResponse(w, AdminSaveResponse{Layers: saved}, http.StatusOK)
+}
+
+func (h *Handler) handleAdminStats(w http.ResponseWriter, r *http.Request) {
+	h.jsonResponse(w, adminStatsResponse(h.admin.AdminStats()), http.StatusOK)
+}
+
+func (h *Handler) adminPlayerID(w http.ResponseWriter, r *http.Request) (types.EntityID, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.jsonError(w, "invalid character id", http.StatusBadRequest)
+		return 0, false
+	}
+	return types.EntityID(id), true
+}
+
+// decodeOptionalBody decodes a JSON body if one was sent.
+func (h *Handler) decodeOptionalBody(w http.ResponseWriter, r *http.Request, dst any) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		h.jsonError(w, "invalid request body", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// adminError maps game errors to responses. Timeouts are reported as 504, everything else is
+// a rejected operation (offline target, unknown item, insufficient role of the target...).
+func (h *Handler) adminError(w http.ResponseWriter, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		h.jsonError(w, "operation timed out", http.StatusGatewayTimeout)
+		return
+	}
+	h.jsonError(w, err.Error(), http.StatusUnprocessableEntity)
+}
+
+func adminStatsResponse(stats game.AdminStats) AdminStatsResponse {
+	resp := AdminStatsResponse{
+		ConnectedClients:  stats.Game.ConnectedClients,
+		TotalPlayers:      stats.Game.TotalPlayers,
+		CurrentTick:       stats.Game.CurrentTick,
+		TickRate:          stats.Game.TickRate,
+		AvgTickDurationMs: float64(stats.Game.AvgTickDuration) / float64(time.Millisecond),
+		EventQueue: AdminEventQueueItem{
+			High:   stats.EventQueueHigh,
+			Medium: stats.EventQueueMedium,
+			Low:    stats.EventQueueLow,
+		},
+		Shards: make([]AdminShardStatsItem, 0, len(stats.Shards)),
+	}
+	for _, s := range stats.Shards {
+		resp.Shards = append(resp.Shards, AdminShardStatsItem{
+			Layer:   s.Layer,
+			Players: s.Players,
+			Chunks: AdminChunkStatsItem{
+				Active:       s.Chunks.ActiveCount,
+				Preloaded:    s.Chunks.PreloadedCount,
+				Inactive:     s.Chunks.InactiveCount,
+				LoadRequests: s.Chunks.LoadRequests,
+				SaveRequests: s.Chunks.SaveRequests,
+				CacheHits:    s.Chunks.CacheHits,
+				CacheMisses:  s.Chunks.CacheMisses,
+			},
+			PlayerInbox: AdminInboxStatsItem(s.PlayerInbox),
+			ServerInbox: AdminInboxStatsItem(s.ServerInbox),
+		})
+	}
+	return resp
+}
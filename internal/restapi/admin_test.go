@@ -0,0 +1,230 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"origin/internal/game"
+	"origin/internal/types"
+)
+
+type fakeAdminService struct {
+	moderation []game.ModerationRequest
+	moderr     error
+	gives      []string
+	roles      map[int64]game.AccountRole
+	chatSearch []fakeChatSearch
+}
+
+type fakeChatSearch struct {
+	characterID types.EntityID
+	from, to    time.Time
+	limit       int
+}
+
+func (f *fakeAdminService) AdminOnlinePlayers() []game.AdminOnlinePlayer {
+	return []game.AdminOnlinePlayer{{EntityID: 7, Name: "alice", Layer: 1, X: 10, Y: 20, Connected: true}}
+}
+
+func (f *fakeAdminService) AdminModerate(ctx context.Context, req game.ModerationRequest) (game.ModerationResult, error) {
+	f.moderation = append(f.moderation, req)
+	if f.moderr != nil {
+		return game.ModerationResult{}, f.moderr
+	}
+	return game.ModerationResult{TargetID: 7, Message: "done"}, nil
+}
+
+func (f *fakeAdminService) AdminTeleport(ctx context.Context, playerID types.EntityID, x, y int, targetLayer *int) error {
+	return nil
+}
+
+func (f *fakeAdminService) AdminGiveItem(ctx context.Context, playerID types.EntityID, itemKey string, count, quality uint32) (string, error) {
+	f.gives = append(f.gives, itemKey)
+	return "ok", nil
+}
+
+func (f *fakeAdminService) AdminSetAccountRole(ctx context.Context, accountID int64, role game.AccountRole) error {
+	if f.roles == nil {
+		f.roles = make(map[int64]game.AccountRole)
+	}
+	f.roles[accountID] = role
+	return nil
+}
+
+func (f *fakeAdminService) AdminSaveAll(ctx context.Context) (map[int]string, error) {
+	return map[int]string{0: "1 characters queued for save"}, nil
+}
+
+func (f *fakeAdminService) AdminStats() game.AdminStats {
+	return game.AdminStats{
+		Game:           game.GameStats{TickRate: 10, AvgTickDuration: 5 * time.Millisecond},
+		EventQueueHigh: 3,
+		Shards:         []game.AdminShardStats{{Layer: 0, Players: 2, ServerInbox: game.AdminInboxStats{Received: 4}}},
+	}
+}
+
+func (f *fakeAdminService) AdminSearchChat(ctx context.Context, characterID types.EntityID, from, to time.Time, limit int) ([]game.AdminChatMessage, error) {
+	f.chatSearch = append(f.chatSearch, fakeChatSearch{characterID: characterID, from: from, to: to, limit: limit})
+	return []game.AdminChatMessage{{ID: 1, SenderID: characterID, ReceiverID: 9, Text: "hi", SentAt: from}}, nil
+}
+
+func newAdminTestHandler(service AdminService) *Handler {
+	h := NewHandler(nil, nil, zap.NewNop(), nil)
+	h.SetAdminService(service)
+	return h
+}
+
+func TestHandleAdminBan_DefaultsToPermanent(t *testing.T) {
+	service := &fakeAdminService{}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/players/bob/ban", strings.NewReader(`{"reason":"cheating"}`))
+	req.SetPathValue("player", "bob")
+	rec := httptest.NewRecorder()
+	h.handleAdminBan(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if len(service.moderation) != 1 {
+		t.Fatalf("expected 1 moderation request, got %d", len(service.moderation))
+	}
+	got := service.moderation[0]
+	if got.Action != game.ModerationBan || got.Target != "bob" || got.Duration != 0 || got.Reason != "cheating" {
+		t.Fatalf("unexpected request: %+v", got)
+	}
+}
+
+func TestHandleAdminBan_RequiresReason(t *testing.T) {
+	service := &fakeAdminService{}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/players/bob/ban", strings.NewReader(`{"duration":"7d"}`))
+	rec := httptest.NewRecorder()
+	h.handleAdminBan(rec, req)
+
+	if rec.Code != http.StatusBadRequest || len(service.moderation) != 0 {
+		t.Fatalf("expected 400 without moderation, got %d", rec.Code)
+	}
+}
+
+func TestHandleAdminKick_ModerationErrorIsUnprocessable(t *testing.T) {
+	service := &fakeAdminService{moderr: errors.New("bob is offline")}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/players/bob/kick", nil)
+	req.SetPathValue("player", "bob")
+	rec := httptest.NewRecorder()
+	h.handleAdminKick(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "bob is offline") {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestHandleAdminGive_RejectsInvalidID(t *testing.T) {
+	service := &fakeAdminService{}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/players/bob/give", strings.NewReader(`{"item_key":"apple"}`))
+	req.SetPathValue("id", "bob")
+	rec := httptest.NewRecorder()
+	h.handleAdminGive(rec, req)
+
+	if rec.Code != http.StatusBadRequest || len(service.gives) != 0 {
+		t.Fatalf("expected 400 without give, got %d", rec.Code)
+	}
+}
+
+func TestHandleAdminSetRole(t *testing.T) {
+	service := &fakeAdminService{}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/accounts/12/role", strings.NewReader(`{"role":"moderator"}`))
+	req.SetPathValue("account", "12")
+	rec := httptest.NewRecorder()
+	h.handleAdminSetRole(rec, req)
+	if rec.Code != http.StatusOK || service.roles[12] != game.RoleModerator {
+		t.Fatalf("expected role set, got %d %v: %s", rec.Code, service.roles, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/admin/accounts/12/role", strings.NewReader(`{"role":"root"}`))
+	req.SetPathValue("account", "12")
+	rec = httptest.NewRecorder()
+	h.handleAdminSetRole(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown role, got %d", rec.Code)
+	}
+}
+
+func TestHandleAdminStats(t *testing.T) {
+	h := newAdminTestHandler(&fakeAdminService{})
+
+	rec := httptest.NewRecorder()
+	h.handleAdminStats(rec, httptest.NewRequest(http.MethodGet, "/admin/stats", nil))
+
+	var resp AdminStatsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.TickRate != 10 || resp.AvgTickDurationMs != 5 || resp.EventQueue.High != 3 {
+		t.Fatalf("unexpected stats: %+v", resp)
+	}
+	if len(resp.Shards) != 1 || resp.Shards[0].Players != 2 || resp.Shards[0].ServerInbox.Received != 4 {
+		t.Fatalf("unexpected shard stats: %+v", resp.Shards)
+	}
+}
+
+func TestRegisterRoutes_AdminRoutesRequireAuthorization(t *testing.T) {
+	h := newAdminTestHandler(&fakeAdminService{})
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/stats", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", rec.Code)
+	}
+}
+
+func TestHandleAdminSearchChat(t *testing.T) {
+	service := &fakeAdminService{}
+	h := newAdminTestHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/players/7/chat?from=2026-03-01T00:00:00Z&to=2026-03-02T00:00:00Z&limit=50", nil)
+	req.SetPathValue("id", "7")
+	rec := httptest.NewRecorder()
+	h.handleAdminSearchChat(rec, req)
+	if rec.Code != http.StatusOK || len(service.chatSearch) != 1 {
+		t.Fatalf("expected search, got %d: %s", rec.Code, rec.Body.String())
+	}
+	search := service.chatSearch[0]
+	if search.characterID != 7 || search.limit != 50 ||
+		!search.from.Equal(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)) ||
+		!search.to.Equal(time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected search params %+v", search)
+	}
+	var resp AdminChatSearchResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.List) != 1 || resp.List[0].Text != "hi" || resp.List[0].ReceiverID != 9 {
+		t.Fatalf("unexpected messages %+v", resp.List)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/admin/players/7/chat?from=2026-03-02T00:00:00Z&to=2026-03-01T00:00:00Z", nil)
+	req.SetPathValue("id", "7")
+	rec = httptest.NewRecorder()
+	h.handleAdminSearchChat(rec, req)
+	if rec.Code != http.StatusBadRequest || len(service.chatSearch) != 1 {
+		t.Fatalf("expected 400 for inverted range, got %d", rec.Code)
+	}
+}
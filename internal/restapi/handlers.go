@@ -34,6 +34,7 @@ type Handler struct {
 	entityIDManager *game.EntityIDManager
 	logger          *zap.Logger
 	gameConfig      *config.GameConfig
+	admin           AdminService
 }
 
 func NewHandler(db *persistence.Postgres, entityIDManager *game.EntityIDManager, logger *zap.Logger, gameConfig *config.GameConfig) *Handler {
@@ -56,6 +57,7 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("POST /characters", h.withAuth(h.handleCreateCharacter))
 	mux.HandleFunc("DELETE /characters/{id}", h.withAuth(h.handleDeleteCharacter))
 	mux.HandleFunc("POST /characters/{id}/enter", h.withAuth(h.handleEnterCharacter))
+	h.registerAdminRoutes(mux)
 }
 
 func (h *Handler) withAuth(next http.HandlerFunc) http.HandlerFunc {
@@ -93,6 +95,15 @@ func (h *Handler) getAccountID(r *http.Request) int64 {
 	return r.Context().Value(accountIDKey).(int64)
 }
 
+// accountBannedError is returned by authenticateAndTokenize for banned accounts.
+type accountBannedError struct {
+	message string
+}
+
+func (e *accountBannedError) Error() string {
+	return e.message
+}
+
 func (h *Handler) authenticateAndTokenize(r *http.Request, login, password string) (string, error) {
 	account, err := h.db.Queries().GetAccountByLogin(r.Context(), login)
 	if err != nil {
@@ -103,6 +114,10 @@ func (h *Handler) authenticateAndTokenize(r *http.Request, login, password strin
 		return "", err
 	}
 
+	if banned, msg := game.AccountBanMessage(account, time.Now()); banned {
+		return "", &accountBannedError{message: msg}
+	}
+
 	token, err := generateToken(64)
 	if err != nil {
 		return "", err
@@ -190,6 +205,11 @@ func (h *Handler) handleLogin(w http.ResponseWriter, r *http.Request) {
 			h.jsonError(w, "invalid credentials", http.StatusUnauthorized)
 			return
 		}
+		var bannedErr *accountBannedError
+		if errors.As(err, &bannedErr) {
+			h.jsonError(w, bannedErr.Error(), http.StatusForbidden)
+			return
+		}
 		h.logger.Error("failed to authenticate", zap.Error(err))
 		h.jsonError(w, "internal error", http.StatusInternalServerError)
 		return
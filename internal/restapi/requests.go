@@ -13,3 +13,34 @@ type LoginRequest struct {
 type CreateCharacterRequest struct {
 	Name string `json:"name"`
 }
+
+type AdminKickRequest struct {
+	Reason string `json:"reason"`
+}
+
+type AdminMuteRequest struct {
+	// Duration is a Go duration or days ("30m", "7d"); "0" lifts the mute.
+	Duration string `json:"duration"`
+}
+
+type AdminBanRequest struct {
+	// Duration is a Go duration or days; empty or "perm" bans permanently.
+	Duration string `json:"duration"`
+	Reason   string `json:"reason"`
+}
+
+type AdminTeleportRequest struct {
+	X     int  `json:"x"`
+	Y     int  `json:"y"`
+	Layer *int `json:"layer,omitempty"`
+}
+
+type AdminSetRoleRequest struct {
+	Role string `json:"role"` // player, moderator, gamemaster or admin
+}
+
+type AdminGiveRequest struct {
+	ItemKey string `json:"item_key"`
+	Count   uint32 `json:"count"`
+	Quality uint32 `json:"quality"`
+}
@@ -1,5 +1,7 @@
 package restapi
 
+import "time"
+
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
@@ -21,3 +23,82 @@ type ListCharactersResponse struct {
 type EnterCharacterResponse struct {
 	AuthToken string `json:"auth_token"`
 }
+
+type AdminPlayerItem struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Layer     int    `json:"layer"`
+	X         int    `json:"x"`
+	Y         int    `json:"y"`
+	Connected bool   `json:"connected"`
+}
+
+type AdminPlayersResponse struct {
+	List []AdminPlayerItem `json:"list"`
+}
+
+type AdminResultResponse struct {
+	TargetID int64  `json:"target_id"`
+	Message  string `json:"message"`
+}
+
+type AdminChatMessageItem struct {
+	ID         int64     `json:"id"`
+	Channel    string    `json:"channel"`
+	SenderID   int64     `json:"sender_id,omitempty"`
+	SenderName string    `json:"sender_name,omitempty"`
+	ReceiverID int64     `json:"receiver_id,omitempty"`
+	Layer      int       `json:"layer"`
+	X          int       `json:"x"`
+	Y          int       `json:"y"`
+	Text       string    `json:"text"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+type AdminChatSearchResponse struct {
+	List []AdminChatMessageItem `json:"list"`
+}
+
+type AdminSaveResponse struct {
+	Layers map[int]string `json:"layers"`
+}
+
+type AdminEventQueueItem struct {
+	High   int `json:"high"`
+	Medium int `json:"medium"`
+	Low    int `json:"low"`
+}
+
+type AdminChunkStatsItem struct {
+	Active       int64 `json:"active"`
+	Preloaded    int64 `json:"preloaded"`
+	Inactive     int64 `json:"inactive"`
+	LoadRequests int64 `json:"load_requests"`
+	SaveRequests int64 `json:"save_requests"`
+	CacheHits    int64 `json:"cache_hits"`
+	CacheMisses  int64 `json:"cache_misses"`
+}
+
+type AdminInboxStatsItem struct {
+	Received  uint64 `json:"received"`
+	Dropped   uint64 `json:"dropped"`
+	Processed uint64 `json:"processed"`
+}
+
+type AdminShardStatsItem struct {
+	Layer       int                 `json:"layer"`
+	Players     int                 `json:"players"`
+	Chunks      AdminChunkStatsItem `json:"chunks"`
+	PlayerInbox AdminInboxStatsItem `json:"player_inbox"`
+	ServerInbox AdminInboxStatsItem `json:"server_inbox"`
+}
+
+type AdminStatsResponse struct {
+	ConnectedClients  int                   `json:"connected_clients"`
+	TotalPlayers      int                   `json:"total_players"`
+	CurrentTick       uint64                `json:"current_tick"`
+	TickRate          int                   `json:"tick_rate"`
+	AvgTickDurationMs float64               `json:"avg_tick_duration_ms"`
+	EventQueue        AdminEventQueueItem   `json:"event_queue"`
+	Shards            []AdminShardStatsItem `json:"shards"`
+}
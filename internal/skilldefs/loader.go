@@ -0,0 +1,243 @@
+package skilldefs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"origin/internal/characterattrs"
+
+	"go.uber.org/zap"
+)
+
+type LoadError struct {
+	FilePath string
+	DefID    int
+	Key      string
+	Message  string
+}
+
+func (e *LoadError) Error() string {
+	if e.DefID != 0 && e.Key != "" {
+		return fmt.Sprintf("%s: defId=%d key=%s: %s", e.FilePath, e.DefID, e.Key, e.Message)
+	}
+	if e.DefID != 0 {
+		return fmt.Sprintf("%s: defId=%d: %s", e.FilePath, e.DefID, e.Message)
+	}
+	if e.Key != "" {
+		return fmt.Sprintf("%s: key=%s: %s", e.FilePath, e.Key, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Message)
+}
+
+var reLineComment = regexp.MustCompile(`(?m)//.*$`)
+var reBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+func stripJSONCComments(data []byte) []byte {
+	data = reBlockComment.ReplaceAll(data, nil)
+	data = reLineComment.ReplaceAll(data, nil)
+	return data
+}
+
+func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			logger.Warn("Skill definitions directory not found, using empty registry", zap.String("dir", dir))
+			return NewRegistry(nil), nil
+		}
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext == ".json" || ext == ".jsonc" {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		logger.Info("No skill definitions found", zap.String("dir", dir))
+		return NewRegistry(nil), nil
+	}
+
+	all := make([]SkillDef, 0, 32)
+	seenIDs := make(map[int]string)
+	seenKeys := make(map[string]string)
+	for _, filePath := range files {
+		skills, err := loadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, skill := range skills {
+			if prev, exists := seenIDs[skill.DefID]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					DefID:    skill.DefID,
+					Key:      skill.Key,
+					Message:  fmt.Sprintf("duplicate defId, already defined in %s", prev),
+				}
+			}
+			if prev, exists := seenKeys[skill.Key]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					DefID:    skill.DefID,
+					Key:      skill.Key,
+					Message:  fmt.Sprintf("duplicate key, already defined in %s", prev),
+				}
+			}
+			seenIDs[skill.DefID] = filePath
+			seenKeys[skill.Key] = filePath
+			all = append(all, skill)
+		}
+		logger.Debug("Loaded skill definitions file", zap.String("file", filepath.Base(filePath)), zap.Int("count", len(skills)))
+	}
+
+	if err := validatePrerequisites(all, seenKeys); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Skill definitions loaded", zap.Int("files", len(files)), zap.Int("skills", len(all)))
+	return NewRegistry(all), nil
+}
+
+func loadFile(filePath string) ([]SkillDef, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to read file: %v", err)}
+	}
+
+	data = stripJSONCComments(data)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var file SkillsFile
+	if err := dec.Decode(&file); err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to parse JSON: %v", err)}
+	}
+	if file.Version != 1 {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("unsupported version %d, expected 1", file.Version)}
+	}
+
+	for i := range file.Skills {
+		applyDefaults(&file.Skills[i])
+		if err := validateSkill(&file.Skills[i], filePath); err != nil {
+			return nil, err
+		}
+	}
+
+	return file.Skills, nil
+}
+
+func applyDefaults(s *SkillDef) {
+	s.Key = strings.TrimSpace(s.Key)
+	if strings.TrimSpace(s.Name) == "" {
+		s.Name = s.Key
+	}
+	s.RequiredSkills = normalizeStringSet(s.RequiredSkills)
+}
+
+func validateSkill(s *SkillDef, filePath string) error {
+	if s.DefID <= 0 {
+		return &LoadError{FilePath: filePath, Key: s.Key, Message: "defId must be > 0"}
+	}
+	if s.Key == "" {
+		return &LoadError{FilePath: filePath, DefID: s.DefID, Message: "key is required"}
+	}
+	if s.LPCost < 0 {
+		return &LoadError{FilePath: filePath, DefID: s.DefID, Key: s.Key, Message: "lpCost must be >= 0"}
+	}
+	for name, value := range s.RequiredAttributes {
+		if !slices.Contains(characterattrs.RequiredNames(), name) {
+			return &LoadError{FilePath: filePath, DefID: s.DefID, Key: s.Key, Message: fmt.Sprintf("requiredAttributes unknown attribute %q", name)}
+		}
+		if value < characterattrs.DefaultValue {
+			return &LoadError{FilePath: filePath, DefID: s.DefID, Key: s.Key, Message: fmt.Sprintf("requiredAttributes.%s must be >= %d", name, characterattrs.DefaultValue)}
+		}
+	}
+	return nil
+}
+
+// validatePrerequisites checks that required skills exist across all files and
+// do not form a cycle, which would make every skill of the cycle unlearnable.
+func validatePrerequisites(skills []SkillDef, filesByKey map[string]string) error {
+	byKey := make(map[string]*SkillDef, len(skills))
+	for i := range skills {
+		byKey[skills[i].Key] = &skills[i]
+	}
+	for i := range skills {
+		skill := &skills[i]
+		for _, required := range skill.RequiredSkills {
+			if _, ok := byKey[required]; !ok {
+				return &LoadError{FilePath: filesByKey[skill.Key], DefID: skill.DefID, Key: skill.Key, Message: fmt.Sprintf("requiredSkills unknown skill: %s", required)}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(skills))
+	var visit func(key string) bool
+	visit = func(key string) bool {
+		switch state[key] {
+		case visiting:
+			return false
+		case done:
+			return true
+		}
+		state[key] = visiting
+		for _, required := range byKey[key].RequiredSkills {
+			if !visit(required) {
+				return false
+			}
+		}
+		state[key] = done
+		return true
+	}
+	for i := range skills {
+		skill := &skills[i]
+		if !visit(skill.Key) {
+			return &LoadError{FilePath: filesByKey[skill.Key], DefID: skill.DefID, Key: skill.Key, Message: "requiredSkills form a cycle"}
+		}
+	}
+	return nil
+}
+
+func normalizeStringSet(values []string) []string {
+	if len(values) == 0 {
+		return []string{}
+	}
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		v := strings.TrimSpace(value)
+		if v == "" {
+			continue
+		}
+		if _, exists := seen[v]; exists {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
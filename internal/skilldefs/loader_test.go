@@ -0,0 +1,109 @@
+package skilldefs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"origin/internal/characterattrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writeSkillDefsTestFile(t *testing.T, dir string, name string, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0644))
+}
+
+func TestLoadFromDirectory_Success(t *testing.T) {
+	dir := t.TempDir()
+	writeSkillDefsTestFile(t, dir, "a.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"skills": [
+			{
+				"defId": 2,
+				"key": "carpentry",
+				"name": "Carpentry",
+				"lpCost": 500,
+				"requiredSkills": [" lumberjacking ", "lumberjacking"],
+				"requiredAttributes": { "STR": 3 }
+			}
+		]
+	}`)
+	writeSkillDefsTestFile(t, dir, "b.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"skills": [
+			// name defaults to key
+			{ "defId": 1, "key": "lumberjacking", "lpCost": 100 }
+		]
+	}`)
+
+	reg, err := LoadFromDirectory(dir, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 2, reg.Count())
+
+	carpentry, ok := reg.GetByKey("carpentry")
+	require.True(t, ok)
+	assert.Equal(t, int64(500), carpentry.LPCost)
+	assert.Equal(t, []string{"lumberjacking"}, carpentry.RequiredSkills)
+	assert.Equal(t, map[characterattrs.Name]int{characterattrs.STR: 3}, carpentry.RequiredAttributes)
+
+	lumberjacking, ok := reg.GetByID(1)
+	require.True(t, ok)
+	assert.Equal(t, "lumberjacking", lumberjacking.Name)
+
+	all := reg.All()
+	require.Len(t, all, 2)
+	assert.Equal(t, "carpentry", all[0].Key)
+}
+
+func TestLoadFromDirectory_MissingDirReturnsEmptyRegistry(t *testing.T) {
+	reg, err := LoadFromDirectory(filepath.Join(t.TempDir(), "missing"), zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 0, reg.Count())
+}
+
+func TestLoadFromDirectory_ValidationErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		skills string
+		errMsg string
+	}{
+		{name: "defId", skills: `{"defId": 0, "key": "a"}`, errMsg: "defId must be > 0"},
+		{name: "key", skills: `{"defId": 1, "key": " "}`, errMsg: "key is required"},
+		{name: "lpCost", skills: `{"defId": 1, "key": "a", "lpCost": -1}`, errMsg: "lpCost must be >= 0"},
+		{name: "attribute name", skills: `{"defId": 1, "key": "a", "requiredAttributes": {"LUCK": 2}}`, errMsg: `requiredAttributes unknown attribute "LUCK"`},
+		{name: "attribute value", skills: `{"defId": 1, "key": "a", "requiredAttributes": {"STR": 0}}`, errMsg: "requiredAttributes.STR must be >= 1"},
+		{name: "unknown prerequisite", skills: `{"defId": 1, "key": "a", "requiredSkills": ["b"]}`, errMsg: "requiredSkills unknown skill: b"},
+		{
+			name:   "cycle",
+			skills: `{"defId": 1, "key": "a", "requiredSkills": ["b"]}, {"defId": 2, "key": "b", "requiredSkills": ["a"]}`,
+			errMsg: "requiredSkills form a cycle",
+		},
+		{name: "duplicate key", skills: `{"defId": 1, "key": "a"}, {"defId": 2, "key": "a"}`, errMsg: "duplicate key"},
+		{name: "duplicate defId", skills: `{"defId": 1, "key": "a"}, {"defId": 1, "key": "b"}`, errMsg: "duplicate defId"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeSkillDefsTestFile(t, dir, "skills.jsonc", `{"v": 1, "skills": [`+tc.skills+`]}`)
+
+			_, err := LoadFromDirectory(dir, zap.NewNop())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestLoadFromDirectory_RejectsUnknownFields(t *testing.T) {
+	dir := t.TempDir()
+	writeSkillDefsTestFile(t, dir, "skills.jsonc", `{"v": 1, "skills": [{"defId": 1, "key": "a", "cost": 5}]}`)
+
+	_, err := LoadFromDirectory(dir, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown field")
+}
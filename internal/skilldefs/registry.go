@@ -0,0 +1,86 @@
+package skilldefs
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Registry struct {
+	byID  map[int]*SkillDef
+	byKey map[string]*SkillDef
+	all   []*SkillDef
+}
+
+var (
+	globalRegistry atomic.Pointer[Registry]
+	registryOnce   sync.Once
+)
+
+func NewRegistry(skills []SkillDef) *Registry {
+	r := &Registry{
+		byID:  make(map[int]*SkillDef, len(skills)),
+		byKey: make(map[string]*SkillDef, len(skills)),
+		all:   make([]*SkillDef, 0, len(skills)),
+	}
+	for i := range skills {
+		skill := &skills[i]
+		r.byID[skill.DefID] = skill
+		r.byKey[skill.Key] = skill
+		r.all = append(r.all, skill)
+	}
+	return r
+}
+
+func (r *Registry) GetByID(defID int) (*SkillDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byID[defID]
+	return v, ok
+}
+
+func (r *Registry) GetByKey(key string) (*SkillDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byKey[key]
+	return v, ok
+}
+
+// All returns skills in load order: files sorted by name, entries in file order.
+func (r *Registry) All() []*SkillDef {
+	if r == nil {
+		return nil
+	}
+	out := make([]*SkillDef, len(r.all))
+	copy(out, r.all)
+	return out
+}
+
+func (r *Registry) Count() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.byID)
+}
+
+func SetGlobal(r *Registry) {
+	registryOnce.Do(func() {
+		globalRegistry.Store(r)
+	})
+}
+
+func SetGlobalForTesting(r *Registry) {
+	registryOnce = sync.Once{}
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
+}
+
+func Global() *Registry {
+	return globalRegistry.Load()
+}
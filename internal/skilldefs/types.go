@@ -0,0 +1,25 @@
+package skilldefs
+
+import "origin/internal/characterattrs"
+
+// SkillDef is a skill a character learns once by spending LP.
+// Crafts and builds list skill keys in requiredSkills.
+type SkillDef struct {
+	DefID int    `json:"defId"`
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+
+	// LPCost is deducted from CharacterExperience.LP when the skill is learned.
+	LPCost int64 `json:"lpCost"`
+
+	// RequiredSkills must be learned first.
+	RequiredSkills []string `json:"requiredSkills,omitempty"`
+	// RequiredAttributes are minimum attribute values, e.g. {"STR": 5}.
+	RequiredAttributes map[characterattrs.Name]int `json:"requiredAttributes,omitempty"`
+}
+
+type SkillsFile struct {
+	Version int        `json:"v"`
+	Source  string     `json:"source"`
+	Skills  []SkillDef `json:"skills"`
+}
@@ -0,0 +1,169 @@
+package tiledefs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"origin/internal/types"
+
+	"go.uber.org/zap"
+)
+
+type LoadError struct {
+	FilePath string
+	TileID   int
+	Key      string
+	Message  string
+}
+
+func (e *LoadError) Error() string {
+	if e.TileID != 0 && e.Key != "" {
+		return fmt.Sprintf("%s: tileId=%d key=%s: %s", e.FilePath, e.TileID, e.Key, e.Message)
+	}
+	if e.TileID != 0 {
+		return fmt.Sprintf("%s: tileId=%d: %s", e.FilePath, e.TileID, e.Message)
+	}
+	if e.Key != "" {
+		return fmt.Sprintf("%s: key=%s: %s", e.FilePath, e.Key, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Message)
+}
+
+var reLineComment = regexp.MustCompile(`(?m)//.*$`)
+var reBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+func stripJSONCComments(data []byte) []byte {
+	data = reBlockComment.ReplaceAll(data, nil)
+	data = reLineComment.ReplaceAll(data, nil)
+	return data
+}
+
+func LoadFromDirectory(dir string, logger *zap.Logger) (*Registry, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			logger.Warn("Tile definitions directory not found, using empty registry", zap.String("dir", dir))
+			return NewRegistry(nil), nil
+		}
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext == ".json" || ext == ".jsonc" {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		logger.Info("No tile definitions found", zap.String("dir", dir))
+		return NewRegistry(nil), nil
+	}
+
+	all := make([]TileDef, 0, 16)
+	seenIDs := make(map[int]string)
+	seenKeys := make(map[string]string)
+	for _, filePath := range files {
+		tiles, err := loadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, tile := range tiles {
+			if prev, exists := seenIDs[tile.TileID]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					TileID:   tile.TileID,
+					Key:      tile.Key,
+					Message:  fmt.Sprintf("duplicate tileId, already defined in %s", prev),
+				}
+			}
+			if prev, exists := seenKeys[tile.Key]; exists {
+				return nil, &LoadError{
+					FilePath: filePath,
+					TileID:   tile.TileID,
+					Key:      tile.Key,
+					Message:  fmt.Sprintf("duplicate key, already defined in %s", prev),
+				}
+			}
+			seenIDs[tile.TileID] = filePath
+			seenKeys[tile.Key] = filePath
+			all = append(all, tile)
+		}
+		logger.Debug("Loaded tile definitions file", zap.String("file", filepath.Base(filePath)), zap.Int("count", len(tiles)))
+	}
+
+	logger.Info("Tile definitions loaded", zap.Int("files", len(files)), zap.Int("tiles", len(all)))
+	return NewRegistry(all), nil
+}
+
+func loadFile(filePath string) ([]TileDef, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to read file: %v", err)}
+	}
+
+	data = stripJSONCComments(data)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var file TilesFile
+	if err := dec.Decode(&file); err != nil {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("failed to parse JSON: %v", err)}
+	}
+	if file.Version != 1 {
+		return nil, &LoadError{FilePath: filePath, Message: fmt.Sprintf("unsupported version %d, expected 1", file.Version)}
+	}
+
+	for i := range file.Tiles {
+		applyDefaults(&file.Tiles[i])
+		if err := validateTile(&file.Tiles[i], filePath); err != nil {
+			return nil, err
+		}
+	}
+
+	return file.Tiles, nil
+}
+
+func applyDefaults(t *TileDef) {
+	t.Key = strings.TrimSpace(t.Key)
+	if strings.TrimSpace(t.Name) == "" {
+		t.Name = t.Key
+	}
+	if t.Speed == 0 {
+		t.Speed = 1.0
+	}
+	if t.Stamina == 0 {
+		t.Stamina = 1.0
+	}
+}
+
+func validateTile(t *TileDef, filePath string) error {
+	if !types.IsKnownTileID(t.TileID) {
+		return &LoadError{FilePath: filePath, TileID: t.TileID, Key: t.Key, Message: "unknown tileId"}
+	}
+	if t.Key == "" {
+		return &LoadError{FilePath: filePath, TileID: t.TileID, Message: "key is required"}
+	}
+	if t.Speed < 0 {
+		return &LoadError{FilePath: filePath, TileID: t.TileID, Key: t.Key, Message: "speed must be > 0"}
+	}
+	if t.Stamina < 0 {
+		return &LoadError{FilePath: filePath, TileID: t.TileID, Key: t.Key, Message: "stamina must be > 0"}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package tiledefs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"origin/internal/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writeTileDefsTestFile(t *testing.T, dir string, name string, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0644))
+}
+
+func TestLoadFromDirectory_Success(t *testing.T) {
+	dir := t.TempDir()
+	writeTileDefsTestFile(t, dir, "a.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"tiles": [
+			{ "tileId": 12, "key": "stone_paving", "name": "Stone paving", "speed": 1.3, "stamina": 0.8 }
+		]
+	}`)
+	writeTileDefsTestFile(t, dir, "b.jsonc", `{
+		"v": 1,
+		"source": "test",
+		"tiles": [
+			// stamina defaults to 1
+			{ "tileId": 30, "key": "thicket", "speed": 0.7 }
+		]
+	}`)
+
+	reg, err := LoadFromDirectory(dir, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 2, reg.Count())
+
+	paving, ok := reg.GetByKey("stone_paving")
+	require.True(t, ok)
+	assert.Equal(t, types.TileStonePaving, paving.TileID)
+
+	thicket, ok := reg.GetByTileID(types.TileThicket)
+	require.True(t, ok)
+	assert.Equal(t, "thicket", thicket.Name)
+	assert.Equal(t, 1.0, thicket.Stamina)
+
+	assert.Equal(t, 1.3, reg.SpeedMultiplier(types.TileStonePaving))
+	assert.Equal(t, 0.8, reg.StaminaMultiplier(types.TileStonePaving))
+	assert.Equal(t, 0.7, reg.SpeedMultiplier(types.TileThicket))
+	assert.Equal(t, 1.0, reg.SpeedMultiplier(types.TileGrass))
+	assert.Equal(t, 1.0, reg.StaminaMultiplier(types.TileGrass))
+
+	all := reg.All()
+	require.Len(t, all, 2)
+	assert.Equal(t, "stone_paving", all[0].Key)
+}
+
+func TestRegistry_NilUsesBaseMultipliers(t *testing.T) {
+	var reg *Registry
+	assert.Equal(t, 1.0, reg.SpeedMultiplier(types.TileStonePaving))
+	assert.Equal(t, 1.0, reg.StaminaMultiplier(types.TileStonePaving))
+	assert.Equal(t, 0, reg.Count())
+}
+
+func TestLoadFromDirectory_MissingDirReturnsEmptyRegistry(t *testing.T) {
+	reg, err := LoadFromDirectory(filepath.Join(t.TempDir(), "missing"), zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 0, reg.Count())
+}
+
+func TestLoadFromDirectory_ValidationErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		tiles  string
+		errMsg string
+	}{
+		{name: "tileId", tiles: `{"tileId": 2, "key": "a"}`, errMsg: "unknown tileId"},
+		{name: "key", tiles: `{"tileId": 12, "key": " "}`, errMsg: "key is required"},
+		{name: "speed", tiles: `{"tileId": 12, "key": "a", "speed": -1}`, errMsg: "speed must be > 0"},
+		{name: "stamina", tiles: `{"tileId": 12, "key": "a", "stamina": -0.5}`, errMsg: "stamina must be > 0"},
+		{name: "duplicate key", tiles: `{"tileId": 12, "key": "a"}, {"tileId": 14, "key": "a"}`, errMsg: "duplicate key"},
+		{name: "duplicate tileId", tiles: `{"tileId": 12, "key": "a"}, {"tileId": 12, "key": "b"}`, errMsg: "duplicate tileId"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTileDefsTestFile(t, dir, "tiles.jsonc", `{"v": 1, "tiles": [`+tc.tiles+`]}`)
+
+			_, err := LoadFromDirectory(dir, zap.NewNop())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestLoadFromDirectory_RejectsUnknownFields(t *testing.T) {
+	dir := t.TempDir()
+	writeTileDefsTestFile(t, dir, "tiles.jsonc", `{"v": 1, "tiles": [{"tileId": 12, "key": "a", "cost": 5}]}`)
+
+	_, err := LoadFromDirectory(dir, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown field")
+}
@@ -0,0 +1,103 @@
+package tiledefs
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Registry struct {
+	byTileID [256]*TileDef
+	byKey    map[string]*TileDef
+	all      []*TileDef
+}
+
+var (
+	globalRegistry atomic.Pointer[Registry]
+	registryOnce   sync.Once
+)
+
+func NewRegistry(tiles []TileDef) *Registry {
+	r := &Registry{
+		byKey: make(map[string]*TileDef, len(tiles)),
+		all:   make([]*TileDef, 0, len(tiles)),
+	}
+	for i := range tiles {
+		tile := &tiles[i]
+		if tile.TileID >= 0 && tile.TileID < len(r.byTileID) {
+			r.byTileID[tile.TileID] = tile
+		}
+		r.byKey[tile.Key] = tile
+		r.all = append(r.all, tile)
+	}
+	return r
+}
+
+func (r *Registry) GetByTileID(tileID byte) (*TileDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v := r.byTileID[tileID]
+	return v, v != nil
+}
+
+func (r *Registry) GetByKey(key string) (*TileDef, bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, ok := r.byKey[key]
+	return v, ok
+}
+
+// SpeedMultiplier returns the tile speed multiplier, 1 for tiles without a definition.
+func (r *Registry) SpeedMultiplier(tileID byte) float64 {
+	if tile, ok := r.GetByTileID(tileID); ok {
+		return tile.Speed
+	}
+	return 1.0
+}
+
+// StaminaMultiplier returns the tile stamina cost multiplier, 1 for tiles without a definition.
+func (r *Registry) StaminaMultiplier(tileID byte) float64 {
+	if tile, ok := r.GetByTileID(tileID); ok {
+		return tile.Stamina
+	}
+	return 1.0
+}
+
+// All returns tiles in load order: files sorted by name, entries in file order.
+func (r *Registry) All() []*TileDef {
+	if r == nil {
+		return nil
+	}
+	out := make([]*TileDef, len(r.all))
+	copy(out, r.all)
+	return out
+}
+
+func (r *Registry) Count() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.all)
+}
+
+func SetGlobal(r *Registry) {
+	registryOnce.Do(func() {
+		globalRegistry.Store(r)
+	})
+}
+
+func SetGlobalForTesting(r *Registry) {
+	registryOnce = sync.Once{}
+	globalRegistry.Store(r)
+}
+
+// Replace swaps the global registry on hot reload.
+// Callers must swap between ticks so a tick never observes two different registries.
+func Replace(r *Registry) {
+	globalRegistry.Store(r)
+}
+
+func Global() *Registry {
+	return globalRegistry.Load()
+}
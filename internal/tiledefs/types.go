@@ -0,0 +1,21 @@
+package tiledefs
+
+// TileDef sets how a ground tile affects movement. Tiles without a definition
+// move at base speed and cost base stamina.
+type TileDef struct {
+	// TileID is one of the types.Tile* ids stored in chunk tiles.
+	TileID int    `json:"tileId"`
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+
+	// Speed multiplies the movement mode speed; omitted means 1.
+	Speed float64 `json:"speed,omitempty"`
+	// Stamina multiplies the movement stamina cost per tick; omitted means 1.
+	Stamina float64 `json:"stamina,omitempty"`
+}
+
+type TilesFile struct {
+	Version int       `json:"v"`
+	Source  string    `json:"source"`
+	Tiles   []TileDef `json:"tiles"`
+}
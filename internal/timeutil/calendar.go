@@ -0,0 +1,126 @@
+package timeutil
+
+import "time"
+
+// Season of the in-game year. The zero value is spring, the season a new world starts in.
+type Season uint8
+
+const (
+	SeasonSpring Season = iota
+	SeasonSummer
+	SeasonAutumn
+	SeasonWinter
+
+	SeasonsPerYear = 4
+)
+
+func (s Season) String() string {
+	switch s {
+	case SeasonSpring:
+		return "spring"
+	case SeasonSummer:
+		return "summer"
+	case SeasonAutumn:
+		return "autumn"
+	case SeasonWinter:
+		return "winter"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// calendarStartHour is the time of day at runtime zero, so a new world starts in the morning.
+	calendarStartHour = 8
+
+	dawnStartHour = 5
+	dawnEndHour   = 7
+	duskStartHour = 19
+	duskEndHour   = 21
+)
+
+// Calendar maps server runtime seconds to the in-game day and season.
+// Runtime only advances while the server runs, so the world clock does not jump after downtime.
+type Calendar struct {
+	DayLength  time.Duration
+	SeasonDays int
+}
+
+func NewCalendar(dayLength time.Duration, seasonDays int) Calendar {
+	if dayLength < time.Second {
+		dayLength = time.Second
+	}
+	if seasonDays <= 0 {
+		seasonDays = 1
+	}
+	return Calendar{DayLength: dayLength, SeasonDays: seasonDays}
+}
+
+// At returns the world time after runtimeSeconds of server runtime.
+func (c Calendar) At(runtimeSeconds int64) WorldTime {
+	dayLengthSeconds := int64(c.DayLength / time.Second)
+	if dayLengthSeconds <= 0 || c.SeasonDays <= 0 {
+		return WorldTime{}
+	}
+	if runtimeSeconds < 0 {
+		runtimeSeconds = 0
+	}
+
+	elapsed := runtimeSeconds + dayLengthSeconds*calendarStartHour/24
+	day := elapsed / dayLengthSeconds
+	seasonIndex := day / int64(c.SeasonDays)
+	return WorldTime{
+		Day:              day,
+		SecondOfDay:      elapsed % dayLengthSeconds,
+		DayLengthSeconds: dayLengthSeconds,
+		Season:           Season(seasonIndex % SeasonsPerYear),
+		DayOfSeason:      int(day % int64(c.SeasonDays)),
+		SeasonDays:       c.SeasonDays,
+		Year:             seasonIndex / SeasonsPerYear,
+	}
+}
+
+// WorldTime is a point of the in-game calendar. Day, DayOfSeason and Year count from 0.
+// The zero value has no day length and reads as permanent daylight.
+type WorldTime struct {
+	Day              int64
+	SecondOfDay      int64 // runtime seconds since the in-game midnight
+	DayLengthSeconds int64
+	Season           Season
+	DayOfSeason      int
+	SeasonDays       int
+	Year             int64
+}
+
+// DayFraction returns the time of day in [0, 1), 0 being midnight.
+func (t WorldTime) DayFraction() float64 {
+	if t.DayLengthSeconds <= 0 {
+		return float64(calendarStartHour) / 24
+	}
+	return float64(t.SecondOfDay) / float64(t.DayLengthSeconds)
+}
+
+// Hour returns the in-game hour in [0, 24).
+func (t WorldTime) Hour() int {
+	return int(t.DayFraction() * 24)
+}
+
+// Daylight returns 1 during the day, 0 at night, ramping linearly through dawn and dusk.
+func (t WorldTime) Daylight() float64 {
+	hour := t.DayFraction() * 24
+	switch {
+	case hour < dawnStartHour || hour >= duskEndHour:
+		return 0
+	case hour < dawnEndHour:
+		return (hour - dawnStartHour) / (dawnEndHour - dawnStartHour)
+	case hour < duskStartHour:
+		return 1
+	default:
+		return (duskEndHour - hour) / (duskEndHour - duskStartHour)
+	}
+}
+
+// IsNight reports whether it is darker than halfway through dawn or dusk.
+func (t WorldTime) IsNight() bool {
+	return t.Daylight() < 0.5
+}
@@ -0,0 +1,58 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendar_At(t *testing.T) {
+	calendar := NewCalendar(24*time.Minute, 3)
+
+	start := calendar.At(0)
+	if start.Day != 0 || start.Hour() != calendarStartHour || start.Season != SeasonSpring || start.Year != 0 {
+		t.Fatalf("expected a new world to start on the morning of spring day 0, got %+v", start)
+	}
+	if start.IsNight() {
+		t.Fatalf("expected daylight at the start hour")
+	}
+
+	// 16 minutes later is midnight of day 1.
+	midnight := calendar.At(16 * 60)
+	if midnight.Day != 1 || midnight.SecondOfDay != 0 || !midnight.IsNight() || midnight.Daylight() != 0 {
+		t.Fatalf("expected night at midnight of day 1, got %+v", midnight)
+	}
+
+	// Day 10 of a 3-day season calendar is day 1 of winter in year 0.
+	winter := calendar.At(10*24*60 - 8*60)
+	if winter.Day != 10 || winter.Season != SeasonWinter || winter.DayOfSeason != 1 || winter.Year != 0 {
+		t.Fatalf("expected winter day 1, got %+v", winter)
+	}
+	nextYear := calendar.At(12*24*60 - 8*60)
+	if nextYear.Season != SeasonSpring || nextYear.Year != 1 || nextYear.DayOfSeason != 0 {
+		t.Fatalf("expected spring of year 1, got %+v", nextYear)
+	}
+}
+
+func TestWorldTime_DaylightRampsThroughDawnAndDusk(t *testing.T) {
+	at := func(hour float64) WorldTime {
+		return WorldTime{SecondOfDay: int64(hour * 3600), DayLengthSeconds: 24 * 3600}
+	}
+	cases := []struct {
+		hour     float64
+		daylight float64
+	}{
+		{hour: 2, daylight: 0},
+		{hour: 6, daylight: 0.5},
+		{hour: 12, daylight: 1},
+		{hour: 20.5, daylight: 0.25},
+		{hour: 22, daylight: 0},
+	}
+	for _, tc := range cases {
+		if got := at(tc.hour).Daylight(); got != tc.daylight {
+			t.Fatalf("expected daylight %v at %v h, got %v", tc.daylight, tc.hour, got)
+		}
+	}
+	if (WorldTime{}).Daylight() != 1 {
+		t.Fatalf("expected the zero world time to read as daylight")
+	}
+}
@@ -0,0 +1,153 @@
+package weather
+
+import (
+	"math"
+
+	"origin/internal/timeutil"
+	"origin/internal/types"
+)
+
+// Type is the weather over a chunk. Values match netproto.WeatherType.
+type Type uint8
+
+const (
+	Clear Type = iota
+	Rain
+	Fog
+	Storm
+	Snow
+)
+
+func (t Type) String() string {
+	switch t {
+	case Clear:
+		return "clear"
+	case Rain:
+		return "rain"
+	case Fog:
+		return "fog"
+	case Storm:
+		return "storm"
+	case Snow:
+		return "snow"
+	default:
+		return "unknown"
+	}
+}
+
+// VisionPowerScale is the multiplier applied to an observer's vision power.
+func (t Type) VisionPowerScale() float64 {
+	switch t {
+	case Fog:
+		return 0.5
+	case Storm:
+		return 0.75
+	default:
+		return 1
+	}
+}
+
+// StaminaCostScale is the multiplier applied to movement stamina costs.
+func (t Type) StaminaCostScale() float64 {
+	switch t {
+	case Rain:
+		return 1.2
+	case Snow:
+		return 1.3
+	case Storm:
+		return 1.4
+	default:
+		return 1
+	}
+}
+
+// PutsOutFires reports whether lit fires exposed to this weather go out.
+func (t Type) PutsOutFires() bool {
+	return t == Storm
+}
+
+const (
+	// periodHours is how long one weather roll of a cell lasts, in in-game hours.
+	periodHours = 6
+	// driftCellsPerDay is how far weather cells travel with the wind per in-game day.
+	driftCellsPerDay = 1.0
+)
+
+type chance struct {
+	weather Type
+	weight  float64
+}
+
+// seasonChances are the odds of each weather per roll. Weights of a season sum to 1.
+var seasonChances = [timeutil.SeasonsPerYear][]chance{
+	timeutil.SeasonSpring: {{Clear, 0.55}, {Rain, 0.25}, {Fog, 0.12}, {Storm, 0.08}},
+	timeutil.SeasonSummer: {{Clear, 0.65}, {Rain, 0.15}, {Fog, 0.05}, {Storm, 0.15}},
+	timeutil.SeasonAutumn: {{Clear, 0.40}, {Rain, 0.30}, {Fog, 0.20}, {Storm, 0.10}},
+	timeutil.SeasonWinter: {{Clear, 0.45}, {Snow, 0.35}, {Fog, 0.15}, {Storm, 0.05}},
+}
+
+// Model is a deterministic regional weather simulation. The world is split into square
+// cells of CellChunks chunks that drift with a seed-derived wind; every cell rolls its
+// weather once per period from the season's odds. Weather is a pure function of the seed,
+// the chunk and the world time, so it needs no persistence and is the same on every restart.
+// The zero value is always clear.
+type Model struct {
+	Seed       int64
+	CellChunks int
+	driftX     float64 // chunks per in-game day
+	driftY     float64
+}
+
+func NewModel(seed int64, cellChunks int) Model {
+	if cellChunks <= 0 {
+		return Model{Seed: seed}
+	}
+	angle := unitFloat(mix(uint64(seed), 0x77696e64)) * 2 * math.Pi
+	speed := driftCellsPerDay * float64(cellChunks)
+	return Model{
+		Seed:       seed,
+		CellChunks: cellChunks,
+		driftX:     math.Cos(angle) * speed,
+		driftY:     math.Sin(angle) * speed,
+	}
+}
+
+// At returns the weather over the chunk at the given world time.
+func (m Model) At(coord types.ChunkCoord, t timeutil.WorldTime) Type {
+	if m.CellChunks <= 0 || t.DayLengthSeconds <= 0 {
+		return Clear
+	}
+
+	days := float64(t.Day) + t.DayFraction()
+	cellX := int64(math.Floor((float64(coord.X) + 0.5 - m.driftX*days) / float64(m.CellChunks)))
+	cellY := int64(math.Floor((float64(coord.Y) + 0.5 - m.driftY*days) / float64(m.CellChunks)))
+	period := (t.Day*24 + int64(t.Hour())) / periodHours
+
+	h := mix(uint64(m.Seed), uint64(cellX))
+	h = mix(h, uint64(cellY))
+	h = mix(h, uint64(period))
+	return pick(seasonChances[t.Season%timeutil.SeasonsPerYear], unitFloat(h))
+}
+
+func pick(chances []chance, roll float64) Type {
+	for _, c := range chances {
+		if roll < c.weight {
+			return c.weather
+		}
+		roll -= c.weight
+	}
+	return chances[len(chances)-1].weather
+}
+
+// mix folds v into h with a splitmix64 finalizer.
+func mix(h, v uint64) uint64 {
+	z := h ^ (v + 0x9e3779b97f4a7c15 + (h << 6) + (h >> 2))
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// unitFloat maps h to [0, 1).
+func unitFloat(h uint64) float64 {
+	return float64(h>>11) / (1 << 53)
+}
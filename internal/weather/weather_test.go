@@ -0,0 +1,120 @@
+package weather
+
+import (
+	"testing"
+	"time"
+
+	"origin/internal/timeutil"
+	"origin/internal/types"
+)
+
+func worldTimeAt(day int64, hour int64, season timeutil.Season) timeutil.WorldTime {
+	return timeutil.WorldTime{
+		Day:              day,
+		SecondOfDay:      hour * 3600,
+		DayLengthSeconds: 24 * 3600,
+		Season:           season,
+		SeasonDays:       7,
+	}
+}
+
+func TestModel_ZeroValueIsClear(t *testing.T) {
+	var m Model
+	if got := m.At(types.ChunkCoord{X: 3, Y: 4}, worldTimeAt(2, 12, timeutil.SeasonAutumn)); got != Clear {
+		t.Fatalf("zero model weather = %v, want clear", got)
+	}
+
+	m = NewModel(42, 8)
+	if got := m.At(types.ChunkCoord{X: 3, Y: 4}, timeutil.WorldTime{}); got != Clear {
+		t.Fatalf("weather without calendar = %v, want clear", got)
+	}
+}
+
+func TestModel_IsDeterministic(t *testing.T) {
+	a := NewModel(42, 8)
+	b := NewModel(42, 8)
+	other := NewModel(43, 8)
+
+	differs := false
+	for day := int64(0); day < 8; day++ {
+		for x := 0; x < 40; x += 3 {
+			coord := types.ChunkCoord{X: x, Y: x / 2}
+			wt := worldTimeAt(day, 10, timeutil.SeasonSpring)
+			if a.At(coord, wt) != b.At(coord, wt) {
+				t.Fatalf("same seed gave different weather at %v day %d", coord, day)
+			}
+			if a.At(coord, wt) != other.At(coord, wt) {
+				differs = true
+			}
+		}
+	}
+	if !differs {
+		t.Fatal("different seeds gave identical weather everywhere")
+	}
+}
+
+func TestModel_ChunksOfOneCellShareWeather(t *testing.T) {
+	m := Model{Seed: 7, CellChunks: 8}
+	for day := int64(0); day < 4; day++ {
+		wt := worldTimeAt(day, 13, timeutil.SeasonAutumn)
+		want := m.At(types.ChunkCoord{X: 16, Y: 24}, wt)
+		for dx := 0; dx < 8; dx++ {
+			for dy := 0; dy < 8; dy++ {
+				if got := m.At(types.ChunkCoord{X: 16 + dx, Y: 24 + dy}, wt); got != want {
+					t.Fatalf("day %d chunk (%d,%d): weather %v, want %v", day, 16+dx, 24+dy, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestModel_SeasonOdds(t *testing.T) {
+	m := NewModel(1, 4)
+	for season := timeutil.Season(0); season < timeutil.SeasonsPerYear; season++ {
+		counts := make(map[Type]int)
+		for day := int64(0); day < 20; day++ {
+			for hour := int64(0); hour < 24; hour += periodHours {
+				for x := 0; x < 100; x += 4 {
+					counts[m.At(types.ChunkCoord{X: x, Y: x}, worldTimeAt(day, hour, season))]++
+				}
+			}
+		}
+
+		if season == timeutil.SeasonWinter {
+			if counts[Rain] != 0 || counts[Snow] == 0 {
+				t.Fatalf("winter: rain %d, snow %d; want only snow", counts[Rain], counts[Snow])
+			}
+		} else if counts[Snow] != 0 || counts[Rain] == 0 {
+			t.Fatalf("%v: rain %d, snow %d; want only rain", season, counts[Rain], counts[Snow])
+		}
+		if counts[Clear] == 0 || counts[Fog] == 0 || counts[Storm] == 0 {
+			t.Fatalf("%v: missing weather kinds: %v", season, counts)
+		}
+	}
+}
+
+func TestModel_WeatherChangesOverTime(t *testing.T) {
+	m := NewModel(99, 8)
+	calendar := timeutil.NewCalendar(2*time.Hour, 7)
+	coord := types.ChunkCoord{X: 10, Y: 10}
+
+	seen := make(map[Type]struct{})
+	for runtime := int64(0); runtime < 7*2*3600; runtime += 600 {
+		seen[m.At(coord, calendar.At(runtime))] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Fatalf("weather over a week never changed: %v", seen)
+	}
+}
+
+func TestTypeHooks(t *testing.T) {
+	if Fog.VisionPowerScale() >= 1 || Clear.VisionPowerScale() != 1 {
+		t.Fatalf("vision scale: fog %v, clear %v", Fog.VisionPowerScale(), Clear.VisionPowerScale())
+	}
+	if Rain.StaminaCostScale() <= 1 || Clear.StaminaCostScale() != 1 {
+		t.Fatalf("stamina scale: rain %v, clear %v", Rain.StaminaCostScale(), Clear.StaminaCostScale())
+	}
+	if !Storm.PutsOutFires() || Rain.PutsOutFires() {
+		t.Fatal("only storms should put out fires")
+	}
+}
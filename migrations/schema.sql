@@ -9,6 +9,10 @@ CREATE TABLE IF NOT EXISTS account
     login          VARCHAR(128) UNIQUE NOT NULL,
     password_hash  text                NOT NULL,
     status         SMALLINT    DEFAULT 0, -- 0=active, 1=banned, 2=suspended
+    role           SMALLINT            NOT NULL DEFAULT 0, -- 0=player, 1=moderator, 2=gamemaster, 3=admin
+    banned_until   TIMESTAMPTZ         NULL,               -- status=1: NULL = permanent ban
+    ban_reason     TEXT                NULL,
+    muted_until    TIMESTAMPTZ         NULL,               -- chat mute for all characters of the account
     token          text unique,
     last_logged_at TIMESTAMPTZ         NULL,
     created_at     TIMESTAMPTZ DEFAULT now(),
@@ -18,6 +22,23 @@ CREATE INDEX idx_account_status ON account (status) WHERE status != 0;
 -- для аналитики активных
 CREATE INDEX idx_account_last_logged ON account (last_logged_at);
 
+-- ADMIN AUDIT ---------------------------------------------------------
+-- every privileged (slash) command invocation, including denied ones
+CREATE TABLE IF NOT EXISTS admin_audit
+(
+    id           BIGSERIAL PRIMARY KEY,
+    actor_id     BIGINT      NOT NULL, -- character id, account id for api:* commands
+    actor_role   SMALLINT    NOT NULL,
+    target_id    BIGINT      NULL,     -- affected character/entity id
+    command      VARCHAR(32) NOT NULL,
+    args         TEXT        NOT NULL,
+    outcome      SMALLINT    NOT NULL, -- 0=success, 1=denied, 2=failed
+    result       TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_admin_audit_actor ON admin_audit (actor_id, created_at DESC);
+CREATE INDEX idx_admin_audit_target ON admin_audit (target_id, created_at DESC) WHERE target_id IS NOT NULL;
+
 -- CHARACTER (player) -----------------------------------------------------------
 
 CREATE TABLE IF NOT EXISTS character
@@ -182,8 +203,9 @@ CREATE TABLE IF NOT EXISTS chat
 (
     id          BIGSERIAL,
     channel     SMALLINT    NOT NULL,
-    sender_id   BIGINT      NOT NULL REFERENCES character (id),
-    receiver_id BIGINT,               -- для whisper
+    sender_id   BIGINT REFERENCES character (id), -- NULL for server messages (announcements)
+    sender_name VARCHAR(128),                     -- server messages only: announcer name
+    receiver_id BIGINT,                           -- для whisper
 
     region      INT         NOT NULL,
     x           INT         NOT NULL, -- where was said (object coord)
@@ -194,6 +216,7 @@ CREATE TABLE IF NOT EXISTS chat
     created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
     PRIMARY KEY (id, created_at)
 ) PARTITION BY RANGE (created_at);
+-- Monthly partitions chat_yYYYYmMM are created/dropped by the game server chat retention job.
 CREATE TABLE chat_default
     PARTITION OF chat DEFAULT;
 